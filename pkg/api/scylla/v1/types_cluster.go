@@ -87,6 +87,7 @@ type ScyllaClusterSpec struct {
 
 	// scyllaArgs will be appended to Scylla binary during startup.
 	// This is supported from 4.2.0 Scylla version.
+	// Options managed by the operator, like cluster_name or endpoint_snitch, can't be added.
 	// +optional
 	ScyllaArgs string `json:"scyllaArgs,omitempty"`
 
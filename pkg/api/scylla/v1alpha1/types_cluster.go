@@ -51,6 +51,47 @@ type ScyllaDBClusterSpec struct {
 	// datacenters specify the datacenters in the cluster.
 	Datacenters []ScyllaDBClusterDatacenter `json:"datacenters"`
 
+	// replicationOptions specify keyspaces which replication is managed by the Operator when datacenters are added
+	// to or removed from the cluster.
+	// +optional
+	ReplicationOptions *ScyllaDBClusterReplicationOptions `json:"replicationOptions,omitempty"`
+
+	// lostDatacenters specify datacenters which were irrecoverably lost together with their remote Kubernetes cluster.
+	// A lost datacenter has to be removed from datacenters at the same time. Through one of the remaining datacenters,
+	// the Operator drops the lost datacenter from replication of keyspaces specified in replicationOptions,
+	// and then removes its nodes from the cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	LostDatacenters []ScyllaDBClusterLostDatacenter `json:"lostDatacenters,omitempty"`
+
+	// import specifies an existing ScyllaDB cluster running outside of Kubernetes which is migrated to this ScyllaDBCluster.
+	// The datacenters join the existing cluster through scyllaDB.externalSeeds and are rebuilt from its datacenter
+	// using rebuildFrom. Before rebuilding, the Operator validates that the joined nodes report the expected cluster name
+	// and snitch, and that the external seeds belong to the external datacenter according to the gossip state of the nodes.
+	// The API of the external seeds isn't queried. Once clients are moved over, the Operator removes the external datacenter from the replication
+	// of managed keyspaces, after which it can be shut down.
+	// +optional
+	Import *ScyllaDBClusterImport `json:"import,omitempty"`
+
+	// connectivityProbes specify periodic probes checking network connectivity between datacenters.
+	// When set, the Operator periodically connects from every datacenter to the broadcast addresses of nodes
+	// of the other datacenters and reports their reachability in status.
+	// The period and timeout also apply to probes of external seeds, which are always run when external seeds are specified.
+	// +optional
+	ConnectivityProbes *ScyllaDBClusterConnectivityProbes `json:"connectivityProbes,omitempty"`
+
+	// seedPolicy specifies how nodes of other datacenters are selected as seeds of a datacenter.
+	// If not set, all nodes of the other datacenters are used as seeds.
+	// +optional
+	SeedPolicy *ScyllaDBClusterSeedPolicy `json:"seedPolicy,omitempty"`
+
+	// rolloutStrategy specifies how changes are rolled out to datacenters.
+	// When set, datacenters are updated in stages and a datacenter is updated only once the datacenters
+	// preceding it are fully updated and available. When not set, all datacenters are updated at the same time.
+	// +optional
+	RolloutStrategy *ScyllaDBClusterRolloutStrategy `json:"rolloutStrategy,omitempty"`
+
 	// disableAutomaticOrphanedNodeReplacement controls if automatic orphan node replacement should be disabled.
 	DisableAutomaticOrphanedNodeReplacement bool `json:"disableAutomaticOrphanedNodeReplacement,omitempty"`
 
@@ -91,6 +132,108 @@ type ScyllaDBClusterDatacenter struct {
 	// forceRedeploymentReason can be used to force a rolling restart of all racks in this DC by providing a unique string.
 	// +optional
 	ForceRedeploymentReason *string `json:"forceRedeploymentReason,omitempty"`
+
+	// rebuildFrom is the name of an existing datacenter used as a source of data when this datacenter joins the cluster.
+	// When set, once the datacenter bootstraps, the Operator alters the replication of managed keyspaces to include it,
+	// rebuilds every node from the source datacenter and repairs the managed keyspaces.
+	// +optional
+	RebuildFrom *string `json:"rebuildFrom,omitempty"`
+
+	// decommission controls whether this datacenter should be removed from the cluster.
+	// When set, the Operator reduces the replication of managed keyspaces in this datacenter to zero and
+	// decommissions all its nodes. The datacenter can be removed from the spec once the removal completes.
+	// +optional
+	Decommission bool `json:"decommission,omitempty"`
+}
+
+// ScyllaDBClusterLostDatacenter specifies a datacenter which is no longer reachable.
+type ScyllaDBClusterLostDatacenter struct {
+	// name is the name of the lost datacenter.
+	Name string `json:"name"`
+}
+
+// ScyllaDBClusterImport specifies an existing ScyllaDB cluster imported into the ScyllaDBCluster.
+type ScyllaDBClusterImport struct {
+	// externalDatacenter is the name of the datacenter of the existing cluster.
+	ExternalDatacenter string `json:"externalDatacenter"`
+
+	// clientsMigrated confirms that clients were moved over to the datacenters managed by the Operator.
+	// The external datacenter is removed from the replication of managed keyspaces only once it's set.
+	// +optional
+	ClientsMigrated bool `json:"clientsMigrated,omitempty"`
+}
+
+// ScyllaDBClusterConnectivityProbes hold settings of connectivity probes between datacenters.
+type ScyllaDBClusterConnectivityProbes struct {
+	// periodSeconds specifies how often the probes are run.
+	// +kubebuilder:default:=300
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// timeoutSeconds specifies the timeout of a single connection attempt.
+	// +kubebuilder:default:=5
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ScyllaDBClusterSeedPolicy specifies how nodes of other datacenters are selected as seeds.
+// Datacenters without any node eligible to be a seed aren't used as seeds, unless none of the other datacenters has one.
+type ScyllaDBClusterSeedPolicy struct {
+	// seedsPerRack specifies the maximum number of nodes of each rack used as seeds.
+	// Nodes are selected in the order of their names. If not set, all eligible nodes of a rack are used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SeedsPerRack *int32 `json:"seedsPerRack,omitempty"`
+
+	// preferredRacks specifies racks which nodes are used as seeds.
+	// Nodes of other racks are used only when none of the preferred racks of a datacenter has an eligible node.
+	// +listType=set
+	// +optional
+	PreferredRacks []string `json:"preferredRacks,omitempty"`
+
+	// excludeNodesUnderMaintenance controls whether nodes under maintenance are excluded from seeds.
+	// +optional
+	ExcludeNodesUnderMaintenance bool `json:"excludeNodesUnderMaintenance,omitempty"`
+}
+
+// ScyllaDBClusterRolloutStrategy specifies how changes are rolled out to datacenters.
+type ScyllaDBClusterRolloutStrategy struct {
+	// datacenterOrder specifies the order in which datacenters are updated.
+	// Datacenters which aren't listed are updated after the listed ones, in the order of spec.datacenters.
+	// +listType=set
+	// +optional
+	DatacenterOrder []string `json:"datacenterOrder,omitempty"`
+
+	// maxConcurrentDatacenters specifies the maximum number of datacenters updated at the same time.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentDatacenters int32 `json:"maxConcurrentDatacenters,omitempty"`
+}
+
+// ScyllaDBClusterReplicationOptions hold options related to managing keyspace replication.
+type ScyllaDBClusterReplicationOptions struct {
+	// keyspaces specify keyspaces which replication is altered when datacenters are added or removed.
+	// +optional
+	Keyspaces []ScyllaDBClusterKeyspaceReplication `json:"keyspaces,omitempty"`
+
+	// cqlCredentialsSecretRef references a Secret containing `username` and `password` keys used to authenticate
+	// CQL connections altering keyspace replication.
+	// If not provided, connections are not authenticated.
+	// +optional
+	CQLCredentialsSecretRef *LocalObjectReference `json:"cqlCredentialsSecretRef,omitempty"`
+}
+
+// ScyllaDBClusterKeyspaceReplication specifies replication of a keyspace managed by the Operator.
+type ScyllaDBClusterKeyspaceReplication struct {
+	// name is the name of the keyspace.
+	Name string `json:"name"`
+
+	// replicationFactor is the replication factor of the keyspace in every datacenter.
+	// +kubebuilder:validation:Minimum=1
+	ReplicationFactor int32 `json:"replicationFactor"`
 }
 
 type ScyllaDBClusterDatacenterTemplate struct {
@@ -236,6 +379,120 @@ type ScyllaDBClusterDatacenterStatus struct {
 	// racks contains rack statuses.
 	// +optional
 	Racks []ScyllaDBClusterRackStatus `json:"racks,omitempty"`
+
+	// operation describes the progress of adding this datacenter to or removing it from the cluster.
+	// +optional
+	Operation *ScyllaDBClusterDatacenterOperationStatus `json:"operation,omitempty"`
+}
+
+type ScyllaDBClusterDatacenterOperationType string
+
+const (
+	// ScyllaDBClusterDatacenterOperationTypeAdd is an operation adding a datacenter to the cluster.
+	ScyllaDBClusterDatacenterOperationTypeAdd ScyllaDBClusterDatacenterOperationType = "Add"
+
+	// ScyllaDBClusterDatacenterOperationTypeRemove is an operation removing a datacenter from the cluster.
+	ScyllaDBClusterDatacenterOperationTypeRemove ScyllaDBClusterDatacenterOperationType = "Remove"
+)
+
+type ScyllaDBClusterDatacenterOperationPhase string
+
+const (
+	// ScyllaDBClusterDatacenterOperationPhaseBootstrapping means the datacenter nodes are joining the cluster.
+	ScyllaDBClusterDatacenterOperationPhaseBootstrapping ScyllaDBClusterDatacenterOperationPhase = "Bootstrapping"
+
+	// ScyllaDBClusterDatacenterOperationPhaseAlteringReplication means the replication of managed keyspaces is being altered.
+	ScyllaDBClusterDatacenterOperationPhaseAlteringReplication ScyllaDBClusterDatacenterOperationPhase = "AlteringReplication"
+
+	// ScyllaDBClusterDatacenterOperationPhaseRebuilding means the datacenter nodes are streaming data from the source datacenter.
+	ScyllaDBClusterDatacenterOperationPhaseRebuilding ScyllaDBClusterDatacenterOperationPhase = "Rebuilding"
+
+	// ScyllaDBClusterDatacenterOperationPhaseRepairing means the managed keyspaces are being repaired.
+	ScyllaDBClusterDatacenterOperationPhaseRepairing ScyllaDBClusterDatacenterOperationPhase = "Repairing"
+
+	// ScyllaDBClusterDatacenterOperationPhaseDecommissioning means the datacenter nodes are being decommissioned.
+	ScyllaDBClusterDatacenterOperationPhaseDecommissioning ScyllaDBClusterDatacenterOperationPhase = "Decommissioning"
+
+	// ScyllaDBClusterDatacenterOperationPhaseCompleted means the operation has finished.
+	ScyllaDBClusterDatacenterOperationPhaseCompleted ScyllaDBClusterDatacenterOperationPhase = "Completed"
+)
+
+// ScyllaDBClusterDatacenterOperationStatus describes the progress of a datacenter operation.
+type ScyllaDBClusterDatacenterOperationStatus struct {
+	// type is the type of the operation.
+	Type ScyllaDBClusterDatacenterOperationType `json:"type"`
+
+	// phase is the current phase of the operation.
+	Phase ScyllaDBClusterDatacenterOperationPhase `json:"phase"`
+
+	// message is a human-readable description of the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// lastTransitionTime is the time when the operation entered the current phase.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+type ScyllaDBClusterLostDatacenterPhase string
+
+const (
+	// ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs means the host IDs of the lost datacenter nodes are not known yet.
+	ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs ScyllaDBClusterLostDatacenterPhase = "CollectingHostIDs"
+
+	// ScyllaDBClusterLostDatacenterPhaseAlteringReplication means replication of managed keyspaces is being altered
+	// to drop the lost datacenter.
+	ScyllaDBClusterLostDatacenterPhaseAlteringReplication ScyllaDBClusterLostDatacenterPhase = "AlteringReplication"
+
+	// ScyllaDBClusterLostDatacenterPhaseRemovingNodes means the lost datacenter nodes are being removed from the cluster.
+	ScyllaDBClusterLostDatacenterPhaseRemovingNodes ScyllaDBClusterLostDatacenterPhase = "RemovingNodes"
+
+	// ScyllaDBClusterLostDatacenterPhaseCompleted means all lost datacenter nodes were removed from the cluster.
+	ScyllaDBClusterLostDatacenterPhaseCompleted ScyllaDBClusterLostDatacenterPhase = "Completed"
+)
+
+// ScyllaDBClusterLostDatacenterStatus describes the progress of removing a lost datacenter from the cluster.
+type ScyllaDBClusterLostDatacenterStatus struct {
+	// name is the name of the lost datacenter.
+	Name string `json:"name"`
+
+	// phase is the current phase of the removal.
+	Phase ScyllaDBClusterLostDatacenterPhase `json:"phase"`
+
+	// hostIDs are the host IDs of the lost datacenter nodes.
+	// +optional
+	HostIDs []string `json:"hostIDs,omitempty"`
+}
+
+type ScyllaDBClusterImportPhase string
+
+const (
+	// ScyllaDBClusterImportPhaseValidating means the compatibility of the datacenters with the existing cluster is being validated.
+	ScyllaDBClusterImportPhaseValidating ScyllaDBClusterImportPhase = "Validating"
+
+	// ScyllaDBClusterImportPhaseRebuilding means the datacenters are being rebuilt from the external datacenter.
+	ScyllaDBClusterImportPhaseRebuilding ScyllaDBClusterImportPhase = "Rebuilding"
+
+	// ScyllaDBClusterImportPhaseWaitingForClientsMigration means the datacenters hold the data and clients can be moved over to them.
+	ScyllaDBClusterImportPhaseWaitingForClientsMigration ScyllaDBClusterImportPhase = "WaitingForClientsMigration"
+
+	// ScyllaDBClusterImportPhaseDroppingExternalDatacenter means the external datacenter is being removed from the replication of managed keyspaces.
+	ScyllaDBClusterImportPhaseDroppingExternalDatacenter ScyllaDBClusterImportPhase = "DroppingExternalDatacenter"
+
+	// ScyllaDBClusterImportPhaseCompleted means the external datacenter no longer replicates managed keyspaces and can be shut down.
+	ScyllaDBClusterImportPhaseCompleted ScyllaDBClusterImportPhase = "Completed"
+)
+
+// ScyllaDBClusterImportStatus describes the progress of importing an existing cluster.
+type ScyllaDBClusterImportStatus struct {
+	// phase is the current phase of the import.
+	Phase ScyllaDBClusterImportPhase `json:"phase"`
+
+	// message is a human-readable description of the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// lastTransitionTime is the time when the import entered the current phase.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
 // ScyllaDBClusterStatus defines the observed state of ScyllaDBCluster.
@@ -281,6 +538,102 @@ type ScyllaDBClusterStatus struct {
 	// Datacenters reflect the status of datacenters.
 	// +optional
 	Datacenters []ScyllaDBClusterDatacenterStatus `json:"datacenters,omitempty"`
+
+	// lostDatacenters reflect the progress of removing lost datacenters from the cluster.
+	// +optional
+	LostDatacenters []ScyllaDBClusterLostDatacenterStatus `json:"lostDatacenters,omitempty"`
+
+	// import reflects the progress of importing an existing cluster.
+	// It's only set when spec.import is specified.
+	// +optional
+	Import *ScyllaDBClusterImportStatus `json:"import,omitempty"`
+
+	// connectivity reflects the reachability of other datacenters' nodes and of external seeds from each datacenter,
+	// as observed by the latest connectivity probes.
+	// External seeds are probed even when connectivity probes are not enabled.
+	// +optional
+	Connectivity []ScyllaDBClusterDatacenterConnectivityStatus `json:"connectivity,omitempty"`
+
+	// rollout reflects the progress of a staged rollout of datacenters.
+	// It's only set when spec.rolloutStrategy is specified.
+	// +optional
+	Rollout *ScyllaDBClusterRolloutStatus `json:"rollout,omitempty"`
+}
+
+// ScyllaDBClusterRolloutStatus reflects the progress of a staged rollout of datacenters.
+type ScyllaDBClusterRolloutStatus struct {
+	// updatedDatacenters lists datacenters which are fully updated and available.
+	// +optional
+	UpdatedDatacenters []string `json:"updatedDatacenters,omitempty"`
+
+	// updatingDatacenters lists datacenters which are being updated.
+	// +optional
+	UpdatingDatacenters []string `json:"updatingDatacenters,omitempty"`
+
+	// pendingDatacenters lists datacenters waiting for the preceding datacenters to be updated.
+	// +optional
+	PendingDatacenters []string `json:"pendingDatacenters,omitempty"`
+}
+
+// ScyllaDBClusterDatacenterConnectivityStatus reflects the results of connectivity probes run from a datacenter.
+type ScyllaDBClusterDatacenterConnectivityStatus struct {
+	// datacenter is the name of the datacenter the probes were run from.
+	Datacenter string `json:"datacenter"`
+
+	// lastProbeTime is the time the latest probes finished.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// targets reflect the reachability of the probed datacenters.
+	// +optional
+	Targets []ScyllaDBClusterConnectivityTargetStatus `json:"targets,omitempty"`
+
+	// externalSeeds reflect the reachability of external seeds.
+	// +optional
+	ExternalSeeds []ScyllaDBClusterExternalSeedConnectivityStatus `json:"externalSeeds,omitempty"`
+
+	// truncated is true when the report exceeded its size limit and some of its entries were left out.
+	// Reachable external seeds are left out first, followed by unreachable addresses and unreachable external seeds.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ScyllaDBClusterExternalSeedConnectivityStatus reflects the reachability of an external seed.
+type ScyllaDBClusterExternalSeedConnectivityStatus struct {
+	// address is the address of the external seed.
+	Address string `json:"address"`
+
+	// reachable is true when the external seed accepted a connection on the inter-node communication port.
+	Reachable bool `json:"reachable"`
+}
+
+// ScyllaDBClusterConnectivityTargetStatus reflects the reachability of a probed datacenter.
+type ScyllaDBClusterConnectivityTargetStatus struct {
+	// datacenter is the name of the probed datacenter.
+	Datacenter string `json:"datacenter"`
+
+	// ports reflect the reachability of the probed ports at the broadcast addresses of the datacenter's nodes.
+	// +optional
+	Ports []ScyllaDBClusterConnectivityPortStatus `json:"ports,omitempty"`
+}
+
+// ScyllaDBClusterConnectivityPortStatus reflects the reachability of a port at the probed addresses.
+type ScyllaDBClusterConnectivityPortStatus struct {
+	// name is the name of the port.
+	Name string `json:"name"`
+
+	// port is the number of the port.
+	Port int32 `json:"port"`
+
+	// probedAddresses is the number of addresses probed.
+	ProbedAddresses int32 `json:"probedAddresses"`
+
+	// reachableAddresses is the number of addresses that accepted a connection.
+	ReachableAddresses int32 `json:"reachableAddresses"`
+
+	// unreachableAddresses lists addresses which didn't accept a connection. The list may be truncated.
+	// +optional
+	UnreachableAddresses []string `json:"unreachableAddresses,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -10,10 +10,77 @@ type ClientHealthcheckProbes struct {
 	PeriodSeconds int32 `json:"periodSeconds"`
 }
 
+// RemoteServiceAccountTokenSource specifies a ServiceAccount token issued by the remote Kubernetes cluster.
+// The token is kept in a local Secret and refreshed by the operator through the TokenRequest API of the remote cluster,
+// authenticating with the current token, before it expires.
+type RemoteServiceAccountTokenSource struct {
+	// tokenSecretRef is a reference to a Secret keeping the ServiceAccount token under the "token" key.
+	// The initial token has to be provided by the user, the operator replaces it with a rotated one afterwards.
+	TokenSecretRef corev1.SecretReference `json:"tokenSecretRef"`
+
+	// serviceAccountNamespace is the namespace of the ServiceAccount in the remote Kubernetes cluster.
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+
+	// serviceAccountName is the name of the ServiceAccount in the remote Kubernetes cluster.
+	// The ServiceAccount has to be allowed to create tokens for itself.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// expirationSeconds is the requested duration of validity of the rotated tokens.
+	// +kubebuilder:default:=3600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// ProjectedServiceAccountTokenSource specifies a ServiceAccount token issued by the local Kubernetes cluster
+// for the given audience. The remote Kubernetes cluster has to trust the local cluster's service account issuer.
+type ProjectedServiceAccountTokenSource struct {
+	// serviceAccountNamespace is the namespace of the ServiceAccount in the local Kubernetes cluster.
+	// Only ServiceAccounts from the "scylla-operator-remote-credentials" namespace can be used,
+	// as the operator is only allowed to request tokens there.
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+
+	// serviceAccountName is the name of the ServiceAccount in the local Kubernetes cluster.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// audience is the intended audience of the token, as configured in the remote cluster's authenticator.
+	Audience string `json:"audience"`
+
+	// expirationSeconds is the requested duration of validity of the tokens.
+	// +kubebuilder:default:=3600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// RemoteKubernetesClusterCredentials specifies token based credentials to the remote Kubernetes cluster.
+// Exactly one token source has to be set.
+type RemoteKubernetesClusterCredentials struct {
+	// server is the address of the remote Kubernetes API server.
+	Server string `json:"server"`
+
+	// certificateAuthorityData holds PEM-encoded certificate authority certificates of the remote Kubernetes API server.
+	// +optional
+	CertificateAuthorityData []byte `json:"certificateAuthorityData,omitempty"`
+
+	// remoteServiceAccountToken specifies a token issued by the remote cluster, rotated by the operator.
+	// +optional
+	RemoteServiceAccountToken *RemoteServiceAccountTokenSource `json:"remoteServiceAccountToken,omitempty"`
+
+	// projectedServiceAccountToken specifies a token issued by the local cluster for a given audience.
+	// +optional
+	ProjectedServiceAccountToken *ProjectedServiceAccountTokenSource `json:"projectedServiceAccountToken,omitempty"`
+}
+
 type RemoteKubernetesClusterSpec struct {
 	// kubeconfigSecretRef is a reference to a secret keeping kubeconfig allowing to connect to remote Kubernetes cluster.
+	// Exactly one of kubeconfigSecretRef and credentials has to be set.
+	// +optional
 	KubeconfigSecretRef corev1.SecretReference `json:"kubeconfigSecretRef"`
 
+	// credentials specify token based credentials to the remote Kubernetes cluster, that are rotated by the operator.
+	// Exactly one of kubeconfigSecretRef and credentials has to be set.
+	// +optional
+	Credentials *RemoteKubernetesClusterCredentials `json:"credentials,omitempty"`
+
 	// healthcheckProbes hold client healthcheck probes settings.
 	// +kubebuilder:default:={"periodSeconds": 60}
 	// +optional
@@ -26,6 +93,23 @@ type RemoteKubernetesClusterStatus struct {
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 
+	// credentialsExpirationTime is the expiration time of the token currently used to connect to the remote Kubernetes cluster.
+	// +optional
+	CredentialsExpirationTime *metav1.Time `json:"credentialsExpirationTime,omitempty"`
+
+	// kubernetesVersion is the version of the remote Kubernetes API server reported by the last client healthcheck.
+	// +optional
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+
+	// kubernetesMinorVersionSkew is the difference between the minor versions of the remote and the local Kubernetes API servers.
+	// Kubernetes clients support a skew of at most one minor version.
+	// +optional
+	KubernetesMinorVersionSkew *int32 `json:"kubernetesMinorVersionSkew,omitempty"`
+
+	// apiRoundTripLatency is the round-trip latency of the last client healthcheck request to the remote Kubernetes API server.
+	// +optional
+	APIRoundTripLatency *metav1.Duration `json:"apiRoundTripLatency,omitempty"`
+
 	// conditions hold conditions describing RemoteKubernetesCluster state.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -66,6 +66,11 @@ type ScyllaDBDatacenterSpec struct {
 	// +optional
 	ExposeOptions *ExposeOptions `json:"exposeOptions,omitempty"`
 
+	// clientConnectionBundle controls publishing of a Secret with everything clients need to connect to the ScyllaDBDatacenter.
+	// The bundle contains certificates only when the AutomaticTLSCertificates feature is enabled.
+	// +optional
+	ClientConnectionBundle *ClientConnectionBundleOptions `json:"clientConnectionBundle,omitempty"`
+
 	// rackTemplate provides a template for every rack.
 	// Every rack inherits properties specified in the template, unless it's overwritten on the rack level.
 	// +optional
@@ -78,6 +83,18 @@ type ScyllaDBDatacenterSpec struct {
 	// +optional
 	DisableAutomaticOrphanedNodeReplacement *bool `json:"disableAutomaticOrphanedNodeReplacement,omitempty"`
 
+	// automaticFailedDiskNodeReplacement enables automatic replacement of ScyllaDB nodes
+	// whose local disks have been reported as failed by NodeConfig, while the Kubernetes node is still present.
+	// When unset, nodes with failed disks are not replaced automatically.
+	// +optional
+	AutomaticFailedDiskNodeReplacement *AutomaticFailedDiskNodeReplacementOptions `json:"automaticFailedDiskNodeReplacement,omitempty"`
+
+	// nodeReplacements specify requests to replace particular ScyllaDB nodes.
+	// Every request is carried out once. To replace the same node again, the completed request has to be removed
+	// and a request with a new name has to be provided. At most one request can target a particular node.
+	// +optional
+	NodeReplacements []NodeReplacement `json:"nodeReplacements,omitempty"`
+
 	// minTerminationGracePeriodSeconds specifies minimum duration in seconds to wait before every drained node is
 	// terminated. This gives time to potential load balancer in front of a node to notice that node is not ready anymore
 	// and stop forwarding new requests.
@@ -101,6 +118,41 @@ type ScyllaDBDatacenterSpec struct {
 	// about readiness gates.
 	// +optional
 	ReadinessGates []corev1.PodReadinessGate `json:"readinessGates,omitempty"`
+
+	// bootstrapFrom specifies a source the datacenter is pre-populated from once it's created.
+	// The datacenter isn't considered available until the data is restored.
+	// This field is immutable.
+	// +optional
+	BootstrapFrom *BootstrapFromOptions `json:"bootstrapFrom,omitempty"`
+}
+
+// BootstrapFromOptions specifies a source a datacenter is pre-populated from.
+type BootstrapFromOptions struct {
+	// backup specifies a ScyllaDB Manager backup to restore the schema and data from.
+	// Restoring requires the datacenter to be registered with the global ScyllaDB Manager instance.
+	// +optional
+	Backup *BootstrapFromBackupOptions `json:"backup,omitempty"`
+}
+
+// BootstrapFromBackupOptions specifies a ScyllaDB Manager backup a datacenter is restored from.
+type BootstrapFromBackupOptions struct {
+	// location specifies a list of backup locations in the following format: `<provider>:<name>`.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	Location []string `json:"location"`
+
+	// snapshotTag specifies the tag of the snapshot to restore.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// keyspace specifies a list of `glob` patterns used to include or exclude tables from restore.
+	// The patterns match keyspaces and tables. Keyspace names are separated from table names with a dot e.g. `!keyspace.table_prefix_*`.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// credentialsSecretRef references a Secret with the credentials to access the backup location.
+	// Keys of the Secret are exposed as environment variables to ScyllaDB Manager Agent, e.g. `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY`.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 }
 
 type ObjectTemplateMetadata struct {
@@ -141,10 +193,108 @@ type RackTemplate struct {
 	// exposeOptions specifies rack-specific parameters related to exposing ScyllaDBDatacenter backends.
 	// +optional
 	ExposeOptions *RackExposeOptions `json:"exposeOptions,omitempty"`
+
+	// podTemplate specifies overrides of the ScyllaDB Pod spec.
+	// These are merged with the settings set on Datacenter level.
+	// +optional
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
+}
+
+// PodTemplate allows overriding a subset of ScyllaDB Pod settings.
+type PodTemplate struct {
+	// securityContext holds Pod-level security attributes.
+	// When set, it replaces the Pod security context set by the operator.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// hostAliases specify a list of hosts and IPs that are injected into the Pod's hosts file.
+	// +optional
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+
+	// containerOverrides specify overrides of the containers managed by the operator.
+	// Overrides of containers that are not a part of the Pod are ignored.
+	// +optional
+	ContainerOverrides []ContainerOverride `json:"containerOverrides,omitempty"`
+
+	// sidecarContainers specify additional containers appended to the ScyllaDB Pod.
+	// +optional
+	SidecarContainers []SidecarContainer `json:"sidecarContainers,omitempty"`
+}
+
+// SidecarContainer specifies a container appended to the ScyllaDB Pod.
+// It exposes a subset of container settings to keep the size of the API schema in check.
+type SidecarContainer struct {
+	// name specifies the name of the container.
+	Name string `json:"name"`
+
+	// image holds a reference to the container image.
+	Image string `json:"image"`
+
+	// imagePullPolicy specifies the pull policy of the container image.
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// command specifies the entrypoint of the container.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// args specify the arguments of the entrypoint.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// env specify a list of environment variables set in the container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// resources specify the compute resources required by the container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// volumeMounts specify the volumes of the Pod mounted into the container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// securityContext holds container-level security attributes.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// ContainerOverride allows overriding a subset of settings of a container managed by the operator.
+type ContainerOverride struct {
+	// name specifies the name of the container to override.
+	Name string `json:"name"`
+
+	// securityContext holds container-level security attributes.
+	// When set, it replaces the container security context set by the operator.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// env specify a list of environment variables appended to the container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// lifecycle specifies actions that the management system should take in response to container lifecycle events.
+	// Handlers set here replace the respective handlers set by the operator.
+	// The preStop handler of the ScyllaDB container is managed by the operator and can't be overridden.
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
 }
 
 // ScyllaDBTemplate allows overriding a subset of ScyllaDB settings.
 type ScyllaDBTemplate struct {
+	// image holds a reference to the ScyllaDB container image.
+	// Overrides upper level settings.
+	// Version changes of a rack are rolled out independently of other racks, which allows trying out a new ScyllaDB image on a single rack first.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// additionalScyllaDBArguments specify a list of arguments appended to the ScyllaDB binary during startup.
+	// When set, it replaces the arguments set on upper levels.
+	// When set, ScyllaDB may behave unexpectedly, and every such setup is considered unsupported.
+	// Options managed by the operator, like cluster_name or endpoint_snitch, can't be added.
+	// +optional
+	AdditionalScyllaDBArguments []string `json:"additionalScyllaDBArguments,omitempty"`
+
 	// resources specify requirements for the ScyllaDB container
 	// +optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -210,12 +360,178 @@ type ScyllaDB struct {
 	// additionalScyllaDBArguments specify a list of arguments appended to the ScyllaDB binary during startup.
 	// When set, ScyllaDB may behave unexpectedly, and every such setup is considered unsupported.
 	// Instead, consider using customConfigMapRef for setting custom ScyllaDB configuration options.
+	// Options managed by the operator, like cluster_name or endpoint_snitch, can't be added.
 	// +optional
 	AdditionalScyllaDBArguments []string `json:"additionalScyllaDBArguments,omitempty"`
 
 	// developerMode determines if the cluster runs in developer-mode.
 	// +optional
 	EnableDeveloperMode *bool `json:"enableDeveloperMode,omitempty"`
+
+	// config holds commonly tuned ScyllaDB configuration options that are validated and rendered into scylla.yaml.
+	// Options set here take precedence over the ones set in customConfigMapRef
+	// and can't be set again through additionalScyllaDBArguments.
+	// +optional
+	Config *ScyllaDBConfig `json:"config,omitempty"`
+
+	// readiness configures how the readiness of ScyllaDB nodes is determined.
+	// Only ready nodes are routed client traffic by Services.
+	// +optional
+	Readiness *ScyllaDBReadinessOptions `json:"readiness,omitempty"`
+}
+
+type ScyllaDBReadinessMode string
+
+const (
+	// ScyllaDBReadinessModeNodeStatus considers a node ready when it's UP and NORMAL, and it serves CQL clients.
+	ScyllaDBReadinessModeNodeStatus ScyllaDBReadinessMode = "NodeStatus"
+
+	// ScyllaDBReadinessModeCQLQuery additionally requires a lightweight CQL query over the node to succeed.
+	ScyllaDBReadinessModeCQLQuery ScyllaDBReadinessMode = "CQLQuery"
+
+	// ScyllaDBReadinessModePeerQuorum additionally requires a minimum percentage of the nodes in the node's datacenter to be UP, as observed by the node.
+	// Nodes whose Pods aren't scheduled yet aren't taken into account.
+	ScyllaDBReadinessModePeerQuorum ScyllaDBReadinessMode = "PeerQuorum"
+)
+
+// ScyllaDBReadinessOptions configures the readiness of ScyllaDB nodes.
+type ScyllaDBReadinessOptions struct {
+	// mode specifies how the readiness of ScyllaDB nodes is determined.
+	// Supported modes are NodeStatus, CQLQuery and PeerQuorum.
+	// +kubebuilder:default:="NodeStatus"
+	// +optional
+	Mode ScyllaDBReadinessMode `json:"mode,omitempty"`
+
+	// minUpPeersPercent specifies the minimum percentage of the nodes in the node's datacenter, including the node itself,
+	// that have to be UP, as observed by the node, for it to be ready.
+	// The default tolerates a single node being down, e.g. during a rolling restart.
+	// Only used in the PeerQuorum mode.
+	// +kubebuilder:default:=50
+	// +optional
+	MinUpPeersPercent *int32 `json:"minUpPeersPercent,omitempty"`
+}
+
+// ScyllaDBConfig holds typed ScyllaDB configuration options.
+type ScyllaDBConfig struct {
+	// compaction holds compaction options.
+	// +optional
+	Compaction *ScyllaDBCompactionConfig `json:"compaction,omitempty"`
+
+	// streaming holds streaming options.
+	// +optional
+	Streaming *ScyllaDBStreamingConfig `json:"streaming,omitempty"`
+
+	// commitlog holds commitlog options.
+	// +optional
+	Commitlog *ScyllaDBCommitlogConfig `json:"commitlog,omitempty"`
+
+	// timeouts holds server-side request timeouts.
+	// +optional
+	Timeouts *ScyllaDBTimeoutsConfig `json:"timeouts,omitempty"`
+
+	// hintedHandoff holds hinted handoff options.
+	// +optional
+	HintedHandoff *ScyllaDBHintedHandoffConfig `json:"hintedHandoff,omitempty"`
+
+	// tablets holds tablets options.
+	// +optional
+	Tablets *ScyllaDBTabletsConfig `json:"tablets,omitempty"`
+}
+
+// ScyllaDBCompactionConfig holds compaction options.
+type ScyllaDBCompactionConfig struct {
+	// throughputMBPerSecond throttles compaction to the specified total throughput across the node.
+	// Setting it to 0 disables throttling.
+	// Maps to "compaction_throughput_mb_per_sec".
+	// +optional
+	ThroughputMBPerSecond *int32 `json:"throughputMBPerSecond,omitempty"`
+}
+
+// ScyllaDBStreamingConfig holds streaming options.
+type ScyllaDBStreamingConfig struct {
+	// ioThroughputMBPerSecond throttles streaming I/O to the specified total throughput across the node.
+	// Setting it to 0 disables throttling.
+	// Maps to "stream_io_throughput_mb_per_sec".
+	// +optional
+	IOThroughputMBPerSecond *int32 `json:"ioThroughputMBPerSecond,omitempty"`
+}
+
+// ScyllaDBCommitlogConfig holds commitlog options.
+type ScyllaDBCommitlogConfig struct {
+	// segmentSizeMB specifies the size of a single commitlog segment.
+	// Maps to "commitlog_segment_size_in_mb".
+	// +optional
+	SegmentSizeMB *int32 `json:"segmentSizeMB,omitempty"`
+
+	// totalSpaceMB specifies the total space used by commitlogs on a node.
+	// Maps to "commitlog_total_space_in_mb".
+	// +optional
+	TotalSpaceMB *int64 `json:"totalSpaceMB,omitempty"`
+
+	// syncPeriodMilliseconds specifies how often the commitlog is synced to disk.
+	// Maps to "commitlog_sync_period_in_ms".
+	// +optional
+	SyncPeriodMilliseconds *int32 `json:"syncPeriodMilliseconds,omitempty"`
+}
+
+// ScyllaDBTimeoutsConfig holds server-side request timeouts.
+type ScyllaDBTimeoutsConfig struct {
+	// readRequestMilliseconds specifies how long the coordinator waits for read operations to complete.
+	// Maps to "read_request_timeout_in_ms".
+	// +optional
+	ReadRequestMilliseconds *int32 `json:"readRequestMilliseconds,omitempty"`
+
+	// writeRequestMilliseconds specifies how long the coordinator waits for write operations to complete.
+	// Maps to "write_request_timeout_in_ms".
+	// +optional
+	WriteRequestMilliseconds *int32 `json:"writeRequestMilliseconds,omitempty"`
+
+	// rangeRequestMilliseconds specifies how long the coordinator waits for range scans to complete.
+	// Maps to "range_request_timeout_in_ms".
+	// +optional
+	RangeRequestMilliseconds *int32 `json:"rangeRequestMilliseconds,omitempty"`
+
+	// counterWriteRequestMilliseconds specifies how long the coordinator waits for counter writes to complete.
+	// Maps to "counter_write_request_timeout_in_ms".
+	// +optional
+	CounterWriteRequestMilliseconds *int32 `json:"counterWriteRequestMilliseconds,omitempty"`
+
+	// casContentionMilliseconds specifies how long the coordinator keeps retrying a contended lightweight transaction.
+	// Maps to "cas_contention_timeout_in_ms".
+	// +optional
+	CASContentionMilliseconds *int32 `json:"casContentionMilliseconds,omitempty"`
+
+	// truncateRequestMilliseconds specifies how long the coordinator waits for truncates to complete.
+	// Maps to "truncate_request_timeout_in_ms".
+	// +optional
+	TruncateRequestMilliseconds *int32 `json:"truncateRequestMilliseconds,omitempty"`
+
+	// requestMilliseconds specifies the default timeout for other, miscellaneous operations.
+	// Maps to "request_timeout_in_ms".
+	// +optional
+	RequestMilliseconds *int32 `json:"requestMilliseconds,omitempty"`
+}
+
+// ScyllaDBHintedHandoffConfig holds hinted handoff options.
+type ScyllaDBHintedHandoffConfig struct {
+	// enabled specifies whether hints are stored for unavailable replicas.
+	// Maps to "hinted_handoff_enabled".
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// maxHintWindowMilliseconds specifies the maximum amount of time hints are stored for an unavailable replica.
+	// Maps to "max_hint_window_in_ms".
+	// +optional
+	MaxHintWindowMilliseconds *int32 `json:"maxHintWindowMilliseconds,omitempty"`
+}
+
+// ScyllaDBTabletsConfig holds tablets options.
+type ScyllaDBTabletsConfig struct {
+	// enabled specifies whether newly created keyspaces use tablets by default.
+	// Requires ScyllaDB 6.0 or ScyllaDB Enterprise 2024.2, or later.
+	// Maps to "enable_tablets".
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // StorageOptions describes options of storage.
@@ -278,6 +594,89 @@ type AlternatorOptions struct {
 	// +kubebuilder:default:={type:"OperatorManaged"}
 	// +optional
 	ServingCertificate *TLSCertificate `json:"servingCertificate,omitempty"`
+
+	// users specify Alternator users which credentials are managed by the operator.
+	// For every user, the operator creates a CQL role and a Secret named `<scyllaDBDatacenterName>-alternator-user-<userName>`
+	// holding the user's access key ID and secret access key in `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` keys.
+	// The Secret records that the role is owned by the operator, so the role is dropped before the Secret is deleted
+	// when the user is removed from this list. Roles are kept when Alternator options are removed altogether.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Users []AlternatorUser `json:"users,omitempty"`
+
+	// cqlCredentialsSecretRef references a Secret containing `username` and `password` keys of a CQL role
+	// that is allowed to create roles. The operator uses it to set up roles of Alternator users.
+	// It is required when users are specified.
+	// +optional
+	CQLCredentialsSecretRef *corev1.LocalObjectReference `json:"cqlCredentialsSecretRef,omitempty"`
+
+	// endpoint specifies options of a load-balanced endpoint exposing Alternator over HTTPS.
+	// +optional
+	Endpoint *AlternatorEndpointOptions `json:"endpoint,omitempty"`
+}
+
+// AlternatorUser holds options of an Alternator user.
+type AlternatorUser struct {
+	// name specifies the name of the CQL role backing the Alternator user, which is also its access key ID.
+	// The role is created by the operator. Roles that already exist are refused.
+	Name string `json:"name"`
+}
+
+type AlternatorEndpointType string
+
+const (
+	// AlternatorEndpointTypeService exposes Alternator through a Service load-balancing the traffic across ScyllaDB nodes.
+	AlternatorEndpointTypeService AlternatorEndpointType = "Service"
+
+	// AlternatorEndpointTypeIngress exposes Alternator through an Ingress backed by the Alternator Service.
+	AlternatorEndpointTypeIngress AlternatorEndpointType = "Ingress"
+)
+
+// AlternatorEndpointOptions hold options related to exposing Alternator.
+type AlternatorEndpointOptions struct {
+	// type specifies how Alternator is exposed.
+	// +kubebuilder:default:="Service"
+	Type AlternatorEndpointType `json:"type"`
+
+	// service specifies options of the Service load-balancing Alternator traffic across ScyllaDB nodes.
+	// +optional
+	Service *AlternatorEndpointServiceOptions `json:"service,omitempty"`
+
+	// ingress specifies options of the Ingress exposing Alternator.
+	// It can only be set when type is Ingress.
+	// +optional
+	Ingress *AlternatorEndpointIngressOptions `json:"ingress,omitempty"`
+}
+
+// AlternatorEndpointServiceOptions hold options related to the Alternator Service.
+type AlternatorEndpointServiceOptions struct {
+	ObjectTemplateMetadata `json:",inline"`
+
+	// type specifies the Kubernetes Service type.
+	// +kubebuilder:validation:Enum="ClusterIP";"NodePort";"LoadBalancer"
+	// +kubebuilder:default:="ClusterIP"
+	// +optional
+	Type corev1.ServiceType `json:"type,omitempty"`
+
+	// loadBalancerClass controls value of service.spec.loadBalancerClass of the Service.
+	// Check Kubernetes corev1.Service documentation about semantic of this field.
+	// +optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+}
+
+// AlternatorEndpointIngressOptions hold options related to the Alternator Ingress.
+type AlternatorEndpointIngressOptions struct {
+	ObjectTemplateMetadata `json:",inline"`
+
+	// ingressClassName specifies Ingress class name.
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// hosts specify the host names routed to Alternator.
+	// They are added to the operator-managed Alternator serving certificate.
+	// Ingress controllers have to be configured, usually through annotations, to use HTTPS for the backend.
+	Hosts []string `json:"hosts"`
 }
 
 // ScyllaDBManagerAgent holds configuration options related to ScyllaDB Manager Agent.
@@ -317,6 +716,10 @@ const (
 
 	// BroadcastAddressTypeServiceLoadBalancerIngress selects the IP address or Hostname from Service.status.ingress[0]
 	BroadcastAddressTypeServiceLoadBalancerIngress BroadcastAddressType = "ServiceLoadBalancerIngress"
+
+	// BroadcastAddressTypeServiceNodePort selects the IP address of the Kubernetes node hosting the Pod (Pod.status.hostIP).
+	// It is meant to be used together with NodePort node Services.
+	BroadcastAddressTypeServiceNodePort BroadcastAddressType = "ServiceNodePort"
 )
 
 const (
@@ -332,6 +735,15 @@ type BroadcastOptions struct {
 	// podIP holds options related to Pod IP address.
 	// +optional
 	PodIP *PodIPAddressOptions `json:"podIP,omitempty"`
+
+	// ipFamily specifies the IP family of the broadcasted address.
+	// In ScyllaDBDatacenter, it must be one of spec.ipFamilies and choosing a family other than the first one
+	// requires a dual-stack spec.ipFamilyPolicy.
+	// In ScyllaDBCluster, IP families of datacenters are derived from the IP families chosen for nodes and clients.
+	// When unset, the first IP family of the datacenter is used.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	IPFamily *corev1.IPFamily `json:"ipFamily,omitempty"`
 }
 
 // NodeBroadcastOptions hold options related to addresses broadcasted by ScyllaDB node.
@@ -358,8 +770,41 @@ const (
 
 	// NodeServiceTypeLoadBalancer means nodes will be exposed via LoadBalancer Service.
 	NodeServiceTypeLoadBalancer NodeServiceType = "LoadBalancer"
+
+	// NodeServiceTypeNodePort means nodes will be exposed via NodePort Service.
+	NodeServiceTypeNodePort NodeServiceType = "NodePort"
 )
 
+const (
+	NodePortAllocationDefaultMaxNodesPerRack int32 = 16
+
+	// NodePortsPerNode is the number of node ports allocated to every node.
+	NodePortsPerNode = 4
+)
+
+// NodePortAllocationOptions hold options related to allocation of node ports for NodePort node Services.
+// Every node is assigned a block of consecutive node ports, in order: CQL, CQL SSL, shard-aware CQL and shard-aware CQL SSL.
+// The block of a node is computed as `basePort + (rackIndex * maxNodesPerRack + nodeOrdinal) * 4`,
+// where rackIndex is the position of the rack in the ScyllaDBDatacenter spec.
+// CQL and CQL SSL node ports are mapped to the default ScyllaDB ports, while ScyllaDB nodes are configured
+// to serve shard-aware CQL on the node ports allocated to them.
+type NodePortAllocationOptions struct {
+	// basePort is the first node port allocated to nodes of the ScyllaDBDatacenter.
+	// It is required when nodes are exposed via NodePort Services.
+	// All allocated ports have to fit into the node port range configured in the Kubernetes cluster.
+	// Node ports are reserved cluster-wide, so ranges of ScyllaDBDatacenters sharing a Kubernetes cluster must not overlap.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BasePort int32 `json:"basePort"`
+
+	// maxNodesPerRack is the number of nodes every rack reserves node ports for.
+	// Racks can't be scaled beyond this number of nodes.
+	// +kubebuilder:default:=16
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNodesPerRack int32 `json:"maxNodesPerRack,omitempty"`
+}
+
 type NodeServiceTemplate struct {
 	ObjectTemplateMetadata `json:",inline"`
 
@@ -385,6 +830,21 @@ type NodeServiceTemplate struct {
 	// Check Kubernetes corev1.Service documentation about semantic of this field.
 	// +optional
 	InternalTrafficPolicy *corev1.ServiceInternalTrafficPolicy `json:"internalTrafficPolicy,omitempty"`
+
+	// nodePort controls allocation of node ports of each node Service.
+	// It can only be set, and is required, when type is NodePort.
+	// +optional
+	NodePort *NodePortAllocationOptions `json:"nodePort,omitempty"`
+}
+
+// ClientConnectionBundleOptions hold options of the client connection bundle.
+// The bundle is published as a Secret containing the serving CA, a client certificate, credentials and contact points
+// in the CQL connection config, driver-agnostic YAML and environment variables formats.
+type ClientConnectionBundleOptions struct {
+	// credentialsSecretRef references a Secret containing `username` and `password` keys of a CQL role
+	// that is included in the bundle. When unset, the bundle doesn't contain any credentials.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 }
 
 // RackExposeOptions hold options related to exposing rack of ScyllaDBDatacenter.
@@ -412,6 +872,56 @@ type ExposeOptions struct {
 
 	// BroadcastOptions defines how ScyllaDB node publishes its IP address to other nodes and clients.
 	BroadcastOptions *NodeBroadcastOptions `json:"broadcastOptions,omitempty"`
+
+	// gateway specifies options of exposing ScyllaDBDatacenter backends through the Gateway API.
+	// Gateway API CRDs have to be installed in the cluster. Exposing CQL requires the experimental TLSRoute,
+	// and exposing Alternator requires HTTPRoute and BackendTLSPolicy, available in the standard channel.
+	// +optional
+	Gateway *GatewayExposeOptions `json:"gateway,omitempty"`
+}
+
+// GatewayExposeOptions hold options related to exposing ScyllaDBDatacenter backends through the Gateway API.
+// Backends are routed using the same DNS domain naming as Ingresses.
+// Labels and annotations are applied to the Gateway and all its routes.
+type GatewayExposeOptions struct {
+	ObjectTemplateMetadata `json:",inline"`
+
+	// gatewayClassName specifies the name of the GatewayClass of the Gateway.
+	GatewayClassName string `json:"gatewayClassName"`
+
+	// cql specifies options of exposing CQL through the Gateway.
+	// If provided, TLSRoutes passing TLS connections through to the any-node and per-node CQL hostnames are created.
+	// +optional
+	CQL *GatewayCQLOptions `json:"cql,omitempty"`
+
+	// alternator specifies options of exposing Alternator through the Gateway.
+	// If provided, an HTTPRoute routing Alternator hostnames to ScyllaDB nodes is created.
+	// The Gateway terminates TLS using the operator-managed Alternator serving certificate.
+	// A BackendTLSPolicy making the Gateway connect to ScyllaDB nodes over HTTPS, verified with the operator-managed
+	// Alternator serving CA, is created as well.
+	// Alternator has to use an operator-managed serving certificate.
+	// +optional
+	Alternator *GatewayAlternatorOptions `json:"alternator,omitempty"`
+}
+
+// GatewayCQLOptions hold options related to exposing CQL through the Gateway.
+type GatewayCQLOptions struct {
+	// port specifies the port of the Gateway listener accepting CQL connections.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default:=9142
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// GatewayAlternatorOptions hold options related to exposing Alternator through the Gateway.
+type GatewayAlternatorOptions struct {
+	// port specifies the port of the Gateway listener accepting Alternator connections.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default:=8043
+	// +optional
+	Port int32 `json:"port,omitempty"`
 }
 
 // CQLExposeOptions hold options related to exposing CQL backend.
@@ -451,6 +961,100 @@ type Placement struct {
 	// using the matching operator.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// topologySpreadConstraints describes how the Pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// priorityClassName specifies the name of the PriorityClass of the Pod.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// schedulerName specifies the scheduler that dispatches the Pod.
+	// If not specified, the Pod is dispatched by the default scheduler.
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// runtimeClassName refers to a RuntimeClass that should be used to run the Pod.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+}
+
+// AutomaticFailedDiskNodeReplacementOptions configures automatic replacement of nodes with failed local disks.
+type AutomaticFailedDiskNodeReplacementOptions struct {
+	// maxConcurrentReplacements specifies how many nodes can be replaced at the same time.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentReplacements *int32 `json:"maxConcurrentReplacements,omitempty"`
+
+	// minFailureDuration specifies for how long the failure of local disks has to be reported continuously
+	// before the node is replaced. It prevents replacing nodes because of transient failures.
+	// +kubebuilder:default:="10m"
+	// +optional
+	MinFailureDuration *metav1.Duration `json:"minFailureDuration,omitempty"`
+}
+
+// NodeReplacement describes a request to replace a ScyllaDB node.
+type NodeReplacement struct {
+	// name uniquely identifies this request within the datacenter.
+	Name string `json:"name"`
+
+	// rackName specifies the name of the rack the node to be replaced belongs to.
+	RackName string `json:"rackName"`
+
+	// ordinal specifies the ordinal of the node to be replaced within the rack.
+	// +kubebuilder:validation:Minimum=0
+	Ordinal int32 `json:"ordinal"`
+
+	// reason specifies a human-readable reason for the replacement.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+type NodeReplacementPhase string
+
+const (
+	// NodeReplacementPhasePending means the replacement wasn't started yet.
+	NodeReplacementPhasePending NodeReplacementPhase = "Pending"
+
+	// NodeReplacementPhaseRemovingPodAndPVC means the Pod and the PersistentVolumeClaim of the node are being removed.
+	NodeReplacementPhaseRemovingPodAndPVC NodeReplacementPhase = "RemovingPodAndPVC"
+
+	// NodeReplacementPhaseWaitingForReplacementBoot means the replacing node is waiting to be started.
+	NodeReplacementPhaseWaitingForReplacementBoot NodeReplacementPhase = "WaitingForReplacementBoot"
+
+	// NodeReplacementPhaseStreaming means the replacing node has started and is streaming data.
+	NodeReplacementPhaseStreaming NodeReplacementPhase = "Streaming"
+
+	// NodeReplacementPhaseCompleted means the node was replaced.
+	NodeReplacementPhaseCompleted NodeReplacementPhase = "Completed"
+
+	// NodeReplacementPhaseFailed means the replacing node keeps failing and requires a manual recovery.
+	NodeReplacementPhaseFailed NodeReplacementPhase = "Failed"
+)
+
+// NodeReplacementStatus is the status of a node replacement request.
+type NodeReplacementStatus struct {
+	// name specifies the name of the request this status describes.
+	Name string `json:"name"`
+
+	// rackName specifies the name of the rack the replaced node belongs to.
+	RackName string `json:"rackName"`
+
+	// ordinal specifies the ordinal of the replaced node within the rack.
+	Ordinal int32 `json:"ordinal"`
+
+	// phase specifies the current phase of the replacement.
+	Phase NodeReplacementPhase `json:"phase"`
+
+	// replacedHostID specifies the Host ID of the node being replaced.
+	// +optional
+	ReplacedHostID string `json:"replacedHostID,omitempty"`
+
+	// message is a human-readable message with details about the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // RackStatus is the status of a ScyllaDB Rack
@@ -487,6 +1091,57 @@ type RackStatus struct {
 	// stale should eventually become false when the appropriate controller writes a fresh status.
 	// +optional
 	Stale *bool `json:"stale,omitempty"`
+
+	// restartRequiredNodes lists the names of nodes that have ScyllaDB config changes which they need to be restarted for to take effect.
+	// Live-updatable config options are applied by the nodes in place and don't require a restart.
+	// +optional
+	RestartRequiredNodes []string `json:"restartRequiredNodes,omitempty"`
+
+	// degradedNodes specify the number of nodes in rack that aren't up or aren't in the normal operation mode, as reported by the nodes.
+	// +optional
+	DegradedNodes *int32 `json:"degradedNodes,omitempty"`
+
+	// nodeStatuses reflect the runtime status of rack nodes, as reported by the nodes.
+	// +optional
+	NodeStatuses []NodeRuntimeStatus `json:"nodeStatuses,omitempty"`
+}
+
+// NodeRuntimeStatus is the runtime status of a ScyllaDB node, as reported by the nodes.
+type NodeRuntimeStatus struct {
+	// name is the name of the node.
+	Name string `json:"name"`
+
+	// hostID is the ScyllaDB node's host ID.
+	// +optional
+	HostID string `json:"hostID,omitempty"`
+
+	// gossipStatus is the status of the node in gossip, as observed by the nodes of the datacenter.
+	// The node is reported as DOWN when any of the nodes observes it as down.
+	// +optional
+	GossipStatus NodeStatus `json:"gossipStatus,omitempty"`
+
+	// operationMode is the operation mode of the node.
+	// +optional
+	OperationMode NodeOperationMode `json:"operationMode,omitempty"`
+
+	// loadBytes is the approximate size of data stored by the node, in bytes.
+	// +optional
+	LoadBytes *int64 `json:"loadBytes,omitempty"`
+
+	// tokenCount is the number of tokens owned by the node.
+	// +optional
+	TokenCount *int32 `json:"tokenCount,omitempty"`
+
+	// version is the ScyllaDB version of the node.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// bootstrapStalled indicates that the node is bootstrapping and hasn't made progress for a while.
+	// +optional
+	BootstrapStalled bool `json:"bootstrapStalled,omitempty"`
+
+	// degraded indicates that the node isn't up or isn't in the normal operation mode.
+	Degraded bool `json:"degraded"`
 }
 
 // ScyllaDBDatacenterStatus defines the observed state of ScyllaDBDatacenter.
@@ -527,8 +1182,43 @@ type ScyllaDBDatacenterStatus struct {
 	// +optional
 	AvailableNodes *int32 `json:"availableNodes,omitempty"`
 
+	// degradedNodes specify the number of nodes in datacenter that aren't up or aren't in the normal operation mode, as reported by the nodes.
+	// +optional
+	DegradedNodes *int32 `json:"degradedNodes,omitempty"`
+
 	// racks reflect the status of datacenter racks.
 	Racks []RackStatus `json:"racks"`
+
+	// nodeReplacements reflect the status of node replacement requests.
+	// +optional
+	NodeReplacements []NodeReplacementStatus `json:"nodeReplacements,omitempty"`
+
+	// restore reflects the status of restoring the datacenter from the source specified in bootstrapFrom.
+	// +optional
+	Restore *RestoreStatus `json:"restore,omitempty"`
+}
+
+type RestorePhase string
+
+const (
+	// RestorePhaseRestoringSchema means the schema is being restored.
+	RestorePhaseRestoringSchema RestorePhase = "RestoringSchema"
+
+	// RestorePhaseRestoringTables means the content of the tables is being restored.
+	RestorePhaseRestoringTables RestorePhase = "RestoringTables"
+
+	// RestorePhaseCompleted means the schema and data were restored.
+	RestorePhaseCompleted RestorePhase = "Completed"
+)
+
+// RestoreStatus is the status of restoring a datacenter.
+type RestoreStatus struct {
+	// phase specifies the current phase of the restore.
+	Phase RestorePhase `json:"phase"`
+
+	// message is a human-readable message with details about the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -539,6 +1229,7 @@ type ScyllaDBDatacenterStatus struct {
 // +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
 // +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
 // +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="DEGRADED-NODES",type=integer,JSONPath=".status.degradedNodes"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ScyllaDBDatacenter defines a monitoring instance for ScyllaDB clusters.
@@ -568,3 +1259,33 @@ func (s *ScyllaDBDatacenterSpec) GetIPFamily() corev1.IPFamily {
 	}
 	return corev1.IPv4Protocol
 }
+
+// GetNodesBroadcastIPFamily returns the IP family of the address broadcasted for communication with other nodes.
+func (s *ScyllaDBDatacenterSpec) GetNodesBroadcastIPFamily() corev1.IPFamily {
+	if s.ExposeOptions != nil && s.ExposeOptions.BroadcastOptions != nil && s.ExposeOptions.BroadcastOptions.Nodes.IPFamily != nil {
+		return *s.ExposeOptions.BroadcastOptions.Nodes.IPFamily
+	}
+	return s.GetIPFamily()
+}
+
+// GetClientsBroadcastIPFamily returns the IP family of the address broadcasted for communication with clients.
+func (s *ScyllaDBDatacenterSpec) GetClientsBroadcastIPFamily() corev1.IPFamily {
+	if s.ExposeOptions != nil && s.ExposeOptions.BroadcastOptions != nil && s.ExposeOptions.BroadcastOptions.Clients.IPFamily != nil {
+		return *s.ExposeOptions.BroadcastOptions.Clients.IPFamily
+	}
+	return s.GetIPFamily()
+}
+
+// GetNodePortAllocation returns the base port and the maximum number of nodes per rack used to allocate node ports,
+// falling back to the default for unset maximum number of nodes per rack. The base port has no default.
+func (t *NodeServiceTemplate) GetNodePortAllocation() (int32, int32) {
+	var basePort int32
+	maxNodesPerRack := NodePortAllocationDefaultMaxNodesPerRack
+	if t.NodePort != nil {
+		basePort = t.NodePort.BasePort
+		if t.NodePort.MaxNodesPerRack != 0 {
+			maxNodesPerRack = t.NodePort.MaxNodesPerRack
+		}
+	}
+	return basePort, maxNodesPerRack
+}
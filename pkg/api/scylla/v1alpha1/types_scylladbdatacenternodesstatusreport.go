@@ -11,6 +11,19 @@ const (
 	NodeStatusDown NodeStatus = "DOWN"
 )
 
+type NodeOperationMode string
+
+const (
+	NodeOperationModeJoining         NodeOperationMode = "JOINING"
+	NodeOperationModeNormal          NodeOperationMode = "NORMAL"
+	NodeOperationModeLeaving         NodeOperationMode = "LEAVING"
+	NodeOperationModeDecommissioning NodeOperationMode = "DECOMMISSIONING"
+	NodeOperationModeDecommissioned  NodeOperationMode = "DECOMMISSIONED"
+	NodeOperationModeDraining        NodeOperationMode = "DRAINING"
+	NodeOperationModeDrained         NodeOperationMode = "DRAINED"
+	NodeOperationModeUnknown         NodeOperationMode = "UNKNOWN"
+)
+
 type ObservedNodeStatus struct {
 	// HostID is the ScyllaDB node's host ID.
 	HostID string `json:"hostID"`
@@ -30,6 +43,26 @@ type NodeStatusReport struct {
 	// ObservedNodes holds the list of node statuses as observed by the reporter node.
 	// +optional
 	ObservedNodes []ObservedNodeStatus `json:"observedNodes,omitempty"`
+
+	// OperationMode is the operation mode of the reporter node.
+	// +optional
+	OperationMode NodeOperationMode `json:"operationMode,omitempty"`
+
+	// LoadBytes is the approximate size of data stored by the reporter node, in bytes.
+	// +optional
+	LoadBytes *int64 `json:"loadBytes,omitempty"`
+
+	// TokenCount is the number of tokens owned by the reporter node.
+	// +optional
+	TokenCount *int32 `json:"tokenCount,omitempty"`
+
+	// Version is the ScyllaDB version of the reporter node.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// BootstrapStalled indicates that the reporter node is bootstrapping and hasn't made progress for a while.
+	// +optional
+	BootstrapStalled bool `json:"bootstrapStalled,omitempty"`
 }
 
 type RackNodesStatusReport struct {
@@ -10,8 +10,9 @@ import (
 type ScyllaDBManagerTaskType string
 
 const (
-	ScyllaDBManagerTaskTypeBackup ScyllaDBManagerTaskType = "Backup"
-	ScyllaDBManagerTaskTypeRepair ScyllaDBManagerTaskType = "Repair"
+	ScyllaDBManagerTaskTypeBackup  ScyllaDBManagerTaskType = "Backup"
+	ScyllaDBManagerTaskTypeRepair  ScyllaDBManagerTaskType = "Repair"
+	ScyllaDBManagerTaskTypeRestore ScyllaDBManagerTaskType = "Restore"
 )
 
 type ScyllaDBManagerTaskSchedule struct {
@@ -54,7 +55,9 @@ type ScyllaDBManagerBackupTaskOptions struct {
 	// `<dc>:` is optional and allows to specify the location for a datacenter in a multi-datacenter cluster.
 	// `<provider>` specifies the storage provider.
 	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
-	Location []string `json:"location"`
+	// It can be omitted if every datacenter specifies its own location in `datacenters`.
+	// +optional
+	Location []string `json:"location,omitempty"`
 
 	// rateLimit specifies the limit for the upload rate, expressed in mebibytes (MiB) per second, at which the snapshot files can be uploaded from a ScyllaDB node to its backup destination, in the following format: `[<dc>:]<limit>`.
 	// `<dc>:` is optional and allows for specifying different upload limits in selected datacenters.
@@ -76,6 +79,37 @@ type ScyllaDBManagerBackupTaskOptions struct {
 	// For instance, `[]string{"dc1:2", "5"}` corresponds to two parallel nodes in `dc1` datacenter and five parallel nodes in the other datacenters.
 	// +optional
 	UploadParallel []string `json:"uploadParallel,omitempty"`
+
+	// datacenters specifies per-datacenter backup options, keyed by the names of the referenced ScyllaDBCluster's datacenters.
+	// The options take precedence over the global ones and can only be used when the task references a ScyllaDBCluster.
+	// Every backed up datacenter has to have a location, either in `location` or in its datacenter options.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Datacenters []ScyllaDBManagerBackupTaskDatacenterOptions `json:"datacenters,omitempty"`
+}
+
+type ScyllaDBManagerBackupTaskDatacenterOptions struct {
+	// name specifies the name of the ScyllaDBCluster datacenter the options apply to.
+	Name string `json:"name"`
+
+	// location specifies a list of backup locations for the datacenter in the following format: `<provider>:<name>`.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	// +optional
+	Location []string `json:"location,omitempty"`
+
+	// rateLimit specifies the limit for the upload rate in the datacenter, expressed in mebibytes (MiB) per second.
+	// +optional
+	RateLimit *int64 `json:"rateLimit,omitempty"`
+
+	// snapshotParallel specifies the number of nodes in the datacenter taking a snapshot in parallel.
+	// +optional
+	SnapshotParallel *int64 `json:"snapshotParallel,omitempty"`
+
+	// uploadParallel specifies the number of nodes in the datacenter uploading the snapshot files in parallel.
+	// +optional
+	UploadParallel *int64 `json:"uploadParallel,omitempty"`
 }
 
 type ScyllaDBManagerRepairTaskOptions struct {
@@ -127,6 +161,33 @@ type ScyllaDBManagerRepairTaskOptions struct {
 	SmallTableThreshold *resource.Quantity `json:"smallTableThreshold,omitempty"`
 }
 
+type ScyllaDBManagerRestoreTaskOptions struct {
+	// location specifies a list of backup locations to restore from in the following format: `[<dc>:]<provider>:<name>`.
+	// `<dc>:` is optional and allows to specify the datacenter whose nodes should be used to access the location.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	Location []string `json:"location"`
+
+	// snapshotTag specifies the tag of the snapshot to restore.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// keyspace specifies a list of `glob` patterns used to include or exclude tables from restore.
+	// The patterns match keyspaces and tables. Keyspace names are separated from table names with a dot e.g. `!keyspace.table_prefix_*`.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// restoreSchema indicates that the task restores the schema (the system_schema keyspace) from the backup.
+	// Exactly one of restoreSchema and restoreTables has to be set.
+	// +optional
+	RestoreSchema *bool `json:"restoreSchema,omitempty"`
+
+	// restoreTables indicates that the task restores the content of the tables from the backup.
+	// The schema of the restored tables has to exist in the cluster before the restore.
+	// Exactly one of restoreSchema and restoreTables has to be set.
+	// +optional
+	RestoreTables *bool `json:"restoreTables,omitempty"`
+}
+
 type ScyllaDBManagerTaskSpec struct {
 	// scyllaDBClusterRef is a typed reference to the target cluster in the same namespace.
 	// Supported kinds are ScyllaDBCluster and ScyllaDBDatacenter in scylla.scylladb.com group.
@@ -142,6 +203,11 @@ type ScyllaDBManagerTaskSpec struct {
 	// repair specifies the options for a repair task.
 	// +optional
 	Repair *ScyllaDBManagerRepairTaskOptions `json:"repair,omitempty"`
+
+	// restore specifies the options for a restore task.
+	// Restore tasks are run once, as soon as they are created.
+	// +optional
+	Restore *ScyllaDBManagerRestoreTaskOptions `json:"restore,omitempty"`
 }
 
 type ScyllaDBManagerTaskStatus struct {
@@ -158,6 +224,10 @@ type ScyllaDBManagerTaskStatus struct {
 	// It can be used to identify the task when interacting directly with ScyllaDB Manager.
 	// +optional
 	TaskID *string `json:"taskID,omitempty"`
+
+	// lastRunStatus reflects the status of the most recent run of the task in ScyllaDB Manager state, e.g. `DONE` or `ERROR`.
+	// +optional
+	LastRunStatus *string `json:"lastRunStatus,omitempty"`
 }
 
 // +kubebuilder:object:root=true
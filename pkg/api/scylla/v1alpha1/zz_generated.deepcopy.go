@@ -11,6 +11,76 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternatorEndpointIngressOptions) DeepCopyInto(out *AlternatorEndpointIngressOptions) {
+	*out = *in
+	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternatorEndpointIngressOptions.
+func (in *AlternatorEndpointIngressOptions) DeepCopy() *AlternatorEndpointIngressOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternatorEndpointIngressOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternatorEndpointOptions) DeepCopyInto(out *AlternatorEndpointOptions) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(AlternatorEndpointServiceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(AlternatorEndpointIngressOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternatorEndpointOptions.
+func (in *AlternatorEndpointOptions) DeepCopy() *AlternatorEndpointOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternatorEndpointOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternatorEndpointServiceOptions) DeepCopyInto(out *AlternatorEndpointServiceOptions) {
+	*out = *in
+	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternatorEndpointServiceOptions.
+func (in *AlternatorEndpointServiceOptions) DeepCopy() *AlternatorEndpointServiceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternatorEndpointServiceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlternatorOptions) DeepCopyInto(out *AlternatorOptions) {
 	*out = *in
@@ -19,6 +89,21 @@ func (in *AlternatorOptions) DeepCopyInto(out *AlternatorOptions) {
 		*out = new(TLSCertificate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]AlternatorUser, len(*in))
+		copy(*out, *in)
+	}
+	if in.CQLCredentialsSecretRef != nil {
+		in, out := &in.CQLCredentialsSecretRef, &out.CQLCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AlternatorEndpointOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -32,6 +117,100 @@ func (in *AlternatorOptions) DeepCopy() *AlternatorOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternatorUser) DeepCopyInto(out *AlternatorUser) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternatorUser.
+func (in *AlternatorUser) DeepCopy() *AlternatorUser {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternatorUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomaticFailedDiskNodeReplacementOptions) DeepCopyInto(out *AutomaticFailedDiskNodeReplacementOptions) {
+	*out = *in
+	if in.MaxConcurrentReplacements != nil {
+		in, out := &in.MaxConcurrentReplacements, &out.MaxConcurrentReplacements
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinFailureDuration != nil {
+		in, out := &in.MinFailureDuration, &out.MinFailureDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomaticFailedDiskNodeReplacementOptions.
+func (in *AutomaticFailedDiskNodeReplacementOptions) DeepCopy() *AutomaticFailedDiskNodeReplacementOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomaticFailedDiskNodeReplacementOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapFromBackupOptions) DeepCopyInto(out *BootstrapFromBackupOptions) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapFromBackupOptions.
+func (in *BootstrapFromBackupOptions) DeepCopy() *BootstrapFromBackupOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapFromBackupOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapFromOptions) DeepCopyInto(out *BootstrapFromOptions) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BootstrapFromBackupOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapFromOptions.
+func (in *BootstrapFromOptions) DeepCopy() *BootstrapFromOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapFromOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BroadcastOptions) DeepCopyInto(out *BroadcastOptions) {
 	*out = *in
@@ -40,6 +219,11 @@ func (in *BroadcastOptions) DeepCopyInto(out *BroadcastOptions) {
 		*out = new(PodIPAddressOptions)
 		**out = **in
 	}
+	if in.IPFamily != nil {
+		in, out := &in.IPFamily, &out.IPFamily
+		*out = new(v1.IPFamily)
+		**out = **in
+	}
 	return
 }
 
@@ -91,6 +275,27 @@ func (in *CQLExposeOptions) DeepCopy() *CQLExposeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConnectionBundleOptions) DeepCopyInto(out *ClientConnectionBundleOptions) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConnectionBundleOptions.
+func (in *ClientConnectionBundleOptions) DeepCopy() *ClientConnectionBundleOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConnectionBundleOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientHealthcheckProbes) DeepCopyInto(out *ClientHealthcheckProbes) {
 	*out = *in
@@ -133,6 +338,39 @@ func (in *Components) DeepCopy() *Components {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerOverride) DeepCopyInto(out *ContainerOverride) {
+	*out = *in
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(v1.Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverride.
+func (in *ContainerOverride) DeepCopy() *ContainerOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceDiscovery) DeepCopyInto(out *DeviceDiscovery) {
 	*out = *in
@@ -167,6 +405,11 @@ func (in *ExposeOptions) DeepCopyInto(out *ExposeOptions) {
 		*out = new(NodeBroadcastOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayExposeOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -196,6 +439,65 @@ func (in *FilesystemConfiguration) DeepCopy() *FilesystemConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAlternatorOptions) DeepCopyInto(out *GatewayAlternatorOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAlternatorOptions.
+func (in *GatewayAlternatorOptions) DeepCopy() *GatewayAlternatorOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAlternatorOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayCQLOptions) DeepCopyInto(out *GatewayCQLOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCQLOptions.
+func (in *GatewayCQLOptions) DeepCopy() *GatewayCQLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayCQLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayExposeOptions) DeepCopyInto(out *GatewayExposeOptions) {
+	*out = *in
+	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
+	if in.CQL != nil {
+		in, out := &in.CQL, &out.CQL
+		*out = new(GatewayCQLOptions)
+		**out = **in
+	}
+	if in.Alternator != nil {
+		in, out := &in.Alternator, &out.Alternator
+		*out = new(GatewayAlternatorOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayExposeOptions.
+func (in *GatewayExposeOptions) DeepCopy() *GatewayExposeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayExposeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaAuthentication) DeepCopyInto(out *GrafanaAuthentication) {
 	*out = *in
@@ -793,78 +1095,167 @@ func (in *NodeConfigStatus) DeepCopy() *NodeConfigStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeServiceTemplate) DeepCopyInto(out *NodeServiceTemplate) {
+func (in *NodePortAllocationOptions) DeepCopyInto(out *NodePortAllocationOptions) {
 	*out = *in
-	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
-	if in.ExternalTrafficPolicy != nil {
-		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
-		**out = **in
-	}
-	if in.AllocateLoadBalancerNodePorts != nil {
-		in, out := &in.AllocateLoadBalancerNodePorts, &out.AllocateLoadBalancerNodePorts
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LoadBalancerClass != nil {
-		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
-		*out = new(string)
-		**out = **in
-	}
-	if in.InternalTrafficPolicy != nil {
-		in, out := &in.InternalTrafficPolicy, &out.InternalTrafficPolicy
-		*out = new(v1.ServiceInternalTrafficPolicy)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeServiceTemplate.
-func (in *NodeServiceTemplate) DeepCopy() *NodeServiceTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortAllocationOptions.
+func (in *NodePortAllocationOptions) DeepCopy() *NodePortAllocationOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeServiceTemplate)
+	out := new(NodePortAllocationOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeStatusReport) DeepCopyInto(out *NodeStatusReport) {
+func (in *NodeReplacement) DeepCopyInto(out *NodeReplacement) {
 	*out = *in
-	if in.HostID != nil {
-		in, out := &in.HostID, &out.HostID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ObservedNodes != nil {
-		in, out := &in.ObservedNodes, &out.ObservedNodes
-		*out = make([]ObservedNodeStatus, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatusReport.
-func (in *NodeStatusReport) DeepCopy() *NodeStatusReport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacement.
+func (in *NodeReplacement) DeepCopy() *NodeReplacement {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeStatusReport)
+	out := new(NodeReplacement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectTemplateMetadata) DeepCopyInto(out *ObjectTemplateMetadata) {
+func (in *NodeReplacementStatus) DeepCopyInto(out *NodeReplacementStatus) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacementStatus.
+func (in *NodeReplacementStatus) DeepCopy() *NodeReplacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReplacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRuntimeStatus) DeepCopyInto(out *NodeRuntimeStatus) {
+	*out = *in
+	if in.LoadBytes != nil {
+		in, out := &in.LoadBytes, &out.LoadBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TokenCount != nil {
+		in, out := &in.TokenCount, &out.TokenCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRuntimeStatus.
+func (in *NodeRuntimeStatus) DeepCopy() *NodeRuntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRuntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeServiceTemplate) DeepCopyInto(out *NodeServiceTemplate) {
+	*out = *in
+	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(v1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	if in.AllocateLoadBalancerNodePorts != nil {
+		in, out := &in.AllocateLoadBalancerNodePorts, &out.AllocateLoadBalancerNodePorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.InternalTrafficPolicy != nil {
+		in, out := &in.InternalTrafficPolicy, &out.InternalTrafficPolicy
+		*out = new(v1.ServiceInternalTrafficPolicy)
+		**out = **in
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(NodePortAllocationOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeServiceTemplate.
+func (in *NodeServiceTemplate) DeepCopy() *NodeServiceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeServiceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatusReport) DeepCopyInto(out *NodeStatusReport) {
+	*out = *in
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ObservedNodes != nil {
+		in, out := &in.ObservedNodes, &out.ObservedNodes
+		*out = make([]ObservedNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBytes != nil {
+		in, out := &in.LoadBytes, &out.LoadBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TokenCount != nil {
+		in, out := &in.TokenCount, &out.TokenCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatusReport.
+func (in *NodeStatusReport) DeepCopy() *NodeStatusReport {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStatusReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectTemplateMetadata) DeepCopyInto(out *ObjectTemplateMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -952,6 +1343,18 @@ func (in *Placement) DeepCopyInto(out *Placement) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1035,6 +1438,69 @@ func (in *PodIPInterfaceOptions) DeepCopy() *PodIPInterfaceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplate) DeepCopyInto(out *PodTemplate) {
+	*out = *in
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]v1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ContainerOverrides != nil {
+		in, out := &in.ContainerOverrides, &out.ContainerOverrides
+		*out = make([]ContainerOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]SidecarContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplate.
+func (in *PodTemplate) DeepCopy() *PodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectedServiceAccountTokenSource) DeepCopyInto(out *ProjectedServiceAccountTokenSource) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectedServiceAccountTokenSource.
+func (in *ProjectedServiceAccountTokenSource) DeepCopy() *ProjectedServiceAccountTokenSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectedServiceAccountTokenSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusExposeOptions) DeepCopyInto(out *PrometheusExposeOptions) {
 	*out = *in
@@ -1237,6 +1703,23 @@ func (in *RackStatus) DeepCopyInto(out *RackStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RestartRequiredNodes != nil {
+		in, out := &in.RestartRequiredNodes, &out.RestartRequiredNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DegradedNodes != nil {
+		in, out := &in.DegradedNodes, &out.DegradedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeStatuses != nil {
+		in, out := &in.NodeStatuses, &out.NodeStatuses
+		*out = make([]NodeRuntimeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1285,6 +1768,11 @@ func (in *RackTemplate) DeepCopyInto(out *RackTemplate) {
 		*out = new(RackExposeOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(PodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1326,6 +1814,37 @@ func (in *RemoteKubernetesCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteKubernetesClusterCredentials) DeepCopyInto(out *RemoteKubernetesClusterCredentials) {
+	*out = *in
+	if in.CertificateAuthorityData != nil {
+		in, out := &in.CertificateAuthorityData, &out.CertificateAuthorityData
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteServiceAccountToken != nil {
+		in, out := &in.RemoteServiceAccountToken, &out.RemoteServiceAccountToken
+		*out = new(RemoteServiceAccountTokenSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectedServiceAccountToken != nil {
+		in, out := &in.ProjectedServiceAccountToken, &out.ProjectedServiceAccountToken
+		*out = new(ProjectedServiceAccountTokenSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteKubernetesClusterCredentials.
+func (in *RemoteKubernetesClusterCredentials) DeepCopy() *RemoteKubernetesClusterCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteKubernetesClusterCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteKubernetesClusterList) DeepCopyInto(out *RemoteKubernetesClusterList) {
 	*out = *in
@@ -1363,6 +1882,11 @@ func (in *RemoteKubernetesClusterList) DeepCopyObject() runtime.Object {
 func (in *RemoteKubernetesClusterSpec) DeepCopyInto(out *RemoteKubernetesClusterSpec) {
 	*out = *in
 	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(RemoteKubernetesClusterCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClientHealthcheckProbes != nil {
 		in, out := &in.ClientHealthcheckProbes, &out.ClientHealthcheckProbes
 		*out = new(ClientHealthcheckProbes)
@@ -1389,6 +1913,25 @@ func (in *RemoteKubernetesClusterStatus) DeepCopyInto(out *RemoteKubernetesClust
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CredentialsExpirationTime != nil {
+		in, out := &in.CredentialsExpirationTime, &out.CredentialsExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubernetesMinorVersionSkew != nil {
+		in, out := &in.KubernetesMinorVersionSkew, &out.KubernetesMinorVersionSkew
+		*out = new(int32)
+		**out = **in
+	}
+	if in.APIRoundTripLatency != nil {
+		in, out := &in.APIRoundTripLatency, &out.APIRoundTripLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -1468,6 +2011,44 @@ func (in *RemoteOwnerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteServiceAccountTokenSource) DeepCopyInto(out *RemoteServiceAccountTokenSource) {
+	*out = *in
+	out.TokenSecretRef = in.TokenSecretRef
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteServiceAccountTokenSource.
+func (in *RemoteServiceAccountTokenSource) DeepCopy() *RemoteServiceAccountTokenSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteServiceAccountTokenSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDB) DeepCopyInto(out *ScyllaDB) {
 	*out = *in
@@ -1491,6 +2072,16 @@ func (in *ScyllaDB) DeepCopyInto(out *ScyllaDB) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ScyllaDBConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ScyllaDBReadinessOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1533,37 +2124,151 @@ func (in *ScyllaDBCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBClusterDatacenter) DeepCopyInto(out *ScyllaDBClusterDatacenter) {
+func (in *ScyllaDBClusterConnectivityPortStatus) DeepCopyInto(out *ScyllaDBClusterConnectivityPortStatus) {
 	*out = *in
-	in.ScyllaDBClusterDatacenterTemplate.DeepCopyInto(&out.ScyllaDBClusterDatacenterTemplate)
-	if in.ForceRedeploymentReason != nil {
-		in, out := &in.ForceRedeploymentReason, &out.ForceRedeploymentReason
-		*out = new(string)
-		**out = **in
+	if in.UnreachableAddresses != nil {
+		in, out := &in.UnreachableAddresses, &out.UnreachableAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenter.
-func (in *ScyllaDBClusterDatacenter) DeepCopy() *ScyllaDBClusterDatacenter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterConnectivityPortStatus.
+func (in *ScyllaDBClusterConnectivityPortStatus) DeepCopy() *ScyllaDBClusterConnectivityPortStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBClusterDatacenter)
+	out := new(ScyllaDBClusterConnectivityPortStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterDatacenterStatus) {
+func (in *ScyllaDBClusterConnectivityProbes) DeepCopyInto(out *ScyllaDBClusterConnectivityProbes) {
 	*out = *in
-	if in.RemoteNamespaceName != nil {
-		in, out := &in.RemoteNamespaceName, &out.RemoteNamespaceName
-		*out = new(string)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterConnectivityProbes.
+func (in *ScyllaDBClusterConnectivityProbes) DeepCopy() *ScyllaDBClusterConnectivityProbes {
+	if in == nil {
+		return nil
 	}
-	if in.CurrentVersion != nil {
-		in, out := &in.CurrentVersion, &out.CurrentVersion
+	out := new(ScyllaDBClusterConnectivityProbes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterConnectivityTargetStatus) DeepCopyInto(out *ScyllaDBClusterConnectivityTargetStatus) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ScyllaDBClusterConnectivityPortStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterConnectivityTargetStatus.
+func (in *ScyllaDBClusterConnectivityTargetStatus) DeepCopy() *ScyllaDBClusterConnectivityTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterConnectivityTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenter) DeepCopyInto(out *ScyllaDBClusterDatacenter) {
+	*out = *in
+	in.ScyllaDBClusterDatacenterTemplate.DeepCopyInto(&out.ScyllaDBClusterDatacenterTemplate)
+	if in.ForceRedeploymentReason != nil {
+		in, out := &in.ForceRedeploymentReason, &out.ForceRedeploymentReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.RebuildFrom != nil {
+		in, out := &in.RebuildFrom, &out.RebuildFrom
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenter.
+func (in *ScyllaDBClusterDatacenter) DeepCopy() *ScyllaDBClusterDatacenter {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenterConnectivityStatus) DeepCopyInto(out *ScyllaDBClusterDatacenterConnectivityStatus) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ScyllaDBClusterConnectivityTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalSeeds != nil {
+		in, out := &in.ExternalSeeds, &out.ExternalSeeds
+		*out = make([]ScyllaDBClusterExternalSeedConnectivityStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenterConnectivityStatus.
+func (in *ScyllaDBClusterDatacenterConnectivityStatus) DeepCopy() *ScyllaDBClusterDatacenterConnectivityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenterConnectivityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenterOperationStatus) DeepCopyInto(out *ScyllaDBClusterDatacenterOperationStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenterOperationStatus.
+func (in *ScyllaDBClusterDatacenterOperationStatus) DeepCopy() *ScyllaDBClusterDatacenterOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenterOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterDatacenterStatus) {
+	*out = *in
+	if in.RemoteNamespaceName != nil {
+		in, out := &in.RemoteNamespaceName, &out.RemoteNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CurrentVersion != nil {
+		in, out := &in.CurrentVersion, &out.CurrentVersion
 		*out = new(string)
 		**out = **in
 	}
@@ -1609,6 +2314,11 @@ func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterData
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ScyllaDBClusterDatacenterOperationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1703,6 +2413,71 @@ func (in *ScyllaDBClusterExposeOptions) DeepCopy() *ScyllaDBClusterExposeOptions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterExternalSeedConnectivityStatus) DeepCopyInto(out *ScyllaDBClusterExternalSeedConnectivityStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterExternalSeedConnectivityStatus.
+func (in *ScyllaDBClusterExternalSeedConnectivityStatus) DeepCopy() *ScyllaDBClusterExternalSeedConnectivityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterExternalSeedConnectivityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterImport) DeepCopyInto(out *ScyllaDBClusterImport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterImport.
+func (in *ScyllaDBClusterImport) DeepCopy() *ScyllaDBClusterImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterImportStatus) DeepCopyInto(out *ScyllaDBClusterImportStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterImportStatus.
+func (in *ScyllaDBClusterImportStatus) DeepCopy() *ScyllaDBClusterImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterKeyspaceReplication) DeepCopyInto(out *ScyllaDBClusterKeyspaceReplication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterKeyspaceReplication.
+func (in *ScyllaDBClusterKeyspaceReplication) DeepCopy() *ScyllaDBClusterKeyspaceReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterKeyspaceReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBClusterList) DeepCopyInto(out *ScyllaDBClusterList) {
 	*out = *in
@@ -1736,6 +2511,43 @@ func (in *ScyllaDBClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterLostDatacenter) DeepCopyInto(out *ScyllaDBClusterLostDatacenter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterLostDatacenter.
+func (in *ScyllaDBClusterLostDatacenter) DeepCopy() *ScyllaDBClusterLostDatacenter {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterLostDatacenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterLostDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterLostDatacenterStatus) {
+	*out = *in
+	if in.HostIDs != nil {
+		in, out := &in.HostIDs, &out.HostIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterLostDatacenterStatus.
+func (in *ScyllaDBClusterLostDatacenterStatus) DeepCopy() *ScyllaDBClusterLostDatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterLostDatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBClusterNodeBroadcastOptions) DeepCopyInto(out *ScyllaDBClusterNodeBroadcastOptions) {
 	*out = *in
@@ -1810,6 +2622,110 @@ func (in *ScyllaDBClusterRackStatus) DeepCopy() *ScyllaDBClusterRackStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterReplicationOptions) DeepCopyInto(out *ScyllaDBClusterReplicationOptions) {
+	*out = *in
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]ScyllaDBClusterKeyspaceReplication, len(*in))
+		copy(*out, *in)
+	}
+	if in.CQLCredentialsSecretRef != nil {
+		in, out := &in.CQLCredentialsSecretRef, &out.CQLCredentialsSecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterReplicationOptions.
+func (in *ScyllaDBClusterReplicationOptions) DeepCopy() *ScyllaDBClusterReplicationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterReplicationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterRolloutStatus) DeepCopyInto(out *ScyllaDBClusterRolloutStatus) {
+	*out = *in
+	if in.UpdatedDatacenters != nil {
+		in, out := &in.UpdatedDatacenters, &out.UpdatedDatacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatingDatacenters != nil {
+		in, out := &in.UpdatingDatacenters, &out.UpdatingDatacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingDatacenters != nil {
+		in, out := &in.PendingDatacenters, &out.PendingDatacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterRolloutStatus.
+func (in *ScyllaDBClusterRolloutStatus) DeepCopy() *ScyllaDBClusterRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterRolloutStrategy) DeepCopyInto(out *ScyllaDBClusterRolloutStrategy) {
+	*out = *in
+	if in.DatacenterOrder != nil {
+		in, out := &in.DatacenterOrder, &out.DatacenterOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterRolloutStrategy.
+func (in *ScyllaDBClusterRolloutStrategy) DeepCopy() *ScyllaDBClusterRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterSeedPolicy) DeepCopyInto(out *ScyllaDBClusterSeedPolicy) {
+	*out = *in
+	if in.SeedsPerRack != nil {
+		in, out := &in.SeedsPerRack, &out.SeedsPerRack
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreferredRacks != nil {
+		in, out := &in.PreferredRacks, &out.PreferredRacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterSeedPolicy.
+func (in *ScyllaDBClusterSeedPolicy) DeepCopy() *ScyllaDBClusterSeedPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterSeedPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBClusterSpec) DeepCopyInto(out *ScyllaDBClusterSpec) {
 	*out = *in
@@ -1851,6 +2767,36 @@ func (in *ScyllaDBClusterSpec) DeepCopyInto(out *ScyllaDBClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReplicationOptions != nil {
+		in, out := &in.ReplicationOptions, &out.ReplicationOptions
+		*out = new(ScyllaDBClusterReplicationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LostDatacenters != nil {
+		in, out := &in.LostDatacenters, &out.LostDatacenters
+		*out = make([]ScyllaDBClusterLostDatacenter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ScyllaDBClusterImport)
+		**out = **in
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(ScyllaDBClusterConnectivityProbes)
+		**out = **in
+	}
+	if in.SeedPolicy != nil {
+		in, out := &in.SeedPolicy, &out.SeedPolicy
+		*out = new(ScyllaDBClusterSeedPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(ScyllaDBClusterRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinTerminationGracePeriodSeconds != nil {
 		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
 		*out = new(int32)
@@ -1909,42 +2855,164 @@ func (in *ScyllaDBClusterStatus) DeepCopyInto(out *ScyllaDBClusterStatus) {
 		*out = new(int32)
 		**out = **in
 	}
-	if in.CurrentNodes != nil {
-		in, out := &in.CurrentNodes, &out.CurrentNodes
-		*out = new(int32)
-		**out = **in
+	if in.CurrentNodes != nil {
+		in, out := &in.CurrentNodes, &out.CurrentNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpdatedNodes != nil {
+		in, out := &in.UpdatedNodes, &out.UpdatedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyNodes != nil {
+		in, out := &in.ReadyNodes, &out.ReadyNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableNodes != nil {
+		in, out := &in.AvailableNodes, &out.AvailableNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]ScyllaDBClusterDatacenterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LostDatacenters != nil {
+		in, out := &in.LostDatacenters, &out.LostDatacenters
+		*out = make([]ScyllaDBClusterLostDatacenterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ScyllaDBClusterImportStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connectivity != nil {
+		in, out := &in.Connectivity, &out.Connectivity
+		*out = make([]ScyllaDBClusterDatacenterConnectivityStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ScyllaDBClusterRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterStatus.
+func (in *ScyllaDBClusterStatus) DeepCopy() *ScyllaDBClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBCommitlogConfig) DeepCopyInto(out *ScyllaDBCommitlogConfig) {
+	*out = *in
+	if in.SegmentSizeMB != nil {
+		in, out := &in.SegmentSizeMB, &out.SegmentSizeMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TotalSpaceMB != nil {
+		in, out := &in.TotalSpaceMB, &out.TotalSpaceMB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SyncPeriodMilliseconds != nil {
+		in, out := &in.SyncPeriodMilliseconds, &out.SyncPeriodMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBCommitlogConfig.
+func (in *ScyllaDBCommitlogConfig) DeepCopy() *ScyllaDBCommitlogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBCommitlogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBCompactionConfig) DeepCopyInto(out *ScyllaDBCompactionConfig) {
+	*out = *in
+	if in.ThroughputMBPerSecond != nil {
+		in, out := &in.ThroughputMBPerSecond, &out.ThroughputMBPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBCompactionConfig.
+func (in *ScyllaDBCompactionConfig) DeepCopy() *ScyllaDBCompactionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBCompactionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBConfig) DeepCopyInto(out *ScyllaDBConfig) {
+	*out = *in
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(ScyllaDBCompactionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(ScyllaDBStreamingConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.UpdatedNodes != nil {
-		in, out := &in.UpdatedNodes, &out.UpdatedNodes
-		*out = new(int32)
-		**out = **in
+	if in.Commitlog != nil {
+		in, out := &in.Commitlog, &out.Commitlog
+		*out = new(ScyllaDBCommitlogConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ReadyNodes != nil {
-		in, out := &in.ReadyNodes, &out.ReadyNodes
-		*out = new(int32)
-		**out = **in
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(ScyllaDBTimeoutsConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AvailableNodes != nil {
-		in, out := &in.AvailableNodes, &out.AvailableNodes
-		*out = new(int32)
-		**out = **in
+	if in.HintedHandoff != nil {
+		in, out := &in.HintedHandoff, &out.HintedHandoff
+		*out = new(ScyllaDBHintedHandoffConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Datacenters != nil {
-		in, out := &in.Datacenters, &out.Datacenters
-		*out = make([]ScyllaDBClusterDatacenterStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Tablets != nil {
+		in, out := &in.Tablets, &out.Tablets
+		*out = new(ScyllaDBTabletsConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterStatus.
-func (in *ScyllaDBClusterStatus) DeepCopy() *ScyllaDBClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBConfig.
+func (in *ScyllaDBConfig) DeepCopy() *ScyllaDBConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBClusterStatus)
+	out := new(ScyllaDBConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2130,6 +3198,11 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 		*out = new(ExposeOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientConnectionBundle != nil {
+		in, out := &in.ClientConnectionBundle, &out.ClientConnectionBundle
+		*out = new(ClientConnectionBundleOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RackTemplate != nil {
 		in, out := &in.RackTemplate, &out.RackTemplate
 		*out = new(RackTemplate)
@@ -2147,6 +3220,16 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AutomaticFailedDiskNodeReplacement != nil {
+		in, out := &in.AutomaticFailedDiskNodeReplacement, &out.AutomaticFailedDiskNodeReplacement
+		*out = new(AutomaticFailedDiskNodeReplacementOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeReplacements != nil {
+		in, out := &in.NodeReplacements, &out.NodeReplacements
+		*out = make([]NodeReplacement, len(*in))
+		copy(*out, *in)
+	}
 	if in.MinTerminationGracePeriodSeconds != nil {
 		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
 		*out = new(int32)
@@ -2162,6 +3245,11 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 		*out = make([]v1.PodReadinessGate, len(*in))
 		copy(*out, *in)
 	}
+	if in.BootstrapFrom != nil {
+		in, out := &in.BootstrapFrom, &out.BootstrapFrom
+		*out = new(BootstrapFromOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2215,6 +3303,11 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.DegradedNodes != nil {
+		in, out := &in.DegradedNodes, &out.DegradedNodes
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Racks != nil {
 		in, out := &in.Racks, &out.Racks
 		*out = make([]RackStatus, len(*in))
@@ -2222,6 +3315,16 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeReplacements != nil {
+		in, out := &in.NodeReplacements, &out.NodeReplacements
+		*out = make([]NodeReplacementStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(RestoreStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -2235,6 +3338,32 @@ func (in *ScyllaDBDatacenterStatus) DeepCopy() *ScyllaDBDatacenterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBHintedHandoffConfig) DeepCopyInto(out *ScyllaDBHintedHandoffConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxHintWindowMilliseconds != nil {
+		in, out := &in.MaxHintWindowMilliseconds, &out.MaxHintWindowMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBHintedHandoffConfig.
+func (in *ScyllaDBHintedHandoffConfig) DeepCopy() *ScyllaDBHintedHandoffConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBHintedHandoffConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerAgent) DeepCopyInto(out *ScyllaDBManagerAgent) {
 	*out = *in
@@ -2296,6 +3425,42 @@ func (in *ScyllaDBManagerAgentTemplate) DeepCopy() *ScyllaDBManagerAgentTemplate
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerBackupTaskDatacenterOptions) DeepCopyInto(out *ScyllaDBManagerBackupTaskDatacenterOptions) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotParallel != nil {
+		in, out := &in.SnapshotParallel, &out.SnapshotParallel
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UploadParallel != nil {
+		in, out := &in.UploadParallel, &out.UploadParallel
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerBackupTaskDatacenterOptions.
+func (in *ScyllaDBManagerBackupTaskDatacenterOptions) DeepCopy() *ScyllaDBManagerBackupTaskDatacenterOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerBackupTaskDatacenterOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerBackupTaskOptions) DeepCopyInto(out *ScyllaDBManagerBackupTaskOptions) {
 	*out = *in
@@ -2335,6 +3500,13 @@ func (in *ScyllaDBManagerBackupTaskOptions) DeepCopyInto(out *ScyllaDBManagerBac
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]ScyllaDBManagerBackupTaskDatacenterOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2516,6 +3688,42 @@ func (in *ScyllaDBManagerRepairTaskOptions) DeepCopy() *ScyllaDBManagerRepairTas
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerRestoreTaskOptions) DeepCopyInto(out *ScyllaDBManagerRestoreTaskOptions) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoreSchema != nil {
+		in, out := &in.RestoreSchema, &out.RestoreSchema
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreTables != nil {
+		in, out := &in.RestoreTables, &out.RestoreTables
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerRestoreTaskOptions.
+func (in *ScyllaDBManagerRestoreTaskOptions) DeepCopy() *ScyllaDBManagerRestoreTaskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerRestoreTaskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerTask) DeepCopyInto(out *ScyllaDBManagerTask) {
 	*out = *in
@@ -2626,6 +3834,11 @@ func (in *ScyllaDBManagerTaskSpec) DeepCopyInto(out *ScyllaDBManagerTaskSpec) {
 		*out = new(ScyllaDBManagerRepairTaskOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(ScyllaDBManagerRestoreTaskOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2659,6 +3872,11 @@ func (in *ScyllaDBManagerTaskStatus) DeepCopyInto(out *ScyllaDBManagerTaskStatus
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastRunStatus != nil {
+		in, out := &in.LastRunStatus, &out.LastRunStatus
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2788,9 +4006,82 @@ func (in *ScyllaDBMonitoringStatus) DeepCopy() *ScyllaDBMonitoringStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBReadinessOptions) DeepCopyInto(out *ScyllaDBReadinessOptions) {
+	*out = *in
+	if in.MinUpPeersPercent != nil {
+		in, out := &in.MinUpPeersPercent, &out.MinUpPeersPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBReadinessOptions.
+func (in *ScyllaDBReadinessOptions) DeepCopy() *ScyllaDBReadinessOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBReadinessOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBStreamingConfig) DeepCopyInto(out *ScyllaDBStreamingConfig) {
+	*out = *in
+	if in.IOThroughputMBPerSecond != nil {
+		in, out := &in.IOThroughputMBPerSecond, &out.IOThroughputMBPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBStreamingConfig.
+func (in *ScyllaDBStreamingConfig) DeepCopy() *ScyllaDBStreamingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBStreamingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBTabletsConfig) DeepCopyInto(out *ScyllaDBTabletsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBTabletsConfig.
+func (in *ScyllaDBTabletsConfig) DeepCopy() *ScyllaDBTabletsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBTabletsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBTemplate) DeepCopyInto(out *ScyllaDBTemplate) {
 	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalScyllaDBArguments != nil {
+		in, out := &in.AdditionalScyllaDBArguments, &out.AdditionalScyllaDBArguments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -2833,6 +4124,57 @@ func (in *ScyllaDBTemplate) DeepCopy() *ScyllaDBTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBTimeoutsConfig) DeepCopyInto(out *ScyllaDBTimeoutsConfig) {
+	*out = *in
+	if in.ReadRequestMilliseconds != nil {
+		in, out := &in.ReadRequestMilliseconds, &out.ReadRequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WriteRequestMilliseconds != nil {
+		in, out := &in.WriteRequestMilliseconds, &out.WriteRequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RangeRequestMilliseconds != nil {
+		in, out := &in.RangeRequestMilliseconds, &out.RangeRequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CounterWriteRequestMilliseconds != nil {
+		in, out := &in.CounterWriteRequestMilliseconds, &out.CounterWriteRequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CASContentionMilliseconds != nil {
+		in, out := &in.CASContentionMilliseconds, &out.CASContentionMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TruncateRequestMilliseconds != nil {
+		in, out := &in.TruncateRequestMilliseconds, &out.TruncateRequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequestMilliseconds != nil {
+		in, out := &in.RequestMilliseconds, &out.RequestMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBTimeoutsConfig.
+func (in *ScyllaDBTimeoutsConfig) DeepCopy() *ScyllaDBTimeoutsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBTimeoutsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaOperatorConfig) DeepCopyInto(out *ScyllaOperatorConfig) {
 	*out = *in
@@ -2993,6 +4335,52 @@ func (in *ScyllaOperatorConfigStatus) DeepCopy() *ScyllaOperatorConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarContainer) DeepCopyInto(out *SidecarContainer) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarContainer.
+func (in *SidecarContainer) DeepCopy() *SidecarContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
@@ -41,7 +41,23 @@ var (
 )
 
 func ValidateScyllaCluster(c *scyllav1.ScyllaCluster) field.ErrorList {
-	return ValidateScyllaClusterSpec(&c.Spec, field.NewPath("spec"))
+	return validateScyllaCluster(c, nil)
+}
+
+func validateScyllaCluster(c, old *scyllav1.ScyllaCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateScyllaClusterSpec(&c.Spec, field.NewPath("spec"))...)
+
+	// ScyllaArgs are migrated to ScyllaDBDatacenter's additional arguments, which can't set options owned by the operator.
+	// Options that the old object already set are allowed, so objects created before the validation was introduced can still be updated.
+	existingOptions := apimachineryutilsets.New[string]()
+	if old != nil {
+		existingOptions = getScyllaArgsOptions([]string{old.Spec.ScyllaArgs})
+	}
+	allErrs = append(allErrs, validateScyllaArgOperatorOwnedOptions(c.Spec.ScyllaArgs, false, existingOptions, field.NewPath("spec", "scyllaArgs"))...)
+
+	return allErrs
 }
 
 func ValidateUserManagedTLSCertificateOptions(opts *scyllav1.UserManagedTLSCertificateOptions, fldPath *field.Path) field.ErrorList {
@@ -417,7 +433,7 @@ func ValidateScyllaClusterRackSpec(rack scyllav1.RackSpec, rackNames apimachiner
 }
 
 func ValidateScyllaClusterUpdate(new, old *scyllav1.ScyllaCluster) field.ErrorList {
-	allErrs := ValidateScyllaCluster(new)
+	allErrs := validateScyllaCluster(new, old)
 
 	return append(allErrs, ValidateScyllaClusterSpecUpdate(new, old, field.NewPath("spec"))...)
 }
@@ -1078,6 +1078,19 @@ func TestValidateScyllaCluster(t *testing.T) {
 			},
 			expectedErrorString: `spec.alternator.servingCertificate.operatorManagedOptions.additionalIPAddresses: Invalid value: "0.not-an-ip.0.0": must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)`,
 		},
+		{
+			name: "scyllaArgs setting operator-owned options",
+			cluster: func() *scyllav1.ScyllaCluster {
+				cluster := validCluster.DeepCopy()
+				cluster.Spec.ScyllaArgs = "--smp=2 --cluster-name=other --broadcast_address 10.0.0.1"
+				return cluster
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaArgs", BadValue: "", Detail: `argument "--broadcast_address" sets option "broadcast_address" which is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaArgs", BadValue: "", Detail: `argument "--cluster-name" sets option "cluster_name" which is managed by the operator`},
+			},
+			expectedErrorString: `[spec.scyllaArgs: Forbidden: argument "--broadcast_address" sets option "broadcast_address" which is managed by the operator, spec.scyllaArgs: Forbidden: argument "--cluster-name" sets option "cluster_name" which is managed by the operator]`,
+		},
 	}
 
 	for _, test := range tests {
@@ -1353,6 +1366,38 @@ func TestValidateScyllaClusterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "PodIP": field is immutable`,
 		},
+		{
+			name: "scyllaArgs keeping operator-owned options set by the old object",
+			old: func() *scyllav1.ScyllaCluster {
+				cluster := unit.NewSingleRackCluster(3)
+				cluster.Spec.ScyllaArgs = "--seeds=10.0.0.1"
+				return cluster
+			}(),
+			new: func() *scyllav1.ScyllaCluster {
+				cluster := unit.NewSingleRackCluster(3)
+				cluster.Spec.ScyllaArgs = "--seeds=10.0.0.1 --smp=2"
+				return cluster
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "scyllaArgs adding operator-owned options",
+			old: func() *scyllav1.ScyllaCluster {
+				cluster := unit.NewSingleRackCluster(3)
+				cluster.Spec.ScyllaArgs = "--seeds=10.0.0.1"
+				return cluster
+			}(),
+			new: func() *scyllav1.ScyllaCluster {
+				cluster := unit.NewSingleRackCluster(3)
+				cluster.Spec.ScyllaArgs = "--seeds=10.0.0.1 --endpoint-snitch=SimpleSnitch"
+				return cluster
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaArgs", BadValue: "", Detail: `argument "--endpoint-snitch" sets option "endpoint_snitch" which is managed by the operator`},
+			},
+			expectedErrorString: `spec.scyllaArgs: Forbidden: argument "--endpoint-snitch" sets option "endpoint_snitch" which is managed by the operator`,
+		},
 	}
 
 	for _, test := range tests {
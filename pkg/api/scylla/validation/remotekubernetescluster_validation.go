@@ -0,0 +1,163 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"fmt"
+	"net/url"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// minRemoteKubernetesClusterTokenExpirationSeconds is the minimal token validity accepted by the TokenRequest API.
+	minRemoteKubernetesClusterTokenExpirationSeconds = 600
+)
+
+func ValidateRemoteKubernetesCluster(rkc *scyllav1alpha1.RemoteKubernetesCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateRemoteKubernetesClusterSpec(&rkc.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateRemoteKubernetesClusterSpec(spec *scyllav1alpha1.RemoteKubernetesClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	hasKubeconfigSecretRef := spec.KubeconfigSecretRef != corev1.SecretReference{}
+
+	switch {
+	case !hasKubeconfigSecretRef && spec.Credentials == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of kubeconfigSecretRef or credentials must be specified"))
+
+	case hasKubeconfigSecretRef && spec.Credentials != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("credentials"), "can't be specified together with kubeconfigSecretRef"))
+
+	case hasKubeconfigSecretRef:
+		allErrs = append(allErrs, validateSecretReference(spec.KubeconfigSecretRef.Namespace, spec.KubeconfigSecretRef.Name, fldPath.Child("kubeconfigSecretRef"))...)
+
+	default:
+		allErrs = append(allErrs, ValidateRemoteKubernetesClusterCredentials(spec.Credentials, fldPath.Child("credentials"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateRemoteKubernetesClusterCredentials(credentials *scyllav1alpha1.RemoteKubernetesClusterCredentials, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(credentials.Server) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("server"), ""))
+	} else {
+		u, err := url.Parse(credentials.Server)
+		if err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), credentials.Server, "must be a valid https URL"))
+		}
+	}
+
+	switch {
+	case credentials.RemoteServiceAccountToken == nil && credentials.ProjectedServiceAccountToken == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of remoteServiceAccountToken or projectedServiceAccountToken must be specified"))
+
+	case credentials.RemoteServiceAccountToken != nil && credentials.ProjectedServiceAccountToken != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("projectedServiceAccountToken"), "can't be specified together with remoteServiceAccountToken"))
+
+	case credentials.RemoteServiceAccountToken != nil:
+		source := credentials.RemoteServiceAccountToken
+		sourceFldPath := fldPath.Child("remoteServiceAccountToken")
+		allErrs = append(allErrs, validateSecretReference(source.TokenSecretRef.Namespace, source.TokenSecretRef.Name, sourceFldPath.Child("tokenSecretRef"))...)
+		allErrs = append(allErrs, validateServiceAccountReference(source.ServiceAccountNamespace, source.ServiceAccountName, sourceFldPath)...)
+		allErrs = append(allErrs, validateTokenExpirationSeconds(source.ExpirationSeconds, sourceFldPath.Child("expirationSeconds"))...)
+
+	default:
+		source := credentials.ProjectedServiceAccountToken
+		sourceFldPath := fldPath.Child("projectedServiceAccountToken")
+		allErrs = append(allErrs, validateServiceAccountReference(source.ServiceAccountNamespace, source.ServiceAccountName, sourceFldPath)...)
+		// Tokens are minted with the operator's privileges, so they are limited to ServiceAccounts dedicated to remote credentials.
+		if len(source.ServiceAccountNamespace) != 0 && source.ServiceAccountNamespace != naming.RemoteKubernetesClusterCredentialsNamespace {
+			allErrs = append(allErrs, field.Invalid(sourceFldPath.Child("serviceAccountNamespace"), source.ServiceAccountNamespace, fmt.Sprintf("must be %q", naming.RemoteKubernetesClusterCredentialsNamespace)))
+		}
+		if len(source.Audience) == 0 {
+			allErrs = append(allErrs, field.Required(sourceFldPath.Child("audience"), ""))
+		}
+		allErrs = append(allErrs, validateTokenExpirationSeconds(source.ExpirationSeconds, sourceFldPath.Child("expirationSeconds"))...)
+	}
+
+	return allErrs
+}
+
+func validateSecretReference(namespace, name string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), namespace, msg))
+		}
+	}
+
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateServiceAccountReference(namespace, name string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountNamespace"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountNamespace"), namespace, msg))
+		}
+	}
+
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountName"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateServiceAccountName(name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountName"), name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateTokenExpirationSeconds(expirationSeconds *int64, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if expirationSeconds != nil && *expirationSeconds < minRemoteKubernetesClusterTokenExpirationSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath, *expirationSeconds, fmt.Sprintf("must be at least %d", minRemoteKubernetesClusterTokenExpirationSeconds)))
+	}
+
+	return allErrs
+}
+
+func ValidateRemoteKubernetesClusterUpdate(new, old *scyllav1alpha1.RemoteKubernetesCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateRemoteKubernetesCluster(new)...)
+
+	return allErrs
+}
+
+func GetWarningsOnRemoteKubernetesClusterCreate(rkc *scyllav1alpha1.RemoteKubernetesCluster) []string {
+	return nil
+}
+
+func GetWarningsOnRemoteKubernetesClusterUpdate(new, old *scyllav1alpha1.RemoteKubernetesCluster) []string {
+	return nil
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateRemoteKubernetesCluster(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                    string
+		remoteKubernetesCluster *scyllav1alpha1.RemoteKubernetesCluster
+		expectedErrorList       field.ErrorList
+		expectedErrorString     string
+	}{
+		{
+			name:                    "valid with kubeconfig Secret",
+			remoteKubernetesCluster: newValidRemoteKubernetesCluster(),
+			expectedErrorList:       nil,
+			expectedErrorString:     ``,
+		},
+		{
+			name: "valid with remote ServiceAccount token",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "https://remote.example.com:6443",
+					RemoteServiceAccountToken: &scyllav1alpha1.RemoteServiceAccountTokenSource{
+						TokenSecretRef: corev1.SecretReference{
+							Namespace: "default",
+							Name:      "remote-token",
+						},
+						ServiceAccountNamespace: "scylla-operator",
+						ServiceAccountName:      "scylla-operator-remote",
+						ExpirationSeconds:       pointer.Ptr[int64](3600),
+					},
+				}
+				return rkc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "valid with projected ServiceAccount token",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "https://remote.example.com:6443",
+					ProjectedServiceAccountToken: &scyllav1alpha1.ProjectedServiceAccountTokenSource{
+						ServiceAccountNamespace: "scylla-operator-remote-credentials",
+						ServiceAccountName:      "dev-us-east-1",
+						Audience:                "remote.example.com",
+					},
+				}
+				return rkc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "projected ServiceAccount token outside of the remote credentials namespace",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "https://remote.example.com:6443",
+					ProjectedServiceAccountToken: &scyllav1alpha1.ProjectedServiceAccountTokenSource{
+						ServiceAccountNamespace: "scylla-operator",
+						ServiceAccountName:      "scylla-operator",
+						Audience:                "remote.example.com",
+					},
+				}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.credentials.projectedServiceAccountToken.serviceAccountNamespace",
+					BadValue: "scylla-operator",
+					Detail:   `must be "scylla-operator-remote-credentials"`,
+				},
+			},
+			expectedErrorString: `spec.credentials.projectedServiceAccountToken.serviceAccountNamespace: Invalid value: "scylla-operator": must be "scylla-operator-remote-credentials"`,
+		},
+		{
+			name: "neither kubeconfigSecretRef nor credentials",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec",
+					BadValue: "",
+					Detail:   "exactly one of kubeconfigSecretRef or credentials must be specified",
+				},
+			},
+			expectedErrorString: `spec: Required value: exactly one of kubeconfigSecretRef or credentials must be specified`,
+		},
+		{
+			name: "both kubeconfigSecretRef and credentials",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "https://remote.example.com:6443",
+					ProjectedServiceAccountToken: &scyllav1alpha1.ProjectedServiceAccountTokenSource{
+						ServiceAccountNamespace: "scylla-operator",
+						ServiceAccountName:      "scylla-operator",
+						Audience:                "remote.example.com",
+					},
+				}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.credentials",
+					BadValue: "",
+					Detail:   "can't be specified together with kubeconfigSecretRef",
+				},
+			},
+			expectedErrorString: `spec.credentials: Forbidden: can't be specified together with kubeconfigSecretRef`,
+		},
+		{
+			name: "partial kubeconfigSecretRef",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{
+					Name: "kubeconfig",
+				}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.kubeconfigSecretRef.namespace",
+					BadValue: "",
+					Detail:   "",
+				},
+			},
+			expectedErrorString: `spec.kubeconfigSecretRef.namespace: Required value`,
+		},
+		{
+			name: "credentials without token source and with non-https server",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "http://remote.example.com",
+				}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.credentials.server",
+					BadValue: "http://remote.example.com",
+					Detail:   "must be a valid https URL",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.credentials",
+					BadValue: "",
+					Detail:   "exactly one of remoteServiceAccountToken or projectedServiceAccountToken must be specified",
+				},
+			},
+			expectedErrorString: `[spec.credentials.server: Invalid value: "http://remote.example.com": must be a valid https URL, spec.credentials: Required value: exactly one of remoteServiceAccountToken or projectedServiceAccountToken must be specified]`,
+		},
+		{
+			name: "invalid projected ServiceAccount token source",
+			remoteKubernetesCluster: func() *scyllav1alpha1.RemoteKubernetesCluster {
+				rkc := newValidRemoteKubernetesCluster()
+				rkc.Spec.KubeconfigSecretRef = corev1.SecretReference{}
+				rkc.Spec.Credentials = &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+					Server: "https://remote.example.com:6443",
+					ProjectedServiceAccountToken: &scyllav1alpha1.ProjectedServiceAccountTokenSource{
+						ServiceAccountNamespace: "scylla-operator-remote-credentials",
+						ServiceAccountName:      "",
+						Audience:                "",
+						ExpirationSeconds:       pointer.Ptr[int64](60),
+					},
+				}
+				return rkc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.credentials.projectedServiceAccountToken.serviceAccountName",
+					BadValue: "",
+					Detail:   "",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.credentials.projectedServiceAccountToken.audience",
+					BadValue: "",
+					Detail:   "",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.credentials.projectedServiceAccountToken.expirationSeconds",
+					BadValue: int64(60),
+					Detail:   "must be at least 600",
+				},
+			},
+			expectedErrorString: `[spec.credentials.projectedServiceAccountToken.serviceAccountName: Required value, spec.credentials.projectedServiceAccountToken.audience: Required value, spec.credentials.projectedServiceAccountToken.expirationSeconds: Invalid value: 60: must be at least 600]`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := ValidateRemoteKubernetesCluster(tc.remoteKubernetesCluster)
+			if !reflect.DeepEqual(errList, tc.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(tc.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, tc.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(tc.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func newValidRemoteKubernetesCluster() *scyllav1alpha1.RemoteKubernetesCluster {
+	return &scyllav1alpha1.RemoteKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dev-us-east-1",
+		},
+		Spec: scyllav1alpha1.RemoteKubernetesClusterSpec{
+			KubeconfigSecretRef: corev1.SecretReference{
+				Namespace: "default",
+				Name:      "dev-us-east-1-kubeconfig",
+			},
+		},
+	}
+}
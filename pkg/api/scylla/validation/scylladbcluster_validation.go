@@ -4,12 +4,15 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"slices"
 	"sort"
 	"strings"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
@@ -21,6 +24,8 @@ func ValidateScyllaDBCluster(sc *scyllav1alpha1.ScyllaDBCluster) field.ErrorList
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ValidateScyllaDBClusterSpec(&sc.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateScyllaDBClusterScyllaArgsOperatorOwnedOptions(&sc.Spec, nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateScyllaDBClusterExternalSeeds(sc.Spec.ScyllaDB.ExternalSeeds, field.NewPath("spec", "scyllaDB", "externalSeeds"))...)
 
 	return allErrs
 }
@@ -48,6 +53,43 @@ func ValidateScyllaDBClusterSpec(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPa
 		allErrs = append(allErrs, ValidateScyllaDBClusterDatacenter(dcSpec, fldPath.Child("datacenters").Index(i))...)
 	}
 
+	allErrs = append(allErrs, validateScyllaDBClusterAdditionalScyllaDBArguments(spec, fldPath)...)
+
+	allErrs = append(allErrs, validateScyllaDBClusterDatacenterOperations(spec, fldPath)...)
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(spec.LostDatacenters, func(lostDC scyllav1alpha1.ScyllaDBClusterLostDatacenter) string {
+		return lostDC.Name
+	}, "name", fldPath.Child("lostDatacenters"))...)
+
+	for i, lostDC := range spec.LostDatacenters {
+		if len(lostDC.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("lostDatacenters").Index(i).Child("name"), "datacenter name must not be empty"))
+			continue
+		}
+
+		if slices.ContainsFunc(spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == lostDC.Name
+		}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("lostDatacenters").Index(i).Child("name"), lostDC.Name, "lost datacenter must be removed from datacenters"))
+		}
+	}
+
+	if spec.Import != nil {
+		allErrs = append(allErrs, ValidateScyllaDBClusterImport(spec.Import, spec.Datacenters, fldPath.Child("import"))...)
+
+		if len(spec.ScyllaDB.ExternalSeeds) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDB", "externalSeeds"), "external seeds are required when importing an existing cluster"))
+		}
+	}
+
+	if spec.SeedPolicy != nil {
+		allErrs = append(allErrs, ValidateScyllaDBClusterSeedPolicy(spec.SeedPolicy, fldPath.Child("seedPolicy"))...)
+	}
+
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateScyllaDBClusterRolloutStrategy(spec.RolloutStrategy, spec.Datacenters, fldPath.Child("rolloutStrategy"))...)
+	}
+
 	if spec.ExposeOptions != nil {
 		allErrs = append(allErrs, ValidateScyllaDBClusterSpecExposeOptions(spec.ExposeOptions, fldPath.Child("exposeOptions"))...)
 	}
@@ -85,6 +127,297 @@ func ValidateScyllaDBClusterDatacenter(dc scyllav1alpha1.ScyllaDBClusterDatacent
 	return allErrs
 }
 
+// validateScyllaDBClusterAdditionalScyllaDBArguments validates arguments of the datacenter templates,
+// datacenters and their racks against the options set through the typed config.
+// Conflicts of the cluster-wide arguments with the typed config are validated together with the config.
+func validateScyllaDBClusterAdditionalScyllaDBArguments(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	configFldPath := fldPath.Child("scyllaDB", "config")
+
+	validateDatacenterTemplate := func(dcTemplate *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate, dcFldPath *field.Path) {
+		if dcTemplate.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaArgsConfigConflicts(dcTemplate.ScyllaDB.AdditionalScyllaDBArguments, spec.ScyllaDB.Config, configFldPath, dcFldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+
+		if dcTemplate.RackTemplate != nil && dcTemplate.RackTemplate.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaArgsConfigConflicts(dcTemplate.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments, spec.ScyllaDB.Config, configFldPath, dcFldPath.Child("rackTemplate", "scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+
+		for i, rack := range dcTemplate.Racks {
+			if rack.ScyllaDB != nil {
+				allErrs = append(allErrs, validateScyllaArgsConfigConflicts(rack.ScyllaDB.AdditionalScyllaDBArguments, spec.ScyllaDB.Config, configFldPath, dcFldPath.Child("racks").Index(i).Child("scyllaDB", "additionalScyllaDBArguments"))...)
+			}
+		}
+	}
+
+	if spec.DatacenterTemplate != nil {
+		validateDatacenterTemplate(spec.DatacenterTemplate, fldPath.Child("datacenterTemplate"))
+	}
+
+	for i := range spec.Datacenters {
+		validateDatacenterTemplate(&spec.Datacenters[i].ScyllaDBClusterDatacenterTemplate, fldPath.Child("datacenters").Index(i))
+	}
+
+	return allErrs
+}
+
+// getScyllaDBClusterScyllaArgs returns the arguments of the cluster, datacenter templates, datacenters and their racks.
+func getScyllaDBClusterScyllaArgs(spec *scyllav1alpha1.ScyllaDBClusterSpec) [][]string {
+	scyllaArgs := [][]string{spec.ScyllaDB.AdditionalScyllaDBArguments}
+
+	getDatacenterTemplateArgs := func(dcTemplate *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate) {
+		if dcTemplate.ScyllaDB != nil {
+			scyllaArgs = append(scyllaArgs, dcTemplate.ScyllaDB.AdditionalScyllaDBArguments)
+		}
+
+		if dcTemplate.RackTemplate != nil && dcTemplate.RackTemplate.ScyllaDB != nil {
+			scyllaArgs = append(scyllaArgs, dcTemplate.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments)
+		}
+
+		for _, rack := range dcTemplate.Racks {
+			if rack.ScyllaDB != nil {
+				scyllaArgs = append(scyllaArgs, rack.ScyllaDB.AdditionalScyllaDBArguments)
+			}
+		}
+	}
+
+	if spec.DatacenterTemplate != nil {
+		getDatacenterTemplateArgs(spec.DatacenterTemplate)
+	}
+
+	for i := range spec.Datacenters {
+		getDatacenterTemplateArgs(&spec.Datacenters[i].ScyllaDBClusterDatacenterTemplate)
+	}
+
+	return scyllaArgs
+}
+
+// validateScyllaDBClusterScyllaArgsOperatorOwnedOptions rejects arguments of the cluster, datacenter templates,
+// datacenters and their racks setting options that are owned by the operator.
+// On updates, options that the old object already set are allowed, so objects created before the validation
+// was introduced can still be updated.
+func validateScyllaDBClusterScyllaArgsOperatorOwnedOptions(spec, oldSpec *scyllav1alpha1.ScyllaDBClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var nodeService *scyllav1alpha1.NodeServiceTemplate
+	if spec.ExposeOptions != nil {
+		nodeService = spec.ExposeOptions.NodeService
+	}
+
+	existingOptions := apimachineryutilsets.New[string]()
+	if oldSpec != nil {
+		existingOptions = getScyllaArgsOptions(getScyllaDBClusterScyllaArgs(oldSpec)...)
+	}
+
+	allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(spec.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, fldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
+
+	validateDatacenterTemplate := func(dcTemplate *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate, dcFldPath *field.Path) {
+		if dcTemplate.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(dcTemplate.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, dcFldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+
+		if dcTemplate.RackTemplate != nil && dcTemplate.RackTemplate.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(dcTemplate.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, dcFldPath.Child("rackTemplate", "scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+
+		for i, rack := range dcTemplate.Racks {
+			if rack.ScyllaDB != nil {
+				allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(rack.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, dcFldPath.Child("racks").Index(i).Child("scyllaDB", "additionalScyllaDBArguments"))...)
+			}
+		}
+	}
+
+	if spec.DatacenterTemplate != nil {
+		validateDatacenterTemplate(spec.DatacenterTemplate, fldPath.Child("datacenterTemplate"))
+	}
+
+	for i := range spec.Datacenters {
+		validateDatacenterTemplate(&spec.Datacenters[i].ScyllaDBClusterDatacenterTemplate, fldPath.Child("datacenters").Index(i))
+	}
+
+	return allErrs
+}
+
+func validateScyllaDBClusterExternalSeeds(externalSeeds []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := apimachineryutilsets.New[string]()
+	for i, seed := range externalSeeds {
+		if len(seed) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "external seed must not be empty"))
+			continue
+		}
+
+		if seen.Has(seed) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), seed))
+			continue
+		}
+		seen.Insert(seed)
+
+		if net.ParseIP(seed) != nil {
+			continue
+		}
+
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(seed) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), seed, fmt.Sprintf("must be an IP address or a valid DNS name: %s", msg)))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterSeedPolicy(seedPolicy *scyllav1alpha1.ScyllaDBClusterSeedPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if seedPolicy.SeedsPerRack != nil && *seedPolicy.SeedsPerRack < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seedsPerRack"), *seedPolicy.SeedsPerRack, "must be greater than or equal to 1"))
+	}
+
+	seen := apimachineryutilsets.New[string]()
+	for i, rackName := range seedPolicy.PreferredRacks {
+		if len(rackName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("preferredRacks").Index(i), "rack name must not be empty"))
+			continue
+		}
+
+		if seen.Has(rackName) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("preferredRacks").Index(i), rackName))
+			continue
+		}
+		seen.Insert(rackName)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterRolloutStrategy(strategy *scyllav1alpha1.ScyllaDBClusterRolloutStrategy, datacenters []scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	orderedDatacenterNames := apimachineryutilsets.New[string]()
+	for i, dcName := range strategy.DatacenterOrder {
+		if orderedDatacenterNames.Has(dcName) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("datacenterOrder").Index(i), dcName))
+			continue
+		}
+		orderedDatacenterNames.Insert(dcName)
+
+		if !slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == dcName
+		}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("datacenterOrder").Index(i), dcName, "must reference an existing datacenter"))
+		}
+	}
+
+	if strategy.MaxConcurrentDatacenters < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentDatacenters"), strategy.MaxConcurrentDatacenters, "must be greater than or equal to 1"))
+	}
+
+	return allErrs
+}
+
+func validateScyllaDBClusterDatacenterOperations(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	servingDatacenterNames := apimachineryutilsets.New[string]()
+	for _, dc := range spec.Datacenters {
+		if !dc.Decommission {
+			servingDatacenterNames.Insert(dc.Name)
+		}
+	}
+
+	if len(spec.Datacenters) > 0 && servingDatacenterNames.Len() == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("datacenters"), len(spec.Datacenters), "at least one datacenter must not be decommissioned"))
+	}
+
+	// Datacenters of an imported cluster are rebuilt from its external datacenter.
+	// Lost datacenters are dropped from replication before their nodes are removed.
+	requiresReplicationOptions := spec.Import != nil || len(spec.LostDatacenters) != 0
+	for i, dc := range spec.Datacenters {
+		dcFldPath := fldPath.Child("datacenters").Index(i)
+
+		if dc.Decommission {
+			requiresReplicationOptions = true
+		}
+
+		if dc.RebuildFrom == nil {
+			continue
+		}
+		requiresReplicationOptions = true
+
+		switch {
+		case dc.Decommission:
+			allErrs = append(allErrs, field.Forbidden(dcFldPath.Child("rebuildFrom"), "can't be set on a decommissioned datacenter"))
+		case *dc.RebuildFrom == dc.Name:
+			allErrs = append(allErrs, field.Invalid(dcFldPath.Child("rebuildFrom"), *dc.RebuildFrom, "must reference other datacenter"))
+		case spec.Import != nil && *dc.RebuildFrom == spec.Import.ExternalDatacenter:
+			// Datacenters can be rebuilt from the external datacenter of the imported cluster.
+		case !servingDatacenterNames.Has(*dc.RebuildFrom):
+			allErrs = append(allErrs, field.Invalid(dcFldPath.Child("rebuildFrom"), *dc.RebuildFrom, "must reference an existing datacenter which is not decommissioned"))
+		}
+	}
+
+	if spec.ReplicationOptions == nil {
+		if requiresReplicationOptions {
+			allErrs = append(allErrs, field.Required(fldPath.Child("replicationOptions"), "replicationOptions are required when a datacenter is rebuilt, decommissioned or lost"))
+		}
+
+		return allErrs
+	}
+
+	allErrs = append(allErrs, ValidateScyllaDBClusterReplicationOptions(spec.ReplicationOptions, requiresReplicationOptions, fldPath.Child("replicationOptions"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterImport(clusterImport *scyllav1alpha1.ScyllaDBClusterImport, datacenters []scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(clusterImport.ExternalDatacenter) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("externalDatacenter"), "external datacenter name must not be empty"))
+	} else if slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+		return dc.Name == clusterImport.ExternalDatacenter
+	}) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("externalDatacenter"), clusterImport.ExternalDatacenter, "must not collide with a name of a datacenter"))
+	} else if !slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+		return dc.RebuildFrom != nil && *dc.RebuildFrom == clusterImport.ExternalDatacenter
+	}) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("externalDatacenter"), clusterImport.ExternalDatacenter, "at least one datacenter must be rebuilt from the external datacenter"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterReplicationOptions(options *scyllav1alpha1.ScyllaDBClusterReplicationOptions, requireKeyspaces bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if requireKeyspaces && len(options.Keyspaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyspaces"), "at least one keyspace is required when a datacenter is rebuilt, decommissioned or lost"))
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(options.Keyspaces, func(keyspace scyllav1alpha1.ScyllaDBClusterKeyspaceReplication) string {
+		return keyspace.Name
+	}, "name", fldPath.Child("keyspaces"))...)
+
+	for i, keyspace := range options.Keyspaces {
+		if len(keyspace.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyspaces").Index(i).Child("name"), "keyspace name must not be empty"))
+		}
+
+		if keyspace.ReplicationFactor < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyspaces").Index(i).Child("replicationFactor"), keyspace.ReplicationFactor, "must be greater than or equal to 1"))
+		}
+	}
+
+	if options.CQLCredentialsSecretRef != nil {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(options.CQLCredentialsSecretRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cqlCredentialsSecretRef", "name"), options.CQLCredentialsSecretRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateScyllaDBClusterDatacenterTemplate(dcTemplate *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -153,9 +486,9 @@ func ValidateScyllaDBClusterNodeService(nodeService *scyllav1alpha1.NodeServiceT
 	var allErrs field.ErrorList
 
 	if len(nodeService.Type) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("type"), fmt.Sprintf("supported values: %s", strings.Join(oslices.ConvertSlice(supportedNodeServiceTypes, oslices.ToString[scyllav1alpha1.NodeServiceType]), ", "))))
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), fmt.Sprintf("supported values: %s", strings.Join(oslices.ConvertSlice(supportedScyllaDBClusterNodeServiceTypes, oslices.ToString[scyllav1alpha1.NodeServiceType]), ", "))))
 	} else {
-		allErrs = append(allErrs, validateEnum(nodeService.Type, supportedNodeServiceTypes, fldPath.Child("type"))...)
+		allErrs = append(allErrs, validateEnum(nodeService.Type, supportedScyllaDBClusterNodeServiceTypes, fldPath.Child("type"))...)
 	}
 
 	if nodeService.LoadBalancerClass != nil && len(*nodeService.LoadBalancerClass) != 0 {
@@ -167,6 +500,9 @@ func ValidateScyllaDBClusterNodeService(nodeService *scyllav1alpha1.NodeServiceT
 	if len(nodeService.Annotations) != 0 {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(nodeService.Annotations, fldPath.Child("annotations"))...)
 	}
+
+	allErrs = append(allErrs, validateNodePortAllocationOptions(nodeService, fldPath)...)
+
 	return allErrs
 }
 
@@ -181,7 +517,7 @@ func ValidateScyllaDBClusterNodeBroadcastOptions(options *scyllav1alpha1.ScyllaD
 	allErrs = append(allErrs,
 		ValidateScyllaDBDatacenterBroadcastOptions(
 			options.Clients.Type,
-			SupportedScyllaV1Alpha1BroadcastAddressTypes,
+			supportedScyllaV1Alpha1NodesBroadcastAddressTypes,
 			scyllav1alpha1.NodeServiceTypeHeadless,
 			nodeServiceType,
 			allowedNodeServiceTypesByBroadcastAddressType,
@@ -192,7 +528,7 @@ func ValidateScyllaDBClusterNodeBroadcastOptions(options *scyllav1alpha1.ScyllaD
 	allErrs = append(allErrs,
 		ValidateScyllaDBDatacenterBroadcastOptions(
 			options.Nodes.Type,
-			SupportedScyllaV1Alpha1BroadcastAddressTypes,
+			supportedScyllaV1Alpha1NodesBroadcastAddressTypes,
 			scyllav1alpha1.NodeServiceTypeHeadless,
 			nodeServiceType,
 			allowedNodeServiceTypesByBroadcastAddressType,
@@ -200,13 +536,21 @@ func ValidateScyllaDBClusterNodeBroadcastOptions(options *scyllav1alpha1.ScyllaD
 		)...,
 	)
 
+	if options.Clients.IPFamily != nil {
+		allErrs = append(allErrs, validateEnum(*options.Clients.IPFamily, supportedBroadcastIPFamilies, fldPath.Child("clients", "ipFamily"))...)
+	}
+
+	if options.Nodes.IPFamily != nil {
+		allErrs = append(allErrs, validateEnum(*options.Nodes.IPFamily, supportedBroadcastIPFamilies, fldPath.Child("nodes", "ipFamily"))...)
+	}
+
 	return allErrs
 }
 
 func ValidateScyllaDBClusterUpdate(new, old *scyllav1alpha1.ScyllaDBCluster) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, ValidateScyllaDBCluster(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBClusterSpec(&new.Spec, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateScyllaDBClusterSpecUpdate(new, old, field.NewPath("spec"))...)
 
 	return allErrs
@@ -216,6 +560,12 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ClusterName, old.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, validateScyllaDBClusterScyllaArgsOperatorOwnedOptions(&new.Spec, &old.Spec, fldPath)...)
+
+	// External seeds are only validated when they change, so objects created before the validation was introduced can still be updated.
+	if !slices.Equal(new.Spec.ScyllaDB.ExternalSeeds, old.Spec.ScyllaDB.ExternalSeeds) {
+		allErrs = append(allErrs, validateScyllaDBClusterExternalSeeds(new.Spec.ScyllaDB.ExternalSeeds, fldPath.Child("scyllaDB", "externalSeeds"))...)
+	}
 
 	oldDatacenterNames := oslices.ConvertSlice(old.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) string {
 		return dc.Name
@@ -237,6 +587,13 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 				continue
 			}
 
+			// Nodes of lost datacenters are removed by the Operator after the datacenter is removed from the spec.
+			if slices.ContainsFunc(new.Spec.LostDatacenters, func(lostDC scyllav1alpha1.ScyllaDBClusterLostDatacenter) bool {
+				return lostDC.Name == removedDCName
+			}) {
+				continue
+			}
+
 			oldDCStatus, _, ok := oslices.Find(old.Status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
 				return dcStatus.Name == removedDCName
 			})
@@ -256,6 +613,20 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 		}
 	}
 
+	for i, lostDC := range new.Spec.LostDatacenters {
+		wasDatacenter := slices.Contains(oldDatacenterNames, lostDC.Name)
+		wasLostDatacenter := slices.ContainsFunc(old.Spec.LostDatacenters, func(oldLostDC scyllav1alpha1.ScyllaDBClusterLostDatacenter) bool {
+			return oldLostDC.Name == lostDC.Name
+		})
+		if !wasDatacenter && !wasLostDatacenter {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("lostDatacenters").Index(i).Child("name"), lostDC.Name, "must reference a datacenter which is part of the cluster"))
+		}
+	}
+
+	if new.Spec.Import != nil && old.Spec.Import != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.Import.ExternalDatacenter, old.Spec.Import.ExternalDatacenter, fldPath.Child("import", "externalDatacenter"))...)
+	}
+
 	type dcRackProperties struct {
 		datacenter string
 		rack       string
@@ -303,6 +674,13 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	oldRacks := collectRacks(old)
 
 	removedRacks := oslices.Filter(oldRacks, func(odr dcRackProperties) bool {
+		// Racks of lost datacenters can't be scaled down.
+		if slices.ContainsFunc(new.Spec.LostDatacenters, func(lostDC scyllav1alpha1.ScyllaDBClusterLostDatacenter) bool {
+			return lostDC.Name == odr.datacenter
+		}) {
+			return false
+		}
+
 		_, _, ok := oslices.Find(newRacks, func(ndr dcRackProperties) bool {
 			return ndr.rack == odr.rack && ndr.datacenter == odr.datacenter
 		})
@@ -366,6 +744,15 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newRack.storage, oldRackStorage, newRack.fieldPath.Child("scyllaDB", "storage"))...)
 	}
 
+	for dcIdx, newDC := range new.Spec.Datacenters {
+		oldDC, _, ok := oslices.Find(old.Spec.Datacenters, func(oldDC scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return oldDC.Name == newDC.Name
+		})
+		if ok && oldDC.Decommission && !newDC.Decommission {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenters").Index(dcIdx).Child("decommission"), "decommission of a datacenter can't be reverted"))
+		}
+	}
+
 	for dcIdx, newDC := range new.Spec.Datacenters {
 		var oldDatacenterStorage, newDatacenterStorage *scyllav1alpha1.StorageOptions
 		var oldDatacenterRackTemplateStorage, newDatacenterRackTemplateStorage *scyllav1alpha1.StorageOptions
@@ -411,6 +798,24 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodesBroadcastAddressType, oldNodesBroadcastAddressType, fldPath.Child("exposeOptions", "broadcastOptions", "nodes", "type"))...)
 
+	var oldClientsBroadcastIPFamily, newClientsBroadcastIPFamily *corev1.IPFamily
+	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.BroadcastOptions != nil {
+		oldClientsBroadcastIPFamily = old.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily
+	}
+	if new.Spec.ExposeOptions != nil && new.Spec.ExposeOptions.BroadcastOptions != nil {
+		newClientsBroadcastIPFamily = new.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newClientsBroadcastIPFamily, oldClientsBroadcastIPFamily, fldPath.Child("exposeOptions", "broadcastOptions", "clients", "ipFamily"))...)
+
+	var oldNodesBroadcastIPFamily, newNodesBroadcastIPFamily *corev1.IPFamily
+	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.BroadcastOptions != nil {
+		oldNodesBroadcastIPFamily = old.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily
+	}
+	if new.Spec.ExposeOptions != nil && new.Spec.ExposeOptions.BroadcastOptions != nil {
+		newNodesBroadcastIPFamily = new.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodesBroadcastIPFamily, oldNodesBroadcastIPFamily, fldPath.Child("exposeOptions", "broadcastOptions", "nodes", "ipFamily"))...)
+
 	var oldNodeServiceType, newNodeServiceType *scyllav1alpha1.NodeServiceType
 	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.NodeService != nil {
 		oldNodeServiceType = pointer.Ptr(old.Spec.ExposeOptions.NodeService.Type)
@@ -90,6 +90,49 @@ func TestValidateScyllaDBCluster(t *testing.T) {
 			expectedErrorList:   nil,
 			expectedErrorString: "",
 		},
+		{
+			name: "datacenter arguments conflicting with ScyllaDB config",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Compaction: &scyllav1alpha1.ScyllaDBCompactionConfig{
+						ThroughputMBPerSecond: pointer.Ptr[int32](64),
+					},
+				}
+				sc.Spec.DatacenterTemplate.ScyllaDB = &scyllav1alpha1.ScyllaDBTemplate{
+					AdditionalScyllaDBArguments: []string{"--compaction-throughput-mb-per-sec=32"},
+				}
+				sc.Spec.Datacenters[0].RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						AdditionalScyllaDBArguments: []string{"--smp=2", "--compaction_throughput_mb_per_sec 16"},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenterTemplate.scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--compaction-throughput-mb-per-sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[0].rackTemplate.scyllaDB.additionalScyllaDBArguments[1]", BadValue: "", Detail: `argument "--compaction_throughput_mb_per_sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config`},
+			},
+			expectedErrorString: `[spec.datacenterTemplate.scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--compaction-throughput-mb-per-sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config, spec.datacenters[0].rackTemplate.scyllaDB.additionalScyllaDBArguments[1]: Forbidden: argument "--compaction_throughput_mb_per_sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config]`,
+		},
+		{
+			name: "arguments setting operator-owned options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--broadcast-rpc-address=10.0.0.1"}
+				sc.Spec.Datacenters[0].ScyllaDB = &scyllav1alpha1.ScyllaDBTemplate{
+					AdditionalScyllaDBArguments: []string{"--endpoint-snitch=SimpleSnitch"},
+				}
+				sc.Spec.Datacenters[0].Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster_name other"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--broadcast-rpc-address" sets option "broadcast_rpc_address" which is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--endpoint-snitch" sets option "endpoint_snitch" which is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[0].racks[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--cluster_name" sets option "cluster_name" which is managed by the operator`},
+			},
+			expectedErrorString: `[spec.scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--broadcast-rpc-address" sets option "broadcast_rpc_address" which is managed by the operator, spec.datacenters[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--endpoint-snitch" sets option "endpoint_snitch" which is managed by the operator, spec.datacenters[0].racks[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--cluster_name" sets option "cluster_name" which is managed by the operator]`,
+		},
 		{
 			name: "invalid ScyllaDB image",
 			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
@@ -1633,6 +1676,48 @@ func TestValidateScyllaDBCluster(t *testing.T) {
 			},
 			expectedErrorString: `spec.datacenterTemplate.placement.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchFields[0].key: Invalid value: "metadata.namespace": not a valid field selector key`,
 		},
+		{
+			name: "invalid topology spread constraint in datacenter template placement",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.DatacenterTemplate.Placement = &scyllav1alpha1.Placement{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           0,
+							TopologyKey:       "",
+							WhenUnsatisfiable: "Sometimes",
+						},
+					},
+				}
+
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenterTemplate.placement.topologySpreadConstraints[0].maxSkew", BadValue: int32(0), Detail: "must be greater than zero"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.datacenterTemplate.placement.topologySpreadConstraints[0].topologyKey", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.datacenterTemplate.placement.topologySpreadConstraints[0].whenUnsatisfiable", BadValue: corev1.UnsatisfiableConstraintAction("Sometimes"), Detail: `supported values: "DoNotSchedule", "ScheduleAnyway"`},
+			},
+			expectedErrorString: `[spec.datacenterTemplate.placement.topologySpreadConstraints[0].maxSkew: Invalid value: 0: must be greater than zero, spec.datacenterTemplate.placement.topologySpreadConstraints[0].topologyKey: Required value, spec.datacenterTemplate.placement.topologySpreadConstraints[0].whenUnsatisfiable: Unsupported value: "Sometimes": supported values: "DoNotSchedule", "ScheduleAnyway"]`,
+		},
+		{
+			name: "invalid priority class, scheduler and runtime class names in datacenter template placement",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.DatacenterTemplate.Placement = &scyllav1alpha1.Placement{
+					PriorityClassName: "Invalid_Name",
+					SchedulerName:     "Invalid_Name",
+					RuntimeClassName:  pointer.Ptr("Invalid_Name"),
+				}
+
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenterTemplate.placement.priorityClassName", BadValue: "Invalid_Name", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenterTemplate.placement.schedulerName", BadValue: "Invalid_Name", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenterTemplate.placement.runtimeClassName", BadValue: "Invalid_Name", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+			},
+			expectedErrorString: `[spec.datacenterTemplate.placement.priorityClassName: Invalid value: "Invalid_Name": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), spec.datacenterTemplate.placement.schedulerName: Invalid value: "Invalid_Name": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), spec.datacenterTemplate.placement.runtimeClassName: Invalid value: "Invalid_Name": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]`,
+		},
 		{
 			name: "invalid preferredSchedulingTerm in node affinity having weight out of range in datacenter template placement",
 			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
@@ -2510,6 +2595,408 @@ func TestValidateScyllaDBCluster(t *testing.T) {
 			},
 			expectedErrorString: "spec.datacenterTemplate.placement.tolerations[0].effect: Invalid value: \"NoSchedule\": effect must be 'NoExecute' when `tolerationSeconds` is set",
 		},
+		{
+			name: "datacenter rebuilt from other datacenter with replication options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RebuildFrom = pointer.Ptr("dc")
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "datacenter rebuilt without replication options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RebuildFrom = pointer.Ptr("dc")
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replicationOptions", BadValue: "", Detail: "replicationOptions are required when a datacenter is rebuilt, decommissioned or lost"},
+			},
+			expectedErrorString: "spec.replicationOptions: Required value: replicationOptions are required when a datacenter is rebuilt, decommissioned or lost",
+		},
+		{
+			name: "datacenter rebuilt from itself",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("dc")
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenters[0].rebuildFrom", BadValue: "dc", Detail: "must reference other datacenter"},
+			},
+			expectedErrorString: `spec.datacenters[0].rebuildFrom: Invalid value: "dc": must reference other datacenter`,
+		},
+		{
+			name: "datacenter rebuilt from decommissioned datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.Decommission = true
+				dc3 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc3.Name = "dc3"
+				dc3.RebuildFrom = pointer.Ptr("dc2")
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2, dc3)
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenters[2].rebuildFrom", BadValue: "dc2", Detail: "must reference an existing datacenter which is not decommissioned"},
+			},
+			expectedErrorString: `spec.datacenters[2].rebuildFrom: Invalid value: "dc2": must reference an existing datacenter which is not decommissioned`,
+		},
+		{
+			name: "decommissioned datacenter rebuilt from other datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.Decommission = true
+				dc2.RebuildFrom = pointer.Ptr("dc")
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[1].rebuildFrom", BadValue: "", Detail: "can't be set on a decommissioned datacenter"},
+			},
+			expectedErrorString: `spec.datacenters[1].rebuildFrom: Forbidden: can't be set on a decommissioned datacenter`,
+		},
+		{
+			name: "datacenter rebuilt from external datacenter of imported cluster",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "vm-dc",
+				}
+				sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("vm-dc")
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "import without external seeds and replication options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "vm-dc",
+				}
+				sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("vm-dc")
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replicationOptions", BadValue: "", Detail: "replicationOptions are required when a datacenter is rebuilt, decommissioned or lost"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDB.externalSeeds", BadValue: "", Detail: "external seeds are required when importing an existing cluster"},
+			},
+			expectedErrorString: `[spec.replicationOptions: Required value: replicationOptions are required when a datacenter is rebuilt, decommissioned or lost, spec.scyllaDB.externalSeeds: Required value: external seeds are required when importing an existing cluster]`,
+		},
+		{
+			name: "import with external datacenter colliding with datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "dc",
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.import.externalDatacenter", BadValue: "dc", Detail: "must not collide with a name of a datacenter"},
+			},
+			expectedErrorString: `spec.import.externalDatacenter: Invalid value: "dc": must not collide with a name of a datacenter`,
+		},
+		{
+			name: "import without datacenter rebuilt from external datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "vm-dc",
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.import.externalDatacenter", BadValue: "vm-dc", Detail: "at least one datacenter must be rebuilt from the external datacenter"},
+			},
+			expectedErrorString: `spec.import.externalDatacenter: Invalid value: "vm-dc": at least one datacenter must be rebuilt from the external datacenter`,
+		},
+		{
+			name: "import with empty external datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.import.externalDatacenter", BadValue: "", Detail: "external datacenter name must not be empty"},
+			},
+			expectedErrorString: `spec.import.externalDatacenter: Required value: external datacenter name must not be empty`,
+		},
+		{
+			name: "all datacenters decommissioned",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[0].Decommission = true
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenters", BadValue: 1, Detail: "at least one datacenter must not be decommissioned"},
+			},
+			expectedErrorString: `spec.datacenters: Invalid value: 1: at least one datacenter must not be decommissioned`,
+		},
+		{
+			name: "invalid keyspace replication options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+						{
+							Name:              "ks",
+							ReplicationFactor: 0,
+						},
+						{
+							Name:              "",
+							ReplicationFactor: 1,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.replicationOptions.keyspaces[1].name", BadValue: "ks"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.replicationOptions.keyspaces[1].replicationFactor", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replicationOptions.keyspaces[2].name", BadValue: "", Detail: "keyspace name must not be empty"},
+			},
+			expectedErrorString: `[spec.replicationOptions.keyspaces[1].name: Duplicate value: "ks", spec.replicationOptions.keyspaces[1].replicationFactor: Invalid value: 0: must be greater than or equal to 1, spec.replicationOptions.keyspaces[2].name: Required value: keyspace name must not be empty]`,
+		},
+		{
+			name: "lost datacenter still present in datacenters",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+					{
+						Name: "dc",
+					},
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.lostDatacenters[0].name", BadValue: "dc", Detail: "lost datacenter must be removed from datacenters"},
+			},
+			expectedErrorString: `spec.lostDatacenters[0].name: Invalid value: "dc": lost datacenter must be removed from datacenters`,
+		},
+		{
+			name: "lost datacenter requires replication options",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+					{
+						Name: "dc2",
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replicationOptions", BadValue: "", Detail: "replicationOptions are required when a datacenter is rebuilt, decommissioned or lost"},
+			},
+			expectedErrorString: "spec.replicationOptions: Required value: replicationOptions are required when a datacenter is rebuilt, decommissioned or lost",
+		},
+		{
+			name: "duplicated and empty lost datacenters",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+					{
+						Name: "dc2",
+					},
+					{
+						Name: "dc2",
+					},
+					{
+						Name: "",
+					},
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.lostDatacenters[1].name", BadValue: "dc2"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.lostDatacenters[2].name", BadValue: "", Detail: "datacenter name must not be empty"},
+			},
+			expectedErrorString: `[spec.lostDatacenters[1].name: Duplicate value: "dc2", spec.lostDatacenters[2].name: Required value: datacenter name must not be empty]`,
+		},
+		{
+			name: "valid external seeds",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1", "fd00::1", "seed.example.com"}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid, duplicated and empty external seeds",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1", "10.0.0.1", "", "seed.example.com:7000"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.scyllaDB.externalSeeds[1]", BadValue: "10.0.0.1"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDB.externalSeeds[2]", BadValue: "", Detail: "external seed must not be empty"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.externalSeeds[3]", BadValue: "seed.example.com:7000", Detail: "must be an IP address or a valid DNS name: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"},
+			},
+			expectedErrorString: `[spec.scyllaDB.externalSeeds[1]: Duplicate value: "10.0.0.1", spec.scyllaDB.externalSeeds[2]: Required value: external seed must not be empty, spec.scyllaDB.externalSeeds[3]: Invalid value: "seed.example.com:7000": must be an IP address or a valid DNS name: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]`,
+		},
+		{
+			name: "invalid seed policy",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.SeedPolicy = &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+					SeedsPerRack:   pointer.Ptr[int32](0),
+					PreferredRacks: []string{"rack", "rack", ""},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.seedPolicy.seedsPerRack", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.seedPolicy.preferredRacks[1]", BadValue: "rack"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.seedPolicy.preferredRacks[2]", BadValue: "", Detail: "rack name must not be empty"},
+			},
+			expectedErrorString: `[spec.seedPolicy.seedsPerRack: Invalid value: 0: must be greater than or equal to 1, spec.seedPolicy.preferredRacks[1]: Duplicate value: "rack", spec.seedPolicy.preferredRacks[2]: Required value: rack name must not be empty]`,
+		},
+		{
+			name: "valid rollout strategy",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RolloutStrategy = &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+					DatacenterOrder:          []string{"dc"},
+					MaxConcurrentDatacenters: 1,
+				}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rollout strategy with unknown and duplicated datacenters and invalid concurrency",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RolloutStrategy = &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+					DatacenterOrder:          []string{"dc", "dc", "dc2"},
+					MaxConcurrentDatacenters: 0,
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.rolloutStrategy.datacenterOrder[1]", BadValue: "dc"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.datacenterOrder[2]", BadValue: "dc2", Detail: "must reference an existing datacenter"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.maxConcurrentDatacenters", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+			},
+			expectedErrorString: `[spec.rolloutStrategy.datacenterOrder[1]: Duplicate value: "dc", spec.rolloutStrategy.datacenterOrder[2]: Invalid value: "dc2": must reference an existing datacenter, spec.rolloutStrategy.maxConcurrentDatacenters: Invalid value: 0: must be greater than or equal to 1]`,
+		},
 	}
 
 	for _, test := range tests {
@@ -2604,6 +3091,173 @@ func TestValidateScyllaDBClusterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.clusterName: Invalid value: "foo": field is immutable`,
 		},
+		{
+			name: "unchanged invalid external seeds are allowed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"seed.example.com:7000"}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"seed.example.com:7000"}
+				sc.Labels["foo"] = "bar"
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "changed external seeds are validated",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"seed.example.com:7000"}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"seed.example.com:7000", "10.0.0.1", "10.0.0.1"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.externalSeeds[0]", BadValue: "seed.example.com:7000", Detail: "must be an IP address or a valid DNS name: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.scyllaDB.externalSeeds[2]", BadValue: "10.0.0.1"},
+			},
+			expectedErrorString: `[spec.scyllaDB.externalSeeds[0]: Invalid value: "seed.example.com:7000": must be an IP address or a valid DNS name: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), spec.scyllaDB.externalSeeds[2]: Duplicate value: "10.0.0.1"]`,
+		},
+		{
+			name: "lost datacenter with nodes removed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+					{
+						Name: "dc2",
+					},
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "unknown datacenter declared as lost",
+			old:  newValidScyllaDBCluster(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+					{
+						Name: "dc2",
+					},
+				}
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.lostDatacenters[0].name", BadValue: "dc2", Detail: "must reference a datacenter which is part of the cluster"},
+			},
+			expectedErrorString: `spec.lostDatacenters[0].name: Invalid value: "dc2": must reference a datacenter which is part of the cluster`,
+		},
+		{
+			name: "external datacenter of imported cluster changed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "vm-dc",
+				}
+				sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("vm-dc")
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1"}
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "other-vm-dc",
+				}
+				sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("other-vm-dc")
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.import.externalDatacenter", BadValue: "other-vm-dc", Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.import.externalDatacenter: Invalid value: "other-vm-dc": field is immutable`,
+		},
+		{
+			name: "decommission of datacenter reverted",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.Decommission = true
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+					Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+						{
+							Name:              "ks",
+							ReplicationFactor: 3,
+						},
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[1].decommission", BadValue: "", Detail: "decommission of a datacenter can't be reverted"},
+			},
+			expectedErrorString: `spec.datacenters[1].decommission: Forbidden: decommission of a datacenter can't be reverted`,
+		},
 		{
 			name: "empty rack removed",
 			old: func() *scyllav1alpha1.ScyllaDBCluster {
@@ -3090,6 +3744,39 @@ func TestValidateScyllaDBClusterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "ServiceLoadBalancerIngress": field is immutable`,
 		},
+		{
+			name: "operator-owned options set by the old object are allowed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--endpoint-snitch=GossipingPropertyFileSnitch"}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--endpoint-snitch=GossipingPropertyFileSnitch", "--smp=2"}
+				sc.Spec.Datacenters[0].Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--endpoint_snitch=GossipingPropertyFileSnitch"}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "operator-owned options added on update",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--endpoint-snitch=GossipingPropertyFileSnitch"}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--endpoint-snitch=GossipingPropertyFileSnitch", "--cluster-name=other"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.additionalScyllaDBArguments[1]", BadValue: "", Detail: `argument "--cluster-name" sets option "cluster_name" which is managed by the operator`},
+			},
+			expectedErrorString: `spec.scyllaDB.additionalScyllaDBArguments[1]: Forbidden: argument "--cluster-name" sets option "cluster_name" which is managed by the operator`,
+		},
 	}
 
 	for _, test := range tests {
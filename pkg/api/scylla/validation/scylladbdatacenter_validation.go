@@ -4,7 +4,9 @@ package validation
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -12,7 +14,9 @@ import (
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
 	corevalidation "github.com/scylladb/scylla-operator/pkg/thirdparty/k8s.io/kubernetes/pkg/apis/core/validation"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -28,35 +32,97 @@ var (
 		scyllav1alpha1.BroadcastAddressTypePodIP,
 		scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
 		scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress,
+		scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+	}
+
+	// supportedScyllaV1Alpha1NodesBroadcastAddressTypes lists broadcast address types that can be used for inter-node communication.
+	// NodePort Services only expose client ports, so the host IP can't be broadcasted to other nodes.
+	supportedScyllaV1Alpha1NodesBroadcastAddressTypes = []scyllav1alpha1.BroadcastAddressType{
+		scyllav1alpha1.BroadcastAddressTypePodIP,
+		scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
+		scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress,
 	}
 
 	allowedNodeServiceTypesByBroadcastAddressType = map[scyllav1alpha1.BroadcastAddressType][]scyllav1alpha1.NodeServiceType{
 		scyllav1alpha1.BroadcastAddressTypeServiceClusterIP: {
 			scyllav1alpha1.NodeServiceTypeClusterIP,
 			scyllav1alpha1.NodeServiceTypeLoadBalancer,
+			scyllav1alpha1.NodeServiceTypeNodePort,
 		},
 		scyllav1alpha1.BroadcastAddressTypePodIP: {
 			scyllav1alpha1.NodeServiceTypeHeadless,
 			scyllav1alpha1.NodeServiceTypeClusterIP,
 			scyllav1alpha1.NodeServiceTypeLoadBalancer,
+			scyllav1alpha1.NodeServiceTypeNodePort,
 		},
 		scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress: {
 			scyllav1alpha1.NodeServiceTypeLoadBalancer,
 		},
+		scyllav1alpha1.BroadcastAddressTypeServiceNodePort: {
+			scyllav1alpha1.NodeServiceTypeNodePort,
+		},
 	}
 
 	supportedNodeServiceTypes = []scyllav1alpha1.NodeServiceType{
 		scyllav1alpha1.NodeServiceTypeHeadless,
 		scyllav1alpha1.NodeServiceTypeClusterIP,
 		scyllav1alpha1.NodeServiceTypeLoadBalancer,
+		scyllav1alpha1.NodeServiceTypeNodePort,
+	}
+
+	// supportedScyllaDBClusterNodeServiceTypes lists node service types supported by ScyllaDBCluster.
+	// Identity Services of remote datacenters use default ports, so node ports allocated by NodePort node Services can't be used.
+	supportedScyllaDBClusterNodeServiceTypes = []scyllav1alpha1.NodeServiceType{
+		scyllav1alpha1.NodeServiceTypeHeadless,
+		scyllav1alpha1.NodeServiceTypeClusterIP,
+		scyllav1alpha1.NodeServiceTypeLoadBalancer,
+	}
+
+	supportedScyllaDBReadinessModes = []scyllav1alpha1.ScyllaDBReadinessMode{
+		scyllav1alpha1.ScyllaDBReadinessModeNodeStatus,
+		scyllav1alpha1.ScyllaDBReadinessModeCQLQuery,
+		scyllav1alpha1.ScyllaDBReadinessModePeerQuorum,
+	}
+
+	supportedAlternatorEndpointTypes = []scyllav1alpha1.AlternatorEndpointType{
+		scyllav1alpha1.AlternatorEndpointTypeService,
+		scyllav1alpha1.AlternatorEndpointTypeIngress,
+	}
+
+	supportedAlternatorEndpointServiceTypes = []corev1.ServiceType{
+		corev1.ServiceTypeClusterIP,
+		corev1.ServiceTypeNodePort,
+		corev1.ServiceTypeLoadBalancer,
+	}
+
+	supportedBroadcastIPFamilies = []corev1.IPFamily{
+		corev1.IPv4Protocol,
+		corev1.IPv6Protocol,
 	}
 )
 
 func ValidateScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter) field.ErrorList {
+	return validateScyllaDBDatacenter(sdc, nil)
+}
+
+func validateScyllaDBDatacenter(sdc, old *scyllav1alpha1.ScyllaDBDatacenter) field.ErrorList {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ValidateScyllaDBDatacenterSpec(sdc.Spec, field.NewPath("spec"))...)
 
+	var oldSpec *scyllav1alpha1.ScyllaDBDatacenterSpec
+	if old != nil {
+		oldSpec = &old.Spec
+	}
+	allErrs = append(allErrs, validateScyllaDBDatacenterScyllaArgsOperatorOwnedOptions(&sdc.Spec, oldSpec, field.NewPath("spec"))...)
+
+	allErrs = append(allErrs, ValidateScyllaDBDatacenterNodeReplacements(&sdc.Spec, old, field.NewPath("spec", "nodeReplacements"))...)
+
+	// Backups are restored through the global ScyllaDB Manager instance.
+	if sdc.Spec.BootstrapFrom != nil && sdc.Spec.BootstrapFrom.Backup != nil && sdc.Labels[naming.GlobalScyllaDBManagerRegistrationLabel] != naming.LabelValueTrue {
+		allErrs = append(allErrs, field.Required(field.NewPath("metadata", "labels").Key(naming.GlobalScyllaDBManagerRegistrationLabel), fmt.Sprintf("must be %q when bootstrapping from a backup", naming.LabelValueTrue)))
+	}
+
 	return allErrs
 }
 
@@ -67,7 +133,7 @@ func ValidateScyllaDBDatacenterSpec(spec scyllav1alpha1.ScyllaDBDatacenterSpec,
 	allErrs = append(allErrs, ValidateScyllaDBDatacenterScyllaDBManagerAgent(spec.ScyllaDBManagerAgent, fldPath.Child("scyllaDBManagerAgent"))...)
 
 	if spec.ScyllaDB.AdditionalScyllaDBArguments != nil {
-		allErrs = append(allErrs, ValidateScyllaArgsIPFamily(spec.GetIPFamily(), spec.ScyllaDB.AdditionalScyllaDBArguments, fldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
+		allErrs = append(allErrs, validateScyllaDBDatacenterScyllaArgsIPFamilies(&spec, spec.ScyllaDB.AdditionalScyllaDBArguments, fldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
 	}
 
 	allErrs = append(allErrs, validateStructSliceFieldUniqueness(spec.Racks, func(rackSpec scyllav1alpha1.RackSpec) string {
@@ -76,6 +142,26 @@ func ValidateScyllaDBDatacenterSpec(spec scyllav1alpha1.ScyllaDBDatacenterSpec,
 
 	if spec.RackTemplate != nil {
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterRackTemplate(spec.RackTemplate, fldPath.Child("rackTemplate"))...)
+
+		if spec.RackTemplate.ScyllaDB != nil && spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments != nil {
+			allErrs = append(allErrs, validateScyllaDBDatacenterScyllaArgsIPFamilies(&spec, spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments, fldPath.Child("rackTemplate", "scyllaDB", "additionalScyllaDBArguments"))...)
+			allErrs = append(allErrs, validateScyllaArgsConfigConflicts(spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments, spec.ScyllaDB.Config, fldPath.Child("scyllaDB", "config"), fldPath.Child("rackTemplate", "scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+	}
+
+	for i, rack := range spec.Racks {
+		if rack.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaDBImageOverride(rack.ScyllaDB.Image, fldPath.Child("racks").Index(i).Child("scyllaDB", "image"))...)
+
+			if rack.ScyllaDB.AdditionalScyllaDBArguments != nil {
+				allErrs = append(allErrs, validateScyllaDBDatacenterScyllaArgsIPFamilies(&spec, rack.ScyllaDB.AdditionalScyllaDBArguments, fldPath.Child("racks").Index(i).Child("scyllaDB", "additionalScyllaDBArguments"))...)
+				allErrs = append(allErrs, validateScyllaArgsConfigConflicts(rack.ScyllaDB.AdditionalScyllaDBArguments, spec.ScyllaDB.Config, fldPath.Child("scyllaDB", "config"), fldPath.Child("racks").Index(i).Child("scyllaDB", "additionalScyllaDBArguments"))...)
+			}
+		}
+
+		if rack.PodTemplate != nil {
+			allErrs = append(allErrs, ValidateScyllaDBDatacenterPodTemplate(rack.PodTemplate, fldPath.Child("racks").Index(i).Child("podTemplate"))...)
+		}
 	}
 
 	for i, domain := range spec.DNSDomains {
@@ -86,12 +172,33 @@ func ValidateScyllaDBDatacenterSpec(spec scyllav1alpha1.ScyllaDBDatacenterSpec,
 		if spec.ExposeOptions.CQL != nil && spec.ExposeOptions.CQL.Ingress != nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("dnsDomains"), "at least one domain needs to be provided when exposing CQL via ingresses"))
 		}
+
+		if spec.ExposeOptions.Gateway != nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("dnsDomains"), "at least one domain needs to be provided when exposing backends via the Gateway API"))
+		}
+	}
+
+	if spec.ExposeOptions != nil && spec.ExposeOptions.Gateway != nil && spec.ExposeOptions.Gateway.Alternator != nil && spec.ScyllaDB.AlternatorOptions == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("exposeOptions", "gateway", "alternator"), "can't be set when Alternator isn't enabled"))
+	}
+
+	if spec.ExposeOptions != nil && spec.ExposeOptions.Gateway != nil && spec.ExposeOptions.Gateway.Alternator != nil &&
+		spec.ScyllaDB.AlternatorOptions != nil && spec.ScyllaDB.AlternatorOptions.ServingCertificate != nil &&
+		spec.ScyllaDB.AlternatorOptions.ServingCertificate.Type != scyllav1alpha1.TLSCertificateTypeOperatorManaged {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("exposeOptions", "gateway", "alternator"), "can't be set when Alternator doesn't use an operator-managed serving certificate"))
 	}
 
 	if spec.ExposeOptions != nil {
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterSpecExposeOptions(spec.ExposeOptions, fldPath.Child("exposeOptions"))...)
 	}
 
+	allErrs = append(allErrs, validateNodePortAllocation(&spec, fldPath)...)
+	allErrs = append(allErrs, validateBroadcastIPFamilies(&spec, fldPath)...)
+
+	if spec.ClientConnectionBundle != nil {
+		allErrs = append(allErrs, ValidateClientConnectionBundleOptions(spec.ClientConnectionBundle, fldPath.Child("clientConnectionBundle"))...)
+	}
+
 	if spec.MinTerminationGracePeriodSeconds != nil && *spec.MinTerminationGracePeriodSeconds < 0 {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.MinTerminationGracePeriodSeconds), fldPath.Child("minTerminationGracePeriodSeconds"))...)
 	}
@@ -100,6 +207,145 @@ func ValidateScyllaDBDatacenterSpec(spec scyllav1alpha1.ScyllaDBDatacenterSpec,
 		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.MinReadySeconds), fldPath.Child("minReadySeconds"))...)
 	}
 
+	if spec.AutomaticFailedDiskNodeReplacement != nil &&
+		spec.AutomaticFailedDiskNodeReplacement.MaxConcurrentReplacements != nil &&
+		*spec.AutomaticFailedDiskNodeReplacement.MaxConcurrentReplacements < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("automaticFailedDiskNodeReplacement", "maxConcurrentReplacements"), *spec.AutomaticFailedDiskNodeReplacement.MaxConcurrentReplacements, "must be greater than or equal to 1"))
+	}
+
+	if spec.AutomaticFailedDiskNodeReplacement != nil &&
+		spec.AutomaticFailedDiskNodeReplacement.MinFailureDuration != nil &&
+		spec.AutomaticFailedDiskNodeReplacement.MinFailureDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("automaticFailedDiskNodeReplacement", "minFailureDuration"), spec.AutomaticFailedDiskNodeReplacement.MinFailureDuration.Duration.String(), "must be non-negative"))
+	}
+
+	if spec.BootstrapFrom != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterBootstrapFrom(spec.BootstrapFrom, fldPath.Child("bootstrapFrom"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterBootstrapFrom(bootstrapFrom *scyllav1alpha1.BootstrapFromOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if bootstrapFrom.Backup == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("backup"), "a bootstrap source must be specified"))
+		return allErrs
+	}
+
+	backupFldPath := fldPath.Child("backup")
+
+	if len(bootstrapFrom.Backup.Location) == 0 {
+		allErrs = append(allErrs, field.Required(backupFldPath.Child("location"), "location must not be empty"))
+	}
+
+	for i := range bootstrapFrom.Backup.Location {
+		allErrs = append(allErrs, validateLocation(bootstrapFrom.Backup.Location[i], backupFldPath.Child("location").Index(i))...)
+	}
+
+	if len(bootstrapFrom.Backup.SnapshotTag) == 0 {
+		allErrs = append(allErrs, field.Required(backupFldPath.Child("snapshotTag"), ""))
+	}
+
+	for i := range bootstrapFrom.Backup.Keyspace {
+		allErrs = append(allErrs, validateKeyspaceFilter(bootstrapFrom.Backup.Keyspace[i], backupFldPath.Child("keyspace").Index(i))...)
+	}
+
+	if bootstrapFrom.Backup.CredentialsSecretRef != nil {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(bootstrapFrom.Backup.CredentialsSecretRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(backupFldPath.Child("credentialsSecretRef", "name"), bootstrapFrom.Backup.CredentialsSecretRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateScyllaDBDatacenterNodeReplacements validates node replacement requests.
+// On updates, old is set and the ordinal bounds aren't enforced for requests that are unchanged or already completed,
+// so racks can be scaled down after their nodes were replaced.
+func ValidateScyllaDBDatacenterNodeReplacements(spec *scyllav1alpha1.ScyllaDBDatacenterSpec, old *scyllav1alpha1.ScyllaDBDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	isExistingOrCompleted := func(nr scyllav1alpha1.NodeReplacement) bool {
+		if old == nil {
+			return false
+		}
+
+		_, _, ok := oslices.Find(old.Spec.NodeReplacements, func(oldNodeReplacement scyllav1alpha1.NodeReplacement) bool {
+			return oldNodeReplacement.Name == nr.Name && oldNodeReplacement.RackName == nr.RackName && oldNodeReplacement.Ordinal == nr.Ordinal
+		})
+		if ok {
+			return true
+		}
+
+		_, _, ok = oslices.Find(old.Status.NodeReplacements, func(nrs scyllav1alpha1.NodeReplacementStatus) bool {
+			return nrs.Name == nr.Name && nrs.Phase == scyllav1alpha1.NodeReplacementPhaseCompleted
+		})
+		return ok
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(spec.NodeReplacements, func(nr scyllav1alpha1.NodeReplacement) string {
+		return nr.Name
+	}, "name", fldPath)...)
+
+	// Requests targeting the same node would keep taking over the member Service from each other.
+	type nodeKey struct {
+		rackName string
+		ordinal  int32
+	}
+	nodeReplacementNames := map[nodeKey]string{}
+	for i, nr := range spec.NodeReplacements {
+		key := nodeKey{rackName: nr.RackName, ordinal: nr.Ordinal}
+		otherName, ok := nodeReplacementNames[key]
+		if ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), fmt.Sprintf("node %d of rack %q is already targeted by node replacement %q", nr.Ordinal, nr.RackName, otherName)))
+			continue
+		}
+		nodeReplacementNames[key] = nr.Name
+	}
+
+	for i, nr := range spec.NodeReplacements {
+		idxPath := fldPath.Index(i)
+
+		if len(nr.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else {
+			for _, msg := range apimachineryvalidation.NameIsDNSLabel(nr.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), nr.Name, msg))
+			}
+		}
+
+		if nr.Ordinal < 0 {
+			allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(nr.Ordinal), idxPath.Child("ordinal"))...)
+		}
+
+		if len(nr.RackName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("rackName"), ""))
+			continue
+		}
+
+		rackSpec, _, ok := oslices.Find(spec.Racks, func(rackSpec scyllav1alpha1.RackSpec) bool {
+			return rackSpec.Name == nr.RackName
+		})
+		if !ok {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("rackName"), nr.RackName))
+			continue
+		}
+
+		rackNodeCount := int32(0)
+		if spec.RackTemplate != nil && spec.RackTemplate.Nodes != nil {
+			rackNodeCount = *spec.RackTemplate.Nodes
+		}
+		if rackSpec.Nodes != nil {
+			rackNodeCount = *rackSpec.Nodes
+		}
+
+		if nr.Ordinal >= rackNodeCount && !isExistingOrCompleted(nr) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ordinal"), nr.Ordinal, fmt.Sprintf("must be lower than the number of nodes in rack %q (%d)", nr.RackName, rackNodeCount)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -122,11 +368,97 @@ func ValidateScyllaDBDatacenterRackTemplate(rackTemplate *scyllav1alpha1.RackTem
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterScyllaDBManagerAgentTemplate(rackTemplate.ScyllaDBManagerAgent, fldPath.Child("scyllaDBManagerAgent"))...)
 	}
 
+	if rackTemplate.PodTemplate != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterPodTemplate(rackTemplate.PodTemplate, fldPath.Child("podTemplate"))...)
+	}
+
 	// TODO: Add placement validation in >=v1alpha2
 
 	return allErrs
 }
 
+var (
+	operatorManagedContainerNames = []string{
+		naming.ScyllaContainerName,
+		naming.ScyllaDBAPIStatusProbeContainerName,
+		naming.ScyllaDBIgnitionContainerName,
+		naming.ScyllaManagerAgentContainerName,
+		naming.ScyllaDBNodeExporterContainerName,
+		naming.SidecarInjectorContainerName,
+		naming.SysctlBuddyContainerName,
+		naming.ScyllaDBBootstrapBarrierContainerName,
+	}
+
+	operatorManagedEnvVarNames = []string{
+		"SERVICE_NAME",
+		"CPU_COUNT",
+	}
+)
+
+func ValidateScyllaDBDatacenterPodTemplate(podTemplate *scyllav1alpha1.PodTemplate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(podTemplate.ContainerOverrides, func(co scyllav1alpha1.ContainerOverride) string {
+		return co.Name
+	}, "name", fldPath.Child("containerOverrides"))...)
+
+	for i, co := range podTemplate.ContainerOverrides {
+		idxPath := fldPath.Child("containerOverrides").Index(i)
+
+		if len(co.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else {
+			allErrs = append(allErrs, validateEnum(co.Name, operatorManagedContainerNames, idxPath.Child("name"))...)
+		}
+
+		for j, env := range co.Env {
+			envPath := idxPath.Child("env").Index(j).Child("name")
+			if len(env.Name) == 0 {
+				allErrs = append(allErrs, field.Required(envPath, ""))
+				continue
+			}
+
+			for _, msg := range apimachineryutilvalidation.IsEnvVarName(env.Name) {
+				allErrs = append(allErrs, field.Invalid(envPath, env.Name, msg))
+			}
+
+			if oslices.ContainsItem(operatorManagedEnvVarNames, env.Name) {
+				allErrs = append(allErrs, field.Forbidden(envPath, fmt.Sprintf("environment variable %q is managed by the operator", env.Name)))
+			}
+		}
+
+		if co.Name == naming.ScyllaContainerName && co.Lifecycle != nil && co.Lifecycle.PreStop != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("lifecycle", "preStop"), "preStop handler of ScyllaDB container is managed by the operator"))
+		}
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(podTemplate.SidecarContainers, func(c scyllav1alpha1.SidecarContainer) string {
+		return c.Name
+	}, "name", fldPath.Child("sidecarContainers"))...)
+
+	for i, c := range podTemplate.SidecarContainers {
+		idxPath := fldPath.Child("sidecarContainers").Index(i)
+
+		if len(c.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else {
+			for _, msg := range apimachineryvalidation.NameIsDNSLabel(c.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), c.Name, msg))
+			}
+
+			if oslices.ContainsItem(operatorManagedContainerNames, c.Name) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("container name %q is reserved by the operator", c.Name)))
+			}
+		}
+
+		if len(c.Image) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), ""))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateScyllaDBDatacenterScyllaDBManagerAgentTemplate(scyllaDBManagerAgentTemplate *scyllav1alpha1.ScyllaDBManagerAgentTemplate, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -142,6 +474,8 @@ func ValidateScyllaDBDatacenterScyllaDBManagerAgentTemplate(scyllaDBManagerAgent
 func ValidateScyllaDBDatacenterScyllaDBTemplate(scyllaDBTemplate *scyllav1alpha1.ScyllaDBTemplate, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateScyllaDBImageOverride(scyllaDBTemplate.Image, fldPath.Child("image"))...)
+
 	if scyllaDBTemplate.Storage != nil {
 		if scyllaDBTemplate.Storage.Metadata != nil {
 			allErrs = append(allErrs, metav1validation.ValidateLabels(scyllaDBTemplate.Storage.Metadata.Labels, fldPath.Child("storage", "metadata", "labels"))...)
@@ -171,6 +505,26 @@ func ValidateScyllaDBDatacenterScyllaDBTemplate(scyllaDBTemplate *scyllav1alpha1
 	return allErrs
 }
 
+func validateScyllaDBImageOverride(image *string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if image == nil {
+		return allErrs
+	}
+
+	if len(*image) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must not be empty"))
+		return allErrs
+	}
+
+	_, err := imgreference.Parse(*image)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *image, fmt.Sprintf("unable to parse image: %v", err)))
+	}
+
+	return allErrs
+}
+
 func ValidateScyllaDBDatacenterScyllaDB(scyllaDB *scyllav1alpha1.ScyllaDB, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -187,6 +541,252 @@ func ValidateScyllaDBDatacenterScyllaDB(scyllaDB *scyllav1alpha1.ScyllaDB, fldPa
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterAlternatorOptions(scyllaDB.AlternatorOptions, fldPath.Child("alternator"))...)
 	}
 
+	if scyllaDB.Config != nil {
+		allErrs = append(allErrs, ValidateScyllaDBConfig(scyllaDB.Config, scyllaDB.Image, fldPath.Child("config"))...)
+		allErrs = append(allErrs, validateScyllaArgsConfigConflicts(scyllaDB.AdditionalScyllaDBArguments, scyllaDB.Config, fldPath.Child("config"), fldPath.Child("additionalScyllaDBArguments"))...)
+	}
+
+	if scyllaDB.Readiness != nil {
+		allErrs = append(allErrs, ValidateScyllaDBReadinessOptions(scyllaDB.Readiness, fldPath.Child("readiness"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBReadinessOptions(readiness *scyllav1alpha1.ScyllaDBReadinessOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(readiness.Mode) != 0 {
+		allErrs = append(allErrs, validateEnum(readiness.Mode, supportedScyllaDBReadinessModes, fldPath.Child("mode"))...)
+	}
+
+	if readiness.MinUpPeersPercent != nil && (*readiness.MinUpPeersPercent < 0 || *readiness.MinUpPeersPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minUpPeersPercent"), *readiness.MinUpPeersPercent, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBConfig(config *scyllav1alpha1.ScyllaDBConfig, image string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	validatePositive := func(v *int32, fldPath *field.Path) {
+		if v != nil && *v < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath, *v, "must be greater than or equal to 1"))
+		}
+	}
+
+	validateNonNegative := func(v *int32, fldPath *field.Path) {
+		if v != nil {
+			allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*v), fldPath)...)
+		}
+	}
+
+	if config.Compaction != nil {
+		validateNonNegative(config.Compaction.ThroughputMBPerSecond, fldPath.Child("compaction", "throughputMBPerSecond"))
+	}
+
+	if config.Streaming != nil {
+		validateNonNegative(config.Streaming.IOThroughputMBPerSecond, fldPath.Child("streaming", "ioThroughputMBPerSecond"))
+
+		if config.Streaming.IOThroughputMBPerSecond != nil {
+			allErrs = append(allErrs, validateScyllaDBFeatureSupported(image, scyllafeatures.StreamIOThroughput, fldPath.Child("streaming", "ioThroughputMBPerSecond"))...)
+		}
+	}
+
+	if config.Commitlog != nil {
+		commitlogFldPath := fldPath.Child("commitlog")
+		validatePositive(config.Commitlog.SegmentSizeMB, commitlogFldPath.Child("segmentSizeMB"))
+		validatePositive(config.Commitlog.SyncPeriodMilliseconds, commitlogFldPath.Child("syncPeriodMilliseconds"))
+
+		if config.Commitlog.TotalSpaceMB != nil && *config.Commitlog.TotalSpaceMB < 1 {
+			allErrs = append(allErrs, field.Invalid(commitlogFldPath.Child("totalSpaceMB"), *config.Commitlog.TotalSpaceMB, "must be greater than or equal to 1"))
+		}
+	}
+
+	if config.Timeouts != nil {
+		timeoutsFldPath := fldPath.Child("timeouts")
+		validatePositive(config.Timeouts.ReadRequestMilliseconds, timeoutsFldPath.Child("readRequestMilliseconds"))
+		validatePositive(config.Timeouts.WriteRequestMilliseconds, timeoutsFldPath.Child("writeRequestMilliseconds"))
+		validatePositive(config.Timeouts.RangeRequestMilliseconds, timeoutsFldPath.Child("rangeRequestMilliseconds"))
+		validatePositive(config.Timeouts.CounterWriteRequestMilliseconds, timeoutsFldPath.Child("counterWriteRequestMilliseconds"))
+		validatePositive(config.Timeouts.CASContentionMilliseconds, timeoutsFldPath.Child("casContentionMilliseconds"))
+		validatePositive(config.Timeouts.TruncateRequestMilliseconds, timeoutsFldPath.Child("truncateRequestMilliseconds"))
+		validatePositive(config.Timeouts.RequestMilliseconds, timeoutsFldPath.Child("requestMilliseconds"))
+	}
+
+	if config.HintedHandoff != nil {
+		validateNonNegative(config.HintedHandoff.MaxHintWindowMilliseconds, fldPath.Child("hintedHandoff", "maxHintWindowMilliseconds"))
+	}
+
+	if config.Tablets != nil && config.Tablets.Enabled != nil {
+		allErrs = append(allErrs, validateScyllaDBFeatureSupported(image, scyllafeatures.Tablets, fldPath.Child("tablets", "enabled"))...)
+	}
+
+	return allErrs
+}
+
+// validateScyllaDBFeatureSupported rejects options that the ScyllaDB version of the image doesn't support.
+// Images which version can't be determined, e.g. ones referenced by a digest, are not rejected.
+func validateScyllaDBFeatureSupported(image string, feature scyllafeatures.ScyllaFeature, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	supported, err := scyllafeatures.VersionSupports(image, feature)
+	if err != nil {
+		return allErrs
+	}
+
+	if !supported {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("feature %q is not supported by ScyllaDB image %q", feature, image)))
+	}
+
+	return allErrs
+}
+
+// operatorOwnedScyllaDBOptions holds options that the operator sets for every node.
+// Overriding them would break the cluster topology or the node identity.
+// rpc_address and listen_address are not included, as they can be overridden to select the IP family.
+var operatorOwnedScyllaDBOptions = apimachineryutilsets.New[string](
+	"cluster_name",
+	"endpoint_snitch",
+	"seed_provider",
+	"seeds",
+	"broadcast_address",
+	"broadcast_rpc_address",
+)
+
+// nodePortOwnedScyllaDBOptions holds options that the operator sets for every node
+// to the ports allocated by its NodePort Service.
+var nodePortOwnedScyllaDBOptions = apimachineryutilsets.New[string](
+	"native_transport_port",
+	"native_transport_port_ssl",
+	"native_shard_aware_transport_port",
+	"native_shard_aware_transport_port_ssl",
+)
+
+// getScyllaArgsOptions returns the names of the options set by the arguments.
+func getScyllaArgsOptions(scyllaArgs ...[]string) apimachineryutilsets.Set[string] {
+	options := apimachineryutilsets.New[string]()
+
+	for _, args := range scyllaArgs {
+		for _, arg := range args {
+			for argName := range helpers.ParseScyllaArguments(arg) {
+				options.Insert(strings.ReplaceAll(argName, "-", "_"))
+			}
+		}
+	}
+
+	return options
+}
+
+// getScyllaDBDatacenterScyllaArgs returns the arguments of the datacenter, its rack template and racks.
+func getScyllaDBDatacenterScyllaArgs(spec *scyllav1alpha1.ScyllaDBDatacenterSpec) [][]string {
+	scyllaArgs := [][]string{spec.ScyllaDB.AdditionalScyllaDBArguments}
+
+	if spec.RackTemplate != nil && spec.RackTemplate.ScyllaDB != nil {
+		scyllaArgs = append(scyllaArgs, spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments)
+	}
+
+	for _, rack := range spec.Racks {
+		if rack.ScyllaDB != nil {
+			scyllaArgs = append(scyllaArgs, rack.ScyllaDB.AdditionalScyllaDBArguments)
+		}
+	}
+
+	return scyllaArgs
+}
+
+// validateScyllaDBDatacenterScyllaArgsOperatorOwnedOptions rejects arguments of the datacenter, its rack template and racks
+// setting options that are owned by the operator.
+// On updates, options that the old object already set are allowed, so objects created before the validation
+// was introduced can still be updated.
+func validateScyllaDBDatacenterScyllaArgsOperatorOwnedOptions(spec, oldSpec *scyllav1alpha1.ScyllaDBDatacenterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var nodeService *scyllav1alpha1.NodeServiceTemplate
+	if spec.ExposeOptions != nil {
+		nodeService = spec.ExposeOptions.NodeService
+	}
+
+	existingOptions := apimachineryutilsets.New[string]()
+	if oldSpec != nil {
+		existingOptions = getScyllaArgsOptions(getScyllaDBDatacenterScyllaArgs(oldSpec)...)
+	}
+
+	allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(spec.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, fldPath.Child("scyllaDB", "additionalScyllaDBArguments"))...)
+
+	if spec.RackTemplate != nil && spec.RackTemplate.ScyllaDB != nil {
+		allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, fldPath.Child("rackTemplate", "scyllaDB", "additionalScyllaDBArguments"))...)
+	}
+
+	for i, rack := range spec.Racks {
+		if rack.ScyllaDB != nil {
+			allErrs = append(allErrs, validateScyllaArgsOperatorOwnedOptions(rack.ScyllaDB.AdditionalScyllaDBArguments, nodeService, existingOptions, fldPath.Child("racks").Index(i).Child("scyllaDB", "additionalScyllaDBArguments"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateScyllaArgsOperatorOwnedOptions rejects arguments setting options that are owned by the operator,
+// unless the options are in existingOptions.
+func validateScyllaArgsOperatorOwnedOptions(scyllaArgs []string, nodeService *scyllav1alpha1.NodeServiceTemplate, existingOptions apimachineryutilsets.Set[string], fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	isNodePort := nodeService != nil && nodeService.Type == scyllav1alpha1.NodeServiceTypeNodePort
+
+	for i, arg := range scyllaArgs {
+		allErrs = append(allErrs, validateScyllaArgOperatorOwnedOptions(arg, isNodePort, existingOptions, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateScyllaArgOperatorOwnedOptions(arg string, isNodePort bool, existingOptions apimachineryutilsets.Set[string], fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, argName := range slices.Sorted(maps.Keys(helpers.ParseScyllaArguments(arg))) {
+		option := strings.ReplaceAll(argName, "-", "_")
+
+		switch {
+		case existingOptions.Has(option):
+			continue
+		case operatorOwnedScyllaDBOptions.Has(option):
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("argument %q sets option %q which is managed by the operator", "--"+argName, option)))
+		case isNodePort && nodePortOwnedScyllaDBOptions.Has(option):
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("argument %q sets option %q which is managed by the operator when nodeService type is %q", "--"+argName, option, scyllav1alpha1.NodeServiceTypeNodePort)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateScyllaArgsConfigConflicts rejects arguments setting options that are owned by the typed config,
+// as command line arguments would silently take precedence over it.
+func validateScyllaArgsConfigConflicts(scyllaArgs []string, config *scyllav1alpha1.ScyllaDBConfig, configFldPath *field.Path, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config == nil || len(scyllaArgs) == 0 {
+		return allErrs
+	}
+
+	configOptions := helpers.ScyllaDBConfigOptions(config)
+	configArgumentOptions := make(map[string]string, len(configOptions))
+	for option := range configOptions {
+		configArgumentOptions[helpers.ScyllaDBConfigOptionArgumentName(option)] = option
+	}
+
+	for i, arg := range scyllaArgs {
+		for _, argName := range slices.Sorted(maps.Keys(helpers.ParseScyllaArguments(arg))) {
+			option, ok := configArgumentOptions[helpers.ScyllaDBConfigOptionArgumentName(argName)]
+			if !ok {
+				continue
+			}
+
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), fmt.Sprintf("argument %q sets option %q which is already set through %s", "--"+argName, option, configFldPath.String())))
+		}
+	}
+
 	return allErrs
 }
 
@@ -220,6 +820,52 @@ func ValidateScyllaDBDatacenterSpecExposeOptions(options *scyllav1alpha1.ExposeO
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterSpecExposeOptionsNodeBroadcastOptions(options.BroadcastOptions, options.NodeService, fldPath.Child("broadcastOptions"))...)
 	}
 
+	if options.Gateway != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterGatewayOptions(options.Gateway, fldPath.Child("gateway"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterGatewayOptions(options *scyllav1alpha1.GatewayExposeOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(options.GatewayClassName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("gatewayClassName"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(options.GatewayClassName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gatewayClassName"), options.GatewayClassName, msg))
+		}
+	}
+
+	if len(options.Annotations) != 0 {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(options.Annotations, fldPath.Child("annotations"))...)
+	}
+
+	if len(options.Labels) != 0 {
+		allErrs = append(allErrs, metav1validation.ValidateLabels(options.Labels, fldPath.Child("labels"))...)
+	}
+
+	if options.CQL == nil && options.Alternator == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of cql or alternator has to be provided"))
+	}
+
+	if options.CQL != nil && options.CQL.Port != 0 {
+		for _, msg := range apimachineryutilvalidation.IsValidPortNum(int(options.CQL.Port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cql", "port"), options.CQL.Port, msg))
+		}
+	}
+
+	if options.Alternator != nil && options.Alternator.Port != 0 {
+		for _, msg := range apimachineryutilvalidation.IsValidPortNum(int(options.Alternator.Port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("alternator", "port"), options.Alternator.Port, msg))
+		}
+	}
+
+	if options.CQL != nil && options.Alternator != nil && options.CQL.Port != 0 && options.CQL.Port == options.Alternator.Port {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("alternator", "port"), options.Alternator.Port, "must differ from cql port"))
+	}
+
 	return allErrs
 }
 
@@ -256,30 +902,133 @@ func ValidateScyllaDBDatacenterNodeService(options *scyllav1alpha1.ExposeOptions
 	if len(options.NodeService.Annotations) != 0 {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(options.NodeService.Annotations, fldPath.Child("nodeService", "annotations"))...)
 	}
+
+	allErrs = append(allErrs, validateNodePortAllocationOptions(options.NodeService, fldPath.Child("nodeService"))...)
+
 	return allErrs
 }
 
-func ValidateScyllaDBDatacenterSpecExposeOptionsNodeBroadcastOptions(options *scyllav1alpha1.NodeBroadcastOptions, nodeService *scyllav1alpha1.NodeServiceTemplate, fldPath *field.Path) field.ErrorList {
+func validateNodePortAllocationOptions(nodeService *scyllav1alpha1.NodeServiceTemplate, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	var nodeServiceType *scyllav1alpha1.NodeServiceType
-	if nodeService != nil {
-		nodeServiceType = pointer.Ptr(nodeService.Type)
+	if nodeService.NodePort == nil {
+		return allErrs
 	}
 
-	var allowedNodeServiceTypesByBroadcastAddressType = map[scyllav1alpha1.BroadcastAddressType][]scyllav1alpha1.NodeServiceType{
-		scyllav1alpha1.BroadcastAddressTypePodIP: {
-			scyllav1alpha1.NodeServiceTypeHeadless,
-			scyllav1alpha1.NodeServiceTypeClusterIP,
-			scyllav1alpha1.NodeServiceTypeLoadBalancer,
-		},
-		scyllav1alpha1.BroadcastAddressTypeServiceClusterIP: {
-			scyllav1alpha1.NodeServiceTypeClusterIP,
-			scyllav1alpha1.NodeServiceTypeLoadBalancer,
+	if nodeService.Type != scyllav1alpha1.NodeServiceTypeNodePort {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodePort"), fmt.Sprintf("can only be set when type is %q", scyllav1alpha1.NodeServiceTypeNodePort)))
+	}
+
+	if nodeService.NodePort.BasePort != 0 {
+		for _, msg := range apimachineryutilvalidation.IsValidPortNum(int(nodeService.NodePort.BasePort)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodePort", "basePort"), nodeService.NodePort.BasePort, msg))
+		}
+	}
+
+	if nodeService.NodePort.MaxNodesPerRack < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodePort", "maxNodesPerRack"), nodeService.NodePort.MaxNodesPerRack, "must be greater than or equal to 1"))
+	}
+
+	return allErrs
+}
+
+// validateNodePortAllocation validates that node ports of all nodes of the ScyllaDBDatacenter can be allocated.
+func validateNodePortAllocation(spec *scyllav1alpha1.ScyllaDBDatacenterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.ExposeOptions == nil || spec.ExposeOptions.NodeService == nil || spec.ExposeOptions.NodeService.Type != scyllav1alpha1.NodeServiceTypeNodePort {
+		return allErrs
+	}
+
+	basePort, maxNodesPerRack := spec.ExposeOptions.NodeService.GetNodePortAllocation()
+	if basePort == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("exposeOptions", "nodeService", "nodePort", "basePort"), fmt.Sprintf("is required when nodeService type is %q", scyllav1alpha1.NodeServiceTypeNodePort)))
+		return allErrs
+	}
+
+	if maxNodesPerRack <= 0 {
+		return allErrs
+	}
+
+	for i, rack := range spec.Racks {
+		var nodes int32
+		if spec.RackTemplate != nil && spec.RackTemplate.Nodes != nil {
+			nodes = *spec.RackTemplate.Nodes
+		}
+		if rack.Nodes != nil {
+			nodes = *rack.Nodes
+		}
+
+		if nodes > maxNodesPerRack {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("racks").Index(i).Child("nodes"), nodes, fmt.Sprintf("can't exceed the number of nodes node ports are reserved for (%d)", maxNodesPerRack)))
+		}
+	}
+
+	lastPort := int64(basePort) + int64(len(spec.Racks))*int64(maxNodesPerRack)*scyllav1alpha1.NodePortsPerNode - 1
+	if len(spec.Racks) != 0 && lastPort > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("exposeOptions", "nodeService", "nodePort"), lastPort, "node ports reserved for all racks must not exceed 65535"))
+	}
+
+	return allErrs
+}
+
+// validateBroadcastIPFamilies validates that IP families chosen for broadcasted addresses are available to the datacenter.
+func validateBroadcastIPFamilies(spec *scyllav1alpha1.ScyllaDBDatacenterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.ExposeOptions == nil || spec.ExposeOptions.BroadcastOptions == nil {
+		return allErrs
+	}
+
+	ipFamilies := spec.IPFamilies
+	if len(ipFamilies) == 0 {
+		ipFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+	}
+
+	isDualStack := spec.IPFamilyPolicy != nil && *spec.IPFamilyPolicy != corev1.IPFamilyPolicySingleStack
+
+	for _, bo := range []struct {
+		ipFamily *corev1.IPFamily
+		fldPath  *field.Path
+	}{
+		{
+			ipFamily: spec.ExposeOptions.BroadcastOptions.Clients.IPFamily,
+			fldPath:  fldPath.Child("exposeOptions", "broadcastOptions", "clients", "ipFamily"),
 		},
-		scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress: {
-			scyllav1alpha1.NodeServiceTypeLoadBalancer,
+		{
+			ipFamily: spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily,
+			fldPath:  fldPath.Child("exposeOptions", "broadcastOptions", "nodes", "ipFamily"),
 		},
+	} {
+		if bo.ipFamily == nil {
+			continue
+		}
+
+		errs := validateEnum(*bo.ipFamily, supportedBroadcastIPFamilies, bo.fldPath)
+		if len(errs) != 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+
+		if !oslices.ContainsItem(ipFamilies, *bo.ipFamily) {
+			allErrs = append(allErrs, field.Invalid(bo.fldPath, *bo.ipFamily, fmt.Sprintf("must be one of spec.ipFamilies: %v", ipFamilies)))
+			continue
+		}
+
+		if *bo.ipFamily != ipFamilies[0] && !isDualStack {
+			allErrs = append(allErrs, field.Invalid(bo.fldPath, *bo.ipFamily, fmt.Sprintf("must match spec.ipFamilies[0] (%s) unless spec.ipFamilyPolicy is %q or %q", ipFamilies[0], corev1.IPFamilyPolicyPreferDualStack, corev1.IPFamilyPolicyRequireDualStack)))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterSpecExposeOptionsNodeBroadcastOptions(options *scyllav1alpha1.NodeBroadcastOptions, nodeService *scyllav1alpha1.NodeServiceTemplate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var nodeServiceType *scyllav1alpha1.NodeServiceType
+	if nodeService != nil {
+		nodeServiceType = pointer.Ptr(nodeService.Type)
 	}
 
 	allErrs = append(allErrs,
@@ -296,7 +1045,7 @@ func ValidateScyllaDBDatacenterSpecExposeOptionsNodeBroadcastOptions(options *sc
 	allErrs = append(allErrs,
 		ValidateScyllaDBDatacenterBroadcastOptions(
 			options.Nodes.Type,
-			SupportedScyllaV1Alpha1BroadcastAddressTypes,
+			supportedScyllaV1Alpha1NodesBroadcastAddressTypes,
 			scyllav1alpha1.NodeServiceTypeClusterIP,
 			nodeServiceType,
 			allowedNodeServiceTypesByBroadcastAddressType,
@@ -340,6 +1089,80 @@ func ValidateScyllaDBDatacenterAlternatorOptions(alternator *scyllav1alpha1.Alte
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterTLSCertificate(alternator.ServingCertificate, fldPath.Child("servingCertificate"))...)
 	}
 
+	for i, user := range alternator.Users {
+		if len(user.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("users").Index(i).Child("name"), ""))
+			continue
+		}
+
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Label(user.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("users").Index(i).Child("name"), user.Name, msg))
+		}
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(alternator.Users, func(user scyllav1alpha1.AlternatorUser) string {
+		return user.Name
+	}, "name", fldPath.Child("users"))...)
+
+	if alternator.CQLCredentialsSecretRef != nil {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(alternator.CQLCredentialsSecretRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cqlCredentialsSecretRef", "name"), alternator.CQLCredentialsSecretRef.Name, msg))
+		}
+	} else if len(alternator.Users) != 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cqlCredentialsSecretRef"), "cqlCredentialsSecretRef is required when users are specified"))
+	}
+
+	if alternator.Endpoint != nil {
+		allErrs = append(allErrs, ValidateAlternatorEndpointOptions(alternator.Endpoint, fldPath.Child("endpoint"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateAlternatorEndpointOptions(endpoint *scyllav1alpha1.AlternatorEndpointOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateEnum(endpoint.Type, supportedAlternatorEndpointTypes, fldPath.Child("type"))...)
+
+	if endpoint.Service != nil && len(endpoint.Service.Type) != 0 {
+		allErrs = append(allErrs, validateEnum(endpoint.Service.Type, supportedAlternatorEndpointServiceTypes, fldPath.Child("service", "type"))...)
+	}
+
+	switch endpoint.Type {
+	case scyllav1alpha1.AlternatorEndpointTypeIngress:
+		if endpoint.Ingress == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ingress"), "ingress is required when type is Ingress"))
+			break
+		}
+
+		if len(endpoint.Ingress.Hosts) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ingress", "hosts"), ""))
+		}
+
+		for i, host := range endpoint.Ingress.Hosts {
+			for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(host) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("ingress", "hosts").Index(i), host, msg))
+			}
+		}
+
+	default:
+		if endpoint.Ingress != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ingress"), "ingress can only be set when type is Ingress"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateClientConnectionBundleOptions(options *scyllav1alpha1.ClientConnectionBundleOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if options.CredentialsSecretRef != nil {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(options.CredentialsSecretRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("credentialsSecretRef", "name"), options.CredentialsSecretRef.Name, msg))
+		}
+	}
+
 	return allErrs
 }
 
@@ -430,13 +1253,62 @@ func ValidateScyllaDBDatacenterPlacement(placement *scyllav1alpha1.Placement, fl
 		allErrs = append(allErrs, corevalidation.ValidateTolerations(placement.Tolerations, fldPath.Child("tolerations"))...)
 	}
 
+	for i, constraint := range placement.TopologySpreadConstraints {
+		allErrs = append(allErrs, validateTopologySpreadConstraint(&constraint, fldPath.Child("topologySpreadConstraints").Index(i))...)
+	}
+
+	if len(placement.PriorityClassName) != 0 {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(placement.PriorityClassName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassName"), placement.PriorityClassName, msg))
+		}
+	}
+
+	if len(placement.SchedulerName) != 0 {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(placement.SchedulerName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("schedulerName"), placement.SchedulerName, msg))
+		}
+	}
+
+	if placement.RuntimeClassName != nil {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(*placement.RuntimeClassName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("runtimeClassName"), *placement.RuntimeClassName, msg))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedUnsatisfiableConstraintActions = []corev1.UnsatisfiableConstraintAction{
+	corev1.DoNotSchedule,
+	corev1.ScheduleAnyway,
+}
+
+func validateTopologySpreadConstraint(constraint *corev1.TopologySpreadConstraint, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if constraint.MaxSkew <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSkew"), constraint.MaxSkew, "must be greater than zero"))
+	}
+
+	if len(constraint.TopologyKey) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topologyKey"), ""))
+	} else {
+		for _, msg := range apimachineryutilvalidation.IsQualifiedName(constraint.TopologyKey) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("topologyKey"), constraint.TopologyKey, msg))
+		}
+	}
+
+	if !oslices.ContainsItem(supportedUnsatisfiableConstraintActions, constraint.WhenUnsatisfiable) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("whenUnsatisfiable"), constraint.WhenUnsatisfiable, oslices.ConvertSlice(supportedUnsatisfiableConstraintActions, oslices.ToString[corev1.UnsatisfiableConstraintAction])))
+	}
+
 	return allErrs
 }
 
 func ValidateScyllaDBDatacenterUpdate(new, old *scyllav1alpha1.ScyllaDBDatacenter) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, ValidateScyllaDBDatacenter(new)...)
+	allErrs = append(allErrs, validateScyllaDBDatacenter(new, old)...)
 	allErrs = append(allErrs, ValidateScyllaDBDatacenterSpecUpdate(new, old, field.NewPath("spec"))...)
 
 	return allErrs
@@ -446,6 +1318,7 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ClusterName, old.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.BootstrapFrom, old.Spec.BootstrapFrom, fldPath.Child("bootstrapFrom"))...)
 
 	oldRackNames := oslices.ConvertSlice(old.Spec.Racks, func(rackSpec scyllav1alpha1.RackSpec) string {
 		return rackSpec.Name
@@ -545,6 +1418,24 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodesBroadcastAddressType, oldNodesBroadcastAddressType, fldPath.Child("exposeOptions", "broadcastOptions", "nodes", "type"))...)
 
+	var oldClientsBroadcastIPFamily, newClientsBroadcastIPFamily *corev1.IPFamily
+	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.BroadcastOptions != nil {
+		oldClientsBroadcastIPFamily = old.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily
+	}
+	if new.Spec.ExposeOptions != nil && new.Spec.ExposeOptions.BroadcastOptions != nil {
+		newClientsBroadcastIPFamily = new.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newClientsBroadcastIPFamily, oldClientsBroadcastIPFamily, fldPath.Child("exposeOptions", "broadcastOptions", "clients", "ipFamily"))...)
+
+	var oldNodesBroadcastIPFamily, newNodesBroadcastIPFamily *corev1.IPFamily
+	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.BroadcastOptions != nil {
+		oldNodesBroadcastIPFamily = old.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily
+	}
+	if new.Spec.ExposeOptions != nil && new.Spec.ExposeOptions.BroadcastOptions != nil {
+		newNodesBroadcastIPFamily = new.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodesBroadcastIPFamily, oldNodesBroadcastIPFamily, fldPath.Child("exposeOptions", "broadcastOptions", "nodes", "ipFamily"))...)
+
 	var oldNodeServiceType, newNodeServiceType *scyllav1alpha1.NodeServiceType
 	if old.Spec.ExposeOptions != nil && old.Spec.ExposeOptions.NodeService != nil {
 		oldNodeServiceType = pointer.Ptr(old.Spec.ExposeOptions.NodeService.Type)
@@ -554,6 +1445,30 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodeServiceType, oldNodeServiceType, fldPath.Child("exposeOptions", "nodeService", "type"))...)
 
+	// Node ports are allocated based on the position of a rack, so existing racks can't be moved.
+	if newNodeServiceType != nil && *newNodeServiceType == scyllav1alpha1.NodeServiceTypeNodePort {
+		for i, newRack := range new.Spec.Racks {
+			_, oldRackIdx, ok := oslices.Find(old.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+				return spec.Name == newRack.Name
+			})
+			if ok && oldRackIdx != i {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i), fmt.Sprintf("rack %q can't change its position from %d because node ports are allocated based on rack position", newRack.Name, oldRackIdx)))
+			}
+		}
+	}
+
+	for i, newNodeReplacement := range new.Spec.NodeReplacements {
+		oldNodeReplacement, _, ok := oslices.Find(old.Spec.NodeReplacements, func(nr scyllav1alpha1.NodeReplacement) bool {
+			return nr.Name == newNodeReplacement.Name
+		})
+		if !ok {
+			continue
+		}
+
+		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodeReplacement.RackName, oldNodeReplacement.RackName, fldPath.Child("nodeReplacements").Index(i).Child("rackName"))...)
+		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodeReplacement.Ordinal, oldNodeReplacement.Ordinal, fldPath.Child("nodeReplacements").Index(i).Child("ordinal"))...)
+	}
+
 	return allErrs
 }
 
@@ -592,13 +1507,40 @@ func ValidateScyllaArgsIPFamily(ipFamily corev1.IPFamily, scyllaArgs []string, f
 
 	argsStr := strings.Join(scyllaArgs, " ")
 
-	allErrs = append(allErrs, validateScyllaArgIPAddress("rpc-address", ipFamily, argsStr, fldPath)...)
-	allErrs = append(allErrs, validateScyllaArgIPAddress("listen-address", ipFamily, argsStr, fldPath)...)
+	allErrs = append(allErrs, validateScyllaArgIPAddress("rpc-address", ipFamily, "spec.ipFamilies[0]", argsStr, fldPath)...)
+	allErrs = append(allErrs, validateScyllaArgIPAddress("listen-address", ipFamily, "spec.ipFamilies[0]", argsStr, fldPath)...)
+
+	return allErrs
+}
+
+// validateScyllaDBDatacenterScyllaArgsIPFamilies validates that --listen-address matches the IP family broadcasted
+// for communication with other nodes and --rpc-address matches the IP family broadcasted for communication with clients.
+func validateScyllaDBDatacenterScyllaArgsIPFamilies(spec *scyllav1alpha1.ScyllaDBDatacenterSpec, scyllaArgs []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(scyllaArgs) == 0 {
+		return allErrs
+	}
+
+	argsStr := strings.Join(scyllaArgs, " ")
+
+	clientsIPFamilySource, nodesIPFamilySource := "spec.ipFamilies[0]", "spec.ipFamilies[0]"
+	if spec.ExposeOptions != nil && spec.ExposeOptions.BroadcastOptions != nil {
+		if spec.ExposeOptions.BroadcastOptions.Clients.IPFamily != nil {
+			clientsIPFamilySource = "spec.exposeOptions.broadcastOptions.clients.ipFamily"
+		}
+		if spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily != nil {
+			nodesIPFamilySource = "spec.exposeOptions.broadcastOptions.nodes.ipFamily"
+		}
+	}
+
+	allErrs = append(allErrs, validateScyllaArgIPAddress("rpc-address", spec.GetClientsBroadcastIPFamily(), clientsIPFamilySource, argsStr, fldPath)...)
+	allErrs = append(allErrs, validateScyllaArgIPAddress("listen-address", spec.GetNodesBroadcastIPFamily(), nodesIPFamilySource, argsStr, fldPath)...)
 
 	return allErrs
 }
 
-func validateScyllaArgIPAddress(argName string, expectedIPFamily corev1.IPFamily, argsStr string, fldPath *field.Path) field.ErrorList {
+func validateScyllaArgIPAddress(argName string, expectedIPFamily corev1.IPFamily, expectedIPFamilySource string, argsStr string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	parsedArgs := helpers.ParseScyllaArguments(argsStr)
@@ -631,7 +1573,7 @@ func validateScyllaArgIPAddress(argName string, expectedIPFamily corev1.IPFamily
 		allErrs = append(allErrs, field.Invalid(
 			fldPath,
 			argsStr,
-			fmt.Sprintf("--%s '%s' IP family (%s) must match spec.ipFamilies[0] (%s)", argName, value, gotFamily, expectedFamily),
+			fmt.Sprintf("--%s '%s' IP family (%s) must match %s (%s)", argName, value, gotFamily, expectedIPFamilySource, expectedFamily),
 		))
 	}
 
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	"github.com/scylladb/scylla-operator/pkg/test/unit"
 	corev1 "k8s.io/api/core/v1"
@@ -96,6 +98,35 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.scyllaDB.image: Required value: must not be empty`,
 		},
+		{
+			name: "invalid rack template and rack ScyllaDB images",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Image: pointer.Ptr("invalid image"),
+					},
+				}
+				sdc.Spec.Racks[0].ScyllaDB.Image = pointer.Ptr("")
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.image", BadValue: "invalid image", Detail: "unable to parse image: invalid reference format"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.racks[0].scyllaDB.image", BadValue: "", Detail: "must not be empty"},
+			},
+			expectedErrorString: `[spec.rackTemplate.scyllaDB.image: Invalid value: "invalid image": unable to parse image: invalid reference format, spec.racks[0].scyllaDB.image: Required value: must not be empty]`,
+		},
+		{
+			name: "valid rack ScyllaDB image and arguments overrides",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].ScyllaDB.Image = pointer.Ptr("scylladb/scylla:2025.1.1")
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--io-properties-file=/etc/scylla.d/io_properties.yaml"}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
 		{
 			name: "invalid ScyllaDBManagerAgent image",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -149,6 +180,118 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.dnsDomains: Required value: at least one domain needs to be provided when exposing CQL via ingresses`,
 		},
+		{
+			name: "when Gateway is provided, domains must not be empty",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					Gateway: &scyllav1alpha1.GatewayExposeOptions{
+						GatewayClassName: "gateway-class",
+						CQL: &scyllav1alpha1.GatewayCQLOptions{
+							Port: 9142,
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.dnsDomains", BadValue: "", Detail: "at least one domain needs to be provided when exposing backends via the Gateway API"},
+			},
+			expectedErrorString: `spec.dnsDomains: Required value: at least one domain needs to be provided when exposing backends via the Gateway API`,
+		},
+		{
+			name: "Gateway Alternator options are forbidden when Alternator isn't enabled",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.DNSDomains = []string{"public.scylladb.com"}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					Gateway: &scyllav1alpha1.GatewayExposeOptions{
+						GatewayClassName: "gateway-class",
+						Alternator: &scyllav1alpha1.GatewayAlternatorOptions{
+							Port: 8043,
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.exposeOptions.gateway.alternator", BadValue: "", Detail: "can't be set when Alternator isn't enabled"},
+			},
+			expectedErrorString: `spec.exposeOptions.gateway.alternator: Forbidden: can't be set when Alternator isn't enabled`,
+		},
+		{
+			name: "Gateway Alternator options are forbidden when Alternator uses a user-managed serving certificate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.DNSDomains = []string{"public.scylladb.com"}
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeUserManaged,
+						UserManagedOptions: &scyllav1alpha1.UserManagedTLSCertificateOptions{
+							SecretName: "my-tls-certificate",
+						},
+					},
+				}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					Gateway: &scyllav1alpha1.GatewayExposeOptions{
+						GatewayClassName: "gateway-class",
+						Alternator: &scyllav1alpha1.GatewayAlternatorOptions{
+							Port: 8043,
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.exposeOptions.gateway.alternator", BadValue: "", Detail: "can't be set when Alternator doesn't use an operator-managed serving certificate"},
+			},
+			expectedErrorString: `spec.exposeOptions.gateway.alternator: Forbidden: can't be set when Alternator doesn't use an operator-managed serving certificate`,
+		},
+		{
+			name: "Gateway requires a class name and at least one backend",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.DNSDomains = []string{"public.scylladb.com"}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					Gateway: &scyllav1alpha1.GatewayExposeOptions{},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.exposeOptions.gateway.gatewayClassName", BadValue: "", Detail: ""},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.exposeOptions.gateway", BadValue: "", Detail: "at least one of cql or alternator has to be provided"},
+			},
+			expectedErrorString: `[spec.exposeOptions.gateway.gatewayClassName: Required value, spec.exposeOptions.gateway: Required value: at least one of cql or alternator has to be provided]`,
+		},
+		{
+			name: "Gateway listeners must use distinct ports",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.DNSDomains = []string{"public.scylladb.com"}
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					Gateway: &scyllav1alpha1.GatewayExposeOptions{
+						GatewayClassName: "gateway-class",
+						CQL: &scyllav1alpha1.GatewayCQLOptions{
+							Port: 443,
+						},
+						Alternator: &scyllav1alpha1.GatewayAlternatorOptions{
+							Port: 443,
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.gateway.alternator.port", BadValue: int32(443), Detail: "must differ from cql port"},
+			},
+			expectedErrorString: `spec.exposeOptions.gateway.alternator.port: Invalid value: 443: must differ from cql port`,
+		},
 		{
 			name: "invalid domain",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -175,9 +318,9 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.exposeOptions.nodeService.type", BadValue: "", Detail: `supported values: Headless, ClusterIP, LoadBalancer`},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.exposeOptions.nodeService.type", BadValue: "", Detail: `supported values: Headless, ClusterIP, LoadBalancer, NodePort`},
 			},
-			expectedErrorString: `spec.exposeOptions.nodeService.type: Required value: supported values: Headless, ClusterIP, LoadBalancer`,
+			expectedErrorString: `spec.exposeOptions.nodeService.type: Required value: supported values: Headless, ClusterIP, LoadBalancer, NodePort`,
 		},
 		{
 			name: "unsupported type of node service",
@@ -192,9 +335,9 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.nodeService.type", BadValue: scyllav1alpha1.NodeServiceType("foo"), Detail: `supported values: "Headless", "ClusterIP", "LoadBalancer"`},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.nodeService.type", BadValue: scyllav1alpha1.NodeServiceType("foo"), Detail: `supported values: "Headless", "ClusterIP", "LoadBalancer", "NodePort"`},
 			},
-			expectedErrorString: `spec.exposeOptions.nodeService.type: Unsupported value: "foo": supported values: "Headless", "ClusterIP", "LoadBalancer"`,
+			expectedErrorString: `spec.exposeOptions.nodeService.type: Unsupported value: "foo": supported values: "Headless", "ClusterIP", "LoadBalancer", "NodePort"`,
 		},
 		{
 			name: "invalid load balancer class name in node service template",
@@ -248,9 +391,9 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.broadcastOptions.clients.type", BadValue: scyllav1alpha1.BroadcastAddressType("foo"), Detail: `supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress"`},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.broadcastOptions.clients.type", BadValue: scyllav1alpha1.BroadcastAddressType("foo"), Detail: `supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress", "ServiceNodePort"`},
 			},
-			expectedErrorString: `spec.exposeOptions.broadcastOptions.clients.type: Unsupported value: "foo": supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress"`,
+			expectedErrorString: `spec.exposeOptions.broadcastOptions.clients.type: Unsupported value: "foo": supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress", "ServiceNodePort"`,
 		},
 		{
 			name: "unsupported type of node broadcast address",
@@ -295,10 +438,10 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.clients.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer]`},
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer]`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.clients.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer NodePort]`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer NodePort]`},
 			},
-			expectedErrorString: `[spec.exposeOptions.broadcastOptions.clients.type: Invalid value: "ServiceClusterIP": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer], spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "ServiceClusterIP": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer]]`,
+			expectedErrorString: `[spec.exposeOptions.broadcastOptions.clients.type: Invalid value: "ServiceClusterIP": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer NodePort], spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "ServiceClusterIP": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [ClusterIP LoadBalancer NodePort]]`,
 		},
 		{
 			name: "invalid LoadBalancerIngressIP broadcast type when node service is Headless",
@@ -353,319 +496,1152 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			expectedErrorString: `[spec.exposeOptions.broadcastOptions.clients.type: Invalid value: "ServiceLoadBalancerIngress": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [LoadBalancer], spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "ServiceLoadBalancerIngress": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [LoadBalancer]]`,
 		},
 		{
-			name: "negative minTerminationGracePeriodSeconds",
+			name: "valid NodePort node service with ServiceNodePort clients broadcast address",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.MinTerminationGracePeriodSeconds = pointer.Ptr(int32(-42))
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr[int32](3)
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort:        31000,
+							MaxNodesPerRack: 3,
+						},
+					},
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+						},
+					},
+				}
 
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.minTerminationGracePeriodSeconds", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
-			},
-			expectedErrorString: `spec.minTerminationGracePeriodSeconds: Invalid value: -42: must be greater than or equal to 0`,
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
-			name: "negative minReadySeconds",
+			name: "valid per-purpose broadcast IP families in dual-stack datacenter",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.MinReadySeconds = pointer.Ptr(int32(-42))
+				sdc.Spec.IPFamilyPolicy = pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack)
+				sdc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
+							IPFamily: pointer.Ptr(corev1.IPv4Protocol),
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+						},
+					},
+				}
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--rpc-address=10.0.0.1", "--listen-address=2001:db8::1"}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "broadcast IP families must be available in the datacenter",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.IPFamilyPolicy = pointer.Ptr(corev1.IPFamilyPolicySingleStack)
+				sdc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
+							IPFamily: pointer.Ptr(corev1.IPFamily("foo")),
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+						},
+					},
+				}
 
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.minReadySeconds", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.broadcastOptions.clients.ipFamily", BadValue: corev1.IPFamily("foo"), Detail: `supported values: "IPv4", "IPv6"`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.ipFamily", BadValue: corev1.IPv6Protocol, Detail: `must be one of spec.ipFamilies: [IPv4]`},
 			},
-			expectedErrorString: `spec.minReadySeconds: Invalid value: -42: must be greater than or equal to 0`,
+			expectedErrorString: `[spec.exposeOptions.broadcastOptions.clients.ipFamily: Unsupported value: "foo": supported values: "IPv4", "IPv6", spec.exposeOptions.broadcastOptions.nodes.ipFamily: Invalid value: "IPv6": must be one of spec.ipFamilies: [IPv4]]`,
 		},
 		{
-			name: "minimal alternator cluster passes",
+			name: "secondary broadcast IP family requires dual-stack IP family policy and matching scylla arguments",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{}
+				sdc.Spec.IPFamilyPolicy = pointer.Ptr(corev1.IPFamilyPolicySingleStack)
+				sdc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+						},
+					},
+				}
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--listen-address=10.0.0.1"}
+
 				return sdc
 			}(),
-			expectedErrorList:   nil,
-			expectedErrorString: "",
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.additionalScyllaDBArguments", BadValue: "--listen-address=10.0.0.1", Detail: `--listen-address '10.0.0.1' IP family (IPv4) must match spec.exposeOptions.broadcastOptions.nodes.ipFamily (IPv6)`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.ipFamily", BadValue: corev1.IPv6Protocol, Detail: `must match spec.ipFamilies[0] (IPv4) unless spec.ipFamilyPolicy is "PreferDualStack" or "RequireDualStack"`},
+			},
+			expectedErrorString: `[spec.scyllaDB.additionalScyllaDBArguments: Invalid value: "--listen-address=10.0.0.1": --listen-address '10.0.0.1' IP family (IPv4) must match spec.exposeOptions.broadcastOptions.nodes.ipFamily (IPv6), spec.exposeOptions.broadcastOptions.nodes.ipFamily: Invalid value: "IPv6": must match spec.ipFamilies[0] (IPv4) unless spec.ipFamilyPolicy is "PreferDualStack" or "RequireDualStack"]`,
 		},
 		{
-			name: "alternator cluster with user certificate",
+			name: "ServiceNodePort broadcast address type is not supported for nodes and requires NodePort node service",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: scyllav1alpha1.TLSCertificateTypeUserManaged,
-						UserManagedOptions: &scyllav1alpha1.UserManagedTLSCertificateOptions{
-							SecretName: "my-tls-certificate",
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeClusterIP,
+					},
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
 						},
 					},
 				}
+
 				return sdc
 			}(),
-			expectedErrorList:   nil,
-			expectedErrorString: "",
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.clients.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceNodePort, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [NodePort]`},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.exposeOptions.broadcastOptions.nodes.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceNodePort, Detail: `supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress"`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.type", BadValue: scyllav1alpha1.BroadcastAddressTypeServiceNodePort, Detail: `can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [NodePort]`},
+			},
+			expectedErrorString: `[spec.exposeOptions.broadcastOptions.clients.type: Invalid value: "ServiceNodePort": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [NodePort], spec.exposeOptions.broadcastOptions.nodes.type: Unsupported value: "ServiceNodePort": supported values: "PodIP", "ServiceClusterIP", "ServiceLoadBalancerIngress", spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "ServiceNodePort": can't broadcast address unavailable within the selected node service type, allowed types for chosen broadcast address type are: [NodePort]]`,
 		},
 		{
-			name: "alternator cluster with invalid certificate type",
+			name: "node port allocation options are forbidden for other node service types",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: "foo",
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeClusterIP,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort: 70000,
+						},
 					},
 				}
+
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.scyllaDB.alternator.servingCertificate.type", BadValue: scyllav1alpha1.TLSCertificateType("foo"), Detail: `supported values: "OperatorManaged", "UserManaged"`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.exposeOptions.nodeService.nodePort", BadValue: "", Detail: `can only be set when type is "NodePort"`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.nodeService.nodePort.basePort", BadValue: int32(70000), Detail: `must be between 1 and 65535, inclusive`},
 			},
-			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.type: Unsupported value: "foo": supported values: "OperatorManaged", "UserManaged"`,
+			expectedErrorString: `[spec.exposeOptions.nodeService.nodePort: Forbidden: can only be set when type is "NodePort", spec.exposeOptions.nodeService.nodePort.basePort: Invalid value: 70000: must be between 1 and 65535, inclusive]`,
 		},
 		{
-			name: "alternator cluster with valid additional domains",
+			name: "base port is required for NodePort node service",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
-						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
-							AdditionalDNSNames: []string{"scylla-operator.scylladb.com"},
-						},
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
 					},
 				}
+
 				return sdc
 			}(),
-			expectedErrorList:   nil,
-			expectedErrorString: "",
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.exposeOptions.nodeService.nodePort.basePort", BadValue: "", Detail: `is required when nodeService type is "NodePort"`},
+			},
+			expectedErrorString: `spec.exposeOptions.nodeService.nodePort.basePort: Required value: is required when nodeService type is "NodePort"`,
 		},
 		{
-			name: "alternator cluster with invalid additional domains",
+			name: "node ports reserved for racks have to fit into the port range",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
-						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
-							AdditionalDNSNames: []string{"[not a domain]"},
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr[int32](3)
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort:        65530,
+							MaxNodesPerRack: 2,
 						},
 					},
 				}
+
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalDNSNames", BadValue: []string{"[not a domain]"}, Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.racks[0].nodes", BadValue: int32(3), Detail: `can't exceed the number of nodes node ports are reserved for (2)`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.nodeService.nodePort", BadValue: int64(65537), Detail: `node ports reserved for all racks must not exceed 65535`},
 			},
-			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalDNSNames: Invalid value: ["[not a domain]"]: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+			expectedErrorString: `[spec.racks[0].nodes: Invalid value: 3: can't exceed the number of nodes node ports are reserved for (2), spec.exposeOptions.nodeService.nodePort: Invalid value: 65537: node ports reserved for all racks must not exceed 65535]`,
 		},
 		{
-			name: "alternator cluster with valid additional IP addresses",
+			name: "valid client connection bundle",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
-						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
-							AdditionalIPAddresses: []string{"127.0.0.1", "::1"},
-						},
+				sdc.Spec.ClientConnectionBundle = &scyllav1alpha1.ClientConnectionBundleOptions{
+					CredentialsSecretRef: &corev1.LocalObjectReference{
+						Name: "app-credentials",
 					},
 				}
+
 				return sdc
 			}(),
 			expectedErrorList:   nil,
 			expectedErrorString: "",
 		},
 		{
-			name: "alternator cluster with invalid additional IP addresses",
+			name: "invalid client connection bundle credentials secret name",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
-					ServingCertificate: &scyllav1alpha1.TLSCertificate{
-						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
-						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
-							AdditionalIPAddresses: []string{"0.not-an-ip.0.0"},
-						},
+				sdc.Spec.ClientConnectionBundle = &scyllav1alpha1.ClientConnectionBundleOptions{
+					CredentialsSecretRef: &corev1.LocalObjectReference{
+						Name: "App_Credentials",
 					},
 				}
+
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalIPAddresses", BadValue: "0.not-an-ip.0.0", Detail: `must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)`, Origin: "format=ip-strict"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.clientConnectionBundle.credentialsSecretRef.name", BadValue: "App_Credentials", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
 			},
-			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalIPAddresses: Invalid value: "0.not-an-ip.0.0": must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)`,
+			expectedErrorString: `spec.clientConnectionBundle.credentialsSecretRef.name: Invalid value: "App_Credentials": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
 		},
 		{
-			name: "negative rackTemplate nodes",
+			name: "negative minTerminationGracePeriodSeconds",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					Nodes: pointer.Ptr[int32](-42),
-				}
+				sdc.Spec.MinTerminationGracePeriodSeconds = pointer.Ptr(int32(-42))
 
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.nodes", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.minTerminationGracePeriodSeconds", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
 			},
-			expectedErrorString: `spec.rackTemplate.nodes: Invalid value: -42: must be greater than or equal to 0`,
+			expectedErrorString: `spec.minTerminationGracePeriodSeconds: Invalid value: -42: must be greater than or equal to 0`,
 		},
 		{
-			name: "invalid topologyLabelSelector in rackTemplate",
+			name: "negative minReadySeconds",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					TopologyLabelSelector: map[string]string{
-						"-123": "*321",
-					},
-				}
+				sdc.Spec.MinReadySeconds = pointer.Ptr(int32(-42))
 
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.topologyLabelSelector", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.topologyLabelSelector", BadValue: "*321", Detail: `a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`, Origin: "format=k8s-label-value"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.minReadySeconds", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
 			},
-			expectedErrorString: `[spec.rackTemplate.topologyLabelSelector: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), spec.rackTemplate.topologyLabelSelector: Invalid value: "*321": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
+			expectedErrorString: `spec.minReadySeconds: Invalid value: -42: must be greater than or equal to 0`,
 		},
 		{
-			name: "invalid scyllaDB storage capacity in rackTemplate",
+			name: "zero maxConcurrentReplacements of automatic failed disk node replacement",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+				sdc.Spec.AutomaticFailedDiskNodeReplacement = &scyllav1alpha1.AutomaticFailedDiskNodeReplacementOptions{
+					MaxConcurrentReplacements: pointer.Ptr(int32(0)),
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.automaticFailedDiskNodeReplacement.maxConcurrentReplacements", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+			},
+			expectedErrorString: `spec.automaticFailedDiskNodeReplacement.maxConcurrentReplacements: Invalid value: 0: must be greater than or equal to 1`,
+		},
+		{
+			name: "negative minFailureDuration of automatic failed disk node replacement",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.AutomaticFailedDiskNodeReplacement = &scyllav1alpha1.AutomaticFailedDiskNodeReplacementOptions{
+					MinFailureDuration: &metav1.Duration{Duration: -time.Minute},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.automaticFailedDiskNodeReplacement.minFailureDuration", BadValue: "-1m0s", Detail: "must be non-negative"},
+			},
+			expectedErrorString: `spec.automaticFailedDiskNodeReplacement.minFailureDuration: Invalid value: "-1m0s": must be non-negative`,
+		},
+		{
+			name: "valid pod template",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					PodTemplate: &scyllav1alpha1.PodTemplate{
+						SecurityContext: &corev1.PodSecurityContext{
+							FSGroup: pointer.Ptr(int64(1000)),
+						},
+						ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+							{
+								Name: "scylla",
+								Env: []corev1.EnvVar{
+									{
+										Name:  "FOO",
+										Value: "bar",
+									},
+								},
+								Lifecycle: &corev1.Lifecycle{
+									PostStart: &corev1.LifecycleHandler{
+										Exec: &corev1.ExecAction{
+											Command: []string{"true"},
+										},
+									},
+								},
+							},
+						},
+						SidecarContainers: []scyllav1alpha1.SidecarContainer{
+							{
+								Name:  "log-shipper",
+								Image: "log-shipper:latest",
+							},
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "pod template overriding fields managed by the operator",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].PodTemplate = &scyllav1alpha1.PodTemplate{
+					ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+						{
+							Name: "scylla",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "SERVICE_NAME",
+									Value: "foo",
+								},
+							},
+							Lifecycle: &corev1.Lifecycle{
+								PreStop: &corev1.LifecycleHandler{
+									Exec: &corev1.ExecAction{
+										Command: []string{"true"},
+									},
+								},
+							},
+						},
+						{
+							Name: "unknown",
+						},
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "scylla-manager-agent",
+							Image: "agent:latest",
+						},
+						{
+							Name: "no-image",
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].podTemplate.containerOverrides[0].env[0].name", BadValue: "", Detail: `environment variable "SERVICE_NAME" is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].podTemplate.containerOverrides[0].lifecycle.preStop", BadValue: "", Detail: "preStop handler of ScyllaDB container is managed by the operator"},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.racks[0].podTemplate.containerOverrides[1].name", BadValue: "unknown", Detail: `supported values: "scylla", "scylladb-api-status-probe", "scylladb-ignition", "scylla-manager-agent", "scylladb-node-exporter", "sidecar-injection", "sysctl-buddy", "scylladb-bootstrap-barrier"`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].podTemplate.sidecarContainers[0].name", BadValue: "", Detail: `container name "scylla-manager-agent" is reserved by the operator`},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.racks[0].podTemplate.sidecarContainers[1].image", BadValue: ""},
+			},
+			expectedErrorString: `[spec.racks[0].podTemplate.containerOverrides[0].env[0].name: Forbidden: environment variable "SERVICE_NAME" is managed by the operator, spec.racks[0].podTemplate.containerOverrides[0].lifecycle.preStop: Forbidden: preStop handler of ScyllaDB container is managed by the operator, spec.racks[0].podTemplate.containerOverrides[1].name: Unsupported value: "unknown": supported values: "scylla", "scylladb-api-status-probe", "scylladb-ignition", "scylla-manager-agent", "scylladb-node-exporter", "sidecar-injection", "sysctl-buddy", "scylladb-bootstrap-barrier", spec.racks[0].podTemplate.sidecarContainers[0].name: Forbidden: container name "scylla-manager-agent" is reserved by the operator, spec.racks[0].podTemplate.sidecarContainers[1].image: Required value]`,
+		},
+		{
+			name: "minimal alternator cluster passes",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "alternator cluster with user certificate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeUserManaged,
+						UserManagedOptions: &scyllav1alpha1.UserManagedTLSCertificateOptions{
+							SecretName: "my-tls-certificate",
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "alternator cluster with invalid certificate type",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: "foo",
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.scyllaDB.alternator.servingCertificate.type", BadValue: scyllav1alpha1.TLSCertificateType("foo"), Detail: `supported values: "OperatorManaged", "UserManaged"`},
+			},
+			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.type: Unsupported value: "foo": supported values: "OperatorManaged", "UserManaged"`,
+		},
+		{
+			name: "alternator cluster with valid additional domains",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
+						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
+							AdditionalDNSNames: []string{"scylla-operator.scylladb.com"},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "alternator cluster with invalid additional domains",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
+						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
+							AdditionalDNSNames: []string{"[not a domain]"},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalDNSNames", BadValue: []string{"[not a domain]"}, Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+			},
+			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalDNSNames: Invalid value: ["[not a domain]"]: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+		},
+		{
+			name: "alternator cluster with valid additional IP addresses",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
+						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
+							AdditionalIPAddresses: []string{"127.0.0.1", "::1"},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "alternator cluster with invalid additional IP addresses",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					ServingCertificate: &scyllav1alpha1.TLSCertificate{
+						Type: scyllav1alpha1.TLSCertificateTypeOperatorManaged,
+						OperatorManagedOptions: &scyllav1alpha1.OperatorManagedTLSCertificateOptions{
+							AdditionalIPAddresses: []string{"0.not-an-ip.0.0"},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalIPAddresses", BadValue: "0.not-an-ip.0.0", Detail: `must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)`, Origin: "format=ip-strict"},
+			},
+			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.operatorManagedOptions.additionalIPAddresses: Invalid value: "0.not-an-ip.0.0": must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)`,
+		},
+		{
+			name: "negative rackTemplate nodes",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					Nodes: pointer.Ptr[int32](-42),
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.nodes", BadValue: int64(-42), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+			},
+			expectedErrorString: `spec.rackTemplate.nodes: Invalid value: -42: must be greater than or equal to 0`,
+		},
+		{
+			name: "invalid topologyLabelSelector in rackTemplate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					TopologyLabelSelector: map[string]string{
+						"-123": "*321",
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.topologyLabelSelector", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.topologyLabelSelector", BadValue: "*321", Detail: `a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`, Origin: "format=k8s-label-value"},
+			},
+			expectedErrorString: `[spec.rackTemplate.topologyLabelSelector: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), spec.rackTemplate.topologyLabelSelector: Invalid value: "*321": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
+		},
+		{
+			name: "invalid scyllaDB storage capacity in rackTemplate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
 					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
 						Storage: &scyllav1alpha1.StorageOptions{
 							Capacity: "hello",
 						},
 					},
 				}
-
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.capacity", BadValue: "hello", Detail: `unable to parse capacity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.capacity: Invalid value: "hello": unable to parse capacity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		},
+		{
+			name: "invalid negative scyllaDB storage capacity in rackTemplate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "-1Gi",
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.capacity", BadValue: "-1Gi", Detail: `must be greater than zero`},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.capacity: Invalid value: "-1Gi": must be greater than zero`,
+		},
+		{
+			name: "invalid labels in rackTemplate scyllaDB storage metadata",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "1Gi",
+							Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+								Labels: map[string]string{
+									"-123": "*321",
+								},
+							},
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.labels", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.labels", BadValue: "*321", Detail: `a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`, Origin: "format=k8s-label-value"},
+			},
+			expectedErrorString: `[spec.rackTemplate.scyllaDB.storage.metadata.labels: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), spec.rackTemplate.scyllaDB.storage.metadata.labels: Invalid value: "*321": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
+		},
+		{
+			name: "invalid annotations in rackTemplate scyllaDB storage metadata",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "1Gi",
+							Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+								Annotations: map[string]string{
+									"-123": "*321",
+								},
+							},
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.annotations", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.metadata.annotations: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "invalid storageClassName in rackTemplate scyllaDB storage",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity:         "1Gi",
+							StorageClassName: pointer.Ptr("-hello"),
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.storageClassName", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.storageClassName: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+		},
+		{
+			name: "invalid customConfigMapRef in scyllaDB template of rackTemplate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						CustomConfigMapRef: pointer.Ptr("-hello"),
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.customConfigMapRef", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDB.customConfigMapRef: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+		},
+		{
+			name: "invalid customConfigSecretRef of ScyllaDB Manager Agent rackTemplate",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+						CustomConfigSecretRef: pointer.Ptr("-hello"),
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+			},
+			expectedErrorString: `spec.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+		},
+		{
+			name: "valid node replacements",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace-rack-2",
+						RackName: "rack",
+						Ordinal:  2,
+						Reason:   "disk failure",
+					},
+					{
+						Name:     "replace-rack-0",
+						RackName: "rack",
+						Ordinal:  0,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "node replacements targeting the same node",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace-rack-2",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+					{
+						Name:     "replace-rack-2-again",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.nodeReplacements[1]", BadValue: "", Detail: `node 2 of rack "rack" is already targeted by node replacement "replace-rack-2"`},
+			},
+			expectedErrorString: `spec.nodeReplacements[1]: Forbidden: node 2 of rack "rack" is already targeted by node replacement "replace-rack-2"`,
+		},
+		{
+			name: "node replacements with duplicate names",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  0,
+					},
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  1,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.nodeReplacements[1].name", BadValue: "replace"},
+			},
+			expectedErrorString: `spec.nodeReplacements[1].name: Duplicate value: "replace"`,
+		},
+		{
+			name: "node replacement of a nonexistent rack",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "other-rack",
+						Ordinal:  0,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotFound, Field: "spec.nodeReplacements[0].rackName", BadValue: "other-rack"},
+			},
+			expectedErrorString: `spec.nodeReplacements[0].rackName: Not found: "other-rack"`,
+		},
+		{
+			name: "node replacement of an ordinal out of rack bounds",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  3,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.nodeReplacements[0].ordinal", BadValue: int32(3), Detail: `must be lower than the number of nodes in rack "rack" (3)`},
+			},
+			expectedErrorString: `spec.nodeReplacements[0].ordinal: Invalid value: 3: must be lower than the number of nodes in rack "rack" (3)`,
+		},
+		{
+			name: "node replacement with an invalid name",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "-replace",
+						RackName: "rack",
+						Ordinal:  0,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.nodeReplacements[0].name", BadValue: "-replace", Detail: `a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`},
+			},
+			expectedErrorString: `spec.nodeReplacements[0].name: Invalid value: "-replace": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+		},
+		{
+			name: "valid bootstrap from backup",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Labels[naming.GlobalScyllaDBManagerRegistrationLabel] = naming.LabelValueTrue
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250101000000UTC",
+						Keyspace:    []string{"ks*"},
+						CredentialsSecretRef: &corev1.LocalObjectReference{
+							Name: "backup-credentials",
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "bootstrap from backup without global ScyllaDB Manager registration",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250101000000UTC",
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "metadata.labels[scylla-operator.scylladb.com/register-with-global-scylladb-manager]", BadValue: "", Detail: `must be "true" when bootstrapping from a backup`},
+			},
+			expectedErrorString: `metadata.labels[scylla-operator.scylladb.com/register-with-global-scylladb-manager]: Required value: must be "true" when bootstrapping from a backup`,
+		},
+		{
+			name: "bootstrap from backup without location and snapshot tag",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Labels[naming.GlobalScyllaDBManagerRegistrationLabel] = naming.LabelValueTrue
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.bootstrapFrom.backup.location", BadValue: "", Detail: "location must not be empty"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.bootstrapFrom.backup.snapshotTag", BadValue: "", Detail: ""},
+			},
+			expectedErrorString: `[spec.bootstrapFrom.backup.location: Required value: location must not be empty, spec.bootstrapFrom.backup.snapshotTag: Required value]`,
+		},
+		{
+			name: "bootstrap from without a source",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.bootstrapFrom.backup", BadValue: "", Detail: "a bootstrap source must be specified"},
+			},
+			expectedErrorString: `spec.bootstrapFrom.backup: Required value: a bootstrap source must be specified`,
+		},
+		{
+			name: "valid ScyllaDB config",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:2025.1.1"
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Compaction: &scyllav1alpha1.ScyllaDBCompactionConfig{
+						ThroughputMBPerSecond: pointer.Ptr[int32](0),
+					},
+					Streaming: &scyllav1alpha1.ScyllaDBStreamingConfig{
+						IOThroughputMBPerSecond: pointer.Ptr[int32](100),
+					},
+					Commitlog: &scyllav1alpha1.ScyllaDBCommitlogConfig{
+						SegmentSizeMB:          pointer.Ptr[int32](32),
+						TotalSpaceMB:           pointer.Ptr[int64](8192),
+						SyncPeriodMilliseconds: pointer.Ptr[int32](10000),
+					},
+					Timeouts: &scyllav1alpha1.ScyllaDBTimeoutsConfig{
+						ReadRequestMilliseconds:  pointer.Ptr[int32](5000),
+						WriteRequestMilliseconds: pointer.Ptr[int32](2000),
+					},
+					HintedHandoff: &scyllav1alpha1.ScyllaDBHintedHandoffConfig{
+						Enabled:                   pointer.Ptr(true),
+						MaxHintWindowMilliseconds: pointer.Ptr[int32](10800000),
+					},
+					Tablets: &scyllav1alpha1.ScyllaDBTabletsConfig{
+						Enabled: pointer.Ptr(false),
+					},
+				}
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--blocked-reactor-notify-ms 999999999"}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid ScyllaDB config values",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Compaction: &scyllav1alpha1.ScyllaDBCompactionConfig{
+						ThroughputMBPerSecond: pointer.Ptr[int32](-1),
+					},
+					Commitlog: &scyllav1alpha1.ScyllaDBCommitlogConfig{
+						SegmentSizeMB: pointer.Ptr[int32](0),
+						TotalSpaceMB:  pointer.Ptr[int64](0),
+					},
+					Timeouts: &scyllav1alpha1.ScyllaDBTimeoutsConfig{
+						CASContentionMilliseconds: pointer.Ptr[int32](0),
+					},
+					HintedHandoff: &scyllav1alpha1.ScyllaDBHintedHandoffConfig{
+						MaxHintWindowMilliseconds: pointer.Ptr[int32](-1),
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.config.compaction.throughputMBPerSecond", BadValue: int64(-1), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.config.commitlog.segmentSizeMB", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.config.commitlog.totalSpaceMB", BadValue: int64(0), Detail: "must be greater than or equal to 1"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.config.timeouts.casContentionMilliseconds", BadValue: int32(0), Detail: "must be greater than or equal to 1"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.config.hintedHandoff.maxHintWindowMilliseconds", BadValue: int64(-1), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+			},
+			expectedErrorString: `[spec.scyllaDB.config.compaction.throughputMBPerSecond: Invalid value: -1: must be greater than or equal to 0, spec.scyllaDB.config.commitlog.segmentSizeMB: Invalid value: 0: must be greater than or equal to 1, spec.scyllaDB.config.commitlog.totalSpaceMB: Invalid value: 0: must be greater than or equal to 1, spec.scyllaDB.config.timeouts.casContentionMilliseconds: Invalid value: 0: must be greater than or equal to 1, spec.scyllaDB.config.hintedHandoff.maxHintWindowMilliseconds: Invalid value: -1: must be greater than or equal to 0]`,
+		},
+		{
+			name: "ScyllaDB config options unsupported by ScyllaDB version",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:5.0.0"
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Streaming: &scyllav1alpha1.ScyllaDBStreamingConfig{
+						IOThroughputMBPerSecond: pointer.Ptr[int32](100),
+					},
+					Tablets: &scyllav1alpha1.ScyllaDBTabletsConfig{
+						Enabled: pointer.Ptr(true),
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.config.streaming.ioThroughputMBPerSecond", BadValue: "", Detail: `feature "StreamIOThroughput" is not supported by ScyllaDB image "scylladb/scylla:5.0.0"`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.config.tablets.enabled", BadValue: "", Detail: `feature "Tablets" is not supported by ScyllaDB image "scylladb/scylla:5.0.0"`},
+			},
+			expectedErrorString: `[spec.scyllaDB.config.streaming.ioThroughputMBPerSecond: Forbidden: feature "StreamIOThroughput" is not supported by ScyllaDB image "scylladb/scylla:5.0.0", spec.scyllaDB.config.tablets.enabled: Forbidden: feature "Tablets" is not supported by ScyllaDB image "scylladb/scylla:5.0.0"]`,
+		},
+		{
+			name: "ScyllaDB config version checks are skipped for images without a semantic version",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:latest"
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Tablets: &scyllav1alpha1.ScyllaDBTabletsConfig{
+						Enabled: pointer.Ptr(true),
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "arguments conflicting with ScyllaDB config",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Compaction: &scyllav1alpha1.ScyllaDBCompactionConfig{
+						ThroughputMBPerSecond: pointer.Ptr[int32](64),
+					},
+					Timeouts: &scyllav1alpha1.ScyllaDBTimeoutsConfig{
+						WriteRequestMilliseconds: pointer.Ptr[int32](2000),
+					},
+				}
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--smp=2", "--compaction-throughput-mb-per-sec=32"}
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--write_request_timeout_in_ms 1000"}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.additionalScyllaDBArguments[1]", BadValue: "", Detail: `argument "--compaction-throughput-mb-per-sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--write_request_timeout_in_ms" sets option "write_request_timeout_in_ms" which is already set through spec.scyllaDB.config`},
+			},
+			expectedErrorString: `[spec.scyllaDB.additionalScyllaDBArguments[1]: Forbidden: argument "--compaction-throughput-mb-per-sec" sets option "compaction_throughput_mb_per_sec" which is already set through spec.scyllaDB.config, spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--write_request_timeout_in_ms" sets option "write_request_timeout_in_ms" which is already set through spec.scyllaDB.config]`,
+		},
+		{
+			name: "arguments setting operator-owned options",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--smp=2", "--cluster-name=other"}
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						AdditionalScyllaDBArguments: []string{"--endpoint_snitch SimpleSnitch"},
+					},
+				}
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--native-transport-port=9043 --seeds=10.0.0.1"}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.additionalScyllaDBArguments[1]", BadValue: "", Detail: `argument "--cluster-name" sets option "cluster_name" which is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.rackTemplate.scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--endpoint_snitch" sets option "endpoint_snitch" which is managed by the operator`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--seeds" sets option "seeds" which is managed by the operator`},
+			},
+			expectedErrorString: `[spec.scyllaDB.additionalScyllaDBArguments[1]: Forbidden: argument "--cluster-name" sets option "cluster_name" which is managed by the operator, spec.rackTemplate.scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--endpoint_snitch" sets option "endpoint_snitch" which is managed by the operator, spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--seeds" sets option "seeds" which is managed by the operator]`,
+		},
+		{
+			name: "arguments setting CQL ports with NodePort node Services",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr[int32](3)
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort:        31000,
+							MaxNodesPerRack: 3,
+						},
+					},
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+						},
+					},
+				}
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--native-transport-port-ssl=9143"}
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--native-shard-aware-transport-port=19043"}
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.capacity", BadValue: "hello", Detail: `unable to parse capacity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--native-transport-port-ssl" sets option "native_transport_port_ssl" which is managed by the operator when nodeService type is "NodePort"`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--native-shard-aware-transport-port" sets option "native_shard_aware_transport_port" which is managed by the operator when nodeService type is "NodePort"`},
 			},
-			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.capacity: Invalid value: "hello": unable to parse capacity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+			expectedErrorString: `[spec.scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--native-transport-port-ssl" sets option "native_transport_port_ssl" which is managed by the operator when nodeService type is "NodePort", spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--native-shard-aware-transport-port" sets option "native_shard_aware_transport_port" which is managed by the operator when nodeService type is "NodePort"]`,
 		},
 		{
-			name: "invalid negative scyllaDB storage capacity in rackTemplate",
+			name: "valid readiness options",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						Storage: &scyllav1alpha1.StorageOptions{
-							Capacity: "-1Gi",
-						},
-					},
+				sdc.Spec.ScyllaDB.Readiness = &scyllav1alpha1.ScyllaDBReadinessOptions{
+					Mode:              scyllav1alpha1.ScyllaDBReadinessModePeerQuorum,
+					MinUpPeersPercent: pointer.Ptr[int32](100),
 				}
-
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.capacity", BadValue: "-1Gi", Detail: `must be greater than zero`},
-			},
-			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.capacity: Invalid value: "-1Gi": must be greater than zero`,
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
-			name: "invalid labels in rackTemplate scyllaDB storage metadata",
+			name: "invalid readiness options",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						Storage: &scyllav1alpha1.StorageOptions{
-							Capacity: "1Gi",
-							Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
-								Labels: map[string]string{
-									"-123": "*321",
-								},
-							},
-						},
-					},
+				sdc.Spec.ScyllaDB.Readiness = &scyllav1alpha1.ScyllaDBReadinessOptions{
+					Mode:              "Unknown",
+					MinUpPeersPercent: pointer.Ptr[int32](101),
 				}
-
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.labels", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.labels", BadValue: "*321", Detail: `a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`, Origin: "format=k8s-label-value"},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.scyllaDB.readiness.mode", BadValue: scyllav1alpha1.ScyllaDBReadinessMode("Unknown"), Detail: `supported values: "NodeStatus", "CQLQuery", "PeerQuorum"`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.readiness.minUpPeersPercent", BadValue: int32(101), Detail: "must be between 0 and 100"},
 			},
-			expectedErrorString: `[spec.rackTemplate.scyllaDB.storage.metadata.labels: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), spec.rackTemplate.scyllaDB.storage.metadata.labels: Invalid value: "*321": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
+			expectedErrorString: `[spec.scyllaDB.readiness.mode: Unsupported value: "Unknown": supported values: "NodeStatus", "CQLQuery", "PeerQuorum", spec.scyllaDB.readiness.minUpPeersPercent: Invalid value: 101: must be between 0 and 100]`,
 		},
 		{
-			name: "invalid annotations in rackTemplate scyllaDB storage metadata",
+			name: "valid alternator users and ingress endpoint",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						Storage: &scyllav1alpha1.StorageOptions{
-							Capacity: "1Gi",
-							Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
-								Annotations: map[string]string{
-									"-123": "*321",
-								},
-							},
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Users: []scyllav1alpha1.AlternatorUser{
+						{
+							Name: "app",
+						},
+					},
+					CQLCredentialsSecretRef: &corev1.LocalObjectReference{
+						Name: "cql-credentials",
+					},
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type: scyllav1alpha1.AlternatorEndpointTypeIngress,
+						Ingress: &scyllav1alpha1.AlternatorEndpointIngressOptions{
+							Hosts: []string{"alternator.scylladb.com"},
 						},
 					},
 				}
-
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.metadata.annotations", BadValue: "-123", Detail: `name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`, Origin: "format=k8s-label-key"},
-			},
-			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.metadata.annotations: Invalid value: "-123": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
-			name: "invalid storageClassName in rackTemplate scyllaDB storage",
+			name: "invalid alternator users",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						Storage: &scyllav1alpha1.StorageOptions{
-							Capacity:         "1Gi",
-							StorageClassName: pointer.Ptr("-hello"),
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Users: []scyllav1alpha1.AlternatorUser{
+						{
+							Name: "app",
+						},
+						{
+							Name: "App_1",
+						},
+						{
+							Name: "app",
 						},
 					},
 				}
-
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.storageClassName", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDB.alternator.users[1].name", BadValue: "App_1", Detail: "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.scyllaDB.alternator.users[2].name", BadValue: "app"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDB.alternator.cqlCredentialsSecretRef", BadValue: "", Detail: "cqlCredentialsSecretRef is required when users are specified"},
 			},
-			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.storageClassName: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+			expectedErrorString: `[spec.scyllaDB.alternator.users[1].name: Invalid value: "App_1": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), spec.scyllaDB.alternator.users[2].name: Duplicate value: "app", spec.scyllaDB.alternator.cqlCredentialsSecretRef: Required value: cqlCredentialsSecretRef is required when users are specified]`,
 		},
 		{
-			name: "invalid customConfigMapRef in scyllaDB template of rackTemplate",
+			name: "invalid alternator endpoint",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						CustomConfigMapRef: pointer.Ptr("-hello"),
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type: scyllav1alpha1.AlternatorEndpointTypeService,
+						Service: &scyllav1alpha1.AlternatorEndpointServiceOptions{
+							Type: corev1.ServiceTypeExternalName,
+						},
+						Ingress: &scyllav1alpha1.AlternatorEndpointIngressOptions{
+							Hosts: []string{"alternator.scylladb.com"},
+						},
 					},
 				}
-
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.customConfigMapRef", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.scyllaDB.alternator.endpoint.service.type", BadValue: corev1.ServiceTypeExternalName, Detail: `supported values: "ClusterIP", "NodePort", "LoadBalancer"`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.alternator.endpoint.ingress", BadValue: "", Detail: "ingress can only be set when type is Ingress"},
 			},
-			expectedErrorString: `spec.rackTemplate.scyllaDB.customConfigMapRef: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+			expectedErrorString: `[spec.scyllaDB.alternator.endpoint.service.type: Unsupported value: "ExternalName": supported values: "ClusterIP", "NodePort", "LoadBalancer", spec.scyllaDB.alternator.endpoint.ingress: Forbidden: ingress can only be set when type is Ingress]`,
 		},
 		{
-			name: "invalid customConfigSecretRef of ScyllaDB Manager Agent rackTemplate",
+			name: "alternator ingress endpoint requires hosts",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
-					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-						CustomConfigSecretRef: pointer.Ptr("-hello"),
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type:    scyllav1alpha1.AlternatorEndpointTypeIngress,
+						Ingress: &scyllav1alpha1.AlternatorEndpointIngressOptions{},
 					},
 				}
-
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef", BadValue: "-hello", Detail: `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDB.alternator.endpoint.ingress.hosts", BadValue: ""},
 			},
-			expectedErrorString: `spec.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+			expectedErrorString: `spec.scyllaDB.alternator.endpoint.ingress.hosts: Required value`,
 		},
 	}
 
@@ -1144,6 +2120,83 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.exposeOptions.nodeService.type: Invalid value: "ClusterIP": field is immutable`,
 		},
+		{
+			name: "racks can't be reordered when node ports are used",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort: 30000,
+						},
+					},
+				}
+				sdc.Spec.Racks = append(sdc.Spec.Racks, *sdc.Spec.Racks[0].DeepCopy())
+				sdc.Spec.Racks[1].Name = "rack-2"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type: scyllav1alpha1.NodeServiceTypeNodePort,
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort: 30000,
+						},
+					},
+				}
+				sdc.Spec.Racks = append([]scyllav1alpha1.RackSpec{*sdc.Spec.Racks[0].DeepCopy()}, sdc.Spec.Racks...)
+				sdc.Spec.Racks[0].Name = "rack-2"
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0]", BadValue: "", Detail: `rack "rack-2" can't change its position from 1 because node ports are allocated based on rack position`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[1]", BadValue: "", Detail: `rack "rack" can't change its position from 0 because node ports are allocated based on rack position`},
+			},
+			expectedErrorString: `[spec.racks[0]: Forbidden: rack "rack-2" can't change its position from 1 because node ports are allocated based on rack position, spec.racks[1]: Forbidden: rack "rack" can't change its position from 0 because node ports are allocated based on rack position]`,
+		},
+		{
+			name: "nodes broadcast IP family cannot be changed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.IPFamilyPolicy = pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack)
+				sdc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv4Protocol),
+						},
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.IPFamilyPolicy = pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack)
+				sdc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.exposeOptions.broadcastOptions.nodes.ipFamily", BadValue: pointer.Ptr(corev1.IPv6Protocol), Detail: `field is immutable`},
+			},
+			expectedErrorString: `spec.exposeOptions.broadcastOptions.nodes.ipFamily: Invalid value: "IPv6": field is immutable`,
+		},
 		{
 			name: "clients broadcast address type cannot be changed",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -1214,6 +2267,188 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "PodIP": field is immutable`,
 		},
+		{
+			name: "node replacement ordinal cannot be changed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  0,
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  1,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.nodeReplacements[0].ordinal", BadValue: int32(1), Detail: `field is immutable`},
+			},
+			expectedErrorString: `spec.nodeReplacements[0].ordinal: Invalid value: 1: field is immutable`,
+		},
+		{
+			name: "rack can be scaled down below the ordinal of an existing node replacement",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(3))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(2))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "completed node replacement isn't checked against the rack size",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(2))
+				sdc.Status.NodeReplacements = []scyllav1alpha1.NodeReplacementStatus{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  2,
+						Phase:    scyllav1alpha1.NodeReplacementPhaseCompleted,
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(2))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "new node replacement ordinal must be lower than the number of nodes in rack",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(2))
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].Nodes = pointer.Ptr(int32(2))
+				sdc.Spec.NodeReplacements = []scyllav1alpha1.NodeReplacement{
+					{
+						Name:     "replace",
+						RackName: "rack",
+						Ordinal:  2,
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.nodeReplacements[0].ordinal", BadValue: int32(2), Detail: `must be lower than the number of nodes in rack "rack" (2)`},
+			},
+			expectedErrorString: `spec.nodeReplacements[0].ordinal: Invalid value: 2: must be lower than the number of nodes in rack "rack" (2)`,
+		},
+		{
+			name: "bootstrapFrom changed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Labels[naming.GlobalScyllaDBManagerRegistrationLabel] = naming.LabelValueTrue
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250101000000UTC",
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Labels[naming.GlobalScyllaDBManagerRegistrationLabel] = naming.LabelValueTrue
+				sdc.Spec.BootstrapFrom = &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250202000000UTC",
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.bootstrapFrom", BadValue: &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250202000000UTC",
+					},
+				}, Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.bootstrapFrom: Invalid value: {"backup":{"location":["s3:backups"],"snapshotTag":"sm_20250202000000UTC"}}: field is immutable`,
+		},
+		{
+			name: "operator-owned options set by the old object are allowed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster-name=basic"}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster-name=basic", "--smp=2"}
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster_name=basic"}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "operator-owned options added on update",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster-name=basic"}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{"--cluster-name=basic"}
+				sdc.Spec.Racks[0].ScyllaDB.AdditionalScyllaDBArguments = []string{"--seeds=10.0.0.1"}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]", BadValue: "", Detail: `argument "--seeds" sets option "seeds" which is managed by the operator`},
+			},
+			expectedErrorString: `spec.racks[0].scyllaDB.additionalScyllaDBArguments[0]: Forbidden: argument "--seeds" sets option "seeds" which is managed by the operator`,
+		},
 	}
 
 	for _, test := range tests {
@@ -19,6 +19,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/util/duration"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -39,6 +40,7 @@ var (
 	supportedScyllaDBManagerTaskTypes = []scyllav1alpha1.ScyllaDBManagerTaskType{
 		scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
 		scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+		scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
 	}
 
 	// https://github.com/scylladb/scylla-manager/blob/c599d2025d98c13fa3bc943a5456df7c527c5de3/backupspec/location.go
@@ -200,6 +202,10 @@ func validateScyllaDBManagerTaskSpec(spec *scyllav1alpha1.ScyllaDBManagerTaskSpe
 
 		allErrs = append(allErrs, validateScyllaDBManagerBackupTaskOptions(spec.Backup, &flags.validateScyllaDBManagerBackupTaskOptionsFlags, fldPath.Child("backup"))...)
 
+		if len(spec.Backup.Datacenters) != 0 && spec.ScyllaDBClusterRef.Kind != scyllav1alpha1.ScyllaDBClusterGVK.Kind {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("backup", "datacenters"), fmt.Sprintf("datacenters are forbidden when scyllaDBClusterRef kind is not %q", scyllav1alpha1.ScyllaDBClusterGVK.Kind)))
+		}
+
 	case scyllav1alpha1.ScyllaDBManagerTaskTypeRepair:
 		if spec.Repair == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("repair"), fmt.Sprintf("repair options are required when task type is %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRepair)))
@@ -208,6 +214,14 @@ func validateScyllaDBManagerTaskSpec(spec *scyllav1alpha1.ScyllaDBManagerTaskSpe
 
 		allErrs = append(allErrs, validateScyllaDBManagerRepairTaskOptions(spec.Repair, &flags.validateScyllaDBManagerRepairTaskOptionsFlags, fldPath.Child("repair"))...)
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		if spec.Restore == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("restore"), fmt.Sprintf("restore options are required when task type is %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRestore)))
+			break
+		}
+
+		allErrs = append(allErrs, validateScyllaDBManagerRestoreTaskOptions(spec.Restore, fldPath.Child("restore"))...)
+
 	default:
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type, oslices.ConvertSlice(supportedScyllaDBManagerTaskTypes, oslices.ToString)))
 
@@ -221,6 +235,10 @@ func validateScyllaDBManagerTaskSpec(spec *scyllav1alpha1.ScyllaDBManagerTaskSpe
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("repair"), fmt.Sprintf("repair options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRepair)))
 	}
 
+	if spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeRestore && spec.Restore != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("restore"), fmt.Sprintf("restore options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRestore)))
+	}
+
 	return allErrs
 }
 
@@ -242,8 +260,11 @@ func validateScyllaDBManagerBackupTaskOptions(backupOptions *scyllav1alpha1.Scyl
 	}
 
 	if !flags.isLocationValidationDisabled {
-		if backupOptions.Location == nil || len(backupOptions.Location) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty"))
+		hasDatacenterLocation := slices.ContainsFunc(backupOptions.Datacenters, func(dcOptions scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions) bool {
+			return len(dcOptions.Location) != 0
+		})
+		if len(backupOptions.Location) == 0 && !hasDatacenterLocation {
+			allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty unless it is specified for datacenters"))
 		} else {
 			for i := range backupOptions.Location {
 				allErrs = append(allErrs, validateLocation(backupOptions.Location[i], fldPath.Child("location").Index(i))...)
@@ -273,9 +294,88 @@ func validateScyllaDBManagerBackupTaskOptions(backupOptions *scyllav1alpha1.Scyl
 		}
 	}
 
+	dcNames := apimachineryutilsets.New[string]()
+	for i, dcOptions := range backupOptions.Datacenters {
+		dcFldPath := fldPath.Child("datacenters").Index(i)
+
+		if len(dcOptions.Name) == 0 {
+			allErrs = append(allErrs, field.Required(dcFldPath.Child("name"), ""))
+		} else if dcNames.Has(dcOptions.Name) {
+			allErrs = append(allErrs, field.Duplicate(dcFldPath.Child("name"), dcOptions.Name))
+		} else {
+			dcNames.Insert(dcOptions.Name)
+		}
+
+		allErrs = append(allErrs, validateScyllaDBManagerBackupTaskDatacenterOptions(&dcOptions, backupOptions, flags, dcFldPath)...)
+	}
+
 	return allErrs
 }
 
+func validateScyllaDBManagerBackupTaskDatacenterOptions(dcOptions *scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions, backupOptions *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions, flags *validateScyllaDBManagerBackupTaskOptionsFlags, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !flags.isLocationValidationDisabled && len(dcOptions.Location) != 0 {
+		for i := range dcOptions.Location {
+			allErrs = append(allErrs, validateDatacenterLocation(dcOptions.Location[i], fldPath.Child("location").Index(i))...)
+		}
+
+		if hasDCPrefixedEntry(backupOptions.Location, backupTaskSpecOptionsLocationRe, dcOptions.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("location"), fmt.Sprintf("can't be used together with location entries prefixed with datacenter %q", dcOptions.Name)))
+		}
+	}
+
+	type dcLimit struct {
+		fieldName         string
+		value             *int64
+		globalEntries     []string
+		validationEnabled bool
+	}
+	for _, limit := range []dcLimit{
+		{
+			fieldName:         "rateLimit",
+			value:             dcOptions.RateLimit,
+			globalEntries:     backupOptions.RateLimit,
+			validationEnabled: !flags.isRateLimitValidationDisabled,
+		},
+		{
+			fieldName:         "snapshotParallel",
+			value:             dcOptions.SnapshotParallel,
+			globalEntries:     backupOptions.SnapshotParallel,
+			validationEnabled: !flags.isSnapshotParallelValidationDisabled,
+		},
+		{
+			fieldName:         "uploadParallel",
+			value:             dcOptions.UploadParallel,
+			globalEntries:     backupOptions.UploadParallel,
+			validationEnabled: !flags.isUploadParallelValidationDisabled,
+		},
+	} {
+		if !limit.validationEnabled || limit.value == nil {
+			continue
+		}
+
+		if *limit.value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(limit.fieldName), *limit.value, "can't be negative"))
+		}
+
+		if hasDCPrefixedEntry(limit.globalEntries, backupTaskSpecOptionsDCLimitRe, dcOptions.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(limit.fieldName), fmt.Sprintf("can't be used together with %s entries prefixed with datacenter %q", limit.fieldName, dcOptions.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// hasDCPrefixedEntry returns true if any of the entries matching the regular expression is prefixed with the given datacenter name.
+// The datacenter prefix is expected in the second submatch of the regular expression.
+func hasDCPrefixedEntry(entries []string, re *regexp.Regexp, dc string) bool {
+	return slices.ContainsFunc(entries, func(entry string) bool {
+		m := re.FindStringSubmatch(entry)
+		return m != nil && m[2] == dc
+	})
+}
+
 func validateScyllaDBManagerRepairTaskOptions(repairOptions *scyllav1alpha1.ScyllaDBManagerRepairTaskOptions, flags *validateScyllaDBManagerRepairTaskOptionsFlags, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -312,6 +412,34 @@ func validateScyllaDBManagerRepairTaskOptions(repairOptions *scyllav1alpha1.Scyl
 	return allErrs
 }
 
+func validateScyllaDBManagerRestoreTaskOptions(restoreOptions *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(restoreOptions.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty"))
+	}
+
+	for i := range restoreOptions.Location {
+		allErrs = append(allErrs, validateLocation(restoreOptions.Location[i], fldPath.Child("location").Index(i))...)
+	}
+
+	if len(restoreOptions.SnapshotTag) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("snapshotTag"), ""))
+	}
+
+	for i := range restoreOptions.Keyspace {
+		allErrs = append(allErrs, validateKeyspaceFilter(restoreOptions.Keyspace[i], fldPath.Child("keyspace").Index(i))...)
+	}
+
+	restoreSchema := restoreOptions.RestoreSchema != nil && *restoreOptions.RestoreSchema
+	restoreTables := restoreOptions.RestoreTables != nil && *restoreOptions.RestoreTables
+	if restoreSchema == restoreTables {
+		allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("restoreSchema=%t, restoreTables=%t", restoreSchema, restoreTables), "exactly one of restoreSchema and restoreTables must be true"))
+	}
+
+	return allErrs
+}
+
 func validateScyllaDBManagerTaskSchedule(schedule *scyllav1alpha1.ScyllaDBManagerTaskSchedule, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -359,6 +487,8 @@ func validateScyllaDBManagerTaskSpecUpdate(newSpec, oldSpec *scyllav1alpha1.Scyl
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.Type, oldSpec.Type, fldPath.Child("type"))...)
+	// Restore tasks run only once, so changing their options would have no effect.
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.Restore, oldSpec.Restore, fldPath.Child("restore"))...)
 
 	return allErrs
 }
@@ -390,6 +520,20 @@ func validateLocation(s string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateDatacenterLocation(s string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	m := backupTaskSpecOptionsLocationRe.FindStringSubmatch(s)
+	if m == nil || len(m[1]) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be in <provider>:<bucket> format"))
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateLocation(s, fldPath)...)
+
+	return allErrs
+}
+
 // https://github.com/scylladb/scylla-manager/blob/c599d2025d98c13fa3bc943a5456df7c527c5de3/v3/pkg/util/inexlist/dcfilter/dcfilter.go
 func validateDCFilter(s string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
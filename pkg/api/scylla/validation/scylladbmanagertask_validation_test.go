@@ -83,10 +83,10 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 					Type:     field.ErrorTypeNotSupported,
 					Field:    "spec.type",
 					BadValue: scyllav1alpha1.ScyllaDBManagerTaskType("Unsupported"),
-					Detail:   `supported values: "Backup", "Repair"`,
+					Detail:   `supported values: "Backup", "Repair", "Restore"`,
 				},
 			},
-			expectedErrorString: `spec.type: Unsupported value: "Unsupported": supported values: "Backup", "Repair"`,
+			expectedErrorString: `spec.type: Unsupported value: "Unsupported": supported values: "Backup", "Repair", "Restore"`,
 		},
 		{
 			name: "missing required options for repair type",
@@ -136,6 +136,150 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 			},
 			expectedErrorString: `spec.backup: Required value: backup options are required when task type is "Backup"`,
 		},
+		{
+			name: "valid restore",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						Location: []string{
+							"s3:test",
+						},
+						SnapshotTag:   "sm_20250101000000UTC",
+						Keyspace:      []string{"ks*"},
+						RestoreTables: pointer.Ptr(true),
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "restore task type without restore options",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.restore",
+					BadValue: "",
+					Detail:   `restore options are required when task type is "Restore"`,
+				},
+			},
+			expectedErrorString: `spec.restore: Required value: restore options are required when task type is "Restore"`,
+		},
+		{
+			name: "restore without location and snapshot tag",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						RestoreSchema: pointer.Ptr(true),
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.restore.location",
+					BadValue: "",
+					Detail:   "location must not be empty",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.restore.snapshotTag",
+					BadValue: "",
+					Detail:   "",
+				},
+			},
+			expectedErrorString: `[spec.restore.location: Required value: location must not be empty, spec.restore.snapshotTag: Required value]`,
+		},
+		{
+			name: "restore of both schema and tables",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						Location: []string{
+							"s3:test",
+						},
+						SnapshotTag:   "sm_20250101000000UTC",
+						RestoreSchema: pointer.Ptr(true),
+						RestoreTables: pointer.Ptr(true),
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.restore",
+					BadValue: "restoreSchema=true, restoreTables=true",
+					Detail:   "exactly one of restoreSchema and restoreTables must be true",
+				},
+			},
+			expectedErrorString: `spec.restore: Invalid value: "restoreSchema=true, restoreTables=true": exactly one of restoreSchema and restoreTables must be true`,
+		},
+		{
+			name: "restore options for backup task type",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						Location: []string{
+							"gcs:test",
+						},
+					},
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.restore",
+					BadValue: "",
+					Detail:   `restore options are forbidden when task type is not "Restore"`,
+				},
+			},
+			expectedErrorString: `spec.restore: Forbidden: restore options are forbidden when task type is not "Restore"`,
+		},
 		{
 			name: "repair options for backup task type",
 			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
@@ -218,10 +362,10 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 					Type:     field.ErrorTypeRequired,
 					Field:    "spec.backup.location",
 					BadValue: "",
-					Detail:   "location must not be empty",
+					Detail:   "location must not be empty unless it is specified for datacenters",
 				},
 			},
-			expectedErrorString: `spec.backup.location: Required value: location must not be empty`,
+			expectedErrorString: `spec.backup.location: Required value: location must not be empty unless it is specified for datacenters`,
 		},
 		{
 			name: "empty backup location item",
@@ -1842,6 +1986,162 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 			},
 			expectedErrorString: `spec.scyllaDBClusterRef.kind: Unsupported value: "ScyllaCluster": supported values: "ScyllaDBDatacenter", "ScyllaDBCluster"`,
 		},
+		{
+			name: "valid backup with datacenter options",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBCluster",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						RateLimit: []string{
+							"100",
+						},
+						Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+							{
+								Name: "dc1",
+								Location: []string{
+									"gcs:test-dc1",
+								},
+								RateLimit:      pointer.Ptr[int64](10),
+								UploadParallel: pointer.Ptr[int64](2),
+							},
+							{
+								Name: "dc2",
+								Location: []string{
+									"s3:test-dc2",
+								},
+								SnapshotParallel: pointer.Ptr[int64](3),
+							},
+						},
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "backup with datacenter options referencing ScyllaDBDatacenter",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						Location: []string{
+							"gcs:test",
+						},
+						Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+							{
+								Name:      "dc1",
+								RateLimit: pointer.Ptr[int64](10),
+							},
+						},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.backup.datacenters",
+					BadValue: "",
+					Detail:   `datacenters are forbidden when scyllaDBClusterRef kind is not "ScyllaDBCluster"`,
+				},
+			},
+			expectedErrorString: `spec.backup.datacenters: Forbidden: datacenters are forbidden when scyllaDBClusterRef kind is not "ScyllaDBCluster"`,
+		},
+		{
+			name: "backup with invalid datacenter options",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBCluster",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						Location: []string{
+							"dc1:gcs:test",
+						},
+						SnapshotParallel: []string{
+							"dc2:1",
+						},
+						Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+							{
+								Name: "dc1",
+								Location: []string{
+									"gcs:test-dc1",
+								},
+							},
+							{
+								Name: "dc2",
+								Location: []string{
+									"dc2:s3:test-dc2",
+								},
+								RateLimit:        pointer.Ptr[int64](-1),
+								SnapshotParallel: pointer.Ptr[int64](2),
+							},
+							{
+								Name: "dc2",
+							},
+							{
+								Name: "",
+							},
+						},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.backup.datacenters[0].location",
+					BadValue: "",
+					Detail:   `can't be used together with location entries prefixed with datacenter "dc1"`,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.backup.datacenters[1].location[0]",
+					BadValue: "dc2:s3:test-dc2",
+					Detail:   "must be in <provider>:<bucket> format",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.backup.datacenters[1].rateLimit",
+					BadValue: int64(-1),
+					Detail:   "can't be negative",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.backup.datacenters[1].snapshotParallel",
+					BadValue: "",
+					Detail:   `can't be used together with snapshotParallel entries prefixed with datacenter "dc2"`,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeDuplicate,
+					Field:    "spec.backup.datacenters[2].name",
+					BadValue: "dc2",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.backup.datacenters[3].name",
+					BadValue: "",
+				},
+			},
+			expectedErrorString: `[spec.backup.datacenters[0].location: Forbidden: can't be used together with location entries prefixed with datacenter "dc1", spec.backup.datacenters[1].location[0]: Invalid value: "dc2:s3:test-dc2": must be in <provider>:<bucket> format, spec.backup.datacenters[1].rateLimit: Invalid value: -1: can't be negative, spec.backup.datacenters[1].snapshotParallel: Forbidden: can't be used together with snapshotParallel entries prefixed with datacenter "dc2", spec.backup.datacenters[2].name: Duplicate value: "dc2", spec.backup.datacenters[3].name: Required value]`,
+		},
 	}
 
 	for _, tc := range tt {
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package bootstrapprogress
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+)
+
+const (
+	// DefaultStallTimeout is how long a bootstrapping node can go without making progress before its bootstrap is considered stalled.
+	DefaultStallTimeout = 30 * time.Minute
+)
+
+// Tracker detects stalled bootstraps of a ScyllaDB node.
+// Nodes bootstrapping or replacing other nodes stream data from their peers for as long as it takes,
+// which can be hours for large nodes, so a bootstrap is only considered stalled when it stops making progress.
+type Tracker struct {
+	lock sync.Mutex
+
+	stallTimeout time.Duration
+	nowFunc      func() time.Time
+
+	bootstrapping    bool
+	progress         int64
+	lastProgressTime time.Time
+}
+
+func NewTracker(stallTimeout time.Duration, nowFunc func() time.Time) *Tracker {
+	return &Tracker{
+		stallTimeout: stallTimeout,
+		nowFunc:      nowFunc,
+	}
+}
+
+// Observe records the operation mode and the progress of the node, and returns whether its bootstrap is stalled.
+// Progress can be any value that changes while the node receives data.
+func (t *Tracker) Observe(operationMode scyllaclient.OperationalMode, progress int64) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if operationMode != scyllaclient.OperationalModeJoining {
+		t.bootstrapping = false
+		return false
+	}
+
+	now := t.nowFunc()
+	if !t.bootstrapping || progress != t.progress {
+		t.bootstrapping = true
+		t.progress = progress
+		t.lastProgressTime = now
+		return false
+	}
+
+	return now.Sub(t.lastProgressTime) > t.stallTimeout
+}
+
+// GetProgress returns a value that changes while the node receives data, either through streaming or repair based operations.
+func GetProgress(ctx context.Context, scyllaClient *scyllaclient.Client, host string) (int64, error) {
+	load, err := scyllaClient.Load(ctx, host)
+	if err != nil {
+		return 0, fmt.Errorf("can't get load: %w", err)
+	}
+
+	receivedStreamingBytes, err := scyllaClient.ReceivedStreamingBytes(ctx, host)
+	if err != nil {
+		return 0, fmt.Errorf("can't get received streaming bytes: %w", err)
+	}
+
+	return load + receivedStreamingBytes, nil
+}
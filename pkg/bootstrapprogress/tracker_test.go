@@ -0,0 +1,81 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package bootstrapprogress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+)
+
+func TestTracker_Observe(t *testing.T) {
+	t.Parallel()
+
+	type observation struct {
+		after           time.Duration
+		operationMode   scyllaclient.OperationalMode
+		progress        int64
+		expectedStalled bool
+	}
+
+	tt := []struct {
+		name         string
+		observations []observation
+	}{
+		{
+			name: "node in normal operation mode is never stalled",
+			observations: []observation{
+				{after: 0, operationMode: scyllaclient.OperationalModeNormal, progress: 0, expectedStalled: false},
+				{after: time.Hour, operationMode: scyllaclient.OperationalModeNormal, progress: 0, expectedStalled: false},
+			},
+		},
+		{
+			name: "long bootstrap making progress isn't stalled",
+			observations: []observation{
+				{after: 0, operationMode: scyllaclient.OperationalModeJoining, progress: 0, expectedStalled: false},
+				{after: 20 * time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: false},
+				{after: 20 * time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 200, expectedStalled: false},
+				{after: 20 * time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 300, expectedStalled: false},
+			},
+		},
+		{
+			name: "bootstrap without progress is stalled after the timeout",
+			observations: []observation{
+				{after: 0, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: false},
+				{after: 20 * time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: false},
+				{after: 20 * time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: true},
+				{after: time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 200, expectedStalled: false},
+			},
+		},
+		{
+			name: "finished bootstrap resets the tracking",
+			observations: []observation{
+				{after: 0, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: false},
+				{after: time.Hour, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: true},
+				{after: time.Minute, operationMode: scyllaclient.OperationalModeNormal, progress: 100, expectedStalled: false},
+				{after: time.Minute, operationMode: scyllaclient.OperationalModeJoining, progress: 100, expectedStalled: false},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			tracker := NewTracker(30*time.Minute, func() time.Time {
+				return now
+			})
+
+			for i, o := range tc.observations {
+				now = now.Add(o.after)
+
+				stalled := tracker.Observe(o.operationMode, o.progress)
+				if stalled != o.expectedStalled {
+					t.Errorf("observation %d: expected stalled %t, got %t", i, o.expectedStalled, stalled)
+				}
+			}
+		})
+	}
+}
@@ -41,6 +41,12 @@ func NewOperatorCommand(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd.AddCommand(sidecar.NewCmd(streams))
 	cmd.AddCommand(NewNodeSetupCmd(streams))
 	cmd.AddCommand(NewCleanupJobCmd(streams))
+	cmd.AddCommand(NewRebuildJobCmd(streams))
+	cmd.AddCommand(NewRepairJobCmd(streams))
+	cmd.AddCommand(NewReplicationJobCmd(streams))
+	cmd.AddCommand(NewRemoveNodesJobCmd(streams))
+	cmd.AddCommand(NewImportValidationJobCmd(streams))
+	cmd.AddCommand(NewConnectivityProbeCmd(streams))
 	cmd.AddCommand(NewMustGatherCmd(streams))
 	cmd.AddCommand(probeserver.NewServeProbesCmd(streams))
 	cmd.AddCommand(NewIgnitionCmd(streams))
@@ -6,4 +6,7 @@ import "time"
 
 var (
 	resyncPeriod = 12 * time.Hour
+
+	// gatewayAPIDiscoveryInterval is how often the operator checks for Gateway API resources that the cluster doesn't serve yet.
+	gatewayAPIDiscoveryInterval = time.Minute
 )
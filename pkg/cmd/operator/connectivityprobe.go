@@ -0,0 +1,369 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// maxReportSize is the size limit of a termination message the report is passed in.
+	maxReportSize = 4096
+)
+
+type connectivityProbeTarget struct {
+	datacenter string
+	address    string
+}
+
+type connectivityProbePort struct {
+	name string
+	port int32
+}
+
+type ConnectivityProbeOptions struct {
+	Targets                []string
+	Ports                  []string
+	ExternalSeeds          []string
+	ExternalSeedPort       int32
+	Timeout                time.Duration
+	TerminationMessagePath string
+
+	targets []connectivityProbeTarget
+	ports   []connectivityProbePort
+}
+
+func NewConnectivityProbeOptions(streams genericclioptions.IOStreams) *ConnectivityProbeOptions {
+	return &ConnectivityProbeOptions{
+		ExternalSeedPort:       7000,
+		Timeout:                5 * time.Second,
+		TerminationMessagePath: corev1.TerminationMessagePathDefault,
+	}
+}
+
+func NewConnectivityProbeCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewConnectivityProbeOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "connectivity-probe",
+		Short: "Probes network connectivity to ScyllaDB nodes of other datacenters and to external seeds.",
+		Long:  "Probes network connectivity to ScyllaDB nodes of other datacenters and to external seeds and reports the results as a termination message.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringArrayVarP(&o.Targets, "target", "", o.Targets, "Address of a node to probe in the <datacenter>=<address> format.")
+	cmd.Flags().StringArrayVarP(&o.Ports, "port", "", o.Ports, "Port to probe in the <name>=<port> format.")
+	cmd.Flags().StringArrayVarP(&o.ExternalSeeds, "external-seed", "", o.ExternalSeeds, "Address of an external seed to probe.")
+	cmd.Flags().Int32VarP(&o.ExternalSeedPort, "external-seed-port", "", o.ExternalSeedPort, "Port external seeds are probed at.")
+	cmd.Flags().DurationVarP(&o.Timeout, "timeout", "", o.Timeout, "Timeout of a single connection attempt.")
+	cmd.Flags().StringVarP(&o.TerminationMessagePath, "termination-message-path", "", o.TerminationMessagePath, "Path of a file the report is written to.")
+
+	return cmd
+}
+
+func (o *ConnectivityProbeOptions) Validate() error {
+	var errs []error
+
+	for _, target := range o.Targets {
+		dc, address, ok := strings.Cut(target, "=")
+		if !ok || len(dc) == 0 || len(address) == 0 {
+			errs = append(errs, fmt.Errorf("target %q must be in the <datacenter>=<address> format", target))
+		}
+	}
+
+	if len(o.Ports) == 0 {
+		errs = append(errs, fmt.Errorf("port cannot be empty"))
+	}
+
+	for _, port := range o.Ports {
+		name, number, ok := strings.Cut(port, "=")
+		if !ok || len(name) == 0 {
+			errs = append(errs, fmt.Errorf("port %q must be in the <name>=<port> format", port))
+			continue
+		}
+
+		_, err := strconv.ParseInt(number, 10, 32)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't parse port %q: %w", port, err))
+		}
+	}
+
+	for _, seed := range o.ExternalSeeds {
+		if len(seed) == 0 {
+			errs = append(errs, fmt.Errorf("external-seed cannot be empty"))
+		}
+	}
+
+	if o.ExternalSeedPort <= 0 {
+		errs = append(errs, fmt.Errorf("external-seed-port must be positive"))
+	}
+
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive"))
+	}
+
+	if len(o.TerminationMessagePath) == 0 {
+		errs = append(errs, fmt.Errorf("termination-message-path cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *ConnectivityProbeOptions) Complete() error {
+	for _, target := range o.Targets {
+		dc, address, _ := strings.Cut(target, "=")
+		o.targets = append(o.targets, connectivityProbeTarget{
+			datacenter: dc,
+			address:    address,
+		})
+	}
+
+	for _, port := range o.Ports {
+		name, number, _ := strings.Cut(port, "=")
+		n, err := strconv.ParseInt(number, 10, 32)
+		if err != nil {
+			return fmt.Errorf("can't parse port %q: %w", port, err)
+		}
+
+		o.ports = append(o.ports, connectivityProbePort{
+			name: name,
+			port: int32(n),
+		})
+	}
+
+	return nil
+}
+
+func (o *ConnectivityProbeOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	var externalSeedTargets []connectivityProbeTarget
+	for _, seed := range o.ExternalSeeds {
+		externalSeedTargets = append(externalSeedTargets, connectivityProbeTarget{
+			address: seed,
+		})
+	}
+	externalSeedPorts := []connectivityProbePort{
+		{
+			name: "inter-node",
+			port: o.ExternalSeedPort,
+		},
+	}
+
+	var reachable, externalSeedsReachable [][]bool
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		reachable = probeConnectivity(ctx, o.targets, o.ports, o.Timeout)
+	}()
+	go func() {
+		defer wg.Done()
+		externalSeedsReachable = probeConnectivity(ctx, externalSeedTargets, externalSeedPorts, o.Timeout)
+	}()
+	wg.Wait()
+
+	report, err := marshalConnectivityProbeReport(&scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus{
+		Targets:       makeConnectivityProbeReport(o.targets, o.ports, reachable),
+		ExternalSeeds: makeExternalSeedsConnectivityReport(externalSeedTargets, externalSeedsReachable),
+	}, maxReportSize)
+	if err != nil {
+		return fmt.Errorf("can't marshal connectivity probe report: %w", err)
+	}
+
+	err = os.WriteFile(o.TerminationMessagePath, report, 0644)
+	if err != nil {
+		return fmt.Errorf("can't write connectivity probe report to %q: %w", o.TerminationMessagePath, err)
+	}
+
+	return nil
+}
+
+// probeConnectivity tries to connect to every port of every target in parallel.
+// It returns a matrix of results indexed by target and port.
+func probeConnectivity(ctx context.Context, targets []connectivityProbeTarget, ports []connectivityProbePort, timeout time.Duration) [][]bool {
+	reachable := make([][]bool, len(targets))
+	for i := range reachable {
+		reachable[i] = make([]bool, len(ports))
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+	}
+
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		for j, port := range ports {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				address := net.JoinHostPort(target.address, strconv.Itoa(int(port.port)))
+				conn, err := dialer.DialContext(ctx, "tcp", address)
+				if err != nil {
+					klog.InfoS("Address is unreachable", "Datacenter", target.datacenter, "Address", address, "Error", err)
+					return
+				}
+				defer conn.Close()
+
+				klog.V(2).InfoS("Address is reachable", "Datacenter", target.datacenter, "Address", address)
+				reachable[i][j] = true
+			}()
+		}
+	}
+	wg.Wait()
+
+	return reachable
+}
+
+func makeConnectivityProbeReport(targets []connectivityProbeTarget, ports []connectivityProbePort, reachable [][]bool) []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus {
+	var report []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus
+
+	for i, target := range targets {
+		idx := slices.IndexFunc(report, func(ts scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus) bool {
+			return ts.Datacenter == target.datacenter
+		})
+		if idx < 0 {
+			ts := scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus{
+				Datacenter: target.datacenter,
+			}
+			for _, port := range ports {
+				ts.Ports = append(ts.Ports, scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus{
+					Name: port.name,
+					Port: port.port,
+				})
+			}
+
+			report = append(report, ts)
+			idx = len(report) - 1
+		}
+
+		for j := range ports {
+			ps := &report[idx].Ports[j]
+			ps.ProbedAddresses++
+
+			if reachable[i][j] {
+				ps.ReachableAddresses++
+				continue
+			}
+
+			ps.UnreachableAddresses = append(ps.UnreachableAddresses, target.address)
+		}
+	}
+
+	return report
+}
+
+func makeExternalSeedsConnectivityReport(externalSeedTargets []connectivityProbeTarget, reachable [][]bool) []scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus {
+	var report []scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus
+
+	for i, target := range externalSeedTargets {
+		report = append(report, scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus{
+			Address:   target.address,
+			Reachable: !slices.Contains(reachable[i], false),
+		})
+	}
+
+	return report
+}
+
+// marshalConnectivityProbeReport marshals the report, truncating it to fit into maxSize bytes.
+// Reachable external seeds are dropped first, so failures are kept for as long as possible.
+// Unreachable addresses are dropped next, starting with the longest lists. Counts of probed and reachable addresses
+// are kept, so the number of unreachable addresses is still apparent from the report.
+// Unreachable external seeds are dropped from the end as the last resort. Truncated reports are marked as such.
+func marshalConnectivityProbeReport(report *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus, maxSize int) ([]byte, error) {
+	for {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) <= maxSize {
+			return data, nil
+		}
+
+		if !report.Truncated {
+			report.Truncated = true
+			continue
+		}
+
+		reachableExternalSeedIdx := slices.IndexFunc(report.ExternalSeeds, func(s scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus) bool {
+			return s.Reachable
+		})
+		if reachableExternalSeedIdx >= 0 {
+			report.ExternalSeeds = slices.Delete(report.ExternalSeeds, reachableExternalSeedIdx, reachableExternalSeedIdx+1)
+			continue
+		}
+
+		var longest *scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus
+		for i := range report.Targets {
+			for j := range report.Targets[i].Ports {
+				ps := &report.Targets[i].Ports[j]
+				if len(ps.UnreachableAddresses) > 0 && (longest == nil || len(ps.UnreachableAddresses) > len(longest.UnreachableAddresses)) {
+					longest = ps
+				}
+			}
+		}
+
+		switch {
+		case longest != nil:
+			longest.UnreachableAddresses = longest.UnreachableAddresses[:len(longest.UnreachableAddresses)-1]
+
+		case len(report.ExternalSeeds) > 0:
+			report.ExternalSeeds = report.ExternalSeeds[:len(report.ExternalSeeds)-1]
+
+		default:
+			return nil, fmt.Errorf("report of %d bytes can't be truncated to %d bytes", len(data), maxSize)
+		}
+	}
+}
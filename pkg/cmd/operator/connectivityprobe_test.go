@@ -0,0 +1,300 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+)
+
+func TestMakeConnectivityProbeReport(t *testing.T) {
+	t.Parallel()
+
+	ports := []connectivityProbePort{
+		{name: "inter-node", port: 7000},
+		{name: "cql", port: 9042},
+	}
+
+	var manyTargets []connectivityProbeTarget
+	var manyReachable [][]bool
+	var manyUnreachableAddresses []string
+	for i := range 12 {
+		address := fmt.Sprintf("10.0.0.%d", i)
+		manyTargets = append(manyTargets, connectivityProbeTarget{datacenter: "dc2", address: address})
+		manyReachable = append(manyReachable, []bool{false, true})
+		manyUnreachableAddresses = append(manyUnreachableAddresses, address)
+	}
+
+	tt := []struct {
+		name           string
+		targets        []connectivityProbeTarget
+		reachable      [][]bool
+		expectedReport []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus
+	}{
+		{
+			name:           "no targets",
+			targets:        nil,
+			reachable:      nil,
+			expectedReport: nil,
+		},
+		{
+			name: "results are aggregated per datacenter and port",
+			targets: []connectivityProbeTarget{
+				{datacenter: "dc2", address: "10.0.2.1"},
+				{datacenter: "dc3", address: "10.0.3.1"},
+				{datacenter: "dc2", address: "10.0.2.2"},
+			},
+			reachable: [][]bool{
+				{true, true},
+				{true, false},
+				{false, true},
+			},
+			expectedReport: []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus{
+				{
+					Datacenter: "dc2",
+					Ports: []scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus{
+						{
+							Name:                 "inter-node",
+							Port:                 7000,
+							ProbedAddresses:      2,
+							ReachableAddresses:   1,
+							UnreachableAddresses: []string{"10.0.2.2"},
+						},
+						{
+							Name:               "cql",
+							Port:               9042,
+							ProbedAddresses:    2,
+							ReachableAddresses: 2,
+						},
+					},
+				},
+				{
+					Datacenter: "dc3",
+					Ports: []scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus{
+						{
+							Name:               "inter-node",
+							Port:               7000,
+							ProbedAddresses:    1,
+							ReachableAddresses: 1,
+						},
+						{
+							Name:                 "cql",
+							Port:                 9042,
+							ProbedAddresses:      1,
+							ReachableAddresses:   0,
+							UnreachableAddresses: []string{"10.0.3.1"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "all unreachable addresses are reported",
+			targets:   manyTargets,
+			reachable: manyReachable,
+			expectedReport: []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus{
+				{
+					Datacenter: "dc2",
+					Ports: []scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus{
+						{
+							Name:                 "inter-node",
+							Port:                 7000,
+							ProbedAddresses:      int32(len(manyTargets)),
+							ReachableAddresses:   0,
+							UnreachableAddresses: manyUnreachableAddresses,
+						},
+						{
+							Name:               "cql",
+							Port:               9042,
+							ProbedAddresses:    int32(len(manyTargets)),
+							ReachableAddresses: int32(len(manyTargets)),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeConnectivityProbeReport(tc.targets, ports, tc.reachable)
+			if !reflect.DeepEqual(got, tc.expectedReport) {
+				t.Errorf("expected and got reports differ:\n%s\n", cmp.Diff(tc.expectedReport, got))
+			}
+		})
+	}
+}
+
+func TestMakeExternalSeedsConnectivityReport(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		externalSeeds  []connectivityProbeTarget
+		reachable      [][]bool
+		expectedReport []scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus
+	}{
+		{
+			name:           "no external seeds",
+			externalSeeds:  nil,
+			reachable:      nil,
+			expectedReport: nil,
+		},
+		{
+			name: "reachability is reported per external seed",
+			externalSeeds: []connectivityProbeTarget{
+				{address: "10.0.0.1"},
+				{address: "seed.example.com"},
+			},
+			reachable: [][]bool{
+				{true},
+				{false},
+			},
+			expectedReport: []scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus{
+				{
+					Address:   "10.0.0.1",
+					Reachable: true,
+				},
+				{
+					Address:   "seed.example.com",
+					Reachable: false,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeExternalSeedsConnectivityReport(tc.externalSeeds, tc.reachable)
+			if !reflect.DeepEqual(got, tc.expectedReport) {
+				t.Errorf("expected and got reports differ:\n%s\n", cmp.Diff(tc.expectedReport, got))
+			}
+		})
+	}
+}
+
+func TestMarshalConnectivityProbeReport(t *testing.T) {
+	t.Parallel()
+
+	makeReport := func(interNodeUnreachableAddresses []string, cqlUnreachableAddresses []string, reachableExternalSeeds []string, unreachableExternalSeeds []string, truncated bool) *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus {
+		report := &scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus{
+			Targets: []scyllav1alpha1.ScyllaDBClusterConnectivityTargetStatus{
+				{
+					Datacenter: "dc2",
+					Ports: []scyllav1alpha1.ScyllaDBClusterConnectivityPortStatus{
+						{
+							Name:                 "inter-node",
+							Port:                 7000,
+							ProbedAddresses:      4,
+							ReachableAddresses:   0,
+							UnreachableAddresses: interNodeUnreachableAddresses,
+						},
+						{
+							Name:                 "cql",
+							Port:                 9042,
+							ProbedAddresses:      4,
+							ReachableAddresses:   3,
+							UnreachableAddresses: cqlUnreachableAddresses,
+						},
+					},
+				},
+			},
+		}
+
+		for _, seed := range reachableExternalSeeds {
+			report.ExternalSeeds = append(report.ExternalSeeds, scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus{
+				Address:   seed,
+				Reachable: true,
+			})
+		}
+
+		for _, seed := range unreachableExternalSeeds {
+			report.ExternalSeeds = append(report.ExternalSeeds, scyllav1alpha1.ScyllaDBClusterExternalSeedConnectivityStatus{
+				Address:   seed,
+				Reachable: false,
+			})
+		}
+
+		report.Truncated = truncated
+
+		return report
+	}
+
+	mustMarshal := func(report *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) []byte {
+		data, err := json.Marshal(report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	allAddresses := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}
+
+	tt := []struct {
+		name          string
+		report        *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus
+		maxSize       int
+		expectedData  []byte
+		expectedError error
+	}{
+		{
+			name:          "report within the limit is kept intact",
+			report:        makeReport(allAddresses, allAddresses[:1], []string{"seed-1"}, []string{"seed-2"}, false),
+			maxSize:       len(mustMarshal(makeReport(allAddresses, allAddresses[:1], []string{"seed-1"}, []string{"seed-2"}, false))),
+			expectedData:  mustMarshal(makeReport(allAddresses, allAddresses[:1], []string{"seed-1"}, []string{"seed-2"}, false)),
+			expectedError: nil,
+		},
+		{
+			name:          "reachable external seeds are dropped first",
+			report:        makeReport(allAddresses, allAddresses[:1], []string{"seed-1", "seed-2"}, []string{"seed-3"}, false),
+			maxSize:       len(mustMarshal(makeReport(allAddresses, allAddresses[:1], []string{"seed-2"}, []string{"seed-3"}, true))),
+			expectedData:  mustMarshal(makeReport(allAddresses, allAddresses[:1], []string{"seed-2"}, []string{"seed-3"}, true)),
+			expectedError: nil,
+		},
+		{
+			name:          "unreachable addresses are dropped from the longest lists when there are no reachable external seeds left",
+			report:        makeReport(allAddresses, allAddresses[:1], []string{"seed-1"}, []string{"seed-2"}, false),
+			maxSize:       len(mustMarshal(makeReport(allAddresses[:2], allAddresses[:1], nil, []string{"seed-2"}, true))),
+			expectedData:  mustMarshal(makeReport(allAddresses[:2], allAddresses[:1], nil, []string{"seed-2"}, true)),
+			expectedError: nil,
+		},
+		{
+			name:          "unreachable external seeds are dropped when there are no unreachable addresses left",
+			report:        makeReport(allAddresses, allAddresses[:1], []string{"seed-1"}, []string{"seed-2", "seed-3"}, false),
+			maxSize:       len(mustMarshal(makeReport(nil, nil, nil, []string{"seed-2"}, true))),
+			expectedData:  mustMarshal(makeReport(nil, nil, nil, []string{"seed-2"}, true)),
+			expectedError: nil,
+		},
+		{
+			name:          "report which can't be truncated enough fails",
+			report:        makeReport(nil, nil, nil, nil, false),
+			maxSize:       10,
+			expectedData:  nil,
+			expectedError: fmt.Errorf("report of %d bytes can't be truncated to %d bytes", len(mustMarshal(makeReport(nil, nil, nil, nil, true))), 10),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := marshalConnectivityProbeReport(tc.report, tc.maxSize)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected error %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expectedData) {
+				t.Errorf("expected and got data differ:\n%s\n", cmp.Diff(string(tc.expectedData), string(got)))
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+// importedClusterSnitch is the snitch class the nodes managed by the Operator run with.
+// Datacenters of other nodes are only known through gossip with it, so the imported cluster has to use it as well.
+const importedClusterSnitch = "GossipingPropertyFileSnitch"
+
+type ImportValidationJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddresses         []string
+	ClusterName           string
+	ExternalDatacenter    string
+	ExternalSeeds         []string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewImportValidationJobOptions(streams genericclioptions.IOStreams) *ImportValidationJobOptions {
+	return &ImportValidationJobOptions{}
+}
+
+func NewImportValidationJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewImportValidationJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "import-validation-job",
+		Short: "Validates that nodes joined an imported cluster correctly.",
+		Long:  "Validates that nodes joined the imported cluster under the expected cluster name and snitch, and that external seeds belong to its external datacenter. External seeds are looked up in the gossip state of the nodes, their API isn't queried.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringSliceVarP(&o.NodeAddresses, "node-address", "", o.NodeAddresses, "Addresses of nodes which joined the imported cluster.")
+	cmd.Flags().StringVarP(&o.ClusterName, "cluster-name", "", o.ClusterName, "Expected name of the imported cluster.")
+	cmd.Flags().StringVarP(&o.ExternalDatacenter, "external-datacenter", "", o.ExternalDatacenter, "Name of the datacenter of the imported cluster.")
+	cmd.Flags().StringSliceVarP(&o.ExternalSeeds, "external-seed", "", o.ExternalSeeds, "Addresses of external seeds of the imported cluster.")
+
+	return cmd
+}
+
+func (o *ImportValidationJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddresses) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.ClusterName) == 0 {
+		errs = append(errs, fmt.Errorf("cluster-name cannot be empty"))
+	}
+
+	if len(o.ExternalDatacenter) == 0 {
+		errs = append(errs, fmt.Errorf("external-datacenter cannot be empty"))
+	}
+
+	if len(o.ExternalSeeds) == 0 {
+		errs = append(errs, fmt.Errorf("external-seed cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *ImportValidationJobOptions) Complete() error {
+	var err error
+
+	o.scyllaClient, err = newScyllaClientFromManagerAuthConfig(o.ManagerAuthConfigPath, o.NodeAddresses)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ImportValidationJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Import validation completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	nodeClusterNames := make(map[string]string, len(o.NodeAddresses))
+	nodeSnitches := make(map[string]string, len(o.NodeAddresses))
+	for _, nodeAddress := range o.NodeAddresses {
+		clusterName, err := o.scyllaClient.GetClusterName(ctx, nodeAddress)
+		if err != nil {
+			return fmt.Errorf("can't get cluster name of node %q: %w", nodeAddress, err)
+		}
+
+		nodeClusterNames[nodeAddress] = clusterName
+
+		snitch, err := o.scyllaClient.GetSnitchName(ctx, nodeAddress)
+		if err != nil {
+			return fmt.Errorf("can't get snitch of node %q: %w", nodeAddress, err)
+		}
+
+		nodeSnitches[nodeAddress] = snitch
+	}
+
+	// Datacenters of external seeds are resolved through the gossip state of the nodes, which only knows their IP addresses.
+	// The lookups are served by the nodes, the API of the external seeds isn't queried.
+	seedDatacenters := map[string]string{}
+	for _, seed := range o.ExternalSeeds {
+		seedIPs, err := net.DefaultResolver.LookupHost(ctx, seed)
+		if err != nil {
+			return fmt.Errorf("can't resolve external seed %q: %w", seed, err)
+		}
+
+		for _, seedIP := range seedIPs {
+			dc, err := o.scyllaClient.GetSnitchDatacenter(ctx, seedIP)
+			if err != nil {
+				return fmt.Errorf("can't get datacenter of external seed %q: %w", seedIP, err)
+			}
+
+			seedDatacenters[seedIP] = dc
+		}
+	}
+
+	err := apimachineryutilerrors.NewAggregate(validateImportedCluster(o.ClusterName, nodeClusterNames, nodeSnitches, o.ExternalDatacenter, seedDatacenters))
+	if err != nil {
+		return fmt.Errorf("imported cluster isn't compatible: %w", err)
+	}
+
+	return nil
+}
+
+// validateImportedCluster checks cluster names and snitches reported by the nodes and datacenters of external seeds,
+// all keyed by the node or seed address.
+func validateImportedCluster(clusterName string, nodeClusterNames map[string]string, nodeSnitches map[string]string, externalDatacenter string, seedDatacenters map[string]string) []error {
+	var errs []error
+
+	for _, nodeAddress := range slices.Sorted(maps.Keys(nodeClusterNames)) {
+		if nodeClusterNames[nodeAddress] != clusterName {
+			errs = append(errs, fmt.Errorf("node %q is part of cluster %q instead of %q", nodeAddress, nodeClusterNames[nodeAddress], clusterName))
+		}
+	}
+
+	for _, nodeAddress := range slices.Sorted(maps.Keys(nodeSnitches)) {
+		// Snitch names are reported as fully qualified class names.
+		snitch := nodeSnitches[nodeAddress]
+		if snitch[strings.LastIndex(snitch, ".")+1:] != importedClusterSnitch {
+			errs = append(errs, fmt.Errorf("node %q uses snitch %q instead of %q", nodeAddress, snitch, importedClusterSnitch))
+		}
+	}
+
+	for _, seed := range slices.Sorted(maps.Keys(seedDatacenters)) {
+		if seedDatacenters[seed] != externalDatacenter {
+			errs = append(errs, fmt.Errorf("external seed %q belongs to datacenter %q instead of %q", seed, seedDatacenters[seed], externalDatacenter))
+		}
+	}
+
+	return errs
+}
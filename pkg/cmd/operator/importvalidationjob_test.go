@@ -0,0 +1,70 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateImportedCluster(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		nodeClusterNames map[string]string
+		nodeSnitches     map[string]string
+		seedDatacenters  map[string]string
+		expectedErrs     []error
+	}{
+		{
+			name: "compatible cluster",
+			nodeClusterNames: map[string]string{
+				"node-0": "cluster",
+				"node-1": "cluster",
+			},
+			nodeSnitches: map[string]string{
+				"node-0": "org.apache.cassandra.locator.GossipingPropertyFileSnitch",
+				"node-1": "GossipingPropertyFileSnitch",
+			},
+			seedDatacenters: map[string]string{
+				"10.0.0.1": "vm-dc",
+			},
+			expectedErrs: nil,
+		},
+		{
+			name: "mismatched cluster names, snitches and seed datacenters are reported",
+			nodeClusterNames: map[string]string{
+				"node-1": "other-cluster",
+				"node-0": "cluster",
+			},
+			nodeSnitches: map[string]string{
+				"node-1": "org.apache.cassandra.locator.GossipingPropertyFileSnitch",
+				"node-0": "org.apache.cassandra.locator.SimpleSnitch",
+			},
+			seedDatacenters: map[string]string{
+				"10.0.0.2": "UNKNOWN_DC",
+				"10.0.0.1": "vm-dc",
+			},
+			expectedErrs: []error{
+				errors.New(`node "node-1" is part of cluster "other-cluster" instead of "cluster"`),
+				errors.New(`node "node-0" uses snitch "org.apache.cassandra.locator.SimpleSnitch" instead of "GossipingPropertyFileSnitch"`),
+				errors.New(`external seed "10.0.0.2" belongs to datacenter "UNKNOWN_DC" instead of "vm-dc"`),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateImportedCluster("cluster", tc.nodeClusterNames, tc.nodeSnitches, "vm-dc", tc.seedDatacenters)
+			if !reflect.DeepEqual(errs, tc.expectedErrs) {
+				t.Errorf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErrs, errs))
+			}
+		})
+	}
+}
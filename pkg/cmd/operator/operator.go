@@ -30,6 +30,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmonitoring"
 	"github.com/scylladb/scylla-operator/pkg/controller/scyllaoperatorconfig"
 	"github.com/scylladb/scylla-operator/pkg/crypto"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
@@ -39,6 +40,7 @@ import (
 	remoteinformers "github.com/scylladb/scylla-operator/pkg/remoteclient/informers"
 	"github.com/scylladb/scylla-operator/pkg/signals"
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,6 +50,7 @@ import (
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -64,10 +67,12 @@ type OperatorOptions struct {
 	kubeClient                 kubernetes.Interface
 	scyllaClient               scyllaversionedclient.Interface
 	monitoringClient           monitoringversionedclient.Interface
+	dynamicClient              dynamic.Interface
 	dynamicClusterDomainGetter *clusterdomain.DynamicClusterDomain
 
 	clusterKubeClient   remoteclient.ClusterClient[kubernetes.Interface]
 	clusterScyllaClient remoteclient.ClusterClient[scyllaversionedclient.Interface]
+	clusterTokens       *remoteclient.ClusterTokens
 
 	ConcurrentSyncs  int
 	OperatorImage    string
@@ -189,13 +194,21 @@ func (o *OperatorOptions) Complete(cmd *cobra.Command) error {
 		return fmt.Errorf("can't build monitoring clientset: %w", err)
 	}
 
+	o.dynamicClient, err = dynamic.NewForConfig(o.RestConfig)
+	if err != nil {
+		return fmt.Errorf("can't build dynamic client: %w", err)
+	}
+
 	o.dynamicClusterDomainGetter = clusterdomain.NewDynamicClusterDomain(net.DefaultResolver)
 
-	o.clusterKubeClient = *remoteclient.NewClusterClient(func(config []byte) (kubernetes.Interface, error) {
+	o.clusterTokens = remoteclient.NewClusterTokens()
+
+	o.clusterKubeClient = *remoteclient.NewClusterClient(func(cluster string, config []byte) (kubernetes.Interface, error) {
 		restConfig, err := clientcmd.RESTConfigFromKubeConfig(config)
 		if err != nil {
 			return nil, fmt.Errorf("can't create REST config from kubeconfig: %w", err)
 		}
+		restConfig.Wrap(o.clusterTokens.WrapTransport(cluster))
 
 		client, err := kubernetes.NewForConfig(restConfig)
 		if err != nil {
@@ -205,11 +218,12 @@ func (o *OperatorOptions) Complete(cmd *cobra.Command) error {
 		return client, nil
 	})
 
-	o.clusterScyllaClient = *remoteclient.NewClusterClient(func(config []byte) (scyllaversionedclient.Interface, error) {
+	o.clusterScyllaClient = *remoteclient.NewClusterClient(func(cluster string, config []byte) (scyllaversionedclient.Interface, error) {
 		restConfig, err := clientcmd.RESTConfigFromKubeConfig(config)
 		if err != nil {
 			return nil, fmt.Errorf("can't create REST config from kubeconfig: %w", err)
 		}
+		restConfig.Wrap(o.clusterTokens.WrapTransport(cluster))
 
 		client, err := scyllaversionedclient.NewForConfig(restConfig)
 		if err != nil {
@@ -325,6 +339,9 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 
 	monitoringInformers := monitoringinformers.NewSharedInformerFactory(o.monitoringClient, resyncPeriod)
 
+	// Gateway API informers are started once the cluster serves their resources.
+	gatewayAPIInformers := gatewayapi.NewInformers(o.dynamicClient, resyncPeriod)
+
 	sdcc, err := scylladbdatacenter.NewController(
 		o.kubeClient,
 		o.scyllaClient.ScyllaV1alpha1(),
@@ -340,7 +357,10 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		kubeInformers.Batch().V1().Jobs(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenterNodesStatusReports(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBManagerTasks(),
 		scyllaOperatorConfigInformers.Scylla().V1alpha1().ScyllaOperatorConfigs(),
+		o.dynamicClient,
+		gatewayAPIInformers,
 		o.OperatorImage,
 		o.CQLSIngressPort,
 		keyGenerator,
@@ -464,6 +484,7 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		},
 		&o.clusterKubeClient,
 		&o.clusterScyllaClient,
+		o.clusterTokens,
 	)
 	if err != nil {
 		return fmt.Errorf("can't create RemoteKubernetesCluster controller: %w", err)
@@ -681,6 +702,47 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 				}
 			},
 		}),
+		remoteOperatorManagedResourcesOnlyInformer.ForResource(&batchv1.Job{}, remoteinformers.ClusterListWatch[kubernetes.Interface]{
+			ListFunc: func(client remoteclient.ClusterClientInterface[kubernetes.Interface], cluster, ns string) cache.ListFunc {
+				return func(options metav1.ListOptions) (runtime.Object, error) {
+					clusterClient, err := client.Cluster(cluster)
+					if err != nil {
+						return nil, err
+					}
+					return clusterClient.BatchV1().Jobs(ns).List(ctx, options)
+				}
+			},
+			WatchFunc: func(client remoteclient.ClusterClientInterface[kubernetes.Interface], cluster, ns string) cache.WatchFunc {
+				return func(options metav1.ListOptions) (watch.Interface, error) {
+					clusterClient, err := client.Cluster(cluster)
+					if err != nil {
+						return nil, err
+					}
+					return clusterClient.BatchV1().Jobs(ns).Watch(ctx, options)
+				}
+			},
+		}),
+		remoteOperatorManagedResourcesOnlyInformer.ForResource(&corev1.Pod{}, remoteinformers.ClusterListWatch[kubernetes.Interface]{
+			ListFunc: func(client remoteclient.ClusterClientInterface[kubernetes.Interface], cluster, ns string) cache.ListFunc {
+				return func(options metav1.ListOptions) (runtime.Object, error) {
+					clusterClient, err := client.Cluster(cluster)
+					if err != nil {
+						return nil, err
+					}
+					return clusterClient.CoreV1().Pods(ns).List(ctx, options)
+				}
+			},
+			WatchFunc: func(client remoteclient.ClusterClientInterface[kubernetes.Interface], cluster, ns string) cache.WatchFunc {
+				return func(options metav1.ListOptions) (watch.Interface, error) {
+					clusterClient, err := client.Cluster(cluster)
+					if err != nil {
+						return nil, err
+					}
+					return clusterClient.CoreV1().Pods(ns).Watch(ctx, options)
+				}
+			},
+		}),
+		o.OperatorImage,
 	)
 	if err != nil {
 		return fmt.Errorf("can't create ScyllaDBCluster controller: %w", err)
@@ -716,6 +778,7 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		o.scyllaClient.ScyllaV1alpha1(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBManagerTasks(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBManagerClusterRegistrations(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBClusters(),
 	)
 	if err != nil {
 		return fmt.Errorf("can't create ScyllaDBManagerTask controller: %w", err)
@@ -756,6 +819,12 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		}()
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		gatewayAPIInformers.Run(ctx, o.kubeClient.Discovery(), gatewayAPIDiscoveryInterval)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
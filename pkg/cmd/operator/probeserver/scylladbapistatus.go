@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/cmdutil"
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -15,9 +17,11 @@ import (
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	cliflag "k8s.io/component-base/cli/flag"
 )
@@ -29,6 +33,8 @@ type ScyllaDBAPIStatusOptions struct {
 	genericclioptions.InClusterReflection
 	ServiceName            string
 	ScyllaLocalhostAddress string
+	ReadinessMode          string
+	MinUpPeersPercent      int32
 
 	mux        *http.ServeMux
 	kubeClient kubernetes.Interface
@@ -40,6 +46,8 @@ func NewScyllaDBAPIStatusOptions(streams genericclioptions.IOStreams) *ScyllaDBA
 	return &ScyllaDBAPIStatusOptions{
 		ServeProbesOptions: *NewServeProbesOptions(streams, naming.ScyllaDBAPIStatusProbePort, mux),
 		ClientConfig:       genericclioptions.NewClientConfig("scylla-operator-scylladb-api-status-probe"),
+		ReadinessMode:      string(scyllav1alpha1.ScyllaDBReadinessModeNodeStatus),
+		MinUpPeersPercent:  50,
 		mux:                mux,
 	}
 }
@@ -51,6 +59,8 @@ func (o *ScyllaDBAPIStatusOptions) AddFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringVarP(&o.ServiceName, "service-name", "", o.ServiceName, "Name of the service corresponding to the managed node.")
 	cmd.Flags().StringVarP(&o.ScyllaLocalhostAddress, "scylla-localhost-address", "", "127.0.0.1", "Localhost address for connecting to ScyllaDB API (127.0.0.1 for IPv4 or ::1 for IPv6).")
+	cmd.Flags().StringVarP(&o.ReadinessMode, "readiness-mode", "", o.ReadinessMode, "Mode determining the readiness of the node. One of NodeStatus, CQLQuery or PeerQuorum.")
+	cmd.Flags().Int32VarP(&o.MinUpPeersPercent, "min-up-peers-percent", "", o.MinUpPeersPercent, "Minimum percentage of nodes in the local datacenter, including the node itself, that have to be UP, as observed by the node, for it to be ready. Only used in the PeerQuorum readiness mode.")
 }
 
 func NewScyllaDBAPIStatusCmd(streams genericclioptions.IOStreams) *cobra.Command {
@@ -109,6 +119,16 @@ func (o *ScyllaDBAPIStatusOptions) Validate(args []string) error {
 		errs = append(errs, fmt.Errorf("scylla-localhost-address must be either '127.0.0.1' (IPv4) or '::1' (IPv6), got %q", o.ScyllaLocalhostAddress))
 	}
 
+	switch scyllav1alpha1.ScyllaDBReadinessMode(o.ReadinessMode) {
+	case scyllav1alpha1.ScyllaDBReadinessModeNodeStatus, scyllav1alpha1.ScyllaDBReadinessModeCQLQuery, scyllav1alpha1.ScyllaDBReadinessModePeerQuorum:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported readiness-mode %q", o.ReadinessMode))
+	}
+
+	if o.MinUpPeersPercent < 0 || o.MinUpPeersPercent > 100 {
+		errs = append(errs, fmt.Errorf("min-up-peers-percent must be between 0 and 100, got %d", o.MinUpPeersPercent))
+	}
+
 	return apimachineryutilerrors.NewAggregate(errs)
 }
 
@@ -163,25 +183,66 @@ func (o *ScyllaDBAPIStatusOptions) Execute(ctx context.Context, originalStreams
 		),
 	)
 	singleServiceInformer := singleServiceKubeInformers.Core().V1().Services()
+	informersSynced := []cache.InformerSynced{
+		singleServiceInformer.Informer().HasSynced,
+	}
+
+	// Peer quorum needs to know which nodes have their Pods scheduled.
+	var memberServiceLister corev1listers.ServiceLister
+	var podLister corev1listers.PodLister
+	if scyllav1alpha1.ScyllaDBReadinessMode(o.ReadinessMode) == scyllav1alpha1.ScyllaDBReadinessModePeerQuorum {
+		scyllaKubeInformers := informers.NewSharedInformerFactoryWithOptions(
+			o.kubeClient,
+			12*time.Hour,
+			informers.WithNamespace(o.Namespace),
+			informers.WithTweakListOptions(
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labels.SelectorFromSet(naming.ScyllaLabels()).String()
+				},
+			),
+		)
+		memberServiceInformer := scyllaKubeInformers.Core().V1().Services()
+		podInformer := scyllaKubeInformers.Core().V1().Pods()
+		memberServiceLister = memberServiceInformer.Lister()
+		podLister = podInformer.Lister()
+		informersSynced = append(informersSynced, memberServiceInformer.Informer().HasSynced, podInformer.Informer().HasSynced)
+
+		scyllaKubeInformers.Start(ctx.Done())
+		defer scyllaKubeInformers.Shutdown()
+	}
 
 	prober := scylladbapistatus.NewProber(
 		o.Namespace,
 		o.ServiceName,
 		o.ScyllaLocalhostAddress,
+		scyllav1alpha1.ScyllaDBReadinessMode(o.ReadinessMode),
+		o.MinUpPeersPercent,
 		singleServiceInformer.Lister(),
+		memberServiceLister,
+		podLister,
 	)
 
 	o.mux.HandleFunc(naming.LivenessProbePath, prober.Healthz)
 	o.mux.HandleFunc(naming.ReadinessProbePath, prober.Readyz)
+	o.mux.HandleFunc(naming.StartupProbePath, prober.Startupz)
 
 	// Start informers.
 	singleServiceKubeInformers.Start(ctx.Done())
 	defer singleServiceKubeInformers.Shutdown()
 
-	ok := cache.WaitForNamedCacheSync("Prober", ctx.Done(), singleServiceInformer.Informer().HasSynced)
+	ok := cache.WaitForNamedCacheSync("Prober", ctx.Done(), informersSynced...)
 	if !ok {
-		return fmt.Errorf("error waiting for service informer cache to sync")
+		return fmt.Errorf("error waiting for informer caches to sync")
 	}
 
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		prober.TrackBootstrapProgress(ctx)
+	}()
+
 	return o.ServeProbesOptions.Execute(ctx, originalStreams, cmd)
 }
@@ -0,0 +1,156 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+type RebuildJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddresses         []string
+	SourceDatacenter      string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewRebuildJobOptions(streams genericclioptions.IOStreams) *RebuildJobOptions {
+	return &RebuildJobOptions{}
+}
+
+func NewRebuildJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewRebuildJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "rebuild-job",
+		Short: "Rebuilds nodes from a source datacenter.",
+		Long:  "Rebuilds nodes one by one, streaming their data from nodes in the source datacenter.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringSliceVarP(&o.NodeAddresses, "node-address", "", o.NodeAddresses, "Addresses of nodes which will be rebuilt.")
+	cmd.Flags().StringVarP(&o.SourceDatacenter, "source-datacenter", "", o.SourceDatacenter, "Name of the datacenter to stream data from.")
+
+	return cmd
+}
+
+func (o *RebuildJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddresses) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.SourceDatacenter) == 0 {
+		errs = append(errs, fmt.Errorf("source-datacenter cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *RebuildJobOptions) Complete() error {
+	var err error
+
+	o.scyllaClient, err = newScyllaClientFromManagerAuthConfig(o.ManagerAuthConfigPath, o.NodeAddresses)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *RebuildJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Rebuild completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	// Nodes are rebuilt one at a time to limit the streaming load on the source datacenter.
+	for _, nodeAddress := range o.NodeAddresses {
+		klog.InfoS("Starting a node rebuild", "node", nodeAddress, "sourceDatacenter", o.SourceDatacenter)
+		startTime := time.Now()
+
+		err := o.scyllaClient.Rebuild(ctx, nodeAddress, o.SourceDatacenter)
+		if err != nil {
+			return fmt.Errorf("can't rebuild node %q from datacenter %q: %w", nodeAddress, o.SourceDatacenter, err)
+		}
+
+		klog.InfoS("Finished node rebuild", "node", nodeAddress, "duration", time.Since(startTime))
+	}
+
+	return nil
+}
+
+func newScyllaClientFromManagerAuthConfig(managerAuthConfigPath string, hosts []string) (*scyllaclient.Client, error) {
+	buf, err := os.ReadFile(managerAuthConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read auth token file at %q: %w", managerAuthConfigPath, err)
+	}
+
+	authToken, err := helpers.ParseTokenFromConfig(buf)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse auth token file at %q: %w", managerAuthConfigPath, err)
+	}
+
+	if len(authToken) == 0 {
+		return nil, fmt.Errorf("manager agent auth token cannot be empty")
+	}
+
+	scyllaClient, err := controllerhelpers.NewScyllaClientFromToken(hosts, authToken)
+	if err != nil {
+		return nil, fmt.Errorf("can't create scylla client: %w", err)
+	}
+
+	return scyllaClient, nil
+}
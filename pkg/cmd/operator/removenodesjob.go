@@ -0,0 +1,160 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+type RemoveNodesJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddresses         []string
+	HostIDs               []string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewRemoveNodesJobOptions(streams genericclioptions.IOStreams) *RemoveNodesJobOptions {
+	return &RemoveNodesJobOptions{}
+}
+
+func NewRemoveNodesJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewRemoveNodesJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "remove-nodes-job",
+		Short: "Removes dead nodes from the cluster.",
+		Long:  "Removes dead nodes identified by their host IDs from the cluster, one by one, using live nodes as coordinators.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringSliceVarP(&o.NodeAddresses, "node-address", "", o.NodeAddresses, "Addresses of live nodes coordinating the removal.")
+	cmd.Flags().StringSliceVarP(&o.HostIDs, "host-id", "", o.HostIDs, "Host IDs of dead nodes which will be removed.")
+
+	return cmd
+}
+
+func (o *RemoveNodesJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddresses) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.HostIDs) == 0 {
+		errs = append(errs, fmt.Errorf("host-id cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *RemoveNodesJobOptions) Complete() error {
+	var err error
+
+	o.scyllaClient, err = newScyllaClientFromManagerAuthConfig(o.ManagerAuthConfigPath, o.NodeAddresses)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *RemoveNodesJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Removing nodes completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	for _, hostID := range o.HostIDs {
+		// Coordinators are picked for every node, so the removal can continue when a coordinator becomes unreachable.
+		coordinator, ipToHostID, err := o.getCoordinator(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The job may be restarted after some nodes were already removed, skip the ones no longer known to the cluster.
+		if !slices.Contains(slices.Collect(maps.Values(ipToHostID)), hostID) {
+			klog.InfoS("Node is no longer part of the cluster, skipping", "hostID", hostID)
+			continue
+		}
+
+		klog.InfoS("Removing a node", "hostID", hostID, "coordinator", coordinator)
+		startTime := time.Now()
+
+		err = o.scyllaClient.RemoveNode(ctx, coordinator, hostID)
+		if err != nil {
+			return fmt.Errorf("can't remove node with host ID %q: %w", hostID, err)
+		}
+
+		klog.InfoS("Finished removing a node", "hostID", hostID, "duration", time.Since(startTime))
+	}
+
+	return nil
+}
+
+// getCoordinator returns the first reachable node address together with the host IDs known to it.
+func (o *RemoveNodesJobOptions) getCoordinator(ctx context.Context) (string, map[string]string, error) {
+	var errs []error
+	for _, nodeAddress := range o.NodeAddresses {
+		ipToHostID, err := o.scyllaClient.GetIPToHostIDMap(ctx, nodeAddress)
+		if err != nil {
+			klog.InfoS("Node can't coordinate the removal, trying the next one", "node", nodeAddress, "error", err)
+			errs = append(errs, fmt.Errorf("can't get host IDs known to node %q: %w", nodeAddress, err))
+			continue
+		}
+
+		return nodeAddress, ipToHostID, nil
+	}
+
+	return "", nil, fmt.Errorf("no node can coordinate the removal: %w", apimachineryutilerrors.NewAggregate(errs))
+}
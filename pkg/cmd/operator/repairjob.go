@@ -0,0 +1,163 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+const (
+	repairStatusPollInterval = 10 * time.Second
+)
+
+type RepairJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddresses         []string
+	Keyspaces             []string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewRepairJobOptions(streams genericclioptions.IOStreams) *RepairJobOptions {
+	return &RepairJobOptions{}
+}
+
+func NewRepairJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewRepairJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "repair-job",
+		Short: "Repairs keyspaces on nodes.",
+		Long:  "Repairs keyspaces on nodes one by one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringSliceVarP(&o.NodeAddresses, "node-address", "", o.NodeAddresses, "Addresses of nodes where repair will be performed.")
+	cmd.Flags().StringSliceVarP(&o.Keyspaces, "keyspace", "", o.Keyspaces, "Keyspaces to repair.")
+
+	return cmd
+}
+
+func (o *RepairJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddresses) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.Keyspaces) == 0 {
+		errs = append(errs, fmt.Errorf("keyspace cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *RepairJobOptions) Complete() error {
+	var err error
+
+	o.scyllaClient, err = newScyllaClientFromManagerAuthConfig(o.ManagerAuthConfigPath, o.NodeAddresses)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *RepairJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Repair completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	for _, nodeAddress := range o.NodeAddresses {
+		for _, keyspace := range o.Keyspaces {
+			err := o.repairKeyspace(ctx, nodeAddress, keyspace)
+			if err != nil {
+				return fmt.Errorf("can't repair keyspace %q on node %q: %w", keyspace, nodeAddress, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *RepairJobOptions) repairKeyspace(ctx context.Context, nodeAddress string, keyspace string) error {
+	klog.InfoS("Starting a keyspace repair", "node", nodeAddress, "keyspace", keyspace)
+	startTime := time.Now()
+
+	id, err := o.scyllaClient.Repair(ctx, nodeAddress, keyspace)
+	if err != nil {
+		return fmt.Errorf("can't start repair: %w", err)
+	}
+
+	var status scyllaclient.RepairStatus
+	err = apimachineryutilwait.PollUntilContextCancel(ctx, repairStatusPollInterval, true, func(ctx context.Context) (bool, error) {
+		status, err = o.scyllaClient.RepairStatus(ctx, nodeAddress, keyspace, id)
+		if err != nil {
+			klog.Warningf("Can't get status of repair %d of keyspace %q on node %q: %v", id, keyspace, nodeAddress, err)
+			return false, nil
+		}
+
+		return status != scyllaclient.RepairStatusRunning, nil
+	})
+	if err != nil {
+		return fmt.Errorf("can't wait for repair %d to finish: %w", id, err)
+	}
+
+	if status != scyllaclient.RepairStatusSuccessful {
+		return fmt.Errorf("repair %d finished with status %q", id, status)
+	}
+
+	klog.InfoS("Finished keyspace repair", "node", nodeAddress, "keyspace", keyspace, "duration", time.Since(startTime))
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+type ReplicationJobOptions struct {
+	NodeAddresses              []string
+	KeyspaceReplicationFactors map[string]int64
+	Datacenters                []string
+	RemovedDatacenters         []string
+	CQLCredentialsPath         string
+
+	clusterConfig *gocql.ClusterConfig
+}
+
+func NewReplicationJobOptions(streams genericclioptions.IOStreams) *ReplicationJobOptions {
+	return &ReplicationJobOptions{
+		KeyspaceReplicationFactors: map[string]int64{},
+	}
+}
+
+func NewReplicationJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewReplicationJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "replication-job",
+		Short: "Alters replication of keyspaces.",
+		Long:  "Alters replication of keyspaces to NetworkTopologyStrategy spanning the provided datacenters.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringSliceVarP(&o.NodeAddresses, "node-address", "", o.NodeAddresses, "Addresses of nodes used to connect to the cluster.")
+	cmd.Flags().StringToInt64VarP(&o.KeyspaceReplicationFactors, "keyspace-replication-factor", "", o.KeyspaceReplicationFactors, "Replication factor of a keyspace in every datacenter, in the form of keyspace=replicationFactor.")
+	cmd.Flags().StringSliceVarP(&o.Datacenters, "datacenter", "", o.Datacenters, "Datacenters which should replicate the keyspaces.")
+	cmd.Flags().StringSliceVarP(&o.RemovedDatacenters, "removed-datacenter", "", o.RemovedDatacenters, "Datacenters which should no longer replicate the keyspaces.")
+	cmd.Flags().StringVarP(&o.CQLCredentialsPath, "cql-credentials-path", "", o.CQLCredentialsPath, "Path to a directory containing username and password files used to authenticate CQL connections.")
+
+	return cmd
+}
+
+func (o *ReplicationJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.NodeAddresses) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.KeyspaceReplicationFactors) == 0 {
+		errs = append(errs, fmt.Errorf("keyspace-replication-factor cannot be empty"))
+	}
+
+	for keyspace, rf := range o.KeyspaceReplicationFactors {
+		if rf < 1 {
+			errs = append(errs, fmt.Errorf("replication factor of keyspace %q must be greater than 0", keyspace))
+		}
+	}
+
+	if len(o.Datacenters) == 0 {
+		errs = append(errs, fmt.Errorf("datacenter cannot be empty"))
+	}
+
+	for _, dc := range o.RemovedDatacenters {
+		if slices.Contains(o.Datacenters, dc) {
+			errs = append(errs, fmt.Errorf("datacenter %q can't be both replicating and removed", dc))
+		}
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *ReplicationJobOptions) Complete() error {
+	o.clusterConfig = gocql.NewCluster(o.NodeAddresses...)
+
+	if len(o.CQLCredentialsPath) != 0 {
+		username, err := os.ReadFile(filepath.Join(o.CQLCredentialsPath, corev1.BasicAuthUsernameKey))
+		if err != nil {
+			return fmt.Errorf("can't read CQL username: %w", err)
+		}
+
+		password, err := os.ReadFile(filepath.Join(o.CQLCredentialsPath, corev1.BasicAuthPasswordKey))
+		if err != nil {
+			return fmt.Errorf("can't read CQL password: %w", err)
+		}
+
+		o.clusterConfig.Authenticator = gocql.PasswordAuthenticator{
+			Username: strings.TrimSpace(string(username)),
+			Password: strings.TrimSpace(string(password)),
+		}
+	}
+
+	return nil
+}
+
+func (o *ReplicationJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Replication change completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	session, err := o.clusterConfig.CreateSession()
+	if err != nil {
+		return fmt.Errorf("can't create CQL session: %w", err)
+	}
+	defer session.Close()
+
+	for _, keyspace := range slices.Sorted(maps.Keys(o.KeyspaceReplicationFactors)) {
+		replication := make(map[string]int64, len(o.Datacenters)+len(o.RemovedDatacenters))
+		for _, dc := range o.Datacenters {
+			replication[dc] = o.KeyspaceReplicationFactors[keyspace]
+		}
+		for _, dc := range o.RemovedDatacenters {
+			replication[dc] = 0
+		}
+
+		stmt := makeAlterKeyspaceReplicationStatement(keyspace, replication)
+		klog.InfoS("Altering keyspace replication", "keyspace", keyspace, "statement", stmt)
+
+		err = session.Query(stmt).WithContext(ctx).Exec()
+		if err != nil {
+			return fmt.Errorf("can't alter replication of keyspace %q: %w", keyspace, err)
+		}
+	}
+
+	return nil
+}
+
+func makeAlterKeyspaceReplicationStatement(keyspace string, replication map[string]int64) string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf(`ALTER KEYSPACE "%s" WITH replication = {'class': 'NetworkTopologyStrategy'`, strings.ReplaceAll(keyspace, `"`, `""`)))
+
+	for _, dc := range slices.Sorted(maps.Keys(replication)) {
+		sb.WriteString(fmt.Sprintf(", '%s': %d", strings.ReplaceAll(dc, `'`, `''`), replication[dc]))
+	}
+	sb.WriteString("}")
+
+	return sb.String()
+}
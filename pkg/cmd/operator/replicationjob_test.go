@@ -0,0 +1,48 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package operator
+
+import (
+	"testing"
+)
+
+func TestMakeAlterKeyspaceReplicationStatement(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		keyspace     string
+		replication  map[string]int64
+		expectedStmt string
+	}{
+		{
+			name:     "datacenters are sorted",
+			keyspace: "my_keyspace",
+			replication: map[string]int64{
+				"dc2": 3,
+				"dc1": 3,
+				"dc3": 0,
+			},
+			expectedStmt: `ALTER KEYSPACE "my_keyspace" WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': 3, 'dc2': 3, 'dc3': 0}`,
+		},
+		{
+			name:     "identifiers are escaped",
+			keyspace: `my"keyspace`,
+			replication: map[string]int64{
+				"dc'1": 1,
+			},
+			expectedStmt: `ALTER KEYSPACE "my""keyspace" WITH replication = {'class': 'NetworkTopologyStrategy', 'dc''1': 1}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeAlterKeyspaceReplicationStatement(tc.keyspace, tc.replication)
+			if got != tc.expectedStmt {
+				t.Errorf("expected statement %q, got %q", tc.expectedStmt, got)
+			}
+		})
+	}
+}
@@ -42,11 +42,15 @@ type Options struct {
 	NodesBroadcastAddressTypeString   string
 	ClientsBroadcastAddressTypeString string
 	IPFamilyString                    string
+	NodesBroadcastIPFamilyString      string
+	ClientsBroadcastIPFamilyString    string
 
 	nodesBroadcastAddressType   scyllav1alpha1.BroadcastAddressType
 	scyllaLocalhostAddress      string
 	clientsBroadcastAddressType scyllav1alpha1.BroadcastAddressType
 	ipFamily                    corev1.IPFamily
+	nodesBroadcastIPFamily      corev1.IPFamily
+	clientsBroadcastIPFamily    corev1.IPFamily
 
 	kubeClient kubernetes.Interface
 }
@@ -106,6 +110,8 @@ func NewCmd(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd.Flags().StringVarP(&o.NodesBroadcastAddressTypeString, "nodes-broadcast-address-type", "", o.NodesBroadcastAddressTypeString, "Address type that is broadcasted for communication with other nodes.")
 	cmd.Flags().StringVarP(&o.ClientsBroadcastAddressTypeString, "clients-broadcast-address-type", "", o.ClientsBroadcastAddressTypeString, "Address type that is broadcasted for communication with clients.")
 	cmd.Flags().StringVarP(&o.IPFamilyString, "ip-family", "", string(corev1.IPv4Protocol), "IP family to use for ScyllaDB (IPv4 or IPv6).")
+	cmd.Flags().StringVarP(&o.NodesBroadcastIPFamilyString, "nodes-broadcast-ip-family", "", o.NodesBroadcastIPFamilyString, "IP family of the address that is broadcasted for communication with other nodes (IPv4 or IPv6). Defaults to ip-family.")
+	cmd.Flags().StringVarP(&o.ClientsBroadcastIPFamilyString, "clients-broadcast-ip-family", "", o.ClientsBroadcastIPFamilyString, "IP family of the address that is broadcasted for communication with clients (IPv4 or IPv6). Defaults to ip-family.")
 
 	return cmd
 }
@@ -140,6 +146,14 @@ func (o *Options) Validate() error {
 		errs = append(errs, fmt.Errorf("ip-family must be either %q or %q, got %q", corev1.IPv4Protocol, corev1.IPv6Protocol, o.IPFamilyString))
 	}
 
+	if len(o.NodesBroadcastIPFamilyString) != 0 && o.NodesBroadcastIPFamilyString != string(corev1.IPv4Protocol) && o.NodesBroadcastIPFamilyString != string(corev1.IPv6Protocol) {
+		errs = append(errs, fmt.Errorf("nodes-broadcast-ip-family must be either %q or %q, got %q", corev1.IPv4Protocol, corev1.IPv6Protocol, o.NodesBroadcastIPFamilyString))
+	}
+
+	if len(o.ClientsBroadcastIPFamilyString) != 0 && o.ClientsBroadcastIPFamilyString != string(corev1.IPv4Protocol) && o.ClientsBroadcastIPFamilyString != string(corev1.IPv6Protocol) {
+		errs = append(errs, fmt.Errorf("clients-broadcast-ip-family must be either %q or %q, got %q", corev1.IPv4Protocol, corev1.IPv6Protocol, o.ClientsBroadcastIPFamilyString))
+	}
+
 	return apimachineryutilerrors.NewAggregate(errs)
 }
 
@@ -168,6 +182,16 @@ func (o *Options) Complete() error {
 	o.nodesBroadcastAddressType = scyllav1alpha1.BroadcastAddressType(o.NodesBroadcastAddressTypeString)
 	o.ipFamily = corev1.IPFamily(o.IPFamilyString)
 
+	o.nodesBroadcastIPFamily = o.ipFamily
+	if len(o.NodesBroadcastIPFamilyString) != 0 {
+		o.nodesBroadcastIPFamily = corev1.IPFamily(o.NodesBroadcastIPFamilyString)
+	}
+
+	o.clientsBroadcastIPFamily = o.ipFamily
+	if len(o.ClientsBroadcastIPFamilyString) != 0 {
+		o.clientsBroadcastIPFamily = corev1.IPFamily(o.ClientsBroadcastIPFamilyString)
+	}
+
 	// Automatically set scyllaLocalhostAddress based on IP family
 	switch o.ipFamily {
 	case corev1.IPv4Protocol:
@@ -211,12 +235,15 @@ func (o *Options) Run(streams genericclioptions.IOStreams, cmd *cobra.Command, a
 
 	singleServiceInformer := identityKubeInformers.Core().V1().Services()
 
+	scyllaYAMLReloader := config.NewScyllaYAMLReloader()
+
 	sc, err := sidecarcontroller.NewController(
 		o.Namespace,
 		o.ServiceName,
 		o.scyllaLocalhostAddress,
 		o.kubeClient,
 		singleServiceInformer,
+		scyllaYAMLReloader,
 	)
 	if err != nil {
 		return fmt.Errorf("can't create sidecar controller: %w", err)
@@ -253,14 +280,14 @@ func (o *Options) Run(streams genericclioptions.IOStreams, cmd *cobra.Command, a
 		return fmt.Errorf("can't get pod %q: %w", o.ServiceName, err)
 	}
 
-	member, err := identity.NewMember(service, pod, o.nodesBroadcastAddressType, o.clientsBroadcastAddressType, o.ipFamily, args)
+	member, err := identity.NewMember(service, pod, o.nodesBroadcastAddressType, o.clientsBroadcastAddressType, o.nodesBroadcastIPFamily, o.clientsBroadcastIPFamily, args)
 	if err != nil {
 		return fmt.Errorf("can't create new member from objects: %w", err)
 	}
 
 	klog.V(2).InfoS("Starting scylla")
 
-	cfg := config.NewScyllaConfig(member, o.kubeClient, o.CPUCount, o.ExternalSeeds)
+	cfg := config.NewScyllaConfig(member, o.kubeClient, o.CPUCount, o.ExternalSeeds, scyllaYAMLReloader)
 	scyllaCmd, err := cfg.Setup(ctx)
 	if err != nil {
 		return fmt.Errorf("can't set up scylla: %w", err)
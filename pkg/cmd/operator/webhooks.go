@@ -81,6 +81,12 @@ var (
 			GetWarningsOnCreateFunc: validation.GetWarningsOnScyllaDBManagerTaskCreate,
 			GetWarningsOnUpdateFunc: validation.GetWarningsOnScyllaDBManagerTaskUpdate,
 		},
+		scyllav1alpha1.GroupVersion.WithResource("remotekubernetesclusters"): &GenericValidator[*scyllav1alpha1.RemoteKubernetesCluster]{
+			ValidateCreateFunc:      validation.ValidateRemoteKubernetesCluster,
+			ValidateUpdateFunc:      validation.ValidateRemoteKubernetesClusterUpdate,
+			GetWarningsOnCreateFunc: validation.GetWarningsOnRemoteKubernetesClusterCreate,
+			GetWarningsOnUpdateFunc: validation.GetWarningsOnRemoteKubernetesClusterUpdate,
+		},
 		scyllav1alpha1.GroupVersion.WithResource("scylladbmonitorings"): &GenericValidator[*scyllav1alpha1.ScyllaDBMonitoring]{
 			ValidateCreateFunc:      validation.ValidateScyllaDBMonitoring,
 			ValidateUpdateFunc:      validation.ValidateScyllaDBMonitoringUpdate,
@@ -106,6 +106,11 @@ func NodeConfigClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{"nodes"},
 				Verbs:     []string{"get"},
 			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes/status"},
+				Verbs:     []string{"patch"},
+			},
 			{
 				APIGroups: []string{"apps"},
 				Resources: []string{"daemonsets"},
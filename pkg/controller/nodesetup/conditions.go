@@ -15,6 +15,9 @@ const (
 	loopDeviceControllerNodeSetupProgressingConditionFormat = "LoopDeviceControllerNodeSetup%sProgressing"
 	loopDeviceControllerNodeSetupDegradedConditionFormat    = "LoopDeviceControllerNodeSetup%sDegraded"
 
+	diskHealthControllerNodeSetupProgressingConditionFormat = "DiskHealthControllerNodeSetup%sProgressing"
+	diskHealthControllerNodeSetupDegradedConditionFormat    = "DiskHealthControllerNodeSetup%sDegraded"
+
 	//TODO(rzetelskik): remove deprecated conditions in >=1.16
 	deprecatedRaidControllerNodeSetupProgressingConditionFormat = "RaidControllerNode%sProgressing"
 	deprecatedRaidControllerNodeSetupDegradedConditionFormat    = "RaidControllerNode%sDegraded"
@@ -34,6 +34,10 @@ import (
 
 const (
 	ControllerName = "NodeSetupController"
+
+	// diskHealthCheckInterval is the interval in which the health of local disks is rechecked.
+	// Disk failures are not reflected in any watched object, so we have to resync periodically.
+	diskHealthCheckInterval = time.Minute
 )
 
 var (
@@ -64,6 +68,10 @@ type Controller struct {
 	systemdUnitManager *systemd.UnitManager
 	sysfsPath          string
 	devtmpfsPath       string
+
+	// ioErrorCountBaselines holds I/O error counts of block devices keyed by their names,
+	// against which new I/O errors are counted.
+	ioErrorCountBaselines map[string]uint64
 }
 
 func NewController(
@@ -114,6 +122,8 @@ func NewController(
 		systemdUnitManager: systemd.NewUnitManager("scylla-operator-node-setup"),
 		sysfsPath:          "/sys",
 		devtmpfsPath:       "/dev",
+
+		ioErrorCountBaselines: map[string]uint64{},
 	}
 
 	ncc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.NodeConfig](
@@ -230,5 +240,13 @@ func (nsc *Controller) Run(ctx context.Context) {
 		apimachineryutilwait.UntilWithContext(ctx, nsc.runWorker, time.Second)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		apimachineryutilwait.UntilWithContext(ctx, func(ctx context.Context) {
+			nsc.queue.Add(nsc.nodeConfigName)
+		}, diskHealthCheckInterval)
+	}()
+
 	<-ctx.Done()
 }
@@ -98,6 +98,19 @@ func (nsc *Controller) sync(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("can't sync mounts: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&statusConditions,
+		fmt.Sprintf(diskHealthControllerNodeSetupProgressingConditionFormat, nsc.nodeName),
+		fmt.Sprintf(diskHealthControllerNodeSetupDegradedConditionFormat, nsc.nodeName),
+		nc.Generation,
+		func() ([]metav1.Condition, error) {
+			return nsc.syncDiskHealth(ctx, nc)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync disk health: %w", err))
+	}
+
 	// Aggregate node conditions.
 	var aggregationErrs []error
 	nodeSetupAvailableConditionType := fmt.Sprintf(internalapi.NodeSetupAvailableConditionFormat, nsc.nodeName)
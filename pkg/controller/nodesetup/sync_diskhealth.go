@@ -0,0 +1,160 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package nodesetup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/disks"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ioErrorCountThreshold is the number of new I/O errors of a block device after which the device is considered failed.
+	// A few I/O errors can be caused by transient issues and are retried by the kernel, so they alone don't indicate a failure.
+	ioErrorCountThreshold = 10
+)
+
+// getIOErrorProblems reports devices with at least ioErrorCountThreshold I/O errors on top of their baseline.
+// The baseline is the count first observed for the device, so that errors accumulated before, e.g. before the node setup
+// has been restarted, are not counted. When the count drops, e.g. because the device has been reset, the baseline is reset as well.
+func getIOErrorProblems(baselines map[string]uint64, ioErrorCounts map[string]uint64) []string {
+	var problems []string
+
+	deviceNames := make([]string, 0, len(ioErrorCounts))
+	for deviceName := range ioErrorCounts {
+		deviceNames = append(deviceNames, deviceName)
+	}
+	slices.Sort(deviceNames)
+
+	for _, deviceName := range deviceNames {
+		count := ioErrorCounts[deviceName]
+
+		baseline, ok := baselines[deviceName]
+		if !ok || count < baseline {
+			baselines[deviceName] = count
+			continue
+		}
+
+		newErrorCount := count - baseline
+		if newErrorCount >= ioErrorCountThreshold {
+			problems = append(problems, fmt.Sprintf("device %q reported %d new I/O error(s)", deviceName, newErrorCount))
+		}
+	}
+
+	return problems
+}
+
+// calculateLocalDiskFailureNodeCondition computes the local disk failure condition out of the observed device problems.
+// Missing devices are only considered a failure when the devices have been observed before,
+// so that nodes which are still being set up are not reported as failed.
+func calculateLocalDiskFailureNodeCondition(existingCondition *corev1.NodeCondition, missingDevices []string, deviceProblems []string) (corev1.ConditionStatus, string, string) {
+	if len(deviceProblems) != 0 {
+		return corev1.ConditionTrue, internalapi.LocalDiskFailureDeviceUnhealthyReason, strings.Join(deviceProblems, "\n")
+	}
+
+	if len(missingDevices) != 0 {
+		message := fmt.Sprintf("Device(s) %s can't be found.", strings.Join(missingDevices, ", "))
+		if existingCondition == nil || existingCondition.Status == corev1.ConditionUnknown {
+			return corev1.ConditionUnknown, internalapi.LocalDiskFailureWaitingForDevicesReason, message
+		}
+
+		return corev1.ConditionTrue, internalapi.LocalDiskFailureDeviceMissingReason, message
+	}
+
+	return corev1.ConditionFalse, internalapi.AsExpectedReason, ""
+}
+
+func (nsc *Controller) syncDiskHealth(ctx context.Context, nc *scyllav1alpha1.NodeConfig) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if nc.Spec.LocalDiskSetup == nil || len(nc.Spec.LocalDiskSetup.Mounts) == 0 {
+		return progressingConditions, nil
+	}
+
+	var missingDevices, deviceProblems []string
+	for _, mc := range nc.Spec.LocalDiskSetup.Mounts {
+		device, err := disks.GetDeviceWithName(ctx, nsc.executor, nsc.devtmpfsPath, mc.Device)
+		if err != nil {
+			klog.V(4).InfoS("Can't resolve device", "Device", mc.Device, "Error", err)
+			missingDevices = append(missingDevices, fmt.Sprintf("%q", mc.Device))
+			continue
+		}
+
+		health, err := disks.GetDeviceHealth(nsc.sysfsPath, device)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get health of device %q: %w", device, err)
+		}
+
+		deviceProblems = append(deviceProblems, health.Problems...)
+		deviceProblems = append(deviceProblems, getIOErrorProblems(nsc.ioErrorCountBaselines, health.IOErrorCounts)...)
+	}
+
+	node, err := nsc.kubeClient.CoreV1().Nodes().Get(ctx, nsc.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get node %q: %w", nsc.nodeName, err)
+	}
+
+	existingCondition := controllerhelpers.FindNodeCondition(node.Status.Conditions, internalapi.LocalDiskFailureNodeConditionType)
+	status, reason, message := calculateLocalDiskFailureNodeCondition(existingCondition, missingDevices, deviceProblems)
+	if existingCondition != nil &&
+		existingCondition.Status == status &&
+		existingCondition.Reason == reason &&
+		existingCondition.Message == message {
+		return progressingConditions, nil
+	}
+
+	now := metav1.Now()
+	condition := corev1.NodeCondition{
+		Type:               internalapi.LocalDiskFailureNodeConditionType,
+		Status:             status,
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+	if existingCondition != nil && existingCondition.Status == status {
+		condition.LastTransitionTime = existingCondition.LastTransitionTime
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": []corev1.NodeCondition{condition},
+		},
+	})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't marshal node status patch: %w", err)
+	}
+
+	_, err = nsc.kubeClient.CoreV1().Nodes().PatchStatus(ctx, nsc.nodeName, patch)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't patch status of node %q: %w", nsc.nodeName, err)
+	}
+
+	klog.V(2).InfoS("Local disk failure node condition has been updated", "Node", nsc.nodeName, "Status", status, "Reason", reason)
+
+	if status == corev1.ConditionTrue && (existingCondition == nil || existingCondition.Status != corev1.ConditionTrue) {
+		nsc.eventRecorder.Eventf(
+			&corev1.ObjectReference{
+				Kind: "Node",
+				Name: nsc.nodeName,
+				UID:  nsc.nodeUID,
+			},
+			corev1.EventTypeWarning,
+			"LocalDiskFailureDetected",
+			"Failure of local disk(s) has been detected: %s",
+			message,
+		)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package nodesetup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_calculateLocalDiskFailureNodeCondition(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		existingCondition *corev1.NodeCondition
+		missingDevices    []string
+		deviceProblems    []string
+		expectedStatus    corev1.ConditionStatus
+		expectedReason    string
+		expectedMessage   string
+	}{
+		{
+			name:              "healthy devices",
+			existingCondition: nil,
+			missingDevices:    nil,
+			deviceProblems:    nil,
+			expectedStatus:    corev1.ConditionFalse,
+			expectedReason:    internalapi.AsExpectedReason,
+			expectedMessage:   "",
+		},
+		{
+			name:              "unhealthy devices",
+			existingCondition: nil,
+			missingDevices:    nil,
+			deviceProblems: []string{
+				`device "sdb" is in "offline" state`,
+				`device "sdc" reported 12 new I/O error(s)`,
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  internalapi.LocalDiskFailureDeviceUnhealthyReason,
+			expectedMessage: "device \"sdb\" is in \"offline\" state\ndevice \"sdc\" reported 12 new I/O error(s)",
+		},
+		{
+			name:              "missing devices that haven't been observed yet are waited for",
+			existingCondition: nil,
+			missingDevices:    []string{`"/dev/nvme0n1"`},
+			deviceProblems:    nil,
+			expectedStatus:    corev1.ConditionUnknown,
+			expectedReason:    internalapi.LocalDiskFailureWaitingForDevicesReason,
+			expectedMessage:   `Device(s) "/dev/nvme0n1" can't be found.`,
+		},
+		{
+			name: "missing devices are still waited for while the condition is unknown",
+			existingCondition: &corev1.NodeCondition{
+				Type:   internalapi.LocalDiskFailureNodeConditionType,
+				Status: corev1.ConditionUnknown,
+				Reason: internalapi.LocalDiskFailureWaitingForDevicesReason,
+			},
+			missingDevices:  []string{`"/dev/nvme0n1"`},
+			deviceProblems:  nil,
+			expectedStatus:  corev1.ConditionUnknown,
+			expectedReason:  internalapi.LocalDiskFailureWaitingForDevicesReason,
+			expectedMessage: `Device(s) "/dev/nvme0n1" can't be found.`,
+		},
+		{
+			name: "missing devices that have been observed before are reported as failed",
+			existingCondition: &corev1.NodeCondition{
+				Type:   internalapi.LocalDiskFailureNodeConditionType,
+				Status: corev1.ConditionFalse,
+				Reason: internalapi.AsExpectedReason,
+			},
+			missingDevices:  []string{`"/dev/nvme0n1"`, `"/dev/nvme1n1"`},
+			deviceProblems:  nil,
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  internalapi.LocalDiskFailureDeviceMissingReason,
+			expectedMessage: `Device(s) "/dev/nvme0n1", "/dev/nvme1n1" can't be found.`,
+		},
+		{
+			name: "device problems take precedence over missing devices",
+			existingCondition: &corev1.NodeCondition{
+				Type:   internalapi.LocalDiskFailureNodeConditionType,
+				Status: corev1.ConditionFalse,
+				Reason: internalapi.AsExpectedReason,
+			},
+			missingDevices:  []string{`"/dev/nvme1n1"`},
+			deviceProblems:  []string{`device "nvme0n1" is in "dead" state`},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  internalapi.LocalDiskFailureDeviceUnhealthyReason,
+			expectedMessage: `device "nvme0n1" is in "dead" state`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, reason, message := calculateLocalDiskFailureNodeCondition(tc.existingCondition, tc.missingDevices, tc.deviceProblems)
+			if status != tc.expectedStatus {
+				t.Errorf("expected status %q, got %q", tc.expectedStatus, status)
+			}
+
+			if reason != tc.expectedReason {
+				t.Errorf("expected reason %q, got %q", tc.expectedReason, reason)
+			}
+
+			if message != tc.expectedMessage {
+				t.Errorf("expected message %q, got %q", tc.expectedMessage, message)
+			}
+		})
+	}
+}
+
+func Test_getIOErrorProblems(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		baselines         map[string]uint64
+		ioErrorCounts     map[string]uint64
+		expectedProblems  []string
+		expectedBaselines map[string]uint64
+	}{
+		{
+			name:             "errors accumulated before the first observation aren't reported",
+			baselines:        map[string]uint64{},
+			ioErrorCounts:    map[string]uint64{"sdb": 100},
+			expectedProblems: nil,
+			expectedBaselines: map[string]uint64{
+				"sdb": 100,
+			},
+		},
+		{
+			name:             "few new errors aren't reported",
+			baselines:        map[string]uint64{"sdb": 100},
+			ioErrorCounts:    map[string]uint64{"sdb": 109},
+			expectedProblems: nil,
+			expectedBaselines: map[string]uint64{
+				"sdb": 100,
+			},
+		},
+		{
+			name:          "new errors reaching the threshold are reported",
+			baselines:     map[string]uint64{"sdb": 100, "sdc": 0},
+			ioErrorCounts: map[string]uint64{"sdc": 15, "sdb": 110},
+			expectedProblems: []string{
+				`device "sdb" reported 10 new I/O error(s)`,
+				`device "sdc" reported 15 new I/O error(s)`,
+			},
+			expectedBaselines: map[string]uint64{
+				"sdb": 100,
+				"sdc": 0,
+			},
+		},
+		{
+			name:             "baseline is reset when the count drops",
+			baselines:        map[string]uint64{"sdb": 100},
+			ioErrorCounts:    map[string]uint64{"sdb": 20},
+			expectedProblems: nil,
+			expectedBaselines: map[string]uint64{
+				"sdb": 20,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			problems := getIOErrorProblems(tc.baselines, tc.ioErrorCounts)
+			if !reflect.DeepEqual(problems, tc.expectedProblems) {
+				t.Errorf("expected and got problems differ:\n%s", cmp.Diff(tc.expectedProblems, problems))
+			}
+
+			if !reflect.DeepEqual(tc.baselines, tc.expectedBaselines) {
+				t.Errorf("expected and got baselines differ:\n%s", cmp.Diff(tc.expectedBaselines, tc.baselines))
+			}
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
 	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -257,6 +259,14 @@ func (opc *Controller) updateNode(old, cur interface{}) {
 			Key: key,
 			Obj: oldNode,
 		})
+		return
+	}
+
+	oldCondition := controllerhelpers.FindNodeCondition(oldNode.Status.Conditions, internalapi.LocalDiskFailureNodeConditionType)
+	currentCondition := controllerhelpers.FindNodeCondition(currentNode.Status.Conditions, internalapi.LocalDiskFailureNodeConditionType)
+	if !apiequality.Semantic.DeepEqual(oldCondition, currentCondition) {
+		klog.V(4).InfoS("Observed change of local disk failure condition", "Node", klog.KObj(currentNode))
+		opc.enqueueAllScyllaDBDatacentersOnBackground()
 	}
 }
 
@@ -3,10 +3,12 @@ package orphanedpv
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -14,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 )
@@ -71,50 +74,26 @@ func (opc *Controller) getPVsForScyllaDBDatacenter(ctx context.Context, sdc *scy
 	return pis, requeueReasons, apimachineryutilerrors.NewAggregate(errs)
 }
 
-func (opc *Controller) sync(ctx context.Context, key string) error {
-	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+func (opc *Controller) markServiceForReplacement(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, serviceName string) error {
+	_, err := opc.kubeClient.CoreV1().Services(sdc.Namespace).Patch(
+		ctx,
+		serviceName,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"metadata": {"labels": {%q: ""} } }`, naming.ReplaceLabel)),
+		metav1.PatchOptions{},
+	)
 	if err != nil {
-		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
 		return err
 	}
 
-	startTime := time.Now()
-	klog.V(4).InfoS("Started syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "startTime", startTime)
-	defer func() {
-		klog.V(4).InfoS("Finished syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "duration", time.Since(startTime))
-	}()
-
-	sdc, err := opc.scyllaDBDatacenterLister.ScyllaDBDatacenters(namespace).Get(name)
-	if apierrors.IsNotFound(err) {
-		klog.V(2).InfoS("ScyllaDBDatacenter has been deleted", "ScyllaDBDatacenter", klog.KRef(namespace, name))
-		return nil
-	}
-	if err != nil {
-		return err
-	}
+	klog.V(2).InfoS("Marked service for replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KRef(sdc.Namespace, serviceName))
 
-	if sdc.DeletionTimestamp != nil {
-		return nil
-	}
-
-	if sdc.Spec.DisableAutomaticOrphanedNodeReplacement == nil || *sdc.Spec.DisableAutomaticOrphanedNodeReplacement {
-		klog.V(4).InfoS("ScyllaDBDatacenter has AutomaticOrphanedNodeReplacement disabled", "ScyllaDBDatacenter", klog.KObj(sdc))
-		return nil
-	}
-
-	nodes, err := opc.nodeLister.List(labels.Everything())
-	if err != nil {
-		return err
-	}
+	return nil
+}
 
+func (opc *Controller) replaceOrphanedNodes(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, pis []*PVItem, nodes []*corev1.Node) error {
 	var errs []error
 
-	pis, requeueReasons, err := opc.getPVsForScyllaDBDatacenter(ctx, sdc)
-	// Process at least some PVs even if there were errors retrieving the rest
-	if err != nil {
-		errs = append(errs, err)
-	}
-
 	for _, pi := range pis {
 		orphaned, err := controllerhelpers.IsOrphanedPV(pi.PV, nodes)
 		if err != nil {
@@ -149,19 +128,210 @@ func (opc *Controller) sync(ctx context.Context, key string) error {
 
 		klog.V(2).InfoS("PV is verified as orphaned.", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV))
 
-		_, err = opc.kubeClient.CoreV1().Services(sdc.Namespace).Patch(
-			ctx,
-			pi.ServiceName,
-			types.MergePatchType,
-			[]byte(fmt.Sprintf(`{"metadata": {"labels": {%q: ""} } }`, naming.ReplaceLabel)),
-			metav1.PatchOptions{},
-		)
+		err = opc.markServiceForReplacement(ctx, sdc, pi.ServiceName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+// getFailedDiskReplacementCandidates returns PVs bound to nodes whose local disks have been reported as failed
+// for at least minFailureDuration.
+// Only PVs created before the failure was reported are considered, so that the replacing node isn't replaced again
+// before the failure is cleared.
+func getFailedDiskReplacementCandidates(sdc *scyllav1alpha1.ScyllaDBDatacenter, pis []*PVItem, nodes []*corev1.Node, minFailureDuration time.Duration, now time.Time) ([]*PVItem, []string, error) {
+	var errs []error
+	var requeueReasons []string
+
+	var candidates []*PVItem
+	for _, pi := range pis {
+		node, err := controllerhelpers.FindNodeForPV(pi.PV, nodes)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if node == nil {
+			continue
+		}
+
+		condition := controllerhelpers.FindNodeCondition(node.Status.Conditions, internalapi.LocalDiskFailureNodeConditionType)
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if !pi.PV.CreationTimestamp.Before(&condition.LastTransitionTime) {
+			klog.V(4).InfoS("PV was created after the disk failure was reported, skipping", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV), "Node", klog.KObj(node))
+			continue
+		}
+
+		failureDuration := now.Sub(condition.LastTransitionTime.Time)
+		if failureDuration < minFailureDuration {
+			klog.V(2).InfoS("Local disk failure hasn't been reported for long enough, postponing replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV), "Node", klog.KObj(node), "FailureDuration", failureDuration, "MinFailureDuration", minFailureDuration)
+			requeueReasons = append(requeueReasons, "Local disk failure hasn't been reported for long enough")
+			continue
+		}
+
+		klog.V(2).InfoS("PV is bound to a node with failed local disks", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV), "Node", klog.KObj(node))
+		candidates = append(candidates, pi)
+	}
+
+	return candidates, requeueReasons, apimachineryutilerrors.NewAggregate(errs)
+}
+
+// getServicesToReplace returns names of the candidate services that can be marked for replacement
+// without exceeding maxConcurrentReplacements, including the replacements already in progress.
+func getServicesToReplace(candidates []*PVItem, replacingServiceNames apimachineryutilsets.Set[string], maxConcurrentReplacements int) ([]string, bool) {
+	var serviceNames []string
+	postponed := false
+
+	replacingCount := replacingServiceNames.Len()
+	for _, pi := range candidates {
+		if replacingServiceNames.Has(pi.ServiceName) || slices.Contains(serviceNames, pi.ServiceName) {
+			continue
+		}
+
+		if replacingCount >= maxConcurrentReplacements {
+			postponed = true
+			continue
+		}
+
+		serviceNames = append(serviceNames, pi.ServiceName)
+		replacingCount++
+	}
+
+	return serviceNames, postponed
+}
+
+// replaceNodesWithFailedDisks marks services of nodes whose local disks were reported as failed for replacement.
+func (opc *Controller) replaceNodesWithFailedDisks(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, pis []*PVItem, nodes []*corev1.Node) ([]string, error) {
+	var errs []error
+
+	minFailureDuration := time.Duration(0)
+	if sdc.Spec.AutomaticFailedDiskNodeReplacement.MinFailureDuration != nil {
+		minFailureDuration = sdc.Spec.AutomaticFailedDiskNodeReplacement.MinFailureDuration.Duration
+	}
+
+	candidates, requeueReasons, err := getFailedDiskReplacementCandidates(sdc, pis, nodes, minFailureDuration, time.Now())
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(candidates) == 0 {
+		return requeueReasons, apimachineryutilerrors.NewAggregate(errs)
+	}
+
+	// Use a live call to see replacements that are in progress, so we never exceed the limit because of a stale cache.
+	services, err := opc.kubeClient.CoreV1().Services(sdc.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(naming.ClusterLabels(sdc)).String(),
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't list services: %w", err))
+		return requeueReasons, apimachineryutilerrors.NewAggregate(errs)
+	}
+
+	replacingServiceNames := apimachineryutilsets.New[string]()
+	for _, svc := range services.Items {
+		_, ok := svc.Labels[naming.ReplaceLabel]
+		if ok {
+			replacingServiceNames.Insert(svc.Name)
+		}
+	}
+
+	maxConcurrentReplacements := 1
+	if sdc.Spec.AutomaticFailedDiskNodeReplacement.MaxConcurrentReplacements != nil {
+		maxConcurrentReplacements = int(*sdc.Spec.AutomaticFailedDiskNodeReplacement.MaxConcurrentReplacements)
+	}
+
+	serviceNames, postponed := getServicesToReplace(candidates, replacingServiceNames, maxConcurrentReplacements)
+	if postponed {
+		klog.V(2).InfoS("Maximum number of concurrent replacements reached, postponing replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "MaxConcurrentReplacements", maxConcurrentReplacements)
+		requeueReasons = append(requeueReasons, "Maximum number of concurrent replacements reached")
+	}
+
+	for _, serviceName := range serviceNames {
+		err = opc.markServiceForReplacement(ctx, sdc, serviceName)
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
 
-		klog.V(2).InfoS("Marked service for replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KRef(sdc.Namespace, pi.ServiceName))
+		opc.eventRecorder.Eventf(
+			sdc,
+			corev1.EventTypeWarning,
+			"ReplacingNodeWithFailedDisks",
+			"Replacing node %q because its local disks have failed",
+			serviceName,
+		)
+	}
+
+	return requeueReasons, apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (opc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sdc, err := opc.scyllaDBDatacenterLister.ScyllaDBDatacenters(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBDatacenter has been deleted", "ScyllaDBDatacenter", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if sdc.DeletionTimestamp != nil {
+		return nil
+	}
+
+	orphanedNodeReplacementEnabled := sdc.Spec.DisableAutomaticOrphanedNodeReplacement != nil && !*sdc.Spec.DisableAutomaticOrphanedNodeReplacement
+	failedDiskNodeReplacementEnabled := sdc.Spec.AutomaticFailedDiskNodeReplacement != nil
+	if !orphanedNodeReplacementEnabled && !failedDiskNodeReplacementEnabled {
+		klog.V(4).InfoS("ScyllaDBDatacenter has automatic node replacement disabled", "ScyllaDBDatacenter", klog.KObj(sdc))
+		return nil
+	}
+
+	nodes, err := opc.nodeLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	pis, requeueReasons, err := opc.getPVsForScyllaDBDatacenter(ctx, sdc)
+	// Process at least some PVs even if there were errors retrieving the rest
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if orphanedNodeReplacementEnabled {
+		err = opc.replaceOrphanedNodes(ctx, sdc, pis, nodes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't replace orphaned nodes: %w", err))
+		}
+	} else {
+		klog.V(4).InfoS("ScyllaDBDatacenter has AutomaticOrphanedNodeReplacement disabled", "ScyllaDBDatacenter", klog.KObj(sdc))
+	}
+
+	if failedDiskNodeReplacementEnabled {
+		failedDiskRequeueReasons, err := opc.replaceNodesWithFailedDisks(ctx, sdc, pis, nodes)
+		requeueReasons = append(requeueReasons, failedDiskRequeueReasons...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't replace nodes with failed disks: %w", err))
+		}
 	}
 
 	err = apimachineryutilerrors.NewAggregate(errs)
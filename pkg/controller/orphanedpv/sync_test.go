@@ -0,0 +1,245 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package orphanedpv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+func Test_getFailedDiskReplacementCandidates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newNode := func(name string, conditions ...corev1.NodeCondition) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					corev1.LabelHostname: name,
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	newLocalDiskFailureCondition := func(status corev1.ConditionStatus, lastTransitionTime time.Time) corev1.NodeCondition {
+		return corev1.NodeCondition{
+			Type:               internalapi.LocalDiskFailureNodeConditionType,
+			Status:             status,
+			LastTransitionTime: metav1.NewTime(lastTransitionTime),
+		}
+	}
+
+	newPVItem := func(serviceName, nodeName string, creationTime time.Time) *PVItem {
+		return &PVItem{
+			PV: &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "pv-" + serviceName,
+					CreationTimestamp: metav1.NewTime(creationTime),
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					NodeAffinity: &corev1.VolumeNodeAffinity{
+						Required: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{
+											Key:      corev1.LabelHostname,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{nodeName},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ServiceName: serviceName,
+		}
+	}
+
+	tt := []struct {
+		name                   string
+		pis                    []*PVItem
+		nodes                  []*corev1.Node
+		minFailureDuration     time.Duration
+		expectedServiceNames   []string
+		expectedRequeueReasons []string
+	}{
+		{
+			name: "PVs on healthy nodes aren't candidates",
+			pis: []*PVItem{
+				newPVItem("svc-0", "node-0", now.Add(-time.Hour)),
+				newPVItem("svc-1", "node-1", now.Add(-time.Hour)),
+			},
+			nodes: []*corev1.Node{
+				newNode("node-0"),
+				newNode("node-1", newLocalDiskFailureCondition(corev1.ConditionFalse, now.Add(-time.Hour))),
+			},
+			minFailureDuration:     10 * time.Minute,
+			expectedServiceNames:   nil,
+			expectedRequeueReasons: nil,
+		},
+		{
+			name: "PV on a node with a failure reported for long enough is a candidate",
+			pis: []*PVItem{
+				newPVItem("svc-0", "node-0", now.Add(-time.Hour)),
+			},
+			nodes: []*corev1.Node{
+				newNode("node-0", newLocalDiskFailureCondition(corev1.ConditionTrue, now.Add(-10*time.Minute))),
+			},
+			minFailureDuration:     10 * time.Minute,
+			expectedServiceNames:   []string{"svc-0"},
+			expectedRequeueReasons: nil,
+		},
+		{
+			name: "replacement is postponed when the failure hasn't been reported for long enough",
+			pis: []*PVItem{
+				newPVItem("svc-0", "node-0", now.Add(-time.Hour)),
+			},
+			nodes: []*corev1.Node{
+				newNode("node-0", newLocalDiskFailureCondition(corev1.ConditionTrue, now.Add(-9*time.Minute))),
+			},
+			minFailureDuration:     10 * time.Minute,
+			expectedServiceNames:   nil,
+			expectedRequeueReasons: []string{"Local disk failure hasn't been reported for long enough"},
+		},
+		{
+			name: "PV created after the failure was reported isn't a candidate",
+			pis: []*PVItem{
+				newPVItem("svc-0", "node-0", now.Add(-5*time.Minute)),
+			},
+			nodes: []*corev1.Node{
+				newNode("node-0", newLocalDiskFailureCondition(corev1.ConditionTrue, now.Add(-time.Hour))),
+			},
+			minFailureDuration:     10 * time.Minute,
+			expectedServiceNames:   nil,
+			expectedRequeueReasons: nil,
+		},
+		{
+			name: "PV bound to a missing node isn't a candidate",
+			pis: []*PVItem{
+				newPVItem("svc-0", "node-0", now.Add(-time.Hour)),
+			},
+			nodes:                  []*corev1.Node{},
+			minFailureDuration:     10 * time.Minute,
+			expectedServiceNames:   nil,
+			expectedRequeueReasons: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic",
+					Namespace: "scylla",
+				},
+			}
+
+			candidates, requeueReasons, err := getFailedDiskReplacementCandidates(sdc, tc.pis, tc.nodes, tc.minFailureDuration, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var serviceNames []string
+			for _, pi := range candidates {
+				serviceNames = append(serviceNames, pi.ServiceName)
+			}
+
+			if !reflect.DeepEqual(serviceNames, tc.expectedServiceNames) {
+				t.Errorf("expected and got candidates differ:\n%s", cmp.Diff(tc.expectedServiceNames, serviceNames))
+			}
+
+			if !reflect.DeepEqual(requeueReasons, tc.expectedRequeueReasons) {
+				t.Errorf("expected and got requeue reasons differ:\n%s", cmp.Diff(tc.expectedRequeueReasons, requeueReasons))
+			}
+		})
+	}
+}
+
+func Test_getServicesToReplace(t *testing.T) {
+	t.Parallel()
+
+	newPVItems := func(serviceNames ...string) []*PVItem {
+		var pis []*PVItem
+		for _, serviceName := range serviceNames {
+			pis = append(pis, &PVItem{
+				ServiceName: serviceName,
+			})
+		}
+		return pis
+	}
+
+	tt := []struct {
+		name                      string
+		candidates                []*PVItem
+		replacingServiceNames     apimachineryutilsets.Set[string]
+		maxConcurrentReplacements int
+		expectedServiceNames      []string
+		expectedPostponed         bool
+	}{
+		{
+			name:                      "candidates are replaced up to the limit",
+			candidates:                newPVItems("svc-0", "svc-1", "svc-2"),
+			replacingServiceNames:     apimachineryutilsets.New[string](),
+			maxConcurrentReplacements: 2,
+			expectedServiceNames:      []string{"svc-0", "svc-1"},
+			expectedPostponed:         true,
+		},
+		{
+			name:                      "replacements in progress count towards the limit",
+			candidates:                newPVItems("svc-0", "svc-1"),
+			replacingServiceNames:     apimachineryutilsets.New[string]("svc-5"),
+			maxConcurrentReplacements: 1,
+			expectedServiceNames:      nil,
+			expectedPostponed:         true,
+		},
+		{
+			name:                      "candidates already being replaced are skipped",
+			candidates:                newPVItems("svc-0", "svc-1"),
+			replacingServiceNames:     apimachineryutilsets.New[string]("svc-0"),
+			maxConcurrentReplacements: 2,
+			expectedServiceNames:      []string{"svc-1"},
+			expectedPostponed:         false,
+		},
+		{
+			name:                      "multiple PVs of the same service are replaced once",
+			candidates:                newPVItems("svc-0", "svc-0"),
+			replacingServiceNames:     apimachineryutilsets.New[string](),
+			maxConcurrentReplacements: 1,
+			expectedServiceNames:      []string{"svc-0"},
+			expectedPostponed:         false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceNames, postponed := getServicesToReplace(tc.candidates, tc.replacingServiceNames, tc.maxConcurrentReplacements)
+			if !reflect.DeepEqual(serviceNames, tc.expectedServiceNames) {
+				t.Errorf("expected and got service names differ:\n%s", cmp.Diff(tc.expectedServiceNames, serviceNames))
+			}
+
+			if postponed != tc.expectedPostponed {
+				t.Errorf("expected postponed %t, got %t", tc.expectedPostponed, postponed)
+			}
+		})
+	}
+}
@@ -8,6 +8,14 @@ const (
 	clientHealthcheckControllerProgressingCondition = "ClientHealthcheckControllerProgressing"
 	clientHealthcheckControllerDegradedCondition    = "ClientHealthcheckControllerDegraded"
 
+	remotePermissionsControllerAvailableCondition   = "RemotePermissionsControllerAvailable"
+	remotePermissionsControllerProgressingCondition = "RemotePermissionsControllerProgressing"
+	remotePermissionsControllerDegradedCondition    = "RemotePermissionsControllerDegraded"
+
+	credentialsControllerAvailableCondition   = "CredentialsControllerAvailable"
+	credentialsControllerProgressingCondition = "CredentialsControllerProgressing"
+	credentialsControllerDegradedCondition    = "CredentialsControllerDegraded"
+
 	remoteKubernetesClusterFinalizerProgressingCondition = "RemoteKubernetesClusterFinalizerProgressing"
 	remoteKubernetesClusterFinalizerDegradedCondition    = "RemoteKubernetesClusterFinalizerDegraded"
 )
@@ -23,6 +23,7 @@ import (
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/version"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -42,6 +43,11 @@ var (
 	remoteKubernetesClusterControllerGVK = scyllav1alpha1.GroupVersion.WithKind("RemoteKubernetesCluster")
 )
 
+type projectedToken struct {
+	source scyllav1alpha1.ProjectedServiceAccountTokenSource
+	token  string
+}
+
 type Controller struct {
 	kubeClient   kubernetes.Interface
 	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface
@@ -53,6 +59,19 @@ type Controller struct {
 	clusterKubeClient      remoteclient.ClusterClientInterface[kubernetes.Interface]
 	clusterScyllaClient    remoteclient.ClusterClientInterface[scyllaclient.Interface]
 	dynamicClusterHandlers []remoteclient.DynamicClusterInterface
+	clusterTokens          *remoteclient.ClusterTokens
+
+	// projectedTokens caches tokens requested for projected token sources, keyed by RemoteKubernetesCluster name.
+	projectedTokensLock sync.Mutex
+	projectedTokens     map[string]projectedToken
+
+	// remotePermissions caches results of remote permissions checks, keyed by RemoteKubernetesCluster name.
+	remotePermissionsLock sync.Mutex
+	remotePermissions     map[string]remotePermissionsCheck
+
+	localServerVersionLock      sync.Mutex
+	localServerVersion          *version.Info
+	localServerVersionCheckedAt time.Time
 
 	cachesToSync []cache.InformerSynced
 
@@ -71,6 +90,7 @@ func NewController(
 	dynamicClusterHandlers []remoteclient.DynamicClusterInterface,
 	clusterKubeClient remoteclient.ClusterClientInterface[kubernetes.Interface],
 	clusterScyllaClient remoteclient.ClusterClientInterface[scyllaclient.Interface],
+	clusterTokens *remoteclient.ClusterTokens,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
@@ -85,10 +105,14 @@ func NewController(
 		secretLister:                  secretInformer.Lister(),
 
 		dynamicClusterHandlers: dynamicClusterHandlers,
+		clusterTokens:          clusterTokens,
 
 		clusterKubeClient:   clusterKubeClient,
 		clusterScyllaClient: clusterScyllaClient,
 
+		projectedTokens:   map[string]projectedToken{},
+		remotePermissions: map[string]remotePermissionsCheck{},
+
 		cachesToSync: []cache.InformerSynced{
 			remoteKubernetesClusterInformer.Informer().HasSynced,
 			scyllaDBClusterInformer.Informer().HasSynced,
@@ -262,6 +286,11 @@ func (rkcc *Controller) deleteSecret(obj interface{}) {
 
 func (rkcc *Controller) enqueueRemoteKubernetesClusterUsingSecret(secret *corev1.Secret) controllerhelpers.EnqueueFuncType {
 	return rkcc.handlers.EnqueueAllFunc(rkcc.handlers.EnqueueWithFilterFunc(func(rkc *scyllav1alpha1.RemoteKubernetesCluster) bool {
+		if rkc.Spec.Credentials != nil && rkc.Spec.Credentials.RemoteServiceAccountToken != nil {
+			tokenSecretRef := rkc.Spec.Credentials.RemoteServiceAccountToken.TokenSecretRef
+			return tokenSecretRef.Namespace == secret.Namespace && tokenSecretRef.Name == secret.Name
+		}
+
 		return rkc.Spec.KubeconfigSecretRef.Namespace == secret.Namespace && rkc.Spec.KubeconfigSecretRef.Name == secret.Name
 	}))
 }
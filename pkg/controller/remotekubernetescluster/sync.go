@@ -35,6 +35,9 @@ func (rkcc *Controller) sync(ctx context.Context, key string) error {
 		for _, clusterHandler := range rkcc.dynamicClusterHandlers {
 			clusterHandler.DeleteCluster(name)
 		}
+		rkcc.forgetProjectedServiceAccountToken(name)
+		rkcc.forgetRemotePermissions(name)
+		rkcc.clusterTokens.DeleteToken(name)
 
 		return nil
 	}
@@ -83,6 +86,19 @@ func (rkcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync dynamic cluster handlers: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		credentialsControllerProgressingCondition,
+		credentialsControllerDegradedCondition,
+		rkc.Generation,
+		func() ([]metav1.Condition, error) {
+			return rkcc.syncCredentials(ctx, key, rkc, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync credentials: %w", err))
+	}
+
 	err = controllerhelpers.RunSync(
 		&status.Conditions,
 		clientHealthcheckControllerProgressingCondition,
@@ -96,6 +112,19 @@ func (rkcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync client healthchecks: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		remotePermissionsControllerProgressingCondition,
+		remotePermissionsControllerDegradedCondition,
+		rkc.Generation,
+		func() ([]metav1.Condition, error) {
+			return rkcc.syncRemotePermissions(ctx, rkc, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync remote permissions: %w", err))
+	}
+
 	// Aggregate conditions.
 	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, rkc.Generation)
 	if err != nil {
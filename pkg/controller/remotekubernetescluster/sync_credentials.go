@@ -0,0 +1,323 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package remotekubernetescluster
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// tokenRefreshLifetimeFraction is the fraction of the token lifetime after which the token is refreshed.
+	tokenRefreshLifetimeFraction = 0.8
+
+	defaultTokenExpirationSeconds int64 = 3600
+)
+
+// tokenLifetime describes the validity of a ServiceAccount token.
+// Zero ExpiresAt means the token doesn't expire.
+type tokenLifetime struct {
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// RefreshTime returns the time after which the token should be replaced.
+// Tokens without expiration should be replaced by bound ones right away.
+func (tl *tokenLifetime) RefreshTime() time.Time {
+	if tl.ExpiresAt.IsZero() || tl.IssuedAt.IsZero() {
+		return tl.IssuedAt
+	}
+
+	lifetime := tl.ExpiresAt.Sub(tl.IssuedAt)
+	return tl.IssuedAt.Add(time.Duration(float64(lifetime) * tokenRefreshLifetimeFraction))
+}
+
+func (tl *tokenLifetime) IsExpired(now time.Time) bool {
+	return !tl.ExpiresAt.IsZero() && !now.Before(tl.ExpiresAt)
+}
+
+// parseTokenLifetime reads the lifetime claims of a ServiceAccount token.
+// The token signature is not verified, that's up to the issuing API server.
+func parseTokenLifetime(token string) (*tokenLifetime, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a JWT: expected 3 parts, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("can't decode token payload: %w", err)
+	}
+
+	claims := struct {
+		IssuedAt  *int64 `json:"iat,omitempty"`
+		ExpiresAt *int64 `json:"exp,omitempty"`
+	}{}
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal token claims: %w", err)
+	}
+
+	tl := &tokenLifetime{}
+	if claims.IssuedAt != nil {
+		tl.IssuedAt = time.Unix(*claims.IssuedAt, 0)
+	}
+	if claims.ExpiresAt != nil {
+		tl.ExpiresAt = time.Unix(*claims.ExpiresAt, 0)
+	}
+
+	return tl, nil
+}
+
+// makeTokenKubeconfig returns a kubeconfig for the credentials which doesn't embed the token.
+// The token is injected by the client transport from the cluster tokens, so that refreshing it
+// doesn't change the kubeconfig and restart the clients and informers bound to the cluster.
+func makeTokenKubeconfig(clusterName string, credentials *scyllav1alpha1.RemoteKubernetesClusterCredentials) ([]byte, error) {
+	config := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			clusterName: {
+				Server:                   credentials.Server,
+				CertificateAuthorityData: credentials.CertificateAuthorityData,
+			},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			clusterName: {},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			clusterName: {
+				Cluster:  clusterName,
+				AuthInfo: clusterName,
+			},
+		},
+		CurrentContext: clusterName,
+	}
+
+	return clientcmd.Write(config)
+}
+
+func getTokenExpirationSeconds(expirationSeconds *int64) int64 {
+	if expirationSeconds == nil {
+		return defaultTokenExpirationSeconds
+	}
+
+	return *expirationSeconds
+}
+
+// getProjectedServiceAccountToken returns a token issued by the local cluster for the projected token source.
+// Tokens are cached in memory and requested again when they are due for refresh or the source changes.
+func (rkcc *Controller) getProjectedServiceAccountToken(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster) (string, error) {
+	source := rkc.Spec.Credentials.ProjectedServiceAccountToken
+
+	rkcc.projectedTokensLock.Lock()
+	defer rkcc.projectedTokensLock.Unlock()
+
+	cached, ok := rkcc.projectedTokens[rkc.Name]
+	if ok && cached.source == *source {
+		tl, err := parseTokenLifetime(cached.token)
+		if err == nil && time.Now().Before(tl.RefreshTime()) {
+			return cached.token, nil
+		}
+	}
+
+	klog.V(2).InfoS("Requesting projected ServiceAccount token", "RemoteKubernetesCluster", klog.KObj(rkc), "ServiceAccount", naming.ManualRef(source.ServiceAccountNamespace, source.ServiceAccountName))
+	tr, err := rkcc.kubeClient.CoreV1().ServiceAccounts(source.ServiceAccountNamespace).CreateToken(ctx, source.ServiceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{source.Audience},
+			ExpirationSeconds: pointer.Ptr(getTokenExpirationSeconds(source.ExpirationSeconds)),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("can't request token for ServiceAccount %q: %w", naming.ManualRef(source.ServiceAccountNamespace, source.ServiceAccountName), err)
+	}
+
+	rkcc.projectedTokens[rkc.Name] = projectedToken{
+		source: *source,
+		token:  tr.Status.Token,
+	}
+
+	return tr.Status.Token, nil
+}
+
+func (rkcc *Controller) forgetProjectedServiceAccountToken(name string) {
+	rkcc.projectedTokensLock.Lock()
+	defer rkcc.projectedTokensLock.Unlock()
+
+	delete(rkcc.projectedTokens, name)
+}
+
+// getCredentialsToken returns the current token for the credentials specified in the RemoteKubernetesCluster.
+// Nil token without an error means the token is not available yet and progressing conditions describe why.
+func (rkcc *Controller) getCredentialsToken(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster, progressingConditionType string) (*string, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if rkc.Spec.Credentials.ProjectedServiceAccountToken != nil {
+		token, err := rkcc.getProjectedServiceAccountToken(ctx, rkc)
+		if err != nil {
+			return nil, progressingConditions, err
+		}
+
+		return &token, progressingConditions, nil
+	}
+
+	if rkc.Spec.Credentials.RemoteServiceAccountToken == nil {
+		return nil, progressingConditions, fmt.Errorf("RemoteKubernetesCluster %q doesn't specify any token source", naming.ObjRef(rkc))
+	}
+
+	secretRef := rkc.Spec.Credentials.RemoteServiceAccountToken.TokenSecretRef
+	tokenSecret, err := rkcc.secretLister.Secrets(secretRef.Namespace).Get(secretRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Token Secret referenced by RemoteKubernetesCluster doesn't exists", "Secret", naming.ManualRef(secretRef.Namespace, secretRef.Name), "RemoteKubernetesCluster", rkc.Name)
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               progressingConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "AwaitingSecret",
+				Message:            fmt.Sprintf("Secret %q referenced by RemoteKubernetesCluster %q does not exist yet", naming.ManualRef(secretRef.Namespace, secretRef.Name), rkc.Name),
+				ObservedGeneration: rkc.Generation,
+			})
+
+			return nil, progressingConditions, nil
+		}
+
+		return nil, progressingConditions, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(secretRef.Namespace, secretRef.Name), err)
+	}
+
+	token, ok := tokenSecret.Data[corev1.ServiceAccountTokenKey]
+	if !ok || len(token) == 0 {
+		return nil, progressingConditions, fmt.Errorf("secret %q referenced by RemoteKubernetesCluster %q doesn't have required key %q", naming.ManualRef(secretRef.Namespace, secretRef.Name), naming.ObjRef(rkc), corev1.ServiceAccountTokenKey)
+	}
+
+	return pointer.Ptr(string(token)), progressingConditions, nil
+}
+
+func (rkcc *Controller) rotateRemoteServiceAccountToken(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster) (string, error) {
+	source := rkc.Spec.Credentials.RemoteServiceAccountToken
+
+	kubeClient, err := rkcc.clusterKubeClient.Cluster(rkc.Name)
+	if err != nil {
+		return "", fmt.Errorf("can't get Kubernetes client to cluster %q: %w", rkc.Name, err)
+	}
+
+	tr, err := kubeClient.CoreV1().ServiceAccounts(source.ServiceAccountNamespace).CreateToken(ctx, source.ServiceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: pointer.Ptr(getTokenExpirationSeconds(source.ExpirationSeconds)),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("can't request token for remote ServiceAccount %q: %w", naming.ManualRef(source.ServiceAccountNamespace, source.ServiceAccountName), err)
+	}
+
+	tokenSecret, err := rkcc.secretLister.Secrets(source.TokenSecretRef.Namespace).Get(source.TokenSecretRef.Name)
+	if err != nil {
+		return "", fmt.Errorf("can't get secret %q: %w", naming.ManualRef(source.TokenSecretRef.Namespace, source.TokenSecretRef.Name), err)
+	}
+
+	tokenSecret = tokenSecret.DeepCopy()
+	if tokenSecret.Data == nil {
+		tokenSecret.Data = map[string][]byte{}
+	}
+	tokenSecret.Data[corev1.ServiceAccountTokenKey] = []byte(tr.Status.Token)
+
+	// The Secret update triggers another sync which hands the rotated token over to the cluster tokens.
+	_, err = rkcc.kubeClient.CoreV1().Secrets(tokenSecret.Namespace).Update(ctx, tokenSecret, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("can't update secret %q: %w", naming.ObjRef(tokenSecret), err)
+	}
+
+	rkcc.eventRecorder.Eventf(
+		rkc,
+		corev1.EventTypeNormal,
+		"CredentialsRotated",
+		"Rotated token of remote ServiceAccount %q stored in Secret %q",
+		naming.ManualRef(source.ServiceAccountNamespace, source.ServiceAccountName),
+		naming.ObjRef(tokenSecret),
+	)
+
+	return tr.Status.Token, nil
+}
+
+func (rkcc *Controller) syncCredentials(ctx context.Context, key string, rkc *scyllav1alpha1.RemoteKubernetesCluster, status *scyllav1alpha1.RemoteKubernetesClusterStatus) ([]metav1.Condition, error) {
+	if rkc.Spec.Credentials == nil {
+		status.CredentialsExpirationTime = nil
+		apimeta.RemoveStatusCondition(&status.Conditions, credentialsControllerAvailableCondition)
+		return nil, nil
+	}
+
+	token, progressingConditions, err := rkcc.getCredentialsToken(ctx, rkc, credentialsControllerProgressingCondition)
+	if err != nil || token == nil {
+		return progressingConditions, err
+	}
+
+	tl, err := parseTokenLifetime(*token)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't parse token: %w", err)
+	}
+
+	now := time.Now()
+	if tl.IsExpired(now) {
+		status.CredentialsExpirationTime = pointer.Ptr(metav1.NewTime(tl.ExpiresAt))
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               credentialsControllerAvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "CredentialsExpired",
+			Message:            fmt.Sprintf("Token expired at %s, a new token has to be provided.", tl.ExpiresAt.UTC().Format(time.RFC3339)),
+			ObservedGeneration: rkc.Generation,
+		})
+
+		return progressingConditions, nil
+	}
+
+	if rkc.Spec.Credentials.RemoteServiceAccountToken != nil && !now.Before(tl.RefreshTime()) {
+		rotatedToken, err := rkcc.rotateRemoteServiceAccountToken(ctx, rkc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't rotate token: %w", err)
+		}
+
+		tl, err = parseTokenLifetime(rotatedToken)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't parse rotated token: %w", err)
+		}
+	}
+
+	if tl.ExpiresAt.IsZero() {
+		status.CredentialsExpirationTime = nil
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               credentialsControllerAvailableCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "CredentialsValid",
+			Message:            "Token doesn't expire.",
+			ObservedGeneration: rkc.Generation,
+		})
+
+		return progressingConditions, nil
+	}
+
+	status.CredentialsExpirationTime = pointer.Ptr(metav1.NewTime(tl.ExpiresAt))
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               credentialsControllerAvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "CredentialsValid",
+		Message:            fmt.Sprintf("Token expires at %s.", tl.ExpiresAt.UTC().Format(time.RFC3339)),
+		ObservedGeneration: rkc.Generation,
+	})
+
+	rkcc.queue.AddAfter(key, tl.RefreshTime().Sub(now))
+
+	return progressingConditions, nil
+}
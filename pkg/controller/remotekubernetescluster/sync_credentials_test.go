@@ -0,0 +1,121 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package remotekubernetescluster
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func makeTestToken(payload string) string {
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+}
+
+func TestParseTokenLifetime(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                 string
+		token                string
+		expectedLifetime     *tokenLifetime
+		expectedRefreshTime  time.Time
+		expectedErrorPresent bool
+	}{
+		{
+			name:  "bound token",
+			token: makeTestToken(`{"iat":1700000000,"exp":1700003600,"sub":"system:serviceaccount:scylla-operator:remote"}`),
+			expectedLifetime: &tokenLifetime{
+				IssuedAt:  time.Unix(1700000000, 0),
+				ExpiresAt: time.Unix(1700003600, 0),
+			},
+			expectedRefreshTime: time.Unix(1700002880, 0),
+		},
+		{
+			name:  "legacy token without expiration is refreshed right away",
+			token: makeTestToken(`{"iss":"kubernetes/serviceaccount","sub":"system:serviceaccount:scylla-operator:remote"}`),
+			expectedLifetime: &tokenLifetime{
+				IssuedAt:  time.Time{},
+				ExpiresAt: time.Time{},
+			},
+			expectedRefreshTime: time.Time{},
+		},
+		{
+			name:                 "not a JWT",
+			token:                "opaque-token",
+			expectedLifetime:     nil,
+			expectedErrorPresent: true,
+		},
+		{
+			name:                 "malformed payload",
+			token:                "eyJhbGciOiJSUzI1NiJ9.!!!.c2lnbmF0dXJl",
+			expectedLifetime:     nil,
+			expectedErrorPresent: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tl, err := parseTokenLifetime(tc.token)
+			if (err != nil) != tc.expectedErrorPresent {
+				t.Fatalf("expected error presence %v, got error: %v", tc.expectedErrorPresent, err)
+			}
+
+			if !cmp.Equal(tc.expectedLifetime, tl) {
+				t.Fatalf("expected and got lifetimes differ: %s", cmp.Diff(tc.expectedLifetime, tl))
+			}
+
+			if tl == nil {
+				return
+			}
+
+			refreshTime := tl.RefreshTime()
+			if !refreshTime.Equal(tc.expectedRefreshTime) {
+				t.Errorf("expected refresh time %v, got %v", tc.expectedRefreshTime, refreshTime)
+			}
+		})
+	}
+}
+
+func TestTokenLifetime_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700003600, 0)
+
+	tt := []struct {
+		name     string
+		lifetime tokenLifetime
+		expected bool
+	}{
+		{
+			name:     "token without expiration",
+			lifetime: tokenLifetime{},
+			expected: false,
+		},
+		{
+			name:     "token expiring in future",
+			lifetime: tokenLifetime{ExpiresAt: now.Add(time.Second)},
+			expected: false,
+		},
+		{
+			name:     "token expiring now",
+			lifetime: tokenLifetime{ExpiresAt: now},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.lifetime.IsExpired(now)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -12,9 +12,27 @@ import (
 	"k8s.io/klog/v2"
 )
 
-func (rkcc *Controller) syncDynamicClusterHandlers(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster) ([]metav1.Condition, error) {
+func (rkcc *Controller) getKubeconfig(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster) ([]byte, []metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
 
+	if rkc.Spec.Credentials != nil {
+		token, progressingConditions, err := rkcc.getCredentialsToken(ctx, rkc, dynamicClusterHandlersControllerProgressingCondition)
+		if err != nil || token == nil {
+			return nil, progressingConditions, err
+		}
+
+		config, err := makeTokenKubeconfig(rkc.Name, rkc.Spec.Credentials)
+		if err != nil {
+			return nil, progressingConditions, fmt.Errorf("can't make kubeconfig: %w", err)
+		}
+
+		rkcc.clusterTokens.SetToken(rkc.Name, *token)
+
+		return config, progressingConditions, nil
+	}
+
+	rkcc.clusterTokens.DeleteToken(rkc.Name)
+
 	kubeConfigSecret, err := rkcc.secretLister.Secrets(rkc.Spec.KubeconfigSecretRef.Namespace).Get(rkc.Spec.KubeconfigSecretRef.Name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
@@ -27,16 +45,25 @@ func (rkcc *Controller) syncDynamicClusterHandlers(ctx context.Context, rkc *scy
 				ObservedGeneration: rkc.Generation,
 			})
 
-			return progressingConditions, nil
+			return nil, progressingConditions, nil
 		}
 
-		return progressingConditions, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(rkc.Spec.KubeconfigSecretRef.Namespace, rkc.Spec.KubeconfigSecretRef.Name), err)
+		return nil, progressingConditions, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(rkc.Spec.KubeconfigSecretRef.Namespace, rkc.Spec.KubeconfigSecretRef.Name), err)
 
 	}
 
 	config, ok := kubeConfigSecret.Data[naming.KubeConfigSecretKey]
 	if !ok {
-		return progressingConditions, fmt.Errorf("secret %q referenced by RemoteKubernetesCluster %q doesn't have required key %q", naming.ManualRef(rkc.Spec.KubeconfigSecretRef.Namespace, rkc.Spec.KubeconfigSecretRef.Name), naming.ObjRef(rkc), naming.KubeConfigSecretKey)
+		return nil, progressingConditions, fmt.Errorf("secret %q referenced by RemoteKubernetesCluster %q doesn't have required key %q", naming.ManualRef(rkc.Spec.KubeconfigSecretRef.Namespace, rkc.Spec.KubeconfigSecretRef.Name), naming.ObjRef(rkc), naming.KubeConfigSecretKey)
+	}
+
+	return config, progressingConditions, nil
+}
+
+func (rkcc *Controller) syncDynamicClusterHandlers(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster) ([]metav1.Condition, error) {
+	config, progressingConditions, err := rkcc.getKubeconfig(ctx, rkc)
+	if err != nil || config == nil {
+		return progressingConditions, err
 	}
 
 	var errs []error
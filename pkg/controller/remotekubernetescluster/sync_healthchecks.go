@@ -7,9 +7,14 @@ import (
 	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/discovery"
 	"k8s.io/klog/v2"
 )
@@ -17,8 +22,65 @@ import (
 const (
 	remoteScyllaClientName = "RemoteOpenSourceScylla"
 	remoteKubeClientName   = "RemoteKubernetes"
+
+	// maxKubernetesMinorVersionSkew is the maximal minor version skew between Kubernetes clients and API servers.
+	maxKubernetesMinorVersionSkew = 1
+
+	// localServerVersionRecheckInterval is how long the local Kubernetes server version is reused.
+	localServerVersionRecheckInterval = 10 * time.Minute
 )
 
+// getMinorVersionSkew returns the difference between minor versions of the remote and the local Kubernetes versions.
+// Nil skew is returned when major versions differ.
+func getMinorVersionSkew(localGitVersion, remoteGitVersion string) (*int32, error) {
+	localVersion, err := apimachineryutilversion.ParseGeneric(localGitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse local Kubernetes version %q: %w", localGitVersion, err)
+	}
+
+	remoteVersion, err := apimachineryutilversion.ParseGeneric(remoteGitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse remote Kubernetes version %q: %w", remoteGitVersion, err)
+	}
+
+	if localVersion.Major() != remoteVersion.Major() {
+		return nil, nil
+	}
+
+	return pointer.Ptr(int32(remoteVersion.Minor()) - int32(localVersion.Minor())), nil
+}
+
+// getLocalServerVersion returns the local Kubernetes server version.
+// The version is cached, so it isn't requested on every sync of every RemoteKubernetesCluster.
+func (rkcc *Controller) getLocalServerVersion() (*version.Info, error) {
+	rkcc.localServerVersionLock.Lock()
+	defer rkcc.localServerVersionLock.Unlock()
+
+	now := time.Now()
+	if rkcc.localServerVersion != nil && now.Sub(rkcc.localServerVersionCheckedAt) < localServerVersionRecheckInterval {
+		return rkcc.localServerVersion, nil
+	}
+
+	localServerVersion, err := rkcc.kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	rkcc.localServerVersion = localServerVersion
+	rkcc.localServerVersionCheckedAt = now
+
+	return localServerVersion, nil
+}
+
+func (rkcc *Controller) getKubernetesMinorVersionSkew(remoteServerVersion *version.Info) (*int32, error) {
+	localServerVersion, err := rkcc.getLocalServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("can't get local Kubernetes server version: %w", err)
+	}
+
+	return getMinorVersionSkew(localServerVersion.GitVersion, remoteServerVersion.GitVersion)
+}
+
 func (rkcc *Controller) syncClientHealthchecks(ctx context.Context, key string, rkc *scyllav1alpha1.RemoteKubernetesCluster, status *scyllav1alpha1.RemoteKubernetesClusterStatus) ([]metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
 	var errs []error
@@ -55,6 +117,8 @@ func (rkcc *Controller) syncClientHealthchecks(ctx context.Context, key string,
 	desiredClients := len(clients)
 	successfulClients := 0
 	var messages []string
+	var remoteServerVersion *version.Info
+	var remoteRoundTripLatency time.Duration
 	for clientName, getDiscoveryClient := range clients {
 		discoveryClient, err := getDiscoveryClient(rkc.Name)
 		if err != nil {
@@ -64,7 +128,9 @@ func (rkcc *Controller) syncClientHealthchecks(ctx context.Context, key string,
 			continue
 		}
 
-		_, err = discoveryClient.ServerVersion()
+		requestStart := time.Now()
+		serverVersion, err := discoveryClient.ServerVersion()
+		requestLatency := time.Since(requestStart)
 		if err != nil {
 			message := fmt.Sprintf("can't check server info for %q client", clientName)
 			messages = append(messages, fmt.Sprintf("%s: %v", message, err))
@@ -73,9 +139,37 @@ func (rkcc *Controller) syncClientHealthchecks(ctx context.Context, key string,
 			continue
 		}
 
+		if clientName == remoteKubeClientName {
+			remoteServerVersion = serverVersion
+			remoteRoundTripLatency = requestLatency
+		}
+
 		successfulClients++
 	}
 
+	if remoteServerVersion != nil {
+		status.KubernetesVersion = pointer.Ptr(remoteServerVersion.GitVersion)
+		status.APIRoundTripLatency = &metav1.Duration{Duration: remoteRoundTripLatency.Round(time.Millisecond)}
+
+		skew, err := rkcc.getKubernetesMinorVersionSkew(remoteServerVersion)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't compute Kubernetes version skew: %w", err))
+		} else {
+			if !apiequality.Semantic.DeepEqual(status.KubernetesMinorVersionSkew, skew) && skew != nil && (*skew > maxKubernetesMinorVersionSkew || *skew < -maxKubernetesMinorVersionSkew) {
+				rkcc.eventRecorder.Eventf(
+					rkc,
+					corev1.EventTypeWarning,
+					"UnsupportedVersionSkew",
+					"Remote Kubernetes version %q is %d minor versions apart from the local Kubernetes version, at most %d is supported",
+					remoteServerVersion.GitVersion,
+					*skew,
+					maxKubernetesMinorVersionSkew,
+				)
+			}
+			status.KubernetesMinorVersionSkew = skew
+		}
+	}
+
 	if successfulClients != desiredClients {
 		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
 			Type:               clientHealthcheckControllerAvailableCondition,
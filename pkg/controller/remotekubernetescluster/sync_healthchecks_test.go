@@ -0,0 +1,69 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package remotekubernetescluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+)
+
+func TestGetMinorVersionSkew(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                 string
+		localGitVersion      string
+		remoteGitVersion     string
+		expectedSkew         *int32
+		expectedErrorPresent bool
+	}{
+		{
+			name:             "same versions",
+			localGitVersion:  "v1.31.2",
+			remoteGitVersion: "v1.31.0",
+			expectedSkew:     pointer.Ptr[int32](0),
+		},
+		{
+			name:             "remote is newer",
+			localGitVersion:  "v1.30.4",
+			remoteGitVersion: "v1.32.1-eks-1234567",
+			expectedSkew:     pointer.Ptr[int32](2),
+		},
+		{
+			name:             "remote is older",
+			localGitVersion:  "v1.31.2+k3s1",
+			remoteGitVersion: "v1.30.0-gke.100",
+			expectedSkew:     pointer.Ptr[int32](-1),
+		},
+		{
+			name:             "different major versions",
+			localGitVersion:  "v1.31.0",
+			remoteGitVersion: "v2.0.0",
+			expectedSkew:     nil,
+		},
+		{
+			name:                 "unparsable remote version",
+			localGitVersion:      "v1.31.0",
+			remoteGitVersion:     "unknown",
+			expectedSkew:         nil,
+			expectedErrorPresent: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			skew, err := getMinorVersionSkew(tc.localGitVersion, tc.remoteGitVersion)
+			if (err != nil) != tc.expectedErrorPresent {
+				t.Fatalf("expected error presence %v, got error: %v", tc.expectedErrorPresent, err)
+			}
+
+			if !cmp.Equal(tc.expectedSkew, skew) {
+				t.Errorf("expected and got skews differ: %s", cmp.Diff(tc.expectedSkew, skew))
+			}
+		})
+	}
+}
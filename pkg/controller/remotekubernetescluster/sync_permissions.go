@@ -0,0 +1,207 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package remotekubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/util/hash"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// remotePermissionsRecheckInterval is how long the result of a remote permissions check is reused
+	// while the credentials stay the same.
+	remotePermissionsRecheckInterval = 10 * time.Minute
+)
+
+type resourcePermission struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verbs       []string
+}
+
+var (
+	allVerbs    = []string{"create", "delete", "get", "list", "patch", "update", "watch"}
+	readVerbs   = []string{"get", "list", "watch"}
+	statusVerbs = []string{"get", "list", "patch", "update", "watch"}
+
+	// requiredRemotePermissions are the permissions the ScyllaDBCluster controller needs in a remote Kubernetes cluster.
+	// They have to be kept in sync with the scylladb:controller:operator-remote ClusterRole.
+	requiredRemotePermissions = []resourcePermission{
+		{Group: scyllav1alpha1.GroupName, Resource: "scylladbdatacenters", Verbs: allVerbs},
+		{Group: scyllav1alpha1.GroupName, Resource: "scylladbdatacenters", Subresource: "status", Verbs: statusVerbs},
+		{Group: scyllav1alpha1.GroupName, Resource: "scylladbdatacenters", Subresource: "finalizers", Verbs: []string{"update"}},
+		{Group: scyllav1alpha1.GroupName, Resource: "remoteowners", Verbs: allVerbs},
+		{Group: scyllav1alpha1.GroupName, Resource: "remoteowners", Subresource: "status", Verbs: statusVerbs},
+		{Group: scyllav1alpha1.GroupName, Resource: "remoteowners", Subresource: "finalizers", Verbs: []string{"update"}},
+		{Group: scyllav1alpha1.GroupName, Resource: "scylladbdatacenternodesstatusreports", Verbs: allVerbs},
+		{Group: discoveryv1.GroupName, Resource: "endpointslices", Verbs: allVerbs},
+		{Group: "", Resource: "endpoints", Verbs: allVerbs},
+		{Group: "", Resource: "namespaces", Verbs: allVerbs},
+		{Group: "", Resource: "services", Verbs: allVerbs},
+		{Group: "", Resource: "secrets", Verbs: allVerbs},
+		{Group: "", Resource: "configmaps", Verbs: allVerbs},
+		{Group: "", Resource: "pods", Verbs: readVerbs},
+		{Group: batchv1.GroupName, Resource: "jobs", Verbs: allVerbs},
+	}
+)
+
+func (rp *resourcePermission) String(verb string) string {
+	resource := schema.GroupResource{Group: rp.Group, Resource: rp.Resource}.String()
+	if len(rp.Subresource) != 0 {
+		resource = fmt.Sprintf("%s/%s", resource, rp.Subresource)
+	}
+
+	return fmt.Sprintf("%s %s", verb, resource)
+}
+
+// getMissingPermissions returns the required permissions that are not granted to the current user,
+// using SelfSubjectAccessReviews at the cluster scope.
+func getMissingPermissions(ctx context.Context, client authorizationv1client.SelfSubjectAccessReviewsGetter, permissions []resourcePermission) ([]string, error) {
+	var missing []string
+
+	for _, rp := range permissions {
+		for _, verb := range rp.Verbs {
+			ssar, err := client.SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Verb:        verb,
+						Group:       rp.Group,
+						Resource:    rp.Resource,
+						Subresource: rp.Subresource,
+					},
+				},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("can't create SelfSubjectAccessReview for %q: %w", rp.String(verb), err)
+			}
+
+			if !ssar.Status.Allowed {
+				missing = append(missing, rp.String(verb))
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+// remotePermissionsCheck is a cached result of a remote permissions check.
+type remotePermissionsCheck struct {
+	credentialsHash string
+	checkedAt       time.Time
+	missing         []string
+}
+
+// getCredentialsHash returns a hash of the credentials used to connect to the remote cluster.
+func (rkcc *Controller) getCredentialsHash(rkc *scyllav1alpha1.RemoteKubernetesCluster) (string, error) {
+	if rkc.Spec.Credentials != nil {
+		token, _ := rkcc.clusterTokens.Token(rkc.Name)
+		return hash.HashObjects(rkc.Spec.Credentials, token)
+	}
+
+	secretRef := rkc.Spec.KubeconfigSecretRef
+	kubeconfigSecret, err := rkcc.secretLister.Secrets(secretRef.Namespace).Get(secretRef.Name)
+	if err != nil {
+		return "", fmt.Errorf("can't get secret %q: %w", naming.ManualRef(secretRef.Namespace, secretRef.Name), err)
+	}
+
+	return hash.HashObjects(secretRef, kubeconfigSecret.Data[naming.KubeConfigSecretKey])
+}
+
+// getCachedMissingPermissions returns the permissions missing in the remote cluster.
+// The result is reused until the credentials change or remotePermissionsRecheckInterval passes.
+func (rkcc *Controller) getCachedMissingPermissions(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster, client authorizationv1client.SelfSubjectAccessReviewsGetter) ([]string, error) {
+	credentialsHash, err := rkcc.getCredentialsHash(rkc)
+	if err != nil {
+		return nil, fmt.Errorf("can't hash credentials: %w", err)
+	}
+
+	now := time.Now()
+
+	rkcc.remotePermissionsLock.Lock()
+	cached, ok := rkcc.remotePermissions[rkc.Name]
+	rkcc.remotePermissionsLock.Unlock()
+
+	if ok && cached.credentialsHash == credentialsHash && now.Sub(cached.checkedAt) < remotePermissionsRecheckInterval {
+		return cached.missing, nil
+	}
+
+	klog.V(4).InfoS("Checking remote permissions", "RemoteKubernetesCluster", rkc.Name)
+	missing, err := getMissingPermissions(ctx, client, requiredRemotePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	rkcc.remotePermissionsLock.Lock()
+	defer rkcc.remotePermissionsLock.Unlock()
+
+	rkcc.remotePermissions[rkc.Name] = remotePermissionsCheck{
+		credentialsHash: credentialsHash,
+		checkedAt:       now,
+		missing:         missing,
+	}
+
+	return missing, nil
+}
+
+func (rkcc *Controller) forgetRemotePermissions(name string) {
+	rkcc.remotePermissionsLock.Lock()
+	defer rkcc.remotePermissionsLock.Unlock()
+
+	delete(rkcc.remotePermissions, name)
+}
+
+func (rkcc *Controller) syncRemotePermissions(ctx context.Context, rkc *scyllav1alpha1.RemoteKubernetesCluster, status *scyllav1alpha1.RemoteKubernetesClusterStatus) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if rkc.Spec.ClientHealthcheckProbes == nil {
+		klog.V(4).InfoS("Healthchecks are disabled, skipping remote permissions check", "RemoteKubernetesCluster", rkc.Name)
+		return progressingConditions, nil
+	}
+
+	kubeClient, err := rkcc.clusterKubeClient.Cluster(rkc.Name)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get Kubernetes client to cluster %q: %w", rkc.Name, err)
+	}
+
+	missing, err := rkcc.getCachedMissingPermissions(ctx, rkc, kubeClient.AuthorizationV1())
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't check remote permissions: %w", err)
+	}
+
+	if len(missing) != 0 {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               remotePermissionsControllerAvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "MissingPermissions",
+			Message:            fmt.Sprintf("Credentials lack permissions required in the remote cluster: %s", strings.Join(missing, ", ")),
+			ObservedGeneration: rkc.Generation,
+		})
+
+		return progressingConditions, nil
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               remotePermissionsControllerAvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "PermissionsGranted",
+		Message:            "",
+		ObservedGeneration: rkc.Generation,
+	})
+
+	return progressingConditions, nil
+}
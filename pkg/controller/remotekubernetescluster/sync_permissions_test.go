@@ -0,0 +1,145 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package remotekubernetescluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	remoteclient "github.com/scylladb/scylla-operator/pkg/remoteclient/client"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestGetMissingPermissions(t *testing.T) {
+	t.Parallel()
+
+	permissions := []resourcePermission{
+		{Group: "scylla.scylladb.com", Resource: "scylladbdatacenters", Verbs: []string{"create", "get"}},
+		{Group: "scylla.scylladb.com", Resource: "scylladbdatacenters", Subresource: "status", Verbs: []string{"update"}},
+		{Group: "", Resource: "pods", Verbs: []string{"list"}},
+	}
+
+	tt := []struct {
+		name            string
+		denied          map[string]bool
+		expectedMissing []string
+	}{
+		{
+			name:            "all permissions granted",
+			denied:          map[string]bool{},
+			expectedMissing: nil,
+		},
+		{
+			name: "some permissions missing",
+			denied: map[string]bool{
+				"create scylladbdatacenters.scylla.scylladb.com":        true,
+				"update scylladbdatacenters.scylla.scylladb.com/status": true,
+				"list pods": true,
+			},
+			expectedMissing: []string{
+				"create scylladbdatacenters.scylla.scylladb.com",
+				"update scylladbdatacenters.scylla.scylladb.com/status",
+				"list pods",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := fake.NewSimpleClientset()
+			client.PrependReactor("create", "selfsubjectaccessreviews", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				ssar := action.(kubetesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).DeepCopy()
+				attrs := ssar.Spec.ResourceAttributes
+				rp := resourcePermission{Group: attrs.Group, Resource: attrs.Resource, Subresource: attrs.Subresource}
+				ssar.Status.Allowed = !tc.denied[rp.String(attrs.Verb)]
+				return true, ssar, nil
+			})
+
+			missing, err := getMissingPermissions(context.Background(), client.AuthorizationV1(), permissions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(tc.expectedMissing, missing) {
+				t.Errorf("expected and got missing permissions differ: %s", cmp.Diff(tc.expectedMissing, missing))
+			}
+		})
+	}
+}
+
+func TestController_getCachedMissingPermissions(t *testing.T) {
+	t.Parallel()
+
+	rkc := &scyllav1alpha1.RemoteKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dev-us-east-1",
+		},
+		Spec: scyllav1alpha1.RemoteKubernetesClusterSpec{
+			Credentials: &scyllav1alpha1.RemoteKubernetesClusterCredentials{
+				ProjectedServiceAccountToken: &scyllav1alpha1.ProjectedServiceAccountTokenSource{
+					ServiceAccountNamespace: "scylla-operator",
+					ServiceAccountName:      "remote",
+					Audience:                "dev-us-east-1",
+				},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset()
+	ssarCount := 0
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		ssarCount++
+		ssar := action.(kubetesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).DeepCopy()
+		ssar.Status.Allowed = true
+		return true, ssar, nil
+	})
+
+	rkcc := &Controller{
+		clusterTokens:     remoteclient.NewClusterTokens(),
+		remotePermissions: map[string]remotePermissionsCheck{},
+	}
+	rkcc.clusterTokens.SetToken(rkc.Name, "token-1")
+
+	checkPermissions := func(expectedSSARCount int) {
+		t.Helper()
+
+		missing, err := rkcc.getCachedMissingPermissions(context.Background(), rkc, client.AuthorizationV1())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(missing) != 0 {
+			t.Errorf("expected no missing permissions, got %v", missing)
+		}
+		if ssarCount != expectedSSARCount {
+			t.Errorf("expected %d SelfSubjectAccessReviews, got %d", expectedSSARCount, ssarCount)
+		}
+	}
+
+	requiredSSARCount := 0
+	for _, rp := range requiredRemotePermissions {
+		requiredSSARCount += len(rp.Verbs)
+	}
+
+	checkPermissions(requiredSSARCount)
+
+	// Unchanged credentials reuse the cached result.
+	checkPermissions(requiredSSARCount)
+
+	// Changed credentials are checked again.
+	rkcc.clusterTokens.SetToken(rkc.Name, "token-2")
+	checkPermissions(2 * requiredSSARCount)
+
+	// Expired results are checked again.
+	cached := rkcc.remotePermissions[rkc.Name]
+	cached.checkedAt = cached.checkedAt.Add(-remotePermissionsRecheckInterval)
+	rkcc.remotePermissions[rkc.Name] = cached
+	checkPermissions(3 * requiredSSARCount)
+}
@@ -26,6 +26,10 @@ var (
 	makeRemoteSecretControllerDatacenterDegradedCondition                                 = MakeRemoteKindControllerDatacenterConditionFunc("Secret", scyllav1alpha1.DegradedCondition)
 	makeRemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterProgressingCondition = MakeRemoteKindControllerDatacenterConditionFunc("ScyllaDBDatacenterNodesStatusReport", scyllav1alpha1.ProgressingCondition)
 	makeRemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterDegradedCondition    = MakeRemoteKindControllerDatacenterConditionFunc("ScyllaDBDatacenterNodesStatusReport", scyllav1alpha1.DegradedCondition)
+	makeRemoteJobControllerDatacenterProgressingCondition                                 = MakeRemoteKindControllerDatacenterConditionFunc("Job", scyllav1alpha1.ProgressingCondition)
+	makeRemoteJobControllerDatacenterDegradedCondition                                    = MakeRemoteKindControllerDatacenterConditionFunc("Job", scyllav1alpha1.DegradedCondition)
+	makeRemoteConnectivityProbeControllerDatacenterProgressingCondition                   = MakeRemoteKindControllerDatacenterConditionFunc("ConnectivityProbe", scyllav1alpha1.ProgressingCondition)
+	makeRemoteConnectivityProbeControllerDatacenterDegradedCondition                      = MakeRemoteKindControllerDatacenterConditionFunc("ConnectivityProbe", scyllav1alpha1.DegradedCondition)
 
 	scyllaDBClusterFinalizerProgressingCondition = internalapi.MakeKindFinalizerCondition("ScyllaDBCluster", scyllav1alpha1.ProgressingCondition)
 	scyllaDBClusterFinalizerDegradedCondition    = internalapi.MakeKindFinalizerCondition("ScyllaDBCluster", scyllav1alpha1.DegradedCondition)
@@ -18,6 +18,7 @@ import (
 	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
 	"github.com/scylladb/scylla-operator/pkg/resource"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 	discoveryv1informers "k8s.io/client-go/informers/discovery/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	batchv1listers "k8s.io/client-go/listers/batch/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	discoveryv1listers "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
@@ -71,6 +73,10 @@ type Controller struct {
 	remoteConfigMapLister                           remotelister.GenericClusterLister[corev1listers.ConfigMapLister]
 	remoteSecretLister                              remotelister.GenericClusterLister[corev1listers.SecretLister]
 	remoteScyllaDBDatacenterNodesStatusReportLister remotelister.GenericClusterLister[scyllav1alpha1listers.ScyllaDBDatacenterNodesStatusReportLister]
+	remoteJobLister                                 remotelister.GenericClusterLister[batchv1listers.JobLister]
+	remoteJobPodLister                              remotelister.GenericClusterLister[corev1listers.PodLister]
+
+	operatorImage string
 
 	cachesToSync []cache.InformerSynced
 
@@ -102,6 +108,9 @@ func NewController(
 	remoteConfigMapInformer remoteinformers.GenericClusterInformer,
 	remoteSecretInformer remoteinformers.GenericClusterInformer,
 	remoteScyllaDBDatacenterNodesStatusReportInformer remoteinformers.GenericClusterInformer,
+	remoteJobInformer remoteinformers.GenericClusterInformer,
+	remoteJobPodInformer remoteinformers.GenericClusterInformer,
+	operatorImage string,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
@@ -131,6 +140,10 @@ func NewController(
 		remoteConfigMapLister:                           remotelister.NewClusterLister(corev1listers.NewConfigMapLister, remoteConfigMapInformer.Indexer().Cluster),
 		remoteSecretLister:                              remotelister.NewClusterLister(corev1listers.NewSecretLister, remoteSecretInformer.Indexer().Cluster),
 		remoteScyllaDBDatacenterNodesStatusReportLister: remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterNodesStatusReportLister, remoteScyllaDBDatacenterNodesStatusReportInformer.Indexer().Cluster),
+		remoteJobLister:                                 remotelister.NewClusterLister(batchv1listers.NewJobLister, remoteJobInformer.Indexer().Cluster),
+		remoteJobPodLister:                              remotelister.NewClusterLister(corev1listers.NewPodLister, remoteJobPodInformer.Indexer().Cluster),
+
+		operatorImage: operatorImage,
 
 		cachesToSync: []cache.InformerSynced{
 			scyllaDBClusterInformer.Informer().HasSynced,
@@ -150,6 +163,8 @@ func NewController(
 			remoteConfigMapInformer.Informer().HasSynced,
 			remoteSecretInformer.Informer().HasSynced,
 			remoteScyllaDBDatacenterNodesStatusReportInformer.Informer().HasSynced,
+			remoteJobInformer.Informer().HasSynced,
+			remoteJobPodInformer.Informer().HasSynced,
 		},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbcluster-controller"}),
@@ -311,6 +326,22 @@ func NewController(
 		},
 	)
 
+	remoteJobInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    scc.addRemoteJob,
+			UpdateFunc: scc.updateRemoteJob,
+			DeleteFunc: scc.deleteRemoteJob,
+		},
+	)
+
+	remoteJobPodInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    scc.addRemoteJobPod,
+			UpdateFunc: scc.updateRemoteJobPod,
+			DeleteFunc: scc.deleteRemoteJobPod,
+		},
+	)
+
 	err = apimachineryutilerrors.NewAggregate(errs)
 	if err != nil {
 		return nil, fmt.Errorf("can't register event handlers: %w", err)
@@ -664,6 +695,52 @@ func (scc *Controller) deleteRemoteSecret(obj interface{}) {
 	)
 }
 
+func (scc *Controller) addRemoteJob(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*batchv1.Job),
+		scc.enqueueThroughParentLabel,
+	)
+}
+
+func (scc *Controller) updateRemoteJob(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*batchv1.Job),
+		cur.(*batchv1.Job),
+		scc.enqueueThroughParentLabel,
+		scc.deleteRemoteJob,
+	)
+}
+
+func (scc *Controller) deleteRemoteJob(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.enqueueThroughParentLabel,
+	)
+}
+
+func (scc *Controller) addRemoteJobPod(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*corev1.Pod),
+		scc.enqueueThroughParentLabel,
+	)
+}
+
+func (scc *Controller) updateRemoteJobPod(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*corev1.Pod),
+		cur.(*corev1.Pod),
+		scc.enqueueThroughParentLabel,
+		scc.deleteRemoteJobPod,
+	)
+}
+
+func (scc *Controller) deleteRemoteJobPod(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.enqueueThroughParentLabel,
+	)
+}
+
 func (scc *Controller) addService(obj interface{}) {
 	scc.handlers.HandleAdd(
 		obj.(*corev1.Service),
@@ -4,8 +4,10 @@ import (
 	"cmp"
 	"fmt"
 	"maps"
+	"net"
 	"slices"
 	"strings"
+	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
@@ -16,6 +18,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
 	"github.com/scylladb/scylla-operator/pkg/scylla"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -107,7 +110,9 @@ func makeSeedServices(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.Scy
 
 // Given DC is part of seed list if it's fully reconciled, or is part of another DC seeds list,
 // meaning it was fully reconciled in the past, so DC is part of the cluster.
-func calculateSeedsForDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter, remoteNamespace *corev1.Namespace) ([]string, error) {
+// When a seed policy is set, datacenters without any node eligible to be a seed are left out,
+// unless none of the other datacenters has one, matching the nodes published by the seed Services.
+func calculateSeedsForDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter, remoteNamespace *corev1.Namespace, remoteNamespaces map[string]*corev1.Namespace, remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister]) ([]string, error) {
 	seedDCNamesSet := apimachineryutilsets.New[string]()
 	for _, dcSpec := range sc.Spec.Datacenters {
 		sdc, ok := remoteScyllaDBDatacenters[dcSpec.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dcSpec)]
@@ -137,14 +142,42 @@ func calculateSeedsForDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav
 		}
 	}
 
+	seedDCNamesSet.Delete(dc.Name)
+
+	if sc.Spec.SeedPolicy != nil {
+		ineligibleDCNames := apimachineryutilsets.New[string]()
+		for _, otherDC := range sc.Spec.Datacenters {
+			if !seedDCNamesSet.Has(otherDC.Name) {
+				continue
+			}
+
+			otherDCNamespace, ok := remoteNamespaces[otherDC.RemoteKubernetesClusterName]
+			if !ok {
+				continue
+			}
+
+			seedNodeNames, err := getSeedNodeNames(sc, otherDC, otherDCNamespace, naming.DatacenterPodsSelector(sc, &otherDC), remoteServiceLister)
+			if err != nil {
+				return nil, fmt.Errorf("can't select seed nodes of datacenter %q: %w", otherDC.Name, err)
+			}
+
+			if seedNodeNames.Len() == 0 {
+				ineligibleDCNames.Insert(otherDC.Name)
+			}
+		}
+
+		// Keep all datacenters rather than leaving the datacenter without seeds.
+		if ineligibleDCNames.Len() < seedDCNamesSet.Len() {
+			seedDCNamesSet = seedDCNamesSet.Difference(ineligibleDCNames)
+		} else if ineligibleDCNames.Len() > 0 {
+			klog.V(2).InfoS("No datacenter has a node eligible to be a seed according to the seed policy, using all datacenters", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name)
+		}
+	}
+
 	seeds := make([]string, 0, len(sc.Spec.ScyllaDB.ExternalSeeds)+seedDCNamesSet.Len())
 	seeds = append(seeds, sc.Spec.ScyllaDB.ExternalSeeds...)
 
 	for _, otherDC := range sc.Spec.Datacenters {
-		if otherDC.Name == dc.Name {
-			continue
-		}
-
 		if seedDCNamesSet.Has(otherDC.Name) {
 			seeds = append(seeds, fmt.Sprintf("%s.%s.svc", naming.SeedService(sc, &otherDC), remoteNamespace.Name))
 		}
@@ -153,10 +186,10 @@ func calculateSeedsForDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav
 	return seeds, nil
 }
 
-func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter, remoteNamespace *corev1.Namespace, remoteController metav1.Object, managingClusterDomain string) (*scyllav1alpha1.ScyllaDBDatacenter, error) {
+func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter, remoteNamespace *corev1.Namespace, remoteController metav1.Object, remoteNamespaces map[string]*corev1.Namespace, remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister], managingClusterDomain string) (*scyllav1alpha1.ScyllaDBDatacenter, error) {
 	dcSpec := applyDatacenterTemplateOnDatacenter(sc.Spec.DatacenterTemplate, dc)
 
-	seeds, err := calculateSeedsForDatacenter(sc, dc, remoteScyllaDBDatacenters, remoteNamespace)
+	seeds, err := calculateSeedsForDatacenter(sc, dc, remoteScyllaDBDatacenters, remoteNamespace, remoteNamespaces, remoteServiceLister)
 	if err != nil {
 		return nil, fmt.Errorf("can't calculate seeds for datacenter %q: %w", dc.Name, err)
 	}
@@ -171,6 +204,8 @@ func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyll
 	// Set the agent auth token override secret name annotation to share the generated auth token between ScyllaDBDatacenters.
 	annotations[naming.ScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotation] = agentAuthTokenSecretName
 
+	ipFamilyPolicy, ipFamilies := makeDatacenterIPFamilies(sc)
+
 	return &scyllav1alpha1.ScyllaDBDatacenter{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            naming.ScyllaDBDatacenterName(sc, dcSpec),
@@ -222,6 +257,8 @@ func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyll
 				AlternatorOptions:           sc.Spec.ScyllaDB.AlternatorOptions,
 				AdditionalScyllaDBArguments: sc.Spec.ScyllaDB.AdditionalScyllaDBArguments,
 				EnableDeveloperMode:         sc.Spec.ScyllaDB.EnableDeveloperMode,
+				Config:                      sc.Spec.ScyllaDB.Config,
+				Readiness:                   sc.Spec.ScyllaDB.Readiness,
 			},
 			ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
 				Image: func() *string {
@@ -275,12 +312,14 @@ func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyll
 
 					if sc.Spec.ExposeOptions.BroadcastOptions != nil {
 						exposeOptions.BroadcastOptions.Nodes = scyllav1alpha1.BroadcastOptions{
-							Type:  sc.Spec.ExposeOptions.BroadcastOptions.Nodes.Type,
-							PodIP: sc.Spec.ExposeOptions.BroadcastOptions.Nodes.PodIP,
+							Type:     sc.Spec.ExposeOptions.BroadcastOptions.Nodes.Type,
+							PodIP:    sc.Spec.ExposeOptions.BroadcastOptions.Nodes.PodIP,
+							IPFamily: sc.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily,
 						}
 						exposeOptions.BroadcastOptions.Clients = scyllav1alpha1.BroadcastOptions{
-							Type:  sc.Spec.ExposeOptions.BroadcastOptions.Clients.Type,
-							PodIP: sc.Spec.ExposeOptions.BroadcastOptions.Clients.PodIP,
+							Type:     sc.Spec.ExposeOptions.BroadcastOptions.Clients.Type,
+							PodIP:    sc.Spec.ExposeOptions.BroadcastOptions.Clients.PodIP,
+							IPFamily: sc.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily,
 						}
 					}
 					return exposeOptions
@@ -302,11 +341,45 @@ func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyll
 			DNSPolicy: nil,
 			// TODO not supported yet:
 			// Ref: https://github.com/scylladb/scylla-operator/issues/2602
-			DNSDomains: nil,
+			DNSDomains:     nil,
+			IPFamilyPolicy: ipFamilyPolicy,
+			IPFamilies:     ipFamilies,
 		},
 	}, nil
 }
 
+// getBroadcastIPFamilies returns IP families chosen for addresses broadcasted for communication with other nodes and clients.
+// Nil is returned for a family that isn't set explicitly.
+func getBroadcastIPFamilies(sc *scyllav1alpha1.ScyllaDBCluster) (*corev1.IPFamily, *corev1.IPFamily) {
+	if sc.Spec.ExposeOptions == nil || sc.Spec.ExposeOptions.BroadcastOptions == nil {
+		return nil, nil
+	}
+
+	return sc.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily, sc.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily
+}
+
+// makeDatacenterIPFamilies derives IP family configuration of datacenters from IP families chosen for broadcasted addresses.
+// The nodes IP family goes first, and datacenters become dual-stack when clients use the other IP family.
+// Nothing is returned when no IP family is chosen, so the datacenter defaults apply.
+func makeDatacenterIPFamilies(sc *scyllav1alpha1.ScyllaDBCluster) (*corev1.IPFamilyPolicy, []corev1.IPFamily) {
+	nodesIPFamily, clientsIPFamily := getBroadcastIPFamilies(sc)
+	if nodesIPFamily == nil && clientsIPFamily == nil {
+		return nil, nil
+	}
+
+	ipFamilies := []corev1.IPFamily{corev1.IPv4Protocol}
+	if nodesIPFamily != nil {
+		ipFamilies = []corev1.IPFamily{*nodesIPFamily}
+	}
+
+	if clientsIPFamily != nil && *clientsIPFamily != ipFamilies[0] {
+		ipFamilies = append(ipFamilies, *clientsIPFamily)
+		return pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack), ipFamilies
+	}
+
+	return pointer.Ptr(corev1.IPFamilyPolicySingleStack), ipFamilies
+}
+
 func MakeRemoteEndpointSlices(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteNamespace *corev1.Namespace, remoteController metav1.Object, remoteNamespaces map[string]*corev1.Namespace, remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister], remotePodLister remotelister.GenericClusterLister[corev1listers.PodLister], managingClusterDomain string) ([]metav1.Condition, []*discoveryv1.EndpointSlice, error) {
 	var progressingConditions []metav1.Condition
 	var remoteEndpointSlices []*discoveryv1.EndpointSlice
@@ -332,6 +405,8 @@ func makeEndpointSlicesForSeedService(sc *scyllav1alpha1.ScyllaDBCluster, dc *sc
 		nodeBroadcastType = sc.Spec.ExposeOptions.BroadcastOptions.Nodes.Type
 	}
 
+	nodesIPFamily, _ := getBroadcastIPFamilies(sc)
+
 	for _, otherDC := range sc.Spec.Datacenters {
 		if dc.Name == otherDC.Name {
 			continue
@@ -354,32 +429,53 @@ func makeEndpointSlicesForSeedService(sc *scyllav1alpha1.ScyllaDBCluster, dc *sc
 		dcLabels[discoveryv1.LabelServiceName] = naming.SeedService(sc, &otherDC)
 		dcLabels[discoveryv1.LabelManagedBy] = naming.OperatorAppNameWithDomain
 
-		endpoints, err := calculateEndpointsForRemoteDCPods(sc, nodeBroadcastType, otherDC, otherDCNamespace, otherDCPodSelector, remotePodLister, remoteServiceLister)
+		isSeedNode, err := makeSeedNodeFilter(sc, otherDC, otherDCNamespace, otherDCPodSelector, remoteServiceLister)
+		if err != nil {
+			return progressingConditions, nil, fmt.Errorf("can't select seed nodes of datacenter %q: %w", otherDC.Name, err)
+		}
+
+		endpoints, err := calculateEndpointsForRemoteDCPods(sc, nodeBroadcastType, otherDC, otherDCNamespace, otherDCPodSelector, isSeedNode, remotePodLister, remoteServiceLister)
 		if err != nil {
 			return progressingConditions, nil, fmt.Errorf("can't calculate endpoints to dataceter %q for datacenter %q: %w", otherDC.Name, dc.Name, err)
 		}
 
-		detectedIPFamily := helpers.DetectEndpointsIPFamily(endpoints)
-		addressType := helpers.IPFamilyToAddressType(detectedIPFamily)
+		// The EndpointSlice named after the seed Service keeps addresses of the nodes IP family.
+		// Addresses of other IP families are published in dedicated EndpointSlices, as an EndpointSlice holds a single address type.
+		primaryIPFamily := helpers.DetectEndpointsIPFamily(endpoints)
+		if nodesIPFamily != nil {
+			primaryIPFamily = *nodesIPFamily
+		}
+		endpointsByIPFamily := splitEndpointsByIPFamily(endpoints, primaryIPFamily)
 
-		remoteEndpointSlices = append(remoteEndpointSlices, &discoveryv1.EndpointSlice{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace:       remoteNamespace.Name,
-				Name:            naming.SeedService(sc, &otherDC),
-				Labels:          dcLabels,
-				Annotations:     naming.ScyllaDBClusterDatacenterAnnotations(sc, dc),
-				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(remoteController, remoteControllerGVK)},
-			},
-			AddressType: addressType,
-			Endpoints:   endpoints,
-			Ports: oslices.ConvertSlice(scyllaDBInterNodeCommunicationPorts, func(port portSpec) discoveryv1.EndpointPort {
-				return discoveryv1.EndpointPort{
-					Name:     pointer.Ptr(port.name),
-					Protocol: pointer.Ptr(port.protocol),
-					Port:     pointer.Ptr(port.port),
+		for _, ipFamily := range []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol} {
+			name := naming.SeedService(sc, &otherDC)
+			if ipFamily != primaryIPFamily {
+				if len(endpointsByIPFamily[ipFamily]) == 0 {
+					continue
 				}
-			}),
-		})
+
+				name = fmt.Sprintf("%s-%s", name, strings.ToLower(string(ipFamily)))
+			}
+
+			remoteEndpointSlices = append(remoteEndpointSlices, &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       remoteNamespace.Name,
+					Name:            name,
+					Labels:          maps.Clone(dcLabels),
+					Annotations:     naming.ScyllaDBClusterDatacenterAnnotations(sc, dc),
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(remoteController, remoteControllerGVK)},
+				},
+				AddressType: helpers.IPFamilyToAddressType(ipFamily),
+				Endpoints:   endpointsByIPFamily[ipFamily],
+				Ports: oslices.ConvertSlice(scyllaDBInterNodeCommunicationPorts, func(port portSpec) discoveryv1.EndpointPort {
+					return discoveryv1.EndpointPort{
+						Name:     pointer.Ptr(port.name),
+						Protocol: pointer.Ptr(port.protocol),
+						Port:     pointer.Ptr(port.port),
+					}
+				}),
+			})
+		}
 	}
 
 	return progressingConditions, remoteEndpointSlices, nil
@@ -481,10 +577,96 @@ func mergeAndCompactPortSpecSlices(xs ...[]portSpec) []portSpec {
 	})
 }
 
+// makeSeedNodeFilter returns a function accepting nodes of the remote datacenter which are used as seeds according to the seed policy.
+// It returns nil when all nodes are used as seeds.
+func makeSeedNodeFilter(sc *scyllav1alpha1.ScyllaDBCluster, remoteDC scyllav1alpha1.ScyllaDBClusterDatacenter, remoteDCNamespace *corev1.Namespace, remoteDCPodSelector apimachinerylabels.Selector, remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister]) (func(nodeName string) bool, error) {
+	if sc.Spec.SeedPolicy == nil {
+		return nil, nil
+	}
+
+	seedNodeNames, err := getSeedNodeNames(sc, remoteDC, remoteDCNamespace, remoteDCPodSelector, remoteServiceLister)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fall back to all nodes rather than leaving the datacenter without seeds.
+	// Such a datacenter is only used as a seed when none of the other datacenters has an eligible node.
+	if seedNodeNames.Len() == 0 {
+		klog.V(2).InfoS("No node is eligible to be a seed according to the seed policy, using all nodes", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", remoteDC.Name)
+		return nil, nil
+	}
+
+	return seedNodeNames.Has, nil
+}
+
+// getSeedNodeNames returns names of nodes of the remote datacenter which are eligible to be seeds according to the seed policy.
+// Nodes are identified by their member Services, which carry the rack and maintenance labels.
+func getSeedNodeNames(sc *scyllav1alpha1.ScyllaDBCluster, remoteDC scyllav1alpha1.ScyllaDBClusterDatacenter, remoteDCNamespace *corev1.Namespace, remoteDCPodSelector apimachinerylabels.Selector, remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister]) (apimachineryutilsets.Set[string], error) {
+	seedPolicy := sc.Spec.SeedPolicy
+
+	dcServices, err := remoteServiceLister.Cluster(remoteDC.RemoteKubernetesClusterName).Services(remoteDCNamespace.Name).List(remoteDCPodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("can't list services in %q ScyllaDBCluster %q Datacenter: %w", naming.ObjRef(sc), remoteDC.Name, err)
+	}
+
+	rackCandidates := map[string][]string{}
+	for _, svc := range dcServices {
+		if svc.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
+			continue
+		}
+
+		if _, underMaintenance := svc.Labels[naming.NodeMaintenanceLabel]; underMaintenance && seedPolicy.ExcludeNodesUnderMaintenance {
+			continue
+		}
+
+		rackName := svc.Labels[naming.RackNameLabel]
+		rackCandidates[rackName] = append(rackCandidates[rackName], svc.Name)
+	}
+
+	seedRackNames := slices.Collect(maps.Keys(rackCandidates))
+	preferredRackNames := slices.DeleteFunc(slices.Clone(seedRackNames), func(rackName string) bool {
+		return !slices.Contains(seedPolicy.PreferredRacks, rackName)
+	})
+	if len(preferredRackNames) > 0 {
+		seedRackNames = preferredRackNames
+	}
+
+	seedNodeNames := apimachineryutilsets.New[string]()
+	for _, rackName := range seedRackNames {
+		candidates := rackCandidates[rackName]
+		slices.SortFunc(candidates, compareNodeNames)
+
+		if seedPolicy.SeedsPerRack != nil && len(candidates) > int(*seedPolicy.SeedsPerRack) {
+			candidates = candidates[:*seedPolicy.SeedsPerRack]
+		}
+
+		seedNodeNames.Insert(candidates...)
+	}
+
+	return seedNodeNames, nil
+}
+
+// compareNodeNames orders node names by their ordinals, falling back to comparing the names.
+func compareNodeNames(a, b string) int {
+	aIndex, aErr := naming.IndexFromName(a)
+	bIndex, bErr := naming.IndexFromName(b)
+	if aErr != nil || bErr != nil {
+		return cmp.Compare(a, b)
+	}
+
+	return cmp.Or(cmp.Compare(aIndex, bIndex), cmp.Compare(a, b))
+}
+
 // calculateEndpointsForRemoteDCPods computes endpoints for remote datacenter pods taking into account how nodes are being exposed.
-func calculateEndpointsForRemoteDCPods(sc *scyllav1alpha1.ScyllaDBCluster, broadcastAddressType scyllav1alpha1.BroadcastAddressType, remoteDC scyllav1alpha1.ScyllaDBClusterDatacenter, remoteDCNamespace *corev1.Namespace, remoteDCPodSelector apimachinerylabels.Selector, remotePodLister remotelister.GenericClusterLister[corev1listers.PodLister], remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister]) ([]discoveryv1.Endpoint, error) {
+// Only nodes accepted by isNodeSelected are taken into account, unless it's nil.
+func calculateEndpointsForRemoteDCPods(sc *scyllav1alpha1.ScyllaDBCluster, broadcastAddressType scyllav1alpha1.BroadcastAddressType, remoteDC scyllav1alpha1.ScyllaDBClusterDatacenter, remoteDCNamespace *corev1.Namespace, remoteDCPodSelector apimachinerylabels.Selector, isNodeSelected func(nodeName string) bool, remotePodLister remotelister.GenericClusterLister[corev1listers.PodLister], remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister]) ([]discoveryv1.Endpoint, error) {
 	var endpoints []discoveryv1.Endpoint
 
+	// Addresses of the secondary IP family of dual-stack nodes are only published when a broadcast IP family is chosen explicitly.
+	// Otherwise, endpoints keep a single address of the primary IP family.
+	nodesIPFamily, clientsIPFamily := getBroadcastIPFamilies(sc)
+	includeSecondaryIPs := nodesIPFamily != nil || clientsIPFamily != nil
+
 	switch broadcastAddressType {
 	case scyllav1alpha1.BroadcastAddressTypePodIP:
 		dcPods, err := remotePodLister.Cluster(remoteDC.RemoteKubernetesClusterName).Pods(remoteDCNamespace.Name).List(remoteDCPodSelector)
@@ -499,6 +681,10 @@ func calculateEndpointsForRemoteDCPods(sc *scyllav1alpha1.ScyllaDBCluster, broad
 			return cmp.Compare(a.Name, b.Name)
 		})
 		for _, dcPod := range dcPods {
+			if isNodeSelected != nil && !isNodeSelected(dcPod.Name) {
+				continue
+			}
+
 			if len(dcPod.Status.PodIP) == 0 {
 				continue
 			}
@@ -507,8 +693,18 @@ func calculateEndpointsForRemoteDCPods(sc *scyllav1alpha1.ScyllaDBCluster, broad
 			terminating := dcPod.DeletionTimestamp != nil
 			serving := ready && !terminating
 
+			// Dual-stack Pods have an IP for every IP family, the primary one goes first.
+			addresses := []string{dcPod.Status.PodIP}
+			if includeSecondaryIPs {
+				for _, podIP := range dcPod.Status.PodIPs {
+					if podIP.IP != dcPod.Status.PodIP {
+						addresses = append(addresses, podIP.IP)
+					}
+				}
+			}
+
 			endpoints = append(endpoints, discoveryv1.Endpoint{
-				Addresses: []string{dcPod.Status.PodIP},
+				Addresses: addresses,
 				Conditions: discoveryv1.EndpointConditions{
 					Ready:       pointer.Ptr(ready),
 					Serving:     pointer.Ptr(serving),
@@ -518,14 +714,16 @@ func calculateEndpointsForRemoteDCPods(sc *scyllav1alpha1.ScyllaDBCluster, broad
 		}
 
 	case scyllav1alpha1.BroadcastAddressTypeServiceClusterIP:
-		eps, err := makeRemoteServiceEndpoints(sc, remoteDC, remoteDCNamespace, remoteDCPodSelector, remoteServiceLister, makeServiceClusterIPEndpoints)
+		eps, err := makeRemoteServiceEndpoints(sc, remoteDC, remoteDCNamespace, remoteDCPodSelector, isNodeSelected, remoteServiceLister, func(dcService *corev1.Service) []discoveryv1.Endpoint {
+			return makeServiceClusterIPEndpoints(dcService, includeSecondaryIPs)
+		})
 		if err != nil {
 			return nil, fmt.Errorf("can't make remote service endpoints for %q ScyllaDBCluster %q Datacenter: %w", naming.ObjRef(sc), remoteDC.Name, err)
 		}
 		endpoints = append(endpoints, eps...)
 
 	case scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress:
-		eps, err := makeRemoteServiceEndpoints(sc, remoteDC, remoteDCNamespace, remoteDCPodSelector, remoteServiceLister, makeServiceLoadBalancerIngressEndpoints)
+		eps, err := makeRemoteServiceEndpoints(sc, remoteDC, remoteDCNamespace, remoteDCPodSelector, isNodeSelected, remoteServiceLister, makeServiceLoadBalancerIngressEndpoints)
 		if err != nil {
 			return nil, fmt.Errorf("can't make remote service endpoints for %q ScyllaDBCluster %q Datacenter: %w", naming.ObjRef(sc), remoteDC.Name, err)
 		}
@@ -543,6 +741,7 @@ func makeRemoteServiceEndpoints(
 	remoteDC scyllav1alpha1.ScyllaDBClusterDatacenter,
 	remoteDCNamespace *corev1.Namespace,
 	remoteDCPodSelector apimachinerylabels.Selector,
+	isNodeSelected func(nodeName string) bool,
 	remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister],
 	makeEndpoints func(*corev1.Service) []discoveryv1.Endpoint,
 ) ([]discoveryv1.Endpoint, error) {
@@ -561,13 +760,19 @@ func makeRemoteServiceEndpoints(
 	})
 
 	for _, dcService := range dcServices {
+		if isNodeSelected != nil && !isNodeSelected(dcService.Name) {
+			continue
+		}
+
 		endpoints = append(endpoints, makeEndpoints(dcService)...)
 	}
 
 	return endpoints, nil
 }
 
-func makeServiceClusterIPEndpoints(dcService *corev1.Service) []discoveryv1.Endpoint {
+// makeServiceClusterIPEndpoints returns an endpoint with the preferred ClusterIP of the member Service.
+// ClusterIPs of other IP families of dual-stack Services are only included when includeSecondaryIPs is set.
+func makeServiceClusterIPEndpoints(dcService *corev1.Service, includeSecondaryIPs bool) []discoveryv1.Endpoint {
 	if dcService.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
 		return nil
 	}
@@ -579,15 +784,24 @@ func makeServiceClusterIPEndpoints(dcService *corev1.Service) []discoveryv1.Endp
 	preferredFamily := helpers.GetPreferredServiceIPFamily(dcService)
 
 	// Select the appropriate IP address based on the preferred family
-	// In dual-stack services, this will pick only the matching IP family
 	selectedIP, ok := helpers.GetPreferredServiceIP(dcService, &preferredFamily)
 	if !ok {
 		return nil
 	}
 
+	// Dual-stack Services have a ClusterIP for every IP family, the preferred one goes first.
+	addresses := []string{selectedIP}
+	if includeSecondaryIPs {
+		for _, clusterIP := range dcService.Spec.ClusterIPs {
+			if clusterIP != selectedIP && net.ParseIP(clusterIP) != nil {
+				addresses = append(addresses, clusterIP)
+			}
+		}
+	}
+
 	return []discoveryv1.Endpoint{
 		{
-			Addresses: []string{selectedIP},
+			Addresses: addresses,
 			Conditions: discoveryv1.EndpointConditions{
 				Ready:       pointer.Ptr(true),
 				Serving:     pointer.Ptr(true),
@@ -597,6 +811,33 @@ func makeServiceClusterIPEndpoints(dcService *corev1.Service) []discoveryv1.Endp
 	}
 }
 
+// splitEndpointsByIPFamily groups addresses of endpoints by their IP family, as an EndpointSlice can only hold addresses of a single type.
+// Addresses that aren't IPs, like load balancer hostnames, are kept with the default IP family.
+func splitEndpointsByIPFamily(endpoints []discoveryv1.Endpoint, defaultIPFamily corev1.IPFamily) map[corev1.IPFamily][]discoveryv1.Endpoint {
+	endpointsByIPFamily := map[corev1.IPFamily][]discoveryv1.Endpoint{}
+
+	for _, ep := range endpoints {
+		addressesByIPFamily := map[corev1.IPFamily][]string{}
+		for _, address := range ep.Addresses {
+			ipFamily := defaultIPFamily
+			parsedIP := net.ParseIP(address)
+			if parsedIP != nil {
+				ipFamily = helpers.GetIPFamily(parsedIP)
+			}
+
+			addressesByIPFamily[ipFamily] = append(addressesByIPFamily[ipFamily], address)
+		}
+
+		for ipFamily, addresses := range addressesByIPFamily {
+			familyEndpoint := *ep.DeepCopy()
+			familyEndpoint.Addresses = addresses
+			endpointsByIPFamily[ipFamily] = append(endpointsByIPFamily[ipFamily], familyEndpoint)
+		}
+	}
+
+	return endpointsByIPFamily
+}
+
 func makeServiceLoadBalancerIngressEndpoints(dcService *corev1.Service) []discoveryv1.Endpoint {
 	if dcService.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
 		return nil
@@ -691,6 +932,22 @@ func mergeScyllaV1Alpha1Placement(placementGetters ...func() *scyllav1alpha1.Pla
 		if placementTemplate.Tolerations != nil {
 			placement.Tolerations = append(placement.Tolerations, placementTemplate.Tolerations...)
 		}
+
+		if placementTemplate.TopologySpreadConstraints != nil {
+			placement.TopologySpreadConstraints = append(placement.TopologySpreadConstraints, placementTemplate.TopologySpreadConstraints...)
+		}
+
+		if len(placementTemplate.PriorityClassName) != 0 {
+			placement.PriorityClassName = placementTemplate.PriorityClassName
+		}
+
+		if len(placementTemplate.SchedulerName) != 0 {
+			placement.SchedulerName = placementTemplate.SchedulerName
+		}
+
+		if placementTemplate.RuntimeClassName != nil {
+			placement.RuntimeClassName = placementTemplate.RuntimeClassName
+		}
 	}
 
 	return placement
@@ -709,6 +966,14 @@ func mergeScyllaV1Alpha1ScyllaDB(scyllaDBTemplateGetters ...func() *scyllav1alph
 	for _, templateGetter := range scyllaDBTemplateGetters {
 		template := templateGetter()
 
+		if template.Image != nil {
+			scyllaDBTemplate.Image = template.Image
+		}
+
+		if template.AdditionalScyllaDBArguments != nil {
+			scyllaDBTemplate.AdditionalScyllaDBArguments = template.AdditionalScyllaDBArguments
+		}
+
 		if template.Resources != nil {
 			if scyllaDBTemplate.Resources == nil {
 				scyllaDBTemplate.Resources = &corev1.ResourceRequirements{
@@ -794,6 +1059,15 @@ func mergeScyllaV1Alpha1ScyllaDBManagerAgent(scyllaDBManagerAgentTemplateGetters
 	return scyllaDBManagerAgentTemplate
 }
 
+func mergeScyllaV1Alpha1PodTemplate(podTemplateGetters ...func() *scyllav1alpha1.PodTemplate) *scyllav1alpha1.PodTemplate {
+	podTemplates := make([]*scyllav1alpha1.PodTemplate, 0, len(podTemplateGetters))
+	for _, templateGetter := range podTemplateGetters {
+		podTemplates = append(podTemplates, templateGetter())
+	}
+
+	return controllerhelpers.MergePodTemplates(podTemplates...)
+}
+
 func applyDatacenterTemplateOnDatacenter(dcTemplate *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate, dc *scyllav1alpha1.ScyllaDBClusterDatacenter) *scyllav1alpha1.ScyllaDBClusterDatacenter {
 	if dcTemplate == nil {
 		return dc
@@ -983,6 +1257,20 @@ func applyDatacenterTemplateOnDatacenter(dcTemplate *scyllav1alpha1.ScyllaDBClus
 							return nil
 						},
 					),
+					PodTemplate: mergeScyllaV1Alpha1PodTemplate(
+						func() *scyllav1alpha1.PodTemplate {
+							if dcTemplate.RackTemplate != nil {
+								return dcTemplate.RackTemplate.PodTemplate
+							}
+							return nil
+						},
+						func() *scyllav1alpha1.PodTemplate {
+							if dc.RackTemplate != nil {
+								return dc.RackTemplate.PodTemplate
+							}
+							return nil
+						},
+					),
 				}
 			}(),
 			Racks: func() []scyllav1alpha1.RackSpec {
@@ -1090,6 +1378,10 @@ func getConfigMapsAndSecretsToMirrorForAllDCs(sc *scyllav1alpha1.ScyllaDBCluster
 		secretsToMirror = append(secretsToMirror, dcSecrets...)
 	}
 
+	if sc.Spec.ReplicationOptions != nil && sc.Spec.ReplicationOptions.CQLCredentialsSecretRef != nil {
+		secretsToMirror = append(secretsToMirror, sc.Spec.ReplicationOptions.CQLCredentialsSecretRef.Name)
+	}
+
 	return configMapsToMirror, secretsToMirror, nil
 }
 
@@ -1283,6 +1575,10 @@ func getSecretsToMirrorForAllDCs(sc *scyllav1alpha1.ScyllaDBCluster) ([]string,
 		secretsToMirror = append(secretsToMirror, dcSecrets...)
 	}
 
+	if sc.Spec.ReplicationOptions != nil && sc.Spec.ReplicationOptions.CQLCredentialsSecretRef != nil {
+		secretsToMirror = append(secretsToMirror, sc.Spec.ReplicationOptions.CQLCredentialsSecretRef.Name)
+	}
+
 	return secretsToMirror, nil
 }
 
@@ -1397,7 +1693,7 @@ func makeEndpointSliceForLocalIdentityService(sc *scyllav1alpha1.ScyllaDBCluster
 			continue
 		}
 
-		dcEndpoints, err := calculateEndpointsForRemoteDCPods(sc, clientBroadcastAddressType, dc, dcNamespace, dcPodSelector, remotePodLister, remoteServiceLister)
+		dcEndpoints, err := calculateEndpointsForRemoteDCPods(sc, clientBroadcastAddressType, dc, dcNamespace, dcPodSelector, nil, remotePodLister, remoteServiceLister)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("can't calculate endpoints for %q ScyllaDBCluster %q Datacenter: %w", naming.ObjRef(sc), dc.Name, err))
 			continue
@@ -1406,14 +1702,19 @@ func makeEndpointSliceForLocalIdentityService(sc *scyllav1alpha1.ScyllaDBCluster
 		endpoints = append(endpoints, dcEndpoints...)
 	}
 
-	detectedIPFamily := helpers.DetectEndpointsIPFamily(endpoints)
+	clientsIPFamily := helpers.DetectEndpointsIPFamily(endpoints)
+	if _, explicitClientsIPFamily := getBroadcastIPFamilies(sc); explicitClientsIPFamily != nil {
+		clientsIPFamily = *explicitClientsIPFamily
+	}
 
 	err = apimachineryutilerrors.NewAggregate(errs)
 	if err != nil {
 		return progressingConditions, nil, err
 	}
 
-	addressType := helpers.IPFamilyToAddressType(detectedIPFamily)
+	// Clients are only given addresses of a single IP family.
+	endpoints = splitEndpointsByIPFamily(endpoints, clientsIPFamily)[clientsIPFamily]
+	addressType := helpers.IPFamilyToAddressType(clientsIPFamily)
 
 	es := &discoveryv1.EndpointSlice{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1623,3 +1924,472 @@ func makeExternalScyllaDBDatacenterNodesStatusReports(
 
 	return progressingConditions, externalScyllaDBDatacenterNodesStatusReports, nil
 }
+
+const (
+	datacenterOperationJobAuthTokenVolumeName      = "scylladb-manager-agent-auth-token"
+	datacenterOperationJobAuthTokenPath            = "/etc/scylla-operator/auth-token.yaml"
+	datacenterOperationJobCQLCredentialsVolumeName = "cql-credentials"
+	datacenterOperationJobCQLCredentialsPath       = "/etc/scylla-operator/cql-credentials"
+)
+
+// MakeRemoteDatacenterOperationJobs returns the Jobs required by the current phase of the datacenter operation.
+func MakeRemoteDatacenterOperationJobs(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	operation *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus,
+	operationDatacenters []string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	image string,
+	managingClusterDomain string,
+) ([]*batchv1.Job, error) {
+	if operation == nil || sdc == nil {
+		return nil, nil
+	}
+
+	var jobType naming.NodeJobType
+	switch operation.Phase {
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication:
+		jobType = naming.JobTypeReplication
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding:
+		jobType = naming.JobTypeRebuild
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing:
+		jobType = naming.JobTypeRepair
+	default:
+		return nil, nil
+	}
+
+	nodeAddresses, err := getScyllaDBDatacenterNodeAddresses(sdc)
+	if err != nil {
+		return nil, fmt.Errorf("can't get node addresses: %w", err)
+	}
+
+	var keyspaces []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication
+	if sc.Spec.ReplicationOptions != nil {
+		keyspaces = sc.Spec.ReplicationOptions.Keyspaces
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	var args []string
+	switch jobType {
+	case naming.JobTypeReplication:
+		var removedDatacenters []string
+		if operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove {
+			removedDatacenters = append(removedDatacenters, dc.Name)
+		}
+
+		args, volumes, volumeMounts = makeReplicationJobArgs(sc, nodeAddresses, operationDatacenters, removedDatacenters)
+
+	case naming.JobTypeRebuild:
+		if dc.RebuildFrom == nil {
+			return nil, fmt.Errorf("datacenter %q doesn't specify a datacenter to rebuild from", dc.Name)
+		}
+
+		args = append(args, "rebuild-job", fmt.Sprintf("--manager-auth-config-path=%s", datacenterOperationJobAuthTokenPath))
+		for _, nodeAddress := range nodeAddresses {
+			args = append(args, fmt.Sprintf("--node-address=%s", nodeAddress))
+		}
+		args = append(args, fmt.Sprintf("--source-datacenter=%s", *dc.RebuildFrom))
+
+	case naming.JobTypeRepair:
+		args = append(args, "repair-job", fmt.Sprintf("--manager-auth-config-path=%s", datacenterOperationJobAuthTokenPath))
+		for _, nodeAddress := range nodeAddresses {
+			args = append(args, fmt.Sprintf("--node-address=%s", nodeAddress))
+		}
+		for _, keyspace := range keyspaces {
+			args = append(args, fmt.Sprintf("--keyspace=%s", keyspace.Name))
+		}
+	}
+
+	job, err := makeDatacenterOperationJob(sc, dc, naming.DatacenterOperationJobName(sdc.Name, jobType), jobType, args, volumes, volumeMounts, remoteNamespace, remoteController, image, managingClusterDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*batchv1.Job{job}, nil
+}
+
+// makeReplicationJobArgs returns the arguments of a Job altering the replication of managed keyspaces together with
+// volumes and volume mounts it requires.
+func makeReplicationJobArgs(sc *scyllav1alpha1.ScyllaDBCluster, nodeAddresses []string, datacenters []string, removedDatacenters []string) ([]string, []corev1.Volume, []corev1.VolumeMount) {
+	var keyspaces []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication
+	var cqlCredentialsSecretRef *scyllav1alpha1.LocalObjectReference
+	if sc.Spec.ReplicationOptions != nil {
+		keyspaces = sc.Spec.ReplicationOptions.Keyspaces
+		cqlCredentialsSecretRef = sc.Spec.ReplicationOptions.CQLCredentialsSecretRef
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	args := []string{"replication-job"}
+	for _, nodeAddress := range nodeAddresses {
+		args = append(args, fmt.Sprintf("--node-address=%s", nodeAddress))
+	}
+	for _, keyspace := range keyspaces {
+		args = append(args, fmt.Sprintf("--keyspace-replication-factor=%s=%d", keyspace.Name, keyspace.ReplicationFactor))
+	}
+	for _, dcName := range datacenters {
+		args = append(args, fmt.Sprintf("--datacenter=%s", dcName))
+	}
+	for _, dcName := range removedDatacenters {
+		args = append(args, fmt.Sprintf("--removed-datacenter=%s", dcName))
+	}
+
+	if cqlCredentialsSecretRef != nil {
+		args = append(args, fmt.Sprintf("--cql-credentials-path=%s", datacenterOperationJobCQLCredentialsPath))
+		volumes = append(volumes, corev1.Volume{
+			Name: datacenterOperationJobCQLCredentialsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: cqlCredentialsSecretRef.Name,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      datacenterOperationJobCQLCredentialsVolumeName,
+			ReadOnly:  true,
+			MountPath: datacenterOperationJobCQLCredentialsPath,
+		})
+	}
+
+	return args, volumes, volumeMounts
+}
+
+// MakeRemoteImportJobs returns the Jobs required by the current phase of importing an existing cluster
+// through the given datacenter.
+func MakeRemoteImportJobs(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	importStatus *scyllav1alpha1.ScyllaDBClusterImportStatus,
+	replicatingDatacenters []string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	image string,
+	managingClusterDomain string,
+) ([]*batchv1.Job, error) {
+	if sc.Spec.Import == nil || importStatus == nil || sdc == nil {
+		return nil, nil
+	}
+
+	var jobType naming.NodeJobType
+	switch importStatus.Phase {
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseValidating:
+		jobType = naming.JobTypeImportValidation
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter:
+		jobType = naming.JobTypeReplication
+	default:
+		return nil, nil
+	}
+
+	nodeAddresses, err := getScyllaDBDatacenterNodeAddresses(sdc)
+	if err != nil {
+		return nil, fmt.Errorf("can't get node addresses: %w", err)
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	var args []string
+	switch jobType {
+	case naming.JobTypeImportValidation:
+		args = append(args,
+			"import-validation-job",
+			fmt.Sprintf("--manager-auth-config-path=%s", datacenterOperationJobAuthTokenPath),
+		)
+		for _, nodeAddress := range nodeAddresses {
+			args = append(args, fmt.Sprintf("--node-address=%s", nodeAddress))
+		}
+		args = append(args,
+			fmt.Sprintf("--cluster-name=%s", sdc.Spec.ClusterName),
+			fmt.Sprintf("--external-datacenter=%s", sc.Spec.Import.ExternalDatacenter),
+		)
+		for _, seed := range sc.Spec.ScyllaDB.ExternalSeeds {
+			args = append(args, fmt.Sprintf("--external-seed=%s", seed))
+		}
+
+	case naming.JobTypeReplication:
+		args, volumes, volumeMounts = makeReplicationJobArgs(sc, nodeAddresses, replicatingDatacenters, []string{sc.Spec.Import.ExternalDatacenter})
+	}
+
+	job, err := makeDatacenterOperationJob(sc, dc, naming.ImportJobName(sc, jobType), jobType, args, volumes, volumeMounts, remoteNamespace, remoteController, image, managingClusterDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*batchv1.Job{job}, nil
+}
+
+// MakeRemoteLostDatacenterJobs returns the Jobs dropping lost datacenters from replication of managed keyspaces
+// and removing their nodes from the cluster through the given datacenter.
+func MakeRemoteLostDatacenterJobs(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	lostDatacenters []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus,
+	replicatingDatacenters []string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	image string,
+	managingClusterDomain string,
+) ([]*batchv1.Job, error) {
+	if sdc == nil {
+		return nil, nil
+	}
+
+	var jobs []*batchv1.Job
+	for _, lostDC := range lostDatacenters {
+		var jobType naming.NodeJobType
+		switch lostDC.Phase {
+		case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication:
+			jobType = naming.JobTypeReplication
+		case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes:
+			jobType = naming.JobTypeRemoveNodes
+		default:
+			continue
+		}
+
+		nodeAddresses, err := getScyllaDBDatacenterNodeAddresses(sdc)
+		if err != nil {
+			return nil, fmt.Errorf("can't get node addresses: %w", err)
+		}
+
+		var volumes []corev1.Volume
+		var volumeMounts []corev1.VolumeMount
+		var args []string
+		switch jobType {
+		case naming.JobTypeReplication:
+			args, volumes, volumeMounts = makeReplicationJobArgs(sc, nodeAddresses, replicatingDatacenters, []string{lostDC.Name})
+
+		case naming.JobTypeRemoveNodes:
+			args = append(args,
+				"remove-nodes-job",
+				fmt.Sprintf("--manager-auth-config-path=%s", datacenterOperationJobAuthTokenPath),
+			)
+			for _, nodeAddress := range nodeAddresses {
+				args = append(args, fmt.Sprintf("--node-address=%s", nodeAddress))
+			}
+			for _, hostID := range lostDC.HostIDs {
+				args = append(args, fmt.Sprintf("--host-id=%s", hostID))
+			}
+		}
+
+		job, err := makeDatacenterOperationJob(sc, dc, naming.LostDatacenterJobName(sc, lostDC.Name, jobType), jobType, args, volumes, volumeMounts, remoteNamespace, remoteController, image, managingClusterDomain)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+func getScyllaDBDatacenterNodeAddresses(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]string, error) {
+	var nodeAddresses []string
+	for _, rack := range sdc.Spec.Racks {
+		rackNodes, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return nil, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for i := range *rackNodes {
+			nodeAddresses = append(nodeAddresses, naming.MemberServiceName(rack, sdc, int(i)))
+		}
+	}
+
+	if len(nodeAddresses) == 0 {
+		return nil, fmt.Errorf("ScyllaDBDatacenter %q has no nodes", naming.ObjRef(sdc))
+	}
+
+	return nodeAddresses, nil
+}
+
+func makeDatacenterOperationJob(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	name string,
+	jobType naming.NodeJobType,
+	args []string,
+	volumes []corev1.Volume,
+	volumeMounts []corev1.VolumeMount,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	image string,
+	managingClusterDomain string,
+) (*batchv1.Job, error) {
+	agentAuthTokenSecretName, err := naming.ScyllaDBManagerAgentAuthTokenSecretNameForScyllaDBCluster(sc)
+	if err != nil {
+		return nil, fmt.Errorf("can't get agent auth token secret name for ScyllaDBCluster %q: %w", naming.ObjRef(sc), err)
+	}
+
+	volumes = append([]corev1.Volume{
+		{
+			Name: datacenterOperationJobAuthTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: agentAuthTokenSecretName,
+				},
+			},
+		},
+	}, volumes...)
+	volumeMounts = append([]corev1.VolumeMount{
+		{
+			Name:      datacenterOperationJobAuthTokenVolumeName,
+			ReadOnly:  true,
+			MountPath: datacenterOperationJobAuthTokenPath,
+			SubPath:   naming.ScyllaAgentAuthTokenFileName,
+		},
+	}, volumeMounts...)
+
+	labels := naming.ScyllaDBClusterDatacenterLabels(sc, dc, managingClusterDomain)
+	labels[naming.NodeJobTypeLabel] = string(jobType)
+
+	podLabels := maps.Clone(labels)
+	podLabels[naming.PodTypeLabel] = string(naming.PodTypeDatacenterOperationJob)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       remoteNamespace.Name,
+			Labels:          labels,
+			Annotations:     naming.ScyllaDBClusterDatacenterAnnotations(sc, dc),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(remoteController, remoteControllerGVK)},
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       nil,
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.DatacenterOperationContainerName,
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args:            args,
+							VolumeMounts:    volumeMounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}, nil
+}
+
+var connectivityProbePorts = []portSpec{
+	{
+		name:     portNameStorage,
+		protocol: corev1.ProtocolTCP,
+		port:     scylla.DefaultStoragePort,
+	},
+	{
+		name:     portNameNativeTransport,
+		protocol: corev1.ProtocolTCP,
+		port:     scylla.DefaultNativeTransportPort,
+	},
+	{
+		name:     portNameScyllaManagerAgent,
+		protocol: corev1.ProtocolTCP,
+		port:     scylla.DefaultScyllaManagerAgentPort,
+	},
+}
+
+const (
+	// defaultExternalSeedsProbesPeriod and defaultExternalSeedsProbesTimeout are used for probing external seeds
+	// when connectivity probes aren't configured.
+	defaultExternalSeedsProbesPeriod  = 300 * time.Second
+	defaultExternalSeedsProbesTimeout = 5 * time.Second
+)
+
+func isConnectivityProbeJobRequired(sc *scyllav1alpha1.ScyllaDBCluster) bool {
+	return sc.Spec.ConnectivityProbes != nil || len(sc.Spec.ScyllaDB.ExternalSeeds) > 0
+}
+
+// getConnectivityProbesPeriodAndTimeout returns the period and timeout of probes run by the connectivity probe Job.
+func getConnectivityProbesPeriodAndTimeout(sc *scyllav1alpha1.ScyllaDBCluster) (time.Duration, time.Duration) {
+	if sc.Spec.ConnectivityProbes == nil {
+		return defaultExternalSeedsProbesPeriod, defaultExternalSeedsProbesTimeout
+	}
+
+	return time.Duration(sc.Spec.ConnectivityProbes.PeriodSeconds) * time.Second, time.Duration(sc.Spec.ConnectivityProbes.TimeoutSeconds) * time.Second
+}
+
+// MakeRemoteConnectivityProbeJob makes a Job probing the connectivity from the datacenter to the broadcast addresses
+// of nodes of the other datacenters and to external seeds. Target addresses are keyed by the datacenter name.
+func MakeRemoteConnectivityProbeJob(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	targetAddresses map[string][]string,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	image string,
+	managingClusterDomain string,
+) *batchv1.Job {
+	_, timeout := getConnectivityProbesPeriodAndTimeout(sc)
+	args := []string{
+		"connectivity-probe",
+		fmt.Sprintf("--timeout=%s", timeout),
+	}
+
+	for _, port := range connectivityProbePorts {
+		args = append(args, fmt.Sprintf("--port=%s=%d", port.name, port.port))
+	}
+
+	// Iterate over the spec to keep the order of arguments stable.
+	for _, otherDC := range sc.Spec.Datacenters {
+		for _, address := range targetAddresses[otherDC.Name] {
+			args = append(args, fmt.Sprintf("--target=%s=%s", otherDC.Name, address))
+		}
+	}
+
+	if len(sc.Spec.ScyllaDB.ExternalSeeds) > 0 {
+		args = append(args, fmt.Sprintf("--external-seed-port=%d", scylla.DefaultStoragePort))
+		for _, seed := range sc.Spec.ScyllaDB.ExternalSeeds {
+			args = append(args, fmt.Sprintf("--external-seed=%s", seed))
+		}
+	}
+
+	labels := naming.ScyllaDBClusterDatacenterLabels(sc, dc, managingClusterDomain)
+	labels[naming.NodeJobTypeLabel] = string(naming.JobTypeConnectivityProbe)
+
+	podLabels := maps.Clone(labels)
+	podLabels[naming.PodTypeLabel] = string(naming.PodTypeConnectivityProbeJob)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            naming.ConnectivityProbeJobName(sc, dc),
+			Namespace:       remoteNamespace.Name,
+			Labels:          labels,
+			Annotations:     naming.ScyllaDBClusterDatacenterAnnotations(sc, dc),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(remoteController, remoteControllerGVK)},
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       nil,
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:                     naming.ConnectivityProbeContainerName,
+							Image:                    image,
+							ImagePullPolicy:          corev1.PullIfNotPresent,
+							Args:                     args,
+							TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,17 +2,22 @@ package scylladbcluster
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -461,6 +466,19 @@ func TestMakeScyllaDBDatacenters(t *testing.T) {
 					Effect:   corev1.TaintEffectNoSchedule,
 				},
 			},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       uniqueValue,
+					WhenUnsatisfiable: corev1.DoNotSchedule,
+					LabelSelector: metav1.SetAsLabelSelector(map[string]string{
+						uniqueValue: uniqueValue,
+					}),
+				},
+			},
+			PriorityClassName: uniqueValue,
+			SchedulerName:     uniqueValue,
+			RuntimeClassName:  pointer.Ptr(uniqueValue),
 		}
 	}
 
@@ -1790,6 +1808,43 @@ func TestMakeScyllaDBDatacenters(t *testing.T) {
 							Effect:   corev1.TaintEffectNoSchedule,
 						},
 					},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "foo",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector: metav1.SetAsLabelSelector(map[string]string{
+								"foo": "foo",
+							}),
+						},
+						{
+							MaxSkew:           1,
+							TopologyKey:       "bar",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector: metav1.SetAsLabelSelector(map[string]string{
+								"bar": "bar",
+							}),
+						},
+						{
+							MaxSkew:           1,
+							TopologyKey:       "dar",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector: metav1.SetAsLabelSelector(map[string]string{
+								"dar": "dar",
+							}),
+						},
+						{
+							MaxSkew:           1,
+							TopologyKey:       "zar",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector: metav1.SetAsLabelSelector(map[string]string{
+								"zar": "zar",
+							}),
+						},
+					},
+					PriorityClassName: "zar",
+					SchedulerName:     "zar",
+					RuntimeClassName:  pointer.Ptr("zar"),
 				}
 				return dc
 			}(),
@@ -1882,6 +1937,102 @@ func TestMakeScyllaDBDatacenters(t *testing.T) {
 				return dc
 			}(),
 		},
+		{
+			name:       "rackTemplate ScyllaDB image and additional arguments are taken from the most specific level",
+			datacenter: dcFromSpec(0),
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla-aaa",
+				},
+			},
+			remoteController: &scyllav1alpha1.RemoteOwner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-111",
+					Namespace: "scylla-aaa",
+					UID:       "1234",
+				},
+			},
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				cluster := newBasicScyllaDBCluster()
+				cluster.Spec.DatacenterTemplate.ScyllaDB.Image = pointer.Ptr("scylladb/scylla:2025.1.1")
+				cluster.Spec.DatacenterTemplate.ScyllaDB.AdditionalScyllaDBArguments = []string{"--foo"}
+				cluster.Spec.Datacenters[0].RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Image: pointer.Ptr("scylladb/scylla:2025.1.2"),
+					},
+				}
+				return cluster
+			}(),
+			expectedScyllaDBDatacenters: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				dc := newBasicScyllaDBDatacenter("dc1", "scylla-aaa", []string{})
+				dc.Spec.RackTemplate.ScyllaDB.Image = pointer.Ptr("scylladb/scylla:2025.1.2")
+				dc.Spec.RackTemplate.ScyllaDB.AdditionalScyllaDBArguments = []string{"--foo"}
+				return dc
+			}(),
+		},
+		{
+			name:       "rackTemplate pod template is merged from all levels",
+			datacenter: dcFromSpec(0),
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla-aaa",
+				},
+			},
+			remoteController: &scyllav1alpha1.RemoteOwner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-111",
+					Namespace: "scylla-aaa",
+					UID:       "1234",
+				},
+			},
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				cluster := newBasicScyllaDBCluster()
+				cluster.Spec.DatacenterTemplate.RackTemplate.PodTemplate = &scyllav1alpha1.PodTemplate{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Ptr(int64(1000)),
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "foo",
+							Image: "foo",
+						},
+					},
+				}
+				cluster.Spec.Datacenters[0].RackTemplate = &scyllav1alpha1.RackTemplate{
+					PodTemplate: &scyllav1alpha1.PodTemplate{
+						SecurityContext: &corev1.PodSecurityContext{
+							FSGroup: pointer.Ptr(int64(2000)),
+						},
+						SidecarContainers: []scyllav1alpha1.SidecarContainer{
+							{
+								Name:  "bar",
+								Image: "bar",
+							},
+						},
+					},
+				}
+				return cluster
+			}(),
+			expectedScyllaDBDatacenters: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				dc := newBasicScyllaDBDatacenter("dc1", "scylla-aaa", []string{})
+				dc.Spec.RackTemplate.PodTemplate = &scyllav1alpha1.PodTemplate{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Ptr(int64(2000)),
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "foo",
+							Image: "foo",
+						},
+						{
+							Name:  "bar",
+							Image: "bar",
+						},
+					},
+				}
+				return dc
+			}(),
+		},
 		{
 			name:       "rack template capacity is taken from datacenter level when provided",
 			datacenter: dcFromSpec(0),
@@ -2324,6 +2475,8 @@ func TestMakeScyllaDBDatacenters(t *testing.T) {
 				tc.remoteScyllaDBDatacenters,
 				tc.remoteNamespace,
 				tc.remoteController,
+				nil,
+				remotelister.NewClusterLister(corev1listers.NewServiceLister, newFakeClusterIndexer(t, nil)),
 				testClusterDomain,
 			)
 			if err != nil {
@@ -4873,9 +5026,21 @@ func Test_makeEndpointSlicesForSeedService(t *testing.T) {
 			expectedErr:                   nil,
 		},
 		{
-			name: "remote namespace missing",
+			name: "multi-datacenter cluster, dual-stack pods with IPv6 nodes broadcast, endpoints are split by IP family",
 			sc: func() *scyllav1alpha1.ScyllaDBCluster {
 				sc := newBasicScyllaDBCluster()
+				sc.Spec.ExposeOptions = &scyllav1alpha1.ScyllaDBClusterExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions{
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+						},
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+							IPFamily: pointer.Ptr(corev1.IPv4Protocol),
+						},
+					},
+				}
 				sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
 					{
 						Name:                        "dc1",
@@ -4910,258 +5075,358 @@ func Test_makeEndpointSlicesForSeedService(t *testing.T) {
 						Name: "dc1-rkc-ns",
 					},
 				},
-				// dc2-rkc namespace is missing
-			},
-			existing:              map[string][]apimachineryruntime.Object{},
-			managingClusterDomain: "cluster.local",
-			expected:              []*discoveryv1.EndpointSlice{},
-			expectedProgressingConditions: []metav1.Condition{
-				{
-					Type:    "RemoteEndpointSliceControllerDatacenterdc1Progressing",
-					Status:  "True",
-					Reason:  "WaitingForRemoteNamespace",
-					Message: `Waiting for Namespace to be created in "dc2-rkc" Cluster`,
+				"dc2-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc2-rkc-ns",
+					},
 				},
 			},
-			expectedErr: nil,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-
-			fakeClusterIndexer := newFakeClusterIndexer(t, tc.existing)
-			remoteServiceLister := remotelister.NewClusterLister(corev1listers.NewServiceLister, fakeClusterIndexer)
-			remotePodLister := remotelister.NewClusterLister(corev1listers.NewPodLister, fakeClusterIndexer)
-
-			progressingConditions, endpointSlices, err := makeEndpointSlicesForSeedService(
-				tc.sc,
-				tc.dc,
-				tc.remoteNamespace,
-				tc.remoteController,
-				tc.remoteNamespaces,
-				remoteServiceLister,
-				remotePodLister,
-				tc.managingClusterDomain,
-			)
-
-			if !reflect.DeepEqual(err, tc.expectedErr) {
-				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
-			}
-
-			if !equality.Semantic.DeepEqual(progressingConditions, tc.expectedProgressingConditions) {
-				t.Errorf("expected and got progressing conditions differ: %s", cmp.Diff(tc.expectedProgressingConditions, progressingConditions))
-			}
-
-			if !equality.Semantic.DeepEqual(endpointSlices, tc.expected) {
-				t.Errorf("expected and got endpointslices differ: %s", cmp.Diff(tc.expected, endpointSlices))
-			}
-		})
-	}
-}
-
-func newBasicScyllaDBCluster() *scyllav1alpha1.ScyllaDBCluster {
-	return &scyllav1alpha1.ScyllaDBCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "cluster",
-			Namespace: "scylla",
-			UID:       "uid",
-		},
-		Spec: scyllav1alpha1.ScyllaDBClusterSpec{
-			Metadata:    nil,
-			ClusterName: pointer.Ptr("cluster"),
-			ScyllaDB: scyllav1alpha1.ScyllaDB{
-				Image: "repo/scylla:version",
-			},
-			ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
-				Image: pointer.Ptr("repo/agent:version"),
-			},
-			DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-					Resources: &corev1.ResourceRequirements{
-						Limits: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse("2"),
-							corev1.ResourceMemory: resource.MustParse("2Gi"),
+			existing: map[string][]apimachineryruntime.Object{
+				"dc2-rkc": {
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "dc2-rkc-a-0",
+							Namespace: "dc2-rkc-ns",
+							Labels: map[string]string{
+								"app":                          "scylla",
+								"app.kubernetes.io/name":       "scylla",
+								"app.kubernetes.io/managed-by": "scylla-operator",
+								"scylla/cluster":               "cluster-dc2",
+							},
 						},
-						Requests: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse("1"),
-							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						Status: corev1.PodStatus{
+							PodIP: "10.0.1.1",
+							PodIPs: []corev1.PodIP{
+								{IP: "10.0.1.1"},
+								{IP: "2001:db8::1"},
+							},
+							Conditions: []corev1.PodCondition{
+								{
+									Type:   corev1.PodReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
 						},
 					},
-					Storage: &scyllav1alpha1.StorageOptions{
-						Capacity: "100Gi",
-					},
 				},
-				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-					Resources: &corev1.ResourceRequirements{
-						Limits: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse("4"),
-							corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			managingClusterDomain: "cluster.local",
+			expected: []*discoveryv1.EndpointSlice{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc2-seed-ipv4",
+						Namespace: "scylla",
+						Labels: map[string]string{
+							"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+							"endpointslice.kubernetes.io/managed-by":                              "scylla-operator.scylladb.com",
+							"kubernetes.io/service-name":                                          "cluster-dc2-seed",
+							"scylla-operator.scylladb.com/managed-by-cluster":                     "cluster.local",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+							"scylla-operator.scylladb.com/remote-cluster-endpoints":               "cluster",
 						},
-						Requests: corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse("3"),
-							corev1.ResourceMemory: resource.MustParse("3Gi"),
+						Annotations: map[string]string{},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "RemoteOwner",
+								Name:               "cluster-dc1",
+								UID:                "dc-uid",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
 						},
 					},
-				},
-				RackTemplate: &scyllav1alpha1.RackTemplate{
-					Nodes: pointer.Ptr[int32](3),
-				},
-				Racks: []scyllav1alpha1.RackSpec{
-					{
-						Name: "a",
-					},
-					{
-						Name: "b",
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							Addresses: []string{"10.0.1.1"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       pointer.Ptr(true),
+								Serving:     pointer.Ptr(true),
+								Terminating: pointer.Ptr(false),
+							},
+						},
 					},
-					{
-						Name: "c",
+					Ports: []discoveryv1.EndpointPort{
+						{
+							Name:     pointer.Ptr("inter-node"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7000),
+						},
+						{
+							Name:     pointer.Ptr("inter-node-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7001),
+						},
+						{
+							Name:     pointer.Ptr("cql"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9042),
+						},
+						{
+							Name:     pointer.Ptr("cql-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9142),
+						},
 					},
 				},
-			},
-			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
-				{
-					Name:                        "dc1",
-					RemoteKubernetesClusterName: "dc1-rkc",
-				},
-			},
-		},
-		Status: scyllav1alpha1.ScyllaDBClusterStatus{
-			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
 				{
-					Name:  "dc1",
-					Nodes: pointer.Ptr[int32](3),
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc2-seed",
+						Namespace: "scylla",
+						Labels: map[string]string{
+							"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+							"endpointslice.kubernetes.io/managed-by":                              "scylla-operator.scylladb.com",
+							"kubernetes.io/service-name":                                          "cluster-dc2-seed",
+							"scylla-operator.scylladb.com/managed-by-cluster":                     "cluster.local",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+							"scylla-operator.scylladb.com/remote-cluster-endpoints":               "cluster",
+						},
+						Annotations: map[string]string{},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "RemoteOwner",
+								Name:               "cluster-dc1",
+								UID:                "dc-uid",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
+						},
+					},
+					AddressType: discoveryv1.AddressTypeIPv6,
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							Addresses: []string{"2001:db8::1"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       pointer.Ptr(true),
+								Serving:     pointer.Ptr(true),
+								Terminating: pointer.Ptr(false),
+							},
+						},
+					},
+					Ports: []discoveryv1.EndpointPort{
+						{
+							Name:     pointer.Ptr("inter-node"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7000),
+						},
+						{
+							Name:     pointer.Ptr("inter-node-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7001),
+						},
+						{
+							Name:     pointer.Ptr("cql"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9042),
+						},
+						{
+							Name:     pointer.Ptr("cql-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9142),
+						},
+					},
 				},
 			},
-		},
-	}
-}
-
-func newFakeClusterIndexer(t *testing.T, clusterObjectMap map[string][]apimachineryruntime.Object) func(name string) cache.Indexer {
-	t.Helper()
-
-	newIndexer := func() cache.Indexer {
-		return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
-	}
-
-	cacheMap := map[string]cache.Indexer{}
-	for name, objs := range clusterObjectMap {
-		clusterCache := newIndexer()
-
-		for _, obj := range objs {
-			err := clusterCache.Add(obj)
-			if err != nil {
-				t.Fatal(err)
-			}
-		}
-
-		cacheMap[name] = clusterCache
-	}
-
-	return func(name string) cache.Indexer {
-		c, ok := cacheMap[name]
-		if !ok {
-			return newIndexer()
-		}
-
-		return c
-	}
-}
-
-func Test_mergePortSpecSlices(t *testing.T) {
-	t.Parallel()
-
-	tt := []struct {
-		name     string
-		xs       [][]portSpec
-		expected []portSpec
-	}{
-		{
-			name:     "no slices",
-			xs:       [][]portSpec{},
-			expected: nil,
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
 		},
 		{
-			name: "single empty slice",
-			xs: [][]portSpec{
-				{},
+			name: "multi-datacenter cluster, dual-stack pods without broadcast IP families, only primary addresses are published",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newBasicScyllaDBCluster()
+				sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
+					{
+						Name:                        "dc1",
+						RemoteKubernetesClusterName: "dc1-rkc",
+					},
+					{
+						Name:                        "dc2",
+						RemoteKubernetesClusterName: "dc2-rkc",
+					},
+				}
+				return sc
+			}(),
+			dc: &scyllav1alpha1.ScyllaDBClusterDatacenter{
+				Name:                        "dc1",
+				RemoteKubernetesClusterName: "dc1-rkc",
 			},
-			expected: []portSpec{},
-		},
-		{
-			name: "single non-empty slice",
-			xs: [][]portSpec{
-				{
-					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla",
 				},
 			},
-			expected: []portSpec{
-				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
-				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-			},
-		},
-		{
-			name: "multiple slices with unique specs",
-			xs: [][]portSpec{
-				{
-					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
-				},
-				{
-					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-				},
-				{
-					{name: "dns", port: 53, protocol: corev1.ProtocolUDP},
+			remoteController: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-dc1",
+					Namespace: "scylla",
+					UID:       "dc-uid",
 				},
 			},
-			expected: []portSpec{
-				{name: "dns", port: 53, protocol: corev1.ProtocolUDP},
-				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
-				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-			},
-		},
-		{
-			name: "multiple slices with duplicate specs",
-			xs: [][]portSpec{
-				{
-					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+			remoteNamespaces: map[string]*corev1.Namespace{
+				"dc1-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc1-rkc-ns",
+					},
 				},
-				{
-					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+				"dc2-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc2-rkc-ns",
+					},
 				},
 			},
-			expected: []portSpec{
-				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
-				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
-			},
-		},
-		{
-			name: "slice with duplicate names but different specs",
-			xs: [][]portSpec{
-				{
-					{name: "test", port: 443, protocol: corev1.ProtocolTCP},
-					{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+			existing: map[string][]apimachineryruntime.Object{
+				"dc2-rkc": {
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "dc2-rkc-a-0",
+							Namespace: "dc2-rkc-ns",
+							Labels: map[string]string{
+								"app":                          "scylla",
+								"app.kubernetes.io/name":       "scylla",
+								"app.kubernetes.io/managed-by": "scylla-operator",
+								"scylla/cluster":               "cluster-dc2",
+							},
+						},
+						Status: corev1.PodStatus{
+							PodIP: "10.0.1.1",
+							PodIPs: []corev1.PodIP{
+								{IP: "10.0.1.1"},
+								{IP: "2001:db8::1"},
+							},
+							Conditions: []corev1.PodCondition{
+								{
+									Type:   corev1.PodReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					},
 				},
 			},
-			expected: []portSpec{
-				{name: "test", port: 443, protocol: corev1.ProtocolTCP},
-			},
-		},
-		{
-			name: "multiple slices with duplicate names but different specs",
-			xs: [][]portSpec{
+			managingClusterDomain: "cluster.local",
+			expected: []*discoveryv1.EndpointSlice{
 				{
-					{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc2-seed",
+						Namespace: "scylla",
+						Labels: map[string]string{
+							"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+							"endpointslice.kubernetes.io/managed-by":                              "scylla-operator.scylladb.com",
+							"kubernetes.io/service-name":                                          "cluster-dc2-seed",
+							"scylla-operator.scylladb.com/managed-by-cluster":                     "cluster.local",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+							"scylla-operator.scylladb.com/remote-cluster-endpoints":               "cluster",
+						},
+						Annotations: map[string]string{},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "RemoteOwner",
+								Name:               "cluster-dc1",
+								UID:                "dc-uid",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
+						},
+					},
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							Addresses: []string{"10.0.1.1"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       pointer.Ptr(true),
+								Serving:     pointer.Ptr(true),
+								Terminating: pointer.Ptr(false),
+							},
+						},
+					},
+					Ports: []discoveryv1.EndpointPort{
+						{
+							Name:     pointer.Ptr("inter-node"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7000),
+						},
+						{
+							Name:     pointer.Ptr("inter-node-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](7001),
+						},
+						{
+							Name:     pointer.Ptr("cql"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9042),
+						},
+						{
+							Name:     pointer.Ptr("cql-ssl"),
+							Protocol: pointer.Ptr(corev1.ProtocolTCP),
+							Port:     pointer.Ptr[int32](9142),
+						},
+					},
 				},
-				{
-					{name: "test", port: 443, protocol: corev1.ProtocolTCP},
+			},
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
+		},
+		{
+			name: "remote namespace missing",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newBasicScyllaDBCluster()
+				sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
+					{
+						Name:                        "dc1",
+						RemoteKubernetesClusterName: "dc1-rkc",
+					},
+					{
+						Name:                        "dc2",
+						RemoteKubernetesClusterName: "dc2-rkc",
+					},
+				}
+				return sc
+			}(),
+			dc: &scyllav1alpha1.ScyllaDBClusterDatacenter{
+				Name:                        "dc1",
+				RemoteKubernetesClusterName: "dc1-rkc",
+			},
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla",
 				},
 			},
-			expected: []portSpec{
-				{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+			remoteController: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-dc1",
+					Namespace: "scylla",
+					UID:       "dc-uid",
+				},
+			},
+			remoteNamespaces: map[string]*corev1.Namespace{
+				"dc1-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc1-rkc-ns",
+					},
+				},
+				// dc2-rkc namespace is missing
+			},
+			existing:              map[string][]apimachineryruntime.Object{},
+			managingClusterDomain: "cluster.local",
+			expected:              []*discoveryv1.EndpointSlice{},
+			expectedProgressingConditions: []metav1.Condition{
+				{
+					Type:    "RemoteEndpointSliceControllerDatacenterdc1Progressing",
+					Status:  "True",
+					Reason:  "WaitingForRemoteNamespace",
+					Message: `Waiting for Namespace to be created in "dc2-rkc" Cluster`,
+				},
 			},
+			expectedErr: nil,
 		},
 	}
 
@@ -5169,321 +5434,255 @@ func Test_mergePortSpecSlices(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := mergeAndCompactPortSpecSlices(tc.xs...)
-			if !reflect.DeepEqual(got, tc.expected) {
-				t.Errorf("expected and got port specs differ: %s", cmp.Diff(tc.expected, got, cmpopts.EquateComparable(portSpec{})))
+			fakeClusterIndexer := newFakeClusterIndexer(t, tc.existing)
+			remoteServiceLister := remotelister.NewClusterLister(corev1listers.NewServiceLister, fakeClusterIndexer)
+			remotePodLister := remotelister.NewClusterLister(corev1listers.NewPodLister, fakeClusterIndexer)
+
+			progressingConditions, endpointSlices, err := makeEndpointSlicesForSeedService(
+				tc.sc,
+				tc.dc,
+				tc.remoteNamespace,
+				tc.remoteController,
+				tc.remoteNamespaces,
+				remoteServiceLister,
+				remotePodLister,
+				tc.managingClusterDomain,
+			)
+
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !equality.Semantic.DeepEqual(progressingConditions, tc.expectedProgressingConditions) {
+				t.Errorf("expected and got progressing conditions differ: %s", cmp.Diff(tc.expectedProgressingConditions, progressingConditions))
+			}
+
+			if !equality.Semantic.DeepEqual(endpointSlices, tc.expected) {
+				t.Errorf("expected and got endpointslices differ: %s", cmp.Diff(tc.expected, endpointSlices))
 			}
 		})
 	}
 }
 
-func Test_getConfigMapsAndSecretsToMirrorForAllDCs(t *testing.T) {
-	t.Parallel()
-
-	tt := []struct {
-		name                   string
-		sc                     *scyllav1alpha1.ScyllaDBCluster
-		expectedSecretNames    []string
-		expectedConfigMapNames []string
-		expectedErr            error
-	}{
-		{
-			name: "Operator-managed secrets to mirror only",
-			sc: &scyllav1alpha1.ScyllaDBCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "scylla",
-				},
+func newBasicScyllaDBCluster() *scyllav1alpha1.ScyllaDBCluster {
+	return &scyllav1alpha1.ScyllaDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "scylla",
+			UID:       "uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+			Metadata:    nil,
+			ClusterName: pointer.Ptr("cluster"),
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				Image: "repo/scylla:version",
 			},
-			expectedSecretNames: []string{
-				"scylla-auth-token-1lt9p",
+			ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+				Image: pointer.Ptr("repo/agent:version"),
 			},
-			expectedConfigMapNames: []string{},
-			expectedErr:            nil,
-		},
-		{
-			name: "All possible secrets and configmaps from custom config",
-			sc: &scyllav1alpha1.ScyllaDBCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "scylla",
-				},
-				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
-					DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-						ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-							CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.scyllaDB.customConfigMapRef"),
+			DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
 						},
-						ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-							CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
 						},
-						RackTemplate: &scyllav1alpha1.RackTemplate{
-							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-								CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.rackTemplate.scyllaDB.customConfigMapRef"),
-							},
-							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-								CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
-							},
+					},
+					Storage: &scyllav1alpha1.StorageOptions{
+						Capacity: "100Gi",
+					},
+				},
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("4Gi"),
 						},
-						Racks: []scyllav1alpha1.RackSpec{
-							{
-								RackTemplate: scyllav1alpha1.RackTemplate{
-									ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-										CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.racks[].scyllaDB.customConfigMapRef"),
-									},
-									ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-										CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.racks[].scyllaDBManagerAgent.customConfigSecretRef"),
-									},
-								},
-							},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("3"),
+							corev1.ResourceMemory: resource.MustParse("3Gi"),
 						},
 					},
 				},
-			},
-			expectedSecretNames: []string{
-				"scylla-auth-token-1lt9p",
-				"secret-datacenterTemplate.scyllaDBManagerAgent.customConfigSecretRef",
-				"secret-datacenterTemplate.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef",
-				"secret-datacenterTemplate.racks[].scyllaDBManagerAgent.customConfigSecretRef",
-			},
-			expectedConfigMapNames: []string{
-				"configmap-datacenterTemplate.scyllaDB.customConfigMapRef",
-				"configmap-datacenterTemplate.rackTemplate.scyllaDB.customConfigMapRef",
-				"configmap-datacenterTemplate.racks[].scyllaDB.customConfigMapRef",
-			},
-		},
-		{
-			name: "All possible secrets and configmaps from Volumes",
-			sc: &scyllav1alpha1.ScyllaDBCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "scylla",
+				RackTemplate: &scyllav1alpha1.RackTemplate{
+					Nodes: pointer.Ptr[int32](3),
 				},
-				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
-					DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-						ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-							Volumes: slices.Concat(
-								makeVolumesReferringSecret("secret-scylladb.volumes"),
-								makeVolumesReferringConfigMap("configmap-scylladb.volumes"),
-							),
-						},
-						ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-							Volumes: slices.Concat(
-								makeVolumesReferringSecret("secret-scyllaDBManagerAgent.volumes"),
-								makeVolumesReferringConfigMap("configmap-scyllaDBManagerAgent.volumes"),
-							),
-						},
-						RackTemplate: &scyllav1alpha1.RackTemplate{
-							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-								Volumes: slices.Concat(
-									makeVolumesReferringSecret("secret-rackTemplate.scyllaDB.volumes"),
-									makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDB.volumes"),
-								),
-							},
-							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-								Volumes: slices.Concat(
-									makeVolumesReferringSecret("secret-rackTemplate.scyllaDBManagerAgent.volumes"),
-									makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDBManagerAgent.volumes"),
-								),
-							},
-						},
-						Racks: []scyllav1alpha1.RackSpec{
-							{
-								RackTemplate: scyllav1alpha1.RackTemplate{
-									ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-										Volumes: slices.Concat(
-											makeVolumesReferringSecret("secret-racks[].scyllaDB.volumes"),
-											makeVolumesReferringConfigMap("configmap-racks[].scyllaDB.volumes"),
-										),
-									},
-									ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-										Volumes: slices.Concat(
-											makeVolumesReferringSecret("secret-racks[].scyllaDBManagerAgent.volumes"),
-											makeVolumesReferringConfigMap("configmap-racks[].scyllaDBManagerAgent.volumes"),
-										),
-									},
-								},
-							},
-						},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+					},
+					{
+						Name: "b",
+					},
+					{
+						Name: "c",
 					},
 				},
 			},
-			expectedSecretNames: []string{
-				"scylla-auth-token-1lt9p",
-				"secret-scylladb.volumes",
-				"secret-scyllaDBManagerAgent.volumes",
-				"secret-rackTemplate.scyllaDB.volumes",
-				"secret-rackTemplate.scyllaDBManagerAgent.volumes",
-				"secret-racks[].scyllaDB.volumes",
-				"secret-racks[].scyllaDBManagerAgent.volumes",
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{
+					Name:                        "dc1",
+					RemoteKubernetesClusterName: "dc1-rkc",
+				},
 			},
-			expectedConfigMapNames: []string{
-				"configmap-scylladb.volumes",
-				"configmap-scyllaDBManagerAgent.volumes",
-				"configmap-rackTemplate.scyllaDB.volumes",
-				"configmap-rackTemplate.scyllaDBManagerAgent.volumes",
-				"configmap-racks[].scyllaDB.volumes",
-				"configmap-racks[].scyllaDBManagerAgent.volumes",
+		},
+		Status: scyllav1alpha1.ScyllaDBClusterStatus{
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+				{
+					Name:  "dc1",
+					Nodes: pointer.Ptr[int32](3),
+				},
 			},
 		},
 	}
+}
 
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
+func newFakeClusterIndexer(t *testing.T, clusterObjectMap map[string][]apimachineryruntime.Object) func(name string) cache.Indexer {
+	t.Helper()
 
-			gotConfigMapNames, gotSecretNames, err := getConfigMapsAndSecretsToMirrorForAllDCs(tc.sc)
+	newIndexer := func() cache.Indexer {
+		return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	}
 
-			if !reflect.DeepEqual(err, tc.expectedErr) {
-				t.Fatalf("expected and got errors differ: %s", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+	cacheMap := map[string]cache.Indexer{}
+	for name, objs := range clusterObjectMap {
+		clusterCache := newIndexer()
+
+		for _, obj := range objs {
+			err := clusterCache.Add(obj)
+			if err != nil {
+				t.Fatal(err)
 			}
+		}
 
-			// Sort before comparison to ensure order does not affect the test.
-			sort.Strings(tc.expectedConfigMapNames)
-			sort.Strings(tc.expectedSecretNames)
-			sort.Strings(gotConfigMapNames)
-			sort.Strings(gotSecretNames)
+		cacheMap[name] = clusterCache
+	}
 
-			if !slices.Equal(gotConfigMapNames, tc.expectedConfigMapNames) {
-				t.Errorf("expected and got config map names differ: %s", cmp.Diff(gotConfigMapNames, tc.expectedConfigMapNames))
-			}
+	return func(name string) cache.Indexer {
+		c, ok := cacheMap[name]
+		if !ok {
+			return newIndexer()
+		}
 
-			if !slices.Equal(gotSecretNames, tc.expectedSecretNames) {
-				t.Errorf("expected and got secret names differ: %s", cmp.Diff(gotSecretNames, tc.expectedSecretNames))
-			}
-		})
+		return c
 	}
 }
 
-func Test_getSecretsToMirrorForDC(t *testing.T) {
+func Test_makeSeedNodeFilter(t *testing.T) {
 	t.Parallel()
 
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dc1-rkc-ns",
+		},
+	}
+
+	newMemberService := func(name, rackName string, underMaintenance bool) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "dc1-rkc-ns",
+				Labels: map[string]string{
+					"app":                          "scylla",
+					"app.kubernetes.io/name":       "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"scylla/cluster":               "cluster-dc1",
+					"scylla/rack":                  rackName,
+					"scylla-operator.scylladb.com/scylla-service-type": "member",
+				},
+			},
+		}
+		if underMaintenance {
+			svc.Labels["scylla/node-maintenance"] = ""
+		}
+		return svc
+	}
+
+	services := []apimachineryruntime.Object{
+		newMemberService("cluster-dc1-a-10", "a", false),
+		newMemberService("cluster-dc1-a-2", "a", false),
+		newMemberService("cluster-dc1-a-0", "a", true),
+		newMemberService("cluster-dc1-b-0", "b", false),
+		newMemberService("cluster-dc1-b-1", "b", false),
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-dc1-client",
+				Namespace: "dc1-rkc-ns",
+				Labels: map[string]string{
+					"app":                          "scylla",
+					"app.kubernetes.io/name":       "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"scylla/cluster":               "cluster-dc1",
+					"scylla-operator.scylladb.com/scylla-service-type": "identity",
+				},
+			},
+		},
+	}
+
+	allNodeNames := []string{
+		"cluster-dc1-a-0",
+		"cluster-dc1-a-2",
+		"cluster-dc1-a-10",
+		"cluster-dc1-b-0",
+		"cluster-dc1-b-1",
+	}
+
 	tt := []struct {
-		name                   string
-		dc                     *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate
-		expectedSecretNames    []string
-		expectedConfigMapNames []string
+		name              string
+		seedPolicy        *scyllav1alpha1.ScyllaDBClusterSeedPolicy
+		expectedSeedNodes []string
 	}{
 		{
-			name:                "No secrets nor configmaps to mirror",
-			dc:                  &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{},
-			expectedSecretNames: nil,
+			name:              "all nodes are seeds without a seed policy",
+			seedPolicy:        nil,
+			expectedSeedNodes: allNodeNames,
 		},
 		{
-			name: "All possible secrets and configmaps from Volumes",
-			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-					Volumes: slices.Concat(
-						makeVolumesReferringSecret("secret-scylladb.volumes"),
-						makeVolumesReferringConfigMap("configmap-scylladb.volumes"),
-					),
-				},
-				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-					Volumes: slices.Concat(
-						makeVolumesReferringSecret("secret-scyllaDBManagerAgent.volumes"),
-						makeVolumesReferringConfigMap("configmap-scyllaDBManagerAgent.volumes"),
-					),
-				},
-				RackTemplate: &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						Volumes: slices.Concat(
-							makeVolumesReferringSecret("secret-rackTemplate.scyllaDB.volumes"),
-							makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDB.volumes"),
-						),
-					},
-					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-						Volumes: slices.Concat(
-							makeVolumesReferringSecret("secret-rackTemplate.scyllaDBManagerAgent.volumes"),
-							makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDBManagerAgent.volumes"),
-						),
-					},
-				},
-				Racks: []scyllav1alpha1.RackSpec{
-					{
-						RackTemplate: scyllav1alpha1.RackTemplate{
-							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-								Volumes: slices.Concat(
-									makeVolumesReferringSecret("secret-racks[].scyllaDB.volumes"),
-									makeVolumesReferringConfigMap("configmap-racks[].scyllaDB.volumes"),
-								),
-							},
-							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-								Volumes: slices.Concat(
-									makeVolumesReferringSecret("secret-racks[].scyllaDBManagerAgent.volumes"),
-									makeVolumesReferringConfigMap("configmap-racks[].scyllaDBManagerAgent.volumes"),
-								),
-							},
-						},
-					},
-				},
-			},
-			expectedSecretNames: []string{
-				"secret-scylladb.volumes",
-				"secret-scyllaDBManagerAgent.volumes",
-				"secret-rackTemplate.scyllaDB.volumes",
-				"secret-rackTemplate.scyllaDBManagerAgent.volumes",
-				"secret-racks[].scyllaDB.volumes",
-				"secret-racks[].scyllaDBManagerAgent.volumes",
+			name: "lowest ordinals are selected in every rack",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				SeedsPerRack: pointer.Ptr[int32](2),
 			},
-			expectedConfigMapNames: []string{
-				"configmap-scylladb.volumes",
-				"configmap-scyllaDBManagerAgent.volumes",
-				"configmap-rackTemplate.scyllaDB.volumes",
-				"configmap-rackTemplate.scyllaDBManagerAgent.volumes",
-				"configmap-racks[].scyllaDB.volumes",
-				"configmap-racks[].scyllaDBManagerAgent.volumes",
+			expectedSeedNodes: []string{
+				"cluster-dc1-a-0",
+				"cluster-dc1-a-2",
+				"cluster-dc1-b-0",
+				"cluster-dc1-b-1",
 			},
 		},
 		{
-			name: "All possible secrets from CustomConfigSecretRef",
-			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-					CustomConfigSecretRef: pointer.Ptr("secret-scyllaDBManagerAgent.customConfigSecretRef"),
-				},
-				RackTemplate: &scyllav1alpha1.RackTemplate{
-					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-						CustomConfigSecretRef: pointer.Ptr("secret-rackTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
-					},
-				},
-				Racks: []scyllav1alpha1.RackSpec{
-					{
-						RackTemplate: scyllav1alpha1.RackTemplate{
-							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
-								CustomConfigSecretRef: pointer.Ptr("secret-racks[].scyllaDBManagerAgent.customConfigSecretRef"),
-							},
-						},
-					},
-				},
+			name: "nodes under maintenance are excluded",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				SeedsPerRack:                 pointer.Ptr[int32](1),
+				ExcludeNodesUnderMaintenance: true,
 			},
-			expectedSecretNames: []string{
-				"secret-scyllaDBManagerAgent.customConfigSecretRef",
-				"secret-rackTemplate.scyllaDBManagerAgent.customConfigSecretRef",
-				"secret-racks[].scyllaDBManagerAgent.customConfigSecretRef",
+			expectedSeedNodes: []string{
+				"cluster-dc1-a-2",
+				"cluster-dc1-b-0",
 			},
-			expectedConfigMapNames: []string{},
 		},
 		{
-			name: "All possible configmaps from CustomConfigMapRef",
-			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
-				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-					CustomConfigMapRef: pointer.Ptr("configmap-scyllaDB.customConfigMapRef"),
-				},
-				RackTemplate: &scyllav1alpha1.RackTemplate{
-					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-						CustomConfigMapRef: pointer.Ptr("configmap-rackTemplate.scyllaDB.customConfigMapRef"),
-					},
-				},
-				Racks: []scyllav1alpha1.RackSpec{
-					{
-						RackTemplate: scyllav1alpha1.RackTemplate{
-							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
-								CustomConfigMapRef: pointer.Ptr("configmap-racks[].scyllaDB.customConfigMapRef"),
-							},
-						},
-					},
-				},
+			name: "only preferred racks are used",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				PreferredRacks: []string{"b"},
 			},
-			expectedSecretNames: []string{},
-			expectedConfigMapNames: []string{
-				"configmap-scyllaDB.customConfigMapRef",
-				"configmap-rackTemplate.scyllaDB.customConfigMapRef",
-				"configmap-racks[].scyllaDB.customConfigMapRef",
+			expectedSeedNodes: []string{
+				"cluster-dc1-b-0",
+				"cluster-dc1-b-1",
+			},
+		},
+		{
+			name: "all racks are used when preferred racks don't exist",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				SeedsPerRack:   pointer.Ptr[int32](1),
+				PreferredRacks: []string{"c"},
+			},
+			expectedSeedNodes: []string{
+				"cluster-dc1-a-0",
+				"cluster-dc1-b-0",
 			},
 		},
 	}
@@ -5492,70 +5691,143 @@ func Test_getSecretsToMirrorForDC(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotConfigMapNames, gotSecretNames := getConfigMapsAndSecretsToMirrorForDC(tc.dc)
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.SeedPolicy = tc.seedPolicy
 
-			// Sort before comparison to ensure order does not affect the test.
-			sort.Strings(tc.expectedConfigMapNames)
-			sort.Strings(tc.expectedSecretNames)
-			sort.Strings(gotConfigMapNames)
-			sort.Strings(gotSecretNames)
+			fakeClusterIndexer := newFakeClusterIndexer(t, map[string][]apimachineryruntime.Object{
+				"dc1-rkc": services,
+			})
+			remoteServiceLister := remotelister.NewClusterLister(corev1listers.NewServiceLister, fakeClusterIndexer)
 
-			if !slices.Equal(gotConfigMapNames, tc.expectedConfigMapNames) {
-				t.Errorf("expected and got config map names differ: %s", cmp.Diff(gotConfigMapNames, tc.expectedConfigMapNames))
+			isSeedNode, err := makeSeedNodeFilter(sc, sc.Spec.Datacenters[0], remoteNamespace, naming.DatacenterPodsSelector(sc, &sc.Spec.Datacenters[0]), remoteServiceLister)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
 			}
-			if !slices.Equal(gotSecretNames, tc.expectedSecretNames) {
-				t.Errorf("expected and got secret names differ: %s", cmp.Diff(gotSecretNames, tc.expectedSecretNames))
+
+			var seedNodes []string
+			for _, nodeName := range allNodeNames {
+				if isSeedNode == nil || isSeedNode(nodeName) {
+					seedNodes = append(seedNodes, nodeName)
+				}
+			}
+
+			if !reflect.DeepEqual(seedNodes, tc.expectedSeedNodes) {
+				t.Errorf("expected and got seed nodes differ:\n%s\n", cmp.Diff(tc.expectedSeedNodes, seedNodes))
 			}
 		})
 	}
 }
 
-func Test_collectSecretNamesFromVolumes(t *testing.T) {
+func Test_calculateSeedsForDatacenter(t *testing.T) {
 	t.Parallel()
 
+	newMemberService := func(sdcName, name string, underMaintenance bool) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "scylla-abc",
+				Labels: map[string]string{
+					"app":                          "scylla",
+					"app.kubernetes.io/name":       "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"scylla/cluster":               sdcName,
+					"scylla/rack":                  "a",
+					"scylla-operator.scylladb.com/scylla-service-type": "member",
+				},
+			},
+		}
+		if underMaintenance {
+			svc.Labels["scylla/node-maintenance"] = ""
+		}
+		return svc
+	}
+
+	newRolledOutSDC := func(name string) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "scylla-abc",
+				Generation: 1,
+			},
+			Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               scyllav1alpha1.AvailableCondition,
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+					{
+						Type:               scyllav1alpha1.ProgressingCondition,
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 1,
+					},
+					{
+						Type:               scyllav1alpha1.DegradedCondition,
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		}
+	}
+
 	tt := []struct {
-		name     string
-		volumes  []corev1.Volume
-		expected []string
+		name          string
+		seedPolicy    *scyllav1alpha1.ScyllaDBClusterSeedPolicy
+		existing      map[string][]apimachineryruntime.Object
+		expectedSeeds []string
 	}{
 		{
-			name:     "No volumes",
-			volumes:  []corev1.Volume{},
-			expected: []string{},
+			name:       "all rolled out datacenters are seeds without a seed policy",
+			seedPolicy: nil,
+			existing: map[string][]apimachineryruntime.Object{
+				"dc3-rkc": {
+					newMemberService("cluster-dc3", "cluster-dc3-a-0", true),
+				},
+			},
+			expectedSeeds: []string{
+				"seed.example.com",
+				"cluster-dc2-seed.scylla-abc.svc",
+				"cluster-dc3-seed.scylla-abc.svc",
+			},
 		},
 		{
-			name: "Volumes with secret",
-			volumes: []corev1.Volume{
-				{
-					Name: "test-volume",
-					VolumeSource: corev1.VolumeSource{
-						Secret: &corev1.SecretVolumeSource{
-							SecretName: "s1",
-						},
-					},
+			name: "datacenters without eligible seed nodes are left out",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				ExcludeNodesUnderMaintenance: true,
+			},
+			existing: map[string][]apimachineryruntime.Object{
+				"dc2-rkc": {
+					newMemberService("cluster-dc2", "cluster-dc2-a-0", true),
+					newMemberService("cluster-dc2", "cluster-dc2-a-1", false),
 				},
-				{
-					Name: "test-volume",
-					VolumeSource: corev1.VolumeSource{
-						Secret: &corev1.SecretVolumeSource{
-							SecretName: "s2",
-						},
-					},
+				"dc3-rkc": {
+					newMemberService("cluster-dc3", "cluster-dc3-a-0", true),
 				},
 			},
-			expected: []string{"s1", "s2"},
+			expectedSeeds: []string{
+				"seed.example.com",
+				"cluster-dc2-seed.scylla-abc.svc",
+			},
 		},
 		{
-			name: "Volume with no secret",
-			volumes: []corev1.Volume{
-				{
-					Name: "test-volume",
-					VolumeSource: corev1.VolumeSource{
-						EmptyDir: &corev1.EmptyDirVolumeSource{},
-					},
+			name: "all datacenters are seeds when none has eligible seed nodes",
+			seedPolicy: &scyllav1alpha1.ScyllaDBClusterSeedPolicy{
+				ExcludeNodesUnderMaintenance: true,
+			},
+			existing: map[string][]apimachineryruntime.Object{
+				"dc2-rkc": {
+					newMemberService("cluster-dc2", "cluster-dc2-a-0", true),
+				},
+				"dc3-rkc": {
+					newMemberService("cluster-dc3", "cluster-dc3-a-0", true),
 				},
 			},
-			expected: []string{},
+			expectedSeeds: []string{
+				"seed.example.com",
+				"cluster-dc2-seed.scylla-abc.svc",
+				"cluster-dc3-seed.scylla-abc.svc",
+			},
 		},
 	}
 
@@ -5563,175 +5835,1172 @@ func Test_collectSecretNamesFromVolumes(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := collectSecretNamesFromVolumes(tc.volumes)
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.SeedPolicy = tc.seedPolicy
+			sc.Spec.ScyllaDB.ExternalSeeds = []string{"seed.example.com"}
+			sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{Name: "dc1", RemoteKubernetesClusterName: "dc1-rkc"},
+				{Name: "dc2", RemoteKubernetesClusterName: "dc2-rkc"},
+				{Name: "dc3", RemoteKubernetesClusterName: "dc3-rkc"},
+			}
 
-			// Sort before comparison to ensure order does not affect the test.
-			sort.Strings(tc.expected)
-			sort.Strings(got)
+			remoteNamespaces := map[string]*corev1.Namespace{}
+			remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+			for _, dc := range sc.Spec.Datacenters {
+				remoteNamespaces[dc.RemoteKubernetesClusterName] = &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "scylla-abc",
+					},
+				}
 
-			if !slices.Equal(got, tc.expected) {
-				t.Errorf("expected and got secret names differ: %s", cmp.Diff(tc.expected, got))
+				sdcName := naming.ScyllaDBDatacenterName(sc, &dc)
+				remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+					sdcName: newRolledOutSDC(sdcName),
+				}
+			}
+
+			remoteServiceLister := remotelister.NewClusterLister(corev1listers.NewServiceLister, newFakeClusterIndexer(t, tc.existing))
+
+			seeds, err := calculateSeedsForDatacenter(sc, &sc.Spec.Datacenters[0], remoteScyllaDBDatacenters, remoteNamespaces["dc1-rkc"], remoteNamespaces, remoteServiceLister)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(seeds, tc.expectedSeeds) {
+				t.Errorf("expected and got seeds differ:\n%s\n", cmp.Diff(tc.expectedSeeds, seeds))
 			}
 		})
 	}
 }
 
-func makeVolumesReferringSecret(secretName string) []corev1.Volume {
-	return []corev1.Volume{
+func Test_mergePortSpecSlices(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		xs       [][]portSpec
+		expected []portSpec
+	}{
 		{
-			Name: "test-volume",
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: secretName,
+			name:     "no slices",
+			xs:       [][]portSpec{},
+			expected: nil,
+		},
+		{
+			name: "single empty slice",
+			xs: [][]portSpec{
+				{},
+			},
+			expected: []portSpec{},
+		},
+		{
+			name: "single non-empty slice",
+			xs: [][]portSpec{
+				{
+					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
 				},
 			},
+			expected: []portSpec{
+				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+			},
 		},
-	}
-}
-
-func makeVolumesReferringConfigMap(configMapName string) []corev1.Volume {
-	return []corev1.Volume{
 		{
-			Name: "test-volume",
-			VolumeSource: corev1.VolumeSource{
-				ConfigMap: &corev1.ConfigMapVolumeSource{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: configMapName,
-					},
+			name: "multiple slices with unique specs",
+			xs: [][]portSpec{
+				{
+					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+				},
+				{
+					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+				},
+				{
+					{name: "dns", port: 53, protocol: corev1.ProtocolUDP},
+				},
+			},
+			expected: []portSpec{
+				{name: "dns", port: 53, protocol: corev1.ProtocolUDP},
+				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "multiple slices with duplicate specs",
+			xs: [][]portSpec{
+				{
+					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+					{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+				},
+				{
+					{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+				},
+			},
+			expected: []portSpec{
+				{name: "http", port: 80, protocol: corev1.ProtocolTCP},
+				{name: "https", port: 443, protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "slice with duplicate names but different specs",
+			xs: [][]portSpec{
+				{
+					{name: "test", port: 443, protocol: corev1.ProtocolTCP},
+					{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+				},
+			},
+			expected: []portSpec{
+				{name: "test", port: 443, protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "multiple slices with duplicate names but different specs",
+			xs: [][]portSpec{
+				{
+					{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+				},
+				{
+					{name: "test", port: 443, protocol: corev1.ProtocolTCP},
 				},
 			},
+			expected: []portSpec{
+				{name: "test", port: 80, protocol: corev1.ProtocolTCP},
+			},
 		},
 	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := mergeAndCompactPortSpecSlices(tc.xs...)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got port specs differ: %s", cmp.Diff(tc.expected, got, cmpopts.EquateComparable(portSpec{})))
+			}
+		})
+	}
 }
 
-func Test_makeLocalScyllaDBManagerAgentAuthTokenSecret(t *testing.T) {
+func Test_getConfigMapsAndSecretsToMirrorForAllDCs(t *testing.T) {
 	t.Parallel()
 
 	tt := []struct {
-		name        string
-		sc          *scyllav1alpha1.ScyllaDBCluster
-		authToken   string
-		expected    *corev1.Secret
-		expectedErr error
+		name                   string
+		sc                     *scyllav1alpha1.ScyllaDBCluster
+		expectedSecretNames    []string
+		expectedConfigMapNames []string
+		expectedErr            error
 	}{
 		{
-			name:      "basic",
-			sc:        newBasicScyllaDBCluster(),
-			authToken: "test-auth-token",
-			expected: &corev1.Secret{
+			name: "Operator-managed secrets to mirror only",
+			sc: &scyllav1alpha1.ScyllaDBCluster{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "cluster-auth-token-2s75a",
-					Namespace: "scylla",
-					Labels: map[string]string{
-						"app.kubernetes.io/name":                            "scylla.scylladb.com",
-						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
-						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
-					},
-					Annotations: map[string]string{},
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion:         "scylla.scylladb.com/v1alpha1",
-							Kind:               "ScyllaDBCluster",
-							Name:               "cluster",
-							UID:                "uid",
-							Controller:         pointer.Ptr(true),
-							BlockOwnerDeletion: pointer.Ptr(true),
+					Name: "scylla",
+				},
+			},
+			expectedSecretNames: []string{
+				"scylla-auth-token-1lt9p",
+			},
+			expectedConfigMapNames: []string{},
+			expectedErr:            nil,
+		},
+		{
+			name: "CQL credentials secret used by datacenter operations",
+			sc: &scyllav1alpha1.ScyllaDBCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla",
+				},
+				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+					ReplicationOptions: &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+						CQLCredentialsSecretRef: &scyllav1alpha1.LocalObjectReference{
+							Name: "cql-credentials",
 						},
 					},
 				},
-				Type: corev1.SecretTypeOpaque,
-				Data: map[string][]byte{
-					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
-				},
 			},
-			expectedErr: nil,
+			expectedSecretNames: []string{
+				"cql-credentials",
+				"scylla-auth-token-1lt9p",
+			},
+			expectedConfigMapNames: []string{},
+			expectedErr:            nil,
 		},
 		{
-			name: "with custom labels",
-			sc: func() *scyllav1alpha1.ScyllaDBCluster {
-				sc := newBasicScyllaDBCluster()
-				sc.Spec.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
-					Labels: map[string]string{
-						"custom-label1": "value1",
-						"custom-label2": "value2",
-					},
-				}
-				return sc
-			}(),
-			authToken: "test-auth-token",
-			expected: &corev1.Secret{
+			name: "All possible secrets and configmaps from custom config",
+			sc: &scyllav1alpha1.ScyllaDBCluster{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "cluster-auth-token-2s75a",
-					Namespace: "scylla",
-					Labels: map[string]string{
-						"app.kubernetes.io/name":                            "scylla.scylladb.com",
-						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
-						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
-						"custom-label1":                                     "value1",
-						"custom-label2":                                     "value2",
-					},
-					Annotations: map[string]string{},
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion:         "scylla.scylladb.com/v1alpha1",
-							Kind:               "ScyllaDBCluster",
-							Name:               "cluster",
-							UID:                "uid",
-							Controller:         pointer.Ptr(true),
-							BlockOwnerDeletion: pointer.Ptr(true),
+					Name: "scylla",
+				},
+				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+					DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+						ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+							CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.scyllaDB.customConfigMapRef"),
+						},
+						ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+							CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
+						},
+						RackTemplate: &scyllav1alpha1.RackTemplate{
+							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+								CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.rackTemplate.scyllaDB.customConfigMapRef"),
+							},
+							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+								CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
+							},
+						},
+						Racks: []scyllav1alpha1.RackSpec{
+							{
+								RackTemplate: scyllav1alpha1.RackTemplate{
+									ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+										CustomConfigMapRef: pointer.Ptr("configmap-datacenterTemplate.racks[].scyllaDB.customConfigMapRef"),
+									},
+									ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+										CustomConfigSecretRef: pointer.Ptr("secret-datacenterTemplate.racks[].scyllaDBManagerAgent.customConfigSecretRef"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Type: corev1.SecretTypeOpaque,
-				Data: map[string][]byte{
-					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
-				},
 			},
-			expectedErr: nil,
+			expectedSecretNames: []string{
+				"scylla-auth-token-1lt9p",
+				"secret-datacenterTemplate.scyllaDBManagerAgent.customConfigSecretRef",
+				"secret-datacenterTemplate.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef",
+				"secret-datacenterTemplate.racks[].scyllaDBManagerAgent.customConfigSecretRef",
+			},
+			expectedConfigMapNames: []string{
+				"configmap-datacenterTemplate.scyllaDB.customConfigMapRef",
+				"configmap-datacenterTemplate.rackTemplate.scyllaDB.customConfigMapRef",
+				"configmap-datacenterTemplate.racks[].scyllaDB.customConfigMapRef",
+			},
 		},
 		{
-			name: "with custom annotations",
-			sc: func() *scyllav1alpha1.ScyllaDBCluster {
-				sc := newBasicScyllaDBCluster()
-				sc.Spec.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
-					Annotations: map[string]string{
-						"custom-annotation1": "value1",
-						"custom-annotation2": "value2",
-					},
-				}
-				return sc
-			}(),
-			authToken: "test-auth-token",
-			expected: &corev1.Secret{
+			name: "All possible secrets and configmaps from Volumes",
+			sc: &scyllav1alpha1.ScyllaDBCluster{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "cluster-auth-token-2s75a",
-					Namespace: "scylla",
-					Labels: map[string]string{
-						"app.kubernetes.io/name":                            "scylla.scylladb.com",
-						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
-						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
-					},
-					Annotations: map[string]string{
-						"custom-annotation1": "value1",
-						"custom-annotation2": "value2",
+					Name: "scylla",
+				},
+				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+					DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+						ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+							Volumes: slices.Concat(
+								makeVolumesReferringSecret("secret-scylladb.volumes"),
+								makeVolumesReferringConfigMap("configmap-scylladb.volumes"),
+							),
+						},
+						ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+							Volumes: slices.Concat(
+								makeVolumesReferringSecret("secret-scyllaDBManagerAgent.volumes"),
+								makeVolumesReferringConfigMap("configmap-scyllaDBManagerAgent.volumes"),
+							),
+						},
+						RackTemplate: &scyllav1alpha1.RackTemplate{
+							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+								Volumes: slices.Concat(
+									makeVolumesReferringSecret("secret-rackTemplate.scyllaDB.volumes"),
+									makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDB.volumes"),
+								),
+							},
+							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+								Volumes: slices.Concat(
+									makeVolumesReferringSecret("secret-rackTemplate.scyllaDBManagerAgent.volumes"),
+									makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDBManagerAgent.volumes"),
+								),
+							},
+						},
+						Racks: []scyllav1alpha1.RackSpec{
+							{
+								RackTemplate: scyllav1alpha1.RackTemplate{
+									ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+										Volumes: slices.Concat(
+											makeVolumesReferringSecret("secret-racks[].scyllaDB.volumes"),
+											makeVolumesReferringConfigMap("configmap-racks[].scyllaDB.volumes"),
+										),
+									},
+									ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+										Volumes: slices.Concat(
+											makeVolumesReferringSecret("secret-racks[].scyllaDBManagerAgent.volumes"),
+											makeVolumesReferringConfigMap("configmap-racks[].scyllaDBManagerAgent.volumes"),
+										),
+									},
+								},
+							},
+						},
 					},
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion:         "scylla.scylladb.com/v1alpha1",
-							Kind:               "ScyllaDBCluster",
-							Name:               "cluster",
-							UID:                "uid",
-							Controller:         pointer.Ptr(true),
-							BlockOwnerDeletion: pointer.Ptr(true),
+				},
+			},
+			expectedSecretNames: []string{
+				"scylla-auth-token-1lt9p",
+				"secret-scylladb.volumes",
+				"secret-scyllaDBManagerAgent.volumes",
+				"secret-rackTemplate.scyllaDB.volumes",
+				"secret-rackTemplate.scyllaDBManagerAgent.volumes",
+				"secret-racks[].scyllaDB.volumes",
+				"secret-racks[].scyllaDBManagerAgent.volumes",
+			},
+			expectedConfigMapNames: []string{
+				"configmap-scylladb.volumes",
+				"configmap-scyllaDBManagerAgent.volumes",
+				"configmap-rackTemplate.scyllaDB.volumes",
+				"configmap-rackTemplate.scyllaDBManagerAgent.volumes",
+				"configmap-racks[].scyllaDB.volumes",
+				"configmap-racks[].scyllaDBManagerAgent.volumes",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotConfigMapNames, gotSecretNames, err := getConfigMapsAndSecretsToMirrorForAllDCs(tc.sc)
+
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ: %s", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			// Sort before comparison to ensure order does not affect the test.
+			sort.Strings(tc.expectedConfigMapNames)
+			sort.Strings(tc.expectedSecretNames)
+			sort.Strings(gotConfigMapNames)
+			sort.Strings(gotSecretNames)
+
+			if !slices.Equal(gotConfigMapNames, tc.expectedConfigMapNames) {
+				t.Errorf("expected and got config map names differ: %s", cmp.Diff(gotConfigMapNames, tc.expectedConfigMapNames))
+			}
+
+			if !slices.Equal(gotSecretNames, tc.expectedSecretNames) {
+				t.Errorf("expected and got secret names differ: %s", cmp.Diff(gotSecretNames, tc.expectedSecretNames))
+			}
+		})
+	}
+}
+
+func Test_getSecretsToMirrorForDC(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                   string
+		dc                     *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate
+		expectedSecretNames    []string
+		expectedConfigMapNames []string
+	}{
+		{
+			name:                "No secrets nor configmaps to mirror",
+			dc:                  &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{},
+			expectedSecretNames: nil,
+		},
+		{
+			name: "All possible secrets and configmaps from Volumes",
+			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					Volumes: slices.Concat(
+						makeVolumesReferringSecret("secret-scylladb.volumes"),
+						makeVolumesReferringConfigMap("configmap-scylladb.volumes"),
+					),
+				},
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+					Volumes: slices.Concat(
+						makeVolumesReferringSecret("secret-scyllaDBManagerAgent.volumes"),
+						makeVolumesReferringConfigMap("configmap-scyllaDBManagerAgent.volumes"),
+					),
+				},
+				RackTemplate: &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Volumes: slices.Concat(
+							makeVolumesReferringSecret("secret-rackTemplate.scyllaDB.volumes"),
+							makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDB.volumes"),
+						),
+					},
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+						Volumes: slices.Concat(
+							makeVolumesReferringSecret("secret-rackTemplate.scyllaDBManagerAgent.volumes"),
+							makeVolumesReferringConfigMap("configmap-rackTemplate.scyllaDBManagerAgent.volumes"),
+						),
+					},
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+								Volumes: slices.Concat(
+									makeVolumesReferringSecret("secret-racks[].scyllaDB.volumes"),
+									makeVolumesReferringConfigMap("configmap-racks[].scyllaDB.volumes"),
+								),
+							},
+							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+								Volumes: slices.Concat(
+									makeVolumesReferringSecret("secret-racks[].scyllaDBManagerAgent.volumes"),
+									makeVolumesReferringConfigMap("configmap-racks[].scyllaDBManagerAgent.volumes"),
+								),
+							},
+						},
+					},
+				},
+			},
+			expectedSecretNames: []string{
+				"secret-scylladb.volumes",
+				"secret-scyllaDBManagerAgent.volumes",
+				"secret-rackTemplate.scyllaDB.volumes",
+				"secret-rackTemplate.scyllaDBManagerAgent.volumes",
+				"secret-racks[].scyllaDB.volumes",
+				"secret-racks[].scyllaDBManagerAgent.volumes",
+			},
+			expectedConfigMapNames: []string{
+				"configmap-scylladb.volumes",
+				"configmap-scyllaDBManagerAgent.volumes",
+				"configmap-rackTemplate.scyllaDB.volumes",
+				"configmap-rackTemplate.scyllaDBManagerAgent.volumes",
+				"configmap-racks[].scyllaDB.volumes",
+				"configmap-racks[].scyllaDBManagerAgent.volumes",
+			},
+		},
+		{
+			name: "All possible secrets from CustomConfigSecretRef",
+			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+					CustomConfigSecretRef: pointer.Ptr("secret-scyllaDBManagerAgent.customConfigSecretRef"),
+				},
+				RackTemplate: &scyllav1alpha1.RackTemplate{
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+						CustomConfigSecretRef: pointer.Ptr("secret-rackTemplate.scyllaDBManagerAgent.customConfigSecretRef"),
+					},
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgentTemplate{
+								CustomConfigSecretRef: pointer.Ptr("secret-racks[].scyllaDBManagerAgent.customConfigSecretRef"),
+							},
+						},
+					},
+				},
+			},
+			expectedSecretNames: []string{
+				"secret-scyllaDBManagerAgent.customConfigSecretRef",
+				"secret-rackTemplate.scyllaDBManagerAgent.customConfigSecretRef",
+				"secret-racks[].scyllaDBManagerAgent.customConfigSecretRef",
+			},
+			expectedConfigMapNames: []string{},
+		},
+		{
+			name: "All possible configmaps from CustomConfigMapRef",
+			dc: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					CustomConfigMapRef: pointer.Ptr("configmap-scyllaDB.customConfigMapRef"),
+				},
+				RackTemplate: &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						CustomConfigMapRef: pointer.Ptr("configmap-rackTemplate.scyllaDB.customConfigMapRef"),
+					},
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+								CustomConfigMapRef: pointer.Ptr("configmap-racks[].scyllaDB.customConfigMapRef"),
+							},
+						},
+					},
+				},
+			},
+			expectedSecretNames: []string{},
+			expectedConfigMapNames: []string{
+				"configmap-scyllaDB.customConfigMapRef",
+				"configmap-rackTemplate.scyllaDB.customConfigMapRef",
+				"configmap-racks[].scyllaDB.customConfigMapRef",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotConfigMapNames, gotSecretNames := getConfigMapsAndSecretsToMirrorForDC(tc.dc)
+
+			// Sort before comparison to ensure order does not affect the test.
+			sort.Strings(tc.expectedConfigMapNames)
+			sort.Strings(tc.expectedSecretNames)
+			sort.Strings(gotConfigMapNames)
+			sort.Strings(gotSecretNames)
+
+			if !slices.Equal(gotConfigMapNames, tc.expectedConfigMapNames) {
+				t.Errorf("expected and got config map names differ: %s", cmp.Diff(gotConfigMapNames, tc.expectedConfigMapNames))
+			}
+			if !slices.Equal(gotSecretNames, tc.expectedSecretNames) {
+				t.Errorf("expected and got secret names differ: %s", cmp.Diff(gotSecretNames, tc.expectedSecretNames))
+			}
+		})
+	}
+}
+
+func Test_collectSecretNamesFromVolumes(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		volumes  []corev1.Volume
+		expected []string
+	}{
+		{
+			name:     "No volumes",
+			volumes:  []corev1.Volume{},
+			expected: []string{},
+		},
+		{
+			name: "Volumes with secret",
+			volumes: []corev1.Volume{
+				{
+					Name: "test-volume",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "s1",
+						},
+					},
+				},
+				{
+					Name: "test-volume",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "s2",
+						},
+					},
+				},
+			},
+			expected: []string{"s1", "s2"},
+		},
+		{
+			name: "Volume with no secret",
+			volumes: []corev1.Volume{
+				{
+					Name: "test-volume",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := collectSecretNamesFromVolumes(tc.volumes)
+
+			// Sort before comparison to ensure order does not affect the test.
+			sort.Strings(tc.expected)
+			sort.Strings(got)
+
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("expected and got secret names differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func makeVolumesReferringSecret(secretName string) []corev1.Volume {
+	return []corev1.Volume{
+		{
+			Name: "test-volume",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		},
+	}
+}
+
+func makeVolumesReferringConfigMap(configMapName string) []corev1.Volume {
+	return []corev1.Volume{
+		{
+			Name: "test-volume",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: configMapName,
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_makeLocalScyllaDBManagerAgentAuthTokenSecret(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		sc          *scyllav1alpha1.ScyllaDBCluster
+		authToken   string
+		expected    *corev1.Secret
+		expectedErr error
+	}{
+		{
+			name:      "basic",
+			sc:        newBasicScyllaDBCluster(),
+			authToken: "test-auth-token",
+			expected: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-auth-token-2s75a",
+					Namespace: "scylla",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":                            "scylla.scylladb.com",
+						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
+						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
+					},
+					Annotations: map[string]string{},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBCluster",
+							Name:               "cluster",
+							UID:                "uid",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "with custom labels",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newBasicScyllaDBCluster()
+				sc.Spec.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
+					Labels: map[string]string{
+						"custom-label1": "value1",
+						"custom-label2": "value2",
+					},
+				}
+				return sc
+			}(),
+			authToken: "test-auth-token",
+			expected: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-auth-token-2s75a",
+					Namespace: "scylla",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":                            "scylla.scylladb.com",
+						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
+						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
+						"custom-label1":                                     "value1",
+						"custom-label2":                                     "value2",
+					},
+					Annotations: map[string]string{},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBCluster",
+							Name:               "cluster",
+							UID:                "uid",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "with custom annotations",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newBasicScyllaDBCluster()
+				sc.Spec.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
+					Annotations: map[string]string{
+						"custom-annotation1": "value1",
+						"custom-annotation2": "value2",
+					},
+				}
+				return sc
+			}(),
+			authToken: "test-auth-token",
+			expected: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-auth-token-2s75a",
+					Namespace: "scylla",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":                            "scylla.scylladb.com",
+						"app.kubernetes.io/managed-by":                      "scylla-operator.scylladb.com",
+						"scylla-operator.scylladb.com/scylladbcluster-name": "cluster",
+					},
+					Annotations: map[string]string{
+						"custom-annotation1": "value1",
+						"custom-annotation2": "value2",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBCluster",
+							Name:               "cluster",
+							UID:                "uid",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeLocalScyllaDBManagerAgentAuthTokenSecret(tc.sc, tc.authToken)
+
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got secret differ:\n%s\n", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_makeExternalScyllaDBDatacenterNodesStatusReports(t *testing.T) {
+	t.Parallel()
+
+	newMockMultiDCScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		return &scyllav1alpha1.ScyllaDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+					{
+						Name:                        "dc1",
+						RemoteKubernetesClusterName: "dc1-rkc",
+					},
+					{
+						Name:                        "dc2",
+						RemoteKubernetesClusterName: "dc2-rkc",
+					},
+					{
+						Name:                        "dc3",
+						RemoteKubernetesClusterName: "dc3-rkc",
+					},
+				},
+			},
+		}
+	}
+
+	newMockScyllaDBClusterDatacenter := func() *scyllav1alpha1.ScyllaDBClusterDatacenter {
+		return &scyllav1alpha1.ScyllaDBClusterDatacenter{
+			Name:                        "dc1",
+			RemoteKubernetesClusterName: "dc1-rkc",
+		}
+	}
+
+	newMockScyllaDBClusterDatacenterRemoteNamespace := func() *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "dc1-rkc-remote-namespace",
+			},
+		}
+	}
+
+	newMockRemoteController := func() *scyllav1alpha1.RemoteOwner {
+		return &scyllav1alpha1.RemoteOwner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "remote-owner",
+				UID:  "1234",
+			},
+		}
+	}
+
+	newMockRemoteNamespaces := func() map[string]*corev1.Namespace {
+		return map[string]*corev1.Namespace{
+			"dc1-rkc": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dc1-rkc-remote-namespace",
+				},
+			},
+			"dc2-rkc": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dc2-rkc-remote-namespace",
+				},
+			},
+			"dc3-rkc": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dc3-rkc-remote-namespace",
+				},
+			},
+		}
+	}
+
+	newMockRemoteScyllaDBDatacenters := func() map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter {
+		return map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+			"dc1-rkc": {
+				"cluster-dc1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc1",
+						Namespace: "dc1-rkc-remote-namespace",
+					},
+				},
+			},
+			"dc2-rkc": {
+				"cluster-dc2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc2",
+						Namespace: "dc2-rkc-remote-namespace",
+					},
+				},
+			},
+			"dc3-rkc": {
+				"cluster-dc3": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc3",
+						Namespace: "dc3-rkc-remote-namespace",
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                          string
+		sc                            *scyllav1alpha1.ScyllaDBCluster
+		dc                            *scyllav1alpha1.ScyllaDBClusterDatacenter
+		remoteNamespace               *corev1.Namespace
+		remoteController              metav1.Object
+		remoteNamespaces              map[string]*corev1.Namespace
+		remoteScyllaDBDatacenters     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter
+		existing                      map[string][]apimachineryruntime.Object
+		expected                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		expectedProgressingConditions []metav1.Condition
+		expectedErr                   error
+	}{
+		{
+			name: "self dc",
+			sc: &scyllav1alpha1.ScyllaDBCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "scylla",
+				},
+				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+						{
+							Name:                        "dc1",
+							RemoteKubernetesClusterName: "dc1-rkc",
+						},
+					},
+				},
+			},
+			dc:               newMockScyllaDBClusterDatacenter(),
+			remoteNamespace:  newMockScyllaDBClusterDatacenterRemoteNamespace(),
+			remoteController: newMockRemoteController(),
+			remoteNamespaces: map[string]*corev1.Namespace{
+				"dc1-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc1-rkc-remote-namespace",
+					},
+				},
+			},
+			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
+			existing:                      map[string][]apimachineryruntime.Object{},
+			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
+		},
+		{
+			name:             "remote namespaces of other DCs don't exist",
+			sc:               newMockMultiDCScyllaDBCluster(),
+			dc:               newMockScyllaDBClusterDatacenter(),
+			remoteNamespace:  newMockScyllaDBClusterDatacenterRemoteNamespace(),
+			remoteController: newMockRemoteController(),
+			remoteNamespaces: map[string]*corev1.Namespace{
+				"dc1-rkc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc1-rkc-remote-namespace",
+					},
+				},
+			},
+			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
+			existing:                      map[string][]apimachineryruntime.Object{},
+			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
+		},
+		{
+			name:                          "remote SDCs for other DCs don't exist",
+			sc:                            newMockMultiDCScyllaDBCluster(),
+			dc:                            newMockScyllaDBClusterDatacenter(),
+			remoteNamespace:               newMockScyllaDBClusterDatacenterRemoteNamespace(),
+			remoteController:              newMockRemoteController(),
+			remoteNamespaces:              newMockRemoteNamespaces(),
+			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
+			existing:                      map[string][]apimachineryruntime.Object{},
+			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
+		},
+		{
+			name:                      "remote SDCs exist but their ScyllaDBNodesStatusReports do not",
+			sc:                        newMockMultiDCScyllaDBCluster(),
+			dc:                        newMockScyllaDBClusterDatacenter(),
+			remoteNamespace:           newMockScyllaDBClusterDatacenterRemoteNamespace(),
+			remoteController:          newMockRemoteController(),
+			remoteNamespaces:          newMockRemoteNamespaces(),
+			remoteScyllaDBDatacenters: newMockRemoteScyllaDBDatacenters(),
+			existing:                  map[string][]apimachineryruntime.Object{},
+			expected:                  []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
+			expectedProgressingConditions: []metav1.Condition{
+				{
+					Type:    "RemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterdc1Progressing",
+					Status:  "True",
+					Reason:  "WaitingForRemoteScyllaDBDatacenterNodesStatusReport",
+					Message: `Waiting for ScyllaDBDatacenterNodesStatusReport "dc2-rkc-remote-namespace/cluster-dc2-2n4hb" to be created in "dc2-rkc" Cluster`,
+				},
+				{
+					Type:    "RemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterdc1Progressing",
+					Status:  "True",
+					Reason:  "WaitingForRemoteScyllaDBDatacenterNodesStatusReport",
+					Message: `Waiting for ScyllaDBDatacenterNodesStatusReport "dc3-rkc-remote-namespace/cluster-dc3-11k4m" to be created in "dc3-rkc" Cluster`,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                      "remote SDCs and their ScyllaDBNodesStatusReports exist",
+			sc:                        newMockMultiDCScyllaDBCluster(),
+			dc:                        newMockScyllaDBClusterDatacenter(),
+			remoteNamespace:           newMockScyllaDBClusterDatacenterRemoteNamespace(),
+			remoteController:          newMockRemoteController(),
+			remoteNamespaces:          newMockRemoteNamespaces(),
+			remoteScyllaDBDatacenters: newMockRemoteScyllaDBDatacenters(),
+			existing: map[string][]apimachineryruntime.Object{
+				"dc1-rkc": {
+					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster-dc1-7p9ut",
+							Namespace: "dc1-rkc-remote-namespace",
+						},
+						DatacenterName: "dc1",
+						Racks: []scyllav1alpha1.RackNodesStatusReport{
+							{
+								Name: "rack1",
+								Nodes: []scyllav1alpha1.NodeStatusReport{
+									{
+										Ordinal: 0,
+										HostID:  pointer.Ptr("host-id-dc1-rack1-0"),
+										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
+											{
+												HostID: "host-id-dc1-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc2-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc3-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"dc2-rkc": {
+					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster-dc2-2n4hb",
+							Namespace: "dc2-rkc-remote-namespace",
+						},
+						DatacenterName: "dc2",
+						Racks: []scyllav1alpha1.RackNodesStatusReport{
+							{
+								Name: "rack1",
+								Nodes: []scyllav1alpha1.NodeStatusReport{
+									{
+										Ordinal: 0,
+										HostID:  pointer.Ptr("host-id-dc2-rack1-0"),
+										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
+											{
+												HostID: "host-id-dc1-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc2-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc3-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"dc3-rkc": {
+					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster-dc3-11k4m",
+							Namespace: "dc3-rkc-remote-namespace",
+						},
+						DatacenterName: "dc3",
+						Racks: []scyllav1alpha1.RackNodesStatusReport{
+							{
+								Name: "rack1",
+								Nodes: []scyllav1alpha1.NodeStatusReport{
+									{
+										Ordinal: 0,
+										HostID:  pointer.Ptr("host-id-dc3-rack1-0"),
+										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
+											{
+												HostID: "host-id-dc1-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc2-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+											{
+												HostID: "host-id-dc3-rack1-0",
+												Status: scyllav1alpha1.NodeStatusUp,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc2-external-hglri",
+						Namespace: "dc1-rkc-remote-namespace",
+						Labels: map[string]string{
+							"app.kubernetes.io/managed-by":                                                        "remote.scylla-operator.scylladb.com",
+							"scylla-operator.scylladb.com/managed-by-cluster":                                     "test-cluster.local",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name":                 "dc1",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-name":                            "cluster",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":                       "scylla",
+							"scylla-operator.scylladb.com/remote-cluster-scylladb-datacenter-nodes-status-report": "cluster",
+							"scylla-operator.scylladb.com/scylladb-datacenter-nodes-status-report-selector":       "cluster-dc1",
+						},
+						Annotations: map[string]string{},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "RemoteOwner",
+								Name:               "remote-owner",
+								UID:                "1234",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
+						},
+					},
+					DatacenterName: "dc2",
+					Racks: []scyllav1alpha1.RackNodesStatusReport{
+						{
+							Name: "rack1",
+							Nodes: []scyllav1alpha1.NodeStatusReport{
+								{
+									Ordinal: 0,
+									HostID:  pointer.Ptr("host-id-dc2-rack1-0"),
+									ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
+										{
+											HostID: "host-id-dc1-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+										{
+											HostID: "host-id-dc2-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+										{
+											HostID: "host-id-dc3-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-dc3-external-617i6",
+						Namespace: "dc1-rkc-remote-namespace",
+						Labels: map[string]string{
+							"app.kubernetes.io/managed-by":                                                        "remote.scylla-operator.scylladb.com",
+							"scylla-operator.scylladb.com/managed-by-cluster":                                     "test-cluster.local",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name":                 "dc1",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-name":                            "cluster",
+							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":                       "scylla",
+							"scylla-operator.scylladb.com/remote-cluster-scylladb-datacenter-nodes-status-report": "cluster",
+							"scylla-operator.scylladb.com/scylladb-datacenter-nodes-status-report-selector":       "cluster-dc1",
+						},
+						Annotations: map[string]string{},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "RemoteOwner",
+								Name:               "remote-owner",
+								UID:                "1234",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
+						},
+					},
+					DatacenterName: "dc3",
+					Racks: []scyllav1alpha1.RackNodesStatusReport{
+						{
+							Name: "rack1",
+							Nodes: []scyllav1alpha1.NodeStatusReport{
+								{
+									Ordinal: 0,
+									HostID:  pointer.Ptr("host-id-dc3-rack1-0"),
+									ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
+										{
+											HostID: "host-id-dc1-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+										{
+											HostID: "host-id-dc2-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+										{
+											HostID: "host-id-dc3-rack1-0",
+											Status: scyllav1alpha1.NodeStatusUp,
+										},
+									},
+								},
+							},
 						},
 					},
 				},
-				Type: corev1.SecretTypeOpaque,
-				Data: map[string][]byte{
-					"auth-token.yaml": []byte("auth_token: test-auth-token\n"),
-				},
 			},
-			expectedErr: nil,
+			expectedProgressingConditions: []metav1.Condition{},
+			expectedErr:                   nil,
 		},
 	}
 
@@ -5739,443 +7008,896 @@ func Test_makeLocalScyllaDBManagerAgentAuthTokenSecret(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := makeLocalScyllaDBManagerAgentAuthTokenSecret(tc.sc, tc.authToken)
+			fakeClusterIndexer := newFakeClusterIndexer(t, tc.existing)
+			remoteScyllaDBDatacenterNodesStatusReportLister := remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterNodesStatusReportLister, fakeClusterIndexer)
+
+			progressingConditions, scyllaDBDatacenterNodesStatusReports, err := makeExternalScyllaDBDatacenterNodesStatusReports(
+				tc.sc,
+				tc.dc,
+				tc.remoteNamespace,
+				tc.remoteController,
+				tc.remoteNamespaces,
+				tc.remoteScyllaDBDatacenters,
+				remoteScyllaDBDatacenterNodesStatusReportLister,
+				testClusterDomain,
+			)
 
 			if !reflect.DeepEqual(err, tc.expectedErr) {
 				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
 			}
 
-			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
-				t.Errorf("expected and got secret differ:\n%s\n", cmp.Diff(tc.expected, got))
+			if !equality.Semantic.DeepEqual(progressingConditions, tc.expectedProgressingConditions) {
+				t.Errorf("expected and got progressing conditions differ: %s", cmp.Diff(tc.expectedProgressingConditions, progressingConditions))
+			}
+
+			if !equality.Semantic.DeepEqual(scyllaDBDatacenterNodesStatusReports, tc.expected) {
+				t.Errorf("expected and got ScyllaDBDatacenterNodesStatusReports differ: %s", cmp.Diff(tc.expected, scyllaDBDatacenterNodesStatusReports))
 			}
 		})
 	}
 }
 
-func Test_makeExternalScyllaDBDatacenterNodesStatusReports(t *testing.T) {
+func TestMakeRemoteDatacenterOperationJobs(t *testing.T) {
 	t.Parallel()
 
-	newMockMultiDCScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
-		return &scyllav1alpha1.ScyllaDBCluster{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "cluster",
-				Namespace: "scylla",
-			},
-			Spec: scyllav1alpha1.ScyllaDBClusterSpec{
-				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
-					{
-						Name:                        "dc1",
-						RemoteKubernetesClusterName: "dc1-rkc",
-					},
-					{
-						Name:                        "dc2",
-						RemoteKubernetesClusterName: "dc2-rkc",
-					},
-					{
-						Name:                        "dc3",
-						RemoteKubernetesClusterName: "dc3-rkc",
-					},
-				},
-			},
-		}
-	}
-
-	newMockScyllaDBClusterDatacenter := func() *scyllav1alpha1.ScyllaDBClusterDatacenter {
-		return &scyllav1alpha1.ScyllaDBClusterDatacenter{
-			Name:                        "dc1",
-			RemoteKubernetesClusterName: "dc1-rkc",
-		}
-	}
-
-	newMockScyllaDBClusterDatacenterRemoteNamespace := func() *corev1.Namespace {
+	newRemoteNamespace := func() *corev1.Namespace {
 		return &corev1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "dc1-rkc-remote-namespace",
+				Name: "scylla-abc",
 			},
 		}
 	}
 
-	newMockRemoteController := func() *scyllav1alpha1.RemoteOwner {
+	newRemoteController := func() *scyllav1alpha1.RemoteOwner {
 		return &scyllav1alpha1.RemoteOwner{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "remote-owner",
-				UID:  "1234",
+				Name:      "cluster-111",
+				Namespace: "scylla-abc",
+				UID:       "1234",
 			},
 		}
 	}
 
-	newMockRemoteNamespaces := func() map[string]*corev1.Namespace {
-		return map[string]*corev1.Namespace{
-			"dc1-rkc": {
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "dc1-rkc-remote-namespace",
-				},
-			},
-			"dc2-rkc": {
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "dc2-rkc-remote-namespace",
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		sc := newBasicScyllaDBCluster()
+		sc.Spec.Datacenters = append(sc.Spec.Datacenters, scyllav1alpha1.ScyllaDBClusterDatacenter{
+			Name:                        "dc2",
+			RemoteKubernetesClusterName: "dc2-rkc",
+			RebuildFrom:                 pointer.Ptr("dc1"),
+		})
+		sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+			Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+				{
+					Name:              "ks",
+					ReplicationFactor: 3,
 				},
 			},
-			"dc3-rkc": {
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "dc3-rkc-remote-namespace",
-				},
+			CQLCredentialsSecretRef: &scyllav1alpha1.LocalObjectReference{
+				Name: "cql-credentials",
 			},
 		}
+		return sc
 	}
 
-	newMockRemoteScyllaDBDatacenters := func() map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter {
-		return map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{
-			"dc1-rkc": {
-				"cluster-dc1": {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "cluster-dc1",
-						Namespace: "dc1-rkc-remote-namespace",
+	newScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-dc2",
+				Namespace: "scylla-abc",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				DatacenterName: pointer.Ptr("dc2"),
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							Nodes: pointer.Ptr[int32](2),
+						},
+					},
+					{
+						Name: "b",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							Nodes: pointer.Ptr[int32](1),
+						},
 					},
 				},
 			},
-			"dc2-rkc": {
-				"cluster-dc2": {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "cluster-dc2",
-						Namespace: "dc2-rkc-remote-namespace",
-					},
+		}
+	}
+
+	newOperation := func(operationType scyllav1alpha1.ScyllaDBClusterDatacenterOperationType, phase scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase) *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus {
+		return &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+			Type:  operationType,
+			Phase: phase,
+		}
+	}
+
+	newJob := func(jobType string, args []string, volumes []corev1.Volume, volumeMounts []corev1.VolumeMount) *batchv1.Job {
+		labels := map[string]string{
+			"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc2",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+			"scylla-operator.scylladb.com/managed-by-cluster":                     "test-cluster.local",
+			"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+			"scylla-operator.scylladb.com/node-job-type":                          jobType,
+		}
+
+		podLabels := maps.Clone(labels)
+		podLabels["scylla-operator.scylladb.com/pod-type"] = "datacenter-operation-job"
+
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        fmt.Sprintf("cluster-dc2-%s", strings.ToLower(jobType)),
+				Namespace:   "scylla-abc",
+				Labels:      labels,
+				Annotations: map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(newRemoteController(), remoteControllerGVK),
 				},
 			},
-			"dc3-rkc": {
-				"cluster-dc3": {
+			Spec: batchv1.JobSpec{
+				ManualSelector: pointer.Ptr(false),
+				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "cluster-dc3",
-						Namespace: "dc3-rkc-remote-namespace",
+						Labels: podLabels,
+					},
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+						Containers: []corev1.Container{
+							{
+								Name:            "datacenter-operation",
+								Image:           "scylladb/scylla-operator:latest",
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args:            args,
+								VolumeMounts: append([]corev1.VolumeMount{
+									{
+										Name:      "scylladb-manager-agent-auth-token",
+										ReadOnly:  true,
+										MountPath: "/etc/scylla-operator/auth-token.yaml",
+										SubPath:   "auth-token.yaml",
+									},
+								}, volumeMounts...),
+							},
+						},
+						Volumes: append([]corev1.Volume{
+							{
+								Name: "scylladb-manager-agent-auth-token",
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{
+										SecretName: "cluster-auth-token-2s75a",
+									},
+								},
+							},
+						}, volumes...),
 					},
 				},
 			},
 		}
 	}
 
+	cqlCredentialsVolumes := []corev1.Volume{
+		{
+			Name: "cql-credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "cql-credentials",
+				},
+			},
+		},
+	}
+	cqlCredentialsVolumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "cql-credentials",
+			ReadOnly:  true,
+			MountPath: "/etc/scylla-operator/cql-credentials",
+		},
+	}
+
 	tt := []struct {
-		name                          string
-		sc                            *scyllav1alpha1.ScyllaDBCluster
-		dc                            *scyllav1alpha1.ScyllaDBClusterDatacenter
-		remoteNamespace               *corev1.Namespace
-		remoteController              metav1.Object
-		remoteNamespaces              map[string]*corev1.Namespace
-		remoteScyllaDBDatacenters     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter
-		existing                      map[string][]apimachineryruntime.Object
-		expected                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
-		expectedProgressingConditions []metav1.Condition
-		expectedErr                   error
+		name                 string
+		sc                   *scyllav1alpha1.ScyllaDBCluster
+		dcName               string
+		operation            *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus
+		operationDatacenters []string
+		sdc                  *scyllav1alpha1.ScyllaDBDatacenter
+		expectedJobs         []*batchv1.Job
+		expectedErr          error
 	}{
 		{
-			name: "self dc",
-			sc: &scyllav1alpha1.ScyllaDBCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "cluster",
-					Namespace: "scylla",
-				},
-				Spec: scyllav1alpha1.ScyllaDBClusterSpec{
-					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
-						{
-							Name:                        "dc1",
-							RemoteKubernetesClusterName: "dc1-rkc",
-						},
+			name:                 "no jobs without an operation",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            nil,
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs:         nil,
+			expectedErr:          nil,
+		},
+		{
+			name:                 "no jobs while datacenter is bootstrapping",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping),
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs:         nil,
+			expectedErr:          nil,
+		},
+		{
+			name:                 "no jobs when ScyllaDBDatacenter doesn't exist",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication),
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  nil,
+			expectedJobs:         nil,
+			expectedErr:          nil,
+		},
+		{
+			name:                 "replication job when datacenter is added",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication),
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs: []*batchv1.Job{
+				newJob(
+					"Replication",
+					[]string{
+						"replication-job",
+						"--node-address=cluster-dc2-dc2-a-0",
+						"--node-address=cluster-dc2-dc2-a-1",
+						"--node-address=cluster-dc2-dc2-b-0",
+						"--keyspace-replication-factor=ks=3",
+						"--datacenter=dc1",
+						"--datacenter=dc2",
+						"--cql-credentials-path=/etc/scylla-operator/cql-credentials",
+					},
+					cqlCredentialsVolumes,
+					cqlCredentialsVolumeMounts,
+				),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "replication job when datacenter is removed",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication),
+			operationDatacenters: []string{"dc1"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs: []*batchv1.Job{
+				newJob(
+					"Replication",
+					[]string{
+						"replication-job",
+						"--node-address=cluster-dc2-dc2-a-0",
+						"--node-address=cluster-dc2-dc2-a-1",
+						"--node-address=cluster-dc2-dc2-b-0",
+						"--keyspace-replication-factor=ks=3",
+						"--datacenter=dc1",
+						"--removed-datacenter=dc2",
+						"--cql-credentials-path=/etc/scylla-operator/cql-credentials",
+					},
+					cqlCredentialsVolumes,
+					cqlCredentialsVolumeMounts,
+				),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "rebuild job",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding),
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs: []*batchv1.Job{
+				newJob(
+					"Rebuild",
+					[]string{
+						"rebuild-job",
+						"--manager-auth-config-path=/etc/scylla-operator/auth-token.yaml",
+						"--node-address=cluster-dc2-dc2-a-0",
+						"--node-address=cluster-dc2-dc2-a-1",
+						"--node-address=cluster-dc2-dc2-b-0",
+						"--source-datacenter=dc1",
+					},
+					nil,
+					nil,
+				),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "repair job",
+			sc:                   newScyllaDBCluster(),
+			dcName:               "dc2",
+			operation:            newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing),
+			operationDatacenters: []string{"dc1", "dc2"},
+			sdc:                  newScyllaDBDatacenter(),
+			expectedJobs: []*batchv1.Job{
+				newJob(
+					"Repair",
+					[]string{
+						"repair-job",
+						"--manager-auth-config-path=/etc/scylla-operator/auth-token.yaml",
+						"--node-address=cluster-dc2-dc2-a-0",
+						"--node-address=cluster-dc2-dc2-a-1",
+						"--node-address=cluster-dc2-dc2-b-0",
+						"--keyspace=ks",
+					},
+					nil,
+					nil,
+				),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dc, _, ok := oslices.Find(tc.sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+				return dc.Name == tc.dcName
+			})
+			if !ok {
+				t.Fatalf("can't find datacenter %q", tc.dcName)
+			}
+
+			jobs, err := MakeRemoteDatacenterOperationJobs(tc.sc, &dc, tc.operation, tc.operationDatacenters, tc.sdc, newRemoteNamespace(), newRemoteController(), "scylladb/scylla-operator:latest", testClusterDomain)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !apiequality.Semantic.DeepEqual(jobs, tc.expectedJobs) {
+				t.Errorf("expected and got jobs differ:\n%s\n", cmp.Diff(tc.expectedJobs, jobs))
+			}
+		})
+	}
+}
+
+func TestMakeRemoteLostDatacenterJobs(t *testing.T) {
+	t.Parallel()
+
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "scylla-abc",
+		},
+	}
+
+	remoteController := &scyllav1alpha1.RemoteOwner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-111",
+			Namespace: "scylla-abc",
+			UID:       "1234",
+		},
+	}
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-dc1",
+			Namespace: "scylla-abc",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			DatacenterName: pointer.Ptr("dc1"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](2),
 					},
 				},
 			},
-			dc:               newMockScyllaDBClusterDatacenter(),
-			remoteNamespace:  newMockScyllaDBClusterDatacenterRemoteNamespace(),
-			remoteController: newMockRemoteController(),
-			remoteNamespaces: map[string]*corev1.Namespace{
-				"dc1-rkc": {
+		},
+	}
+
+	newJob := func(name string, jobType string, args []string) *batchv1.Job {
+		labels := map[string]string{
+			"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+			"scylla-operator.scylladb.com/managed-by-cluster":                     "test-cluster.local",
+			"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+			"scylla-operator.scylladb.com/node-job-type":                          jobType,
+		}
+
+		podLabels := maps.Clone(labels)
+		podLabels["scylla-operator.scylladb.com/pod-type"] = "datacenter-operation-job"
+
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "scylla-abc",
+				Labels:      labels,
+				Annotations: map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(remoteController, remoteControllerGVK),
+				},
+			},
+			Spec: batchv1.JobSpec{
+				ManualSelector: pointer.Ptr(false),
+				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "dc1-rkc-remote-namespace",
+						Labels: podLabels,
+					},
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+						Containers: []corev1.Container{
+							{
+								Name:            "datacenter-operation",
+								Image:           "scylladb/scylla-operator:latest",
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args:            args,
+								VolumeMounts: []corev1.VolumeMount{
+									{
+										Name:      "scylladb-manager-agent-auth-token",
+										ReadOnly:  true,
+										MountPath: "/etc/scylla-operator/auth-token.yaml",
+										SubPath:   "auth-token.yaml",
+									},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "scylladb-manager-agent-auth-token",
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{
+										SecretName: "cluster-auth-token-2s75a",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
-			existing:                      map[string][]apimachineryruntime.Object{},
-			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
-			expectedProgressingConditions: []metav1.Condition{},
-			expectedErr:                   nil,
+		}
+	}
+
+	tt := []struct {
+		name            string
+		lostDatacenters []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus
+		sdc             *scyllav1alpha1.ScyllaDBDatacenter
+		expectedJobs    []*batchv1.Job
+		expectedErr     error
+	}{
+		{
+			name:            "no jobs without lost datacenters",
+			lostDatacenters: nil,
+			sdc:             sdc,
+			expectedJobs:    nil,
+			expectedErr:     nil,
 		},
 		{
-			name:             "remote namespaces of other DCs don't exist",
-			sc:               newMockMultiDCScyllaDBCluster(),
-			dc:               newMockScyllaDBClusterDatacenter(),
-			remoteNamespace:  newMockScyllaDBClusterDatacenterRemoteNamespace(),
-			remoteController: newMockRemoteController(),
-			remoteNamespaces: map[string]*corev1.Namespace{
-				"dc1-rkc": {
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "dc1-rkc-remote-namespace",
-					},
+			name: "no jobs when ScyllaDBDatacenter doesn't exist",
+			lostDatacenters: []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+				{
+					Name:    "dc2",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes,
+					HostIDs: []string{"host-a"},
 				},
 			},
-			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
-			existing:                      map[string][]apimachineryruntime.Object{},
-			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
-			expectedProgressingConditions: []metav1.Condition{},
-			expectedErr:                   nil,
-		},
-		{
-			name:                          "remote SDCs for other DCs don't exist",
-			sc:                            newMockMultiDCScyllaDBCluster(),
-			dc:                            newMockScyllaDBClusterDatacenter(),
-			remoteNamespace:               newMockScyllaDBClusterDatacenterRemoteNamespace(),
-			remoteController:              newMockRemoteController(),
-			remoteNamespaces:              newMockRemoteNamespaces(),
-			remoteScyllaDBDatacenters:     map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{},
-			existing:                      map[string][]apimachineryruntime.Object{},
-			expected:                      []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
-			expectedProgressingConditions: []metav1.Condition{},
-			expectedErr:                   nil,
+			sdc:          nil,
+			expectedJobs: nil,
+			expectedErr:  nil,
 		},
 		{
-			name:                      "remote SDCs exist but their ScyllaDBNodesStatusReports do not",
-			sc:                        newMockMultiDCScyllaDBCluster(),
-			dc:                        newMockScyllaDBClusterDatacenter(),
-			remoteNamespace:           newMockScyllaDBClusterDatacenterRemoteNamespace(),
-			remoteController:          newMockRemoteController(),
-			remoteNamespaces:          newMockRemoteNamespaces(),
-			remoteScyllaDBDatacenters: newMockRemoteScyllaDBDatacenters(),
-			existing:                  map[string][]apimachineryruntime.Object{},
-			expected:                  []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{},
-			expectedProgressingConditions: []metav1.Condition{
+			name: "jobs only for lost datacenters with replication being altered or nodes being removed",
+			lostDatacenters: []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
 				{
-					Type:    "RemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterdc1Progressing",
-					Status:  "True",
-					Reason:  "WaitingForRemoteScyllaDBDatacenterNodesStatusReport",
-					Message: `Waiting for ScyllaDBDatacenterNodesStatusReport "dc2-rkc-remote-namespace/cluster-dc2-2n4hb" to be created in "dc2-rkc" Cluster`,
+					Name:  "dc2",
+					Phase: scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs,
 				},
 				{
-					Type:    "RemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterdc1Progressing",
-					Status:  "True",
-					Reason:  "WaitingForRemoteScyllaDBDatacenterNodesStatusReport",
-					Message: `Waiting for ScyllaDBDatacenterNodesStatusReport "dc3-rkc-remote-namespace/cluster-dc3-11k4m" to be created in "dc3-rkc" Cluster`,
+					Name:    "dc3",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication,
+					HostIDs: []string{"host-a", "host-b"},
 				},
+				{
+					Name:    "dc4",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes,
+					HostIDs: []string{"host-a", "host-b"},
+				},
+				{
+					Name:    "dc5",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCompleted,
+					HostIDs: []string{"host-c"},
+				},
+			},
+			sdc: sdc,
+			expectedJobs: []*batchv1.Job{
+				newJob("cluster-dc3-replication", "Replication", []string{
+					"replication-job",
+					"--node-address=cluster-dc1-dc1-a-0",
+					"--node-address=cluster-dc1-dc1-a-1",
+					"--keyspace-replication-factor=ks=3",
+					"--datacenter=dc1",
+					"--removed-datacenter=dc3",
+				}),
+				newJob("cluster-dc4-removenodes", "RemoveNodes", []string{
+					"remove-nodes-job",
+					"--manager-auth-config-path=/etc/scylla-operator/auth-token.yaml",
+					"--node-address=cluster-dc1-dc1-a-0",
+					"--node-address=cluster-dc1-dc1-a-1",
+					"--host-id=host-a",
+					"--host-id=host-b",
+				}),
 			},
 			expectedErr: nil,
 		},
-		{
-			name:                      "remote SDCs and their ScyllaDBNodesStatusReports exist",
-			sc:                        newMockMultiDCScyllaDBCluster(),
-			dc:                        newMockScyllaDBClusterDatacenter(),
-			remoteNamespace:           newMockScyllaDBClusterDatacenterRemoteNamespace(),
-			remoteController:          newMockRemoteController(),
-			remoteNamespaces:          newMockRemoteNamespaces(),
-			remoteScyllaDBDatacenters: newMockRemoteScyllaDBDatacenters(),
-			existing: map[string][]apimachineryruntime.Object{
-				"dc1-rkc": {
-					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "cluster-dc1-7p9ut",
-							Namespace: "dc1-rkc-remote-namespace",
-						},
-						DatacenterName: "dc1",
-						Racks: []scyllav1alpha1.RackNodesStatusReport{
-							{
-								Name: "rack1",
-								Nodes: []scyllav1alpha1.NodeStatusReport{
-									{
-										Ordinal: 0,
-										HostID:  pointer.Ptr("host-id-dc1-rack1-0"),
-										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
-											{
-												HostID: "host-id-dc1-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc2-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc3-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-										},
-									},
-								},
-							},
-						},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+				Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+					{
+						Name:              "ks",
+						ReplicationFactor: 3,
 					},
 				},
-				"dc2-rkc": {
-					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "cluster-dc2-2n4hb",
-							Namespace: "dc2-rkc-remote-namespace",
-						},
-						DatacenterName: "dc2",
-						Racks: []scyllav1alpha1.RackNodesStatusReport{
-							{
-								Name: "rack1",
-								Nodes: []scyllav1alpha1.NodeStatusReport{
-									{
-										Ordinal: 0,
-										HostID:  pointer.Ptr("host-id-dc2-rack1-0"),
-										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
-											{
-												HostID: "host-id-dc1-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc2-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc3-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-										},
-									},
-								},
-							},
-						},
+			}
+
+			jobs, err := MakeRemoteLostDatacenterJobs(sc, &sc.Spec.Datacenters[0], tc.lostDatacenters, []string{"dc1"}, tc.sdc, remoteNamespace, remoteController, "scylladb/scylla-operator:latest", testClusterDomain)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !apiequality.Semantic.DeepEqual(jobs, tc.expectedJobs) {
+				t.Errorf("expected and got jobs differ:\n%s\n", cmp.Diff(tc.expectedJobs, jobs))
+			}
+		})
+	}
+}
+
+func TestMakeRemoteImportJobs(t *testing.T) {
+	t.Parallel()
+
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "scylla-abc",
+		},
+	}
+
+	remoteController := &scyllav1alpha1.RemoteOwner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-111",
+			Namespace: "scylla-abc",
+			UID:       "1234",
+		},
+	}
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-dc1",
+			Namespace: "scylla-abc",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "cluster",
+			DatacenterName: pointer.Ptr("dc1"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](2),
 					},
 				},
-				"dc3-rkc": {
-					&scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "cluster-dc3-11k4m",
-							Namespace: "dc3-rkc-remote-namespace",
-						},
-						DatacenterName: "dc3",
-						Racks: []scyllav1alpha1.RackNodesStatusReport{
-							{
-								Name: "rack1",
-								Nodes: []scyllav1alpha1.NodeStatusReport{
-									{
-										Ordinal: 0,
-										HostID:  pointer.Ptr("host-id-dc3-rack1-0"),
-										ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
-											{
-												HostID: "host-id-dc1-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc2-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-											{
-												HostID: "host-id-dc3-rack1-0",
-												Status: scyllav1alpha1.NodeStatusUp,
-											},
-										},
-									},
-								},
-							},
-						},
-					},
+			},
+		},
+	}
+
+	newJob := func(name string, jobType string, args []string) *batchv1.Job {
+		labels := map[string]string{
+			"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+			"scylla-operator.scylladb.com/managed-by-cluster":                     "test-cluster.local",
+			"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+			"scylla-operator.scylladb.com/node-job-type":                          jobType,
+		}
+
+		podLabels := maps.Clone(labels)
+		podLabels["scylla-operator.scylladb.com/pod-type"] = "datacenter-operation-job"
+
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "scylla-abc",
+				Labels:      labels,
+				Annotations: map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(remoteController, remoteControllerGVK),
 				},
 			},
-			expected: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
-				{
+			Spec: batchv1.JobSpec{
+				ManualSelector: pointer.Ptr(false),
+				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "cluster-dc2-external-hglri",
-						Namespace: "dc1-rkc-remote-namespace",
-						Labels: map[string]string{
-							"app.kubernetes.io/managed-by":                                                        "remote.scylla-operator.scylladb.com",
-							"scylla-operator.scylladb.com/managed-by-cluster":                                     "test-cluster.local",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name":                 "dc1",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-name":                            "cluster",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":                       "scylla",
-							"scylla-operator.scylladb.com/remote-cluster-scylladb-datacenter-nodes-status-report": "cluster",
-							"scylla-operator.scylladb.com/scylladb-datacenter-nodes-status-report-selector":       "cluster-dc1",
-						},
-						Annotations: map[string]string{},
-						OwnerReferences: []metav1.OwnerReference{
-							{
-								APIVersion:         "scylla.scylladb.com/v1alpha1",
-								Kind:               "RemoteOwner",
-								Name:               "remote-owner",
-								UID:                "1234",
-								Controller:         pointer.Ptr(true),
-								BlockOwnerDeletion: pointer.Ptr(true),
-							},
-						},
+						Labels: podLabels,
 					},
-					DatacenterName: "dc2",
-					Racks: []scyllav1alpha1.RackNodesStatusReport{
-						{
-							Name: "rack1",
-							Nodes: []scyllav1alpha1.NodeStatusReport{
-								{
-									Ordinal: 0,
-									HostID:  pointer.Ptr("host-id-dc2-rack1-0"),
-									ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
-										{
-											HostID: "host-id-dc1-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
-										{
-											HostID: "host-id-dc2-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
-										{
-											HostID: "host-id-dc3-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+						Containers: []corev1.Container{
+							{
+								Name:            "datacenter-operation",
+								Image:           "scylladb/scylla-operator:latest",
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args:            args,
+								VolumeMounts: []corev1.VolumeMount{
+									{
+										Name:      "scylladb-manager-agent-auth-token",
+										ReadOnly:  true,
+										MountPath: "/etc/scylla-operator/auth-token.yaml",
+										SubPath:   "auth-token.yaml",
+									},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "scylladb-manager-agent-auth-token",
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{
+										SecretName: "cluster-auth-token-2s75a",
 									},
 								},
 							},
 						},
 					},
 				},
-				{
+			},
+		}
+	}
+
+	tt := []struct {
+		name         string
+		importStatus *scyllav1alpha1.ScyllaDBClusterImportStatus
+		sdc          *scyllav1alpha1.ScyllaDBDatacenter
+		expectedJobs []*batchv1.Job
+		expectedErr  error
+	}{
+		{
+			name:         "no jobs without import status",
+			importStatus: nil,
+			sdc:          sdc,
+			expectedJobs: nil,
+			expectedErr:  nil,
+		},
+		{
+			name: "no jobs when ScyllaDBDatacenter doesn't exist",
+			importStatus: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+				Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseValidating,
+			},
+			sdc:          nil,
+			expectedJobs: nil,
+			expectedErr:  nil,
+		},
+		{
+			name: "validation job while validating",
+			importStatus: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+				Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseValidating,
+			},
+			sdc: sdc,
+			expectedJobs: []*batchv1.Job{
+				newJob("cluster-import-importvalidation", "ImportValidation", []string{
+					"import-validation-job",
+					"--manager-auth-config-path=/etc/scylla-operator/auth-token.yaml",
+					"--node-address=cluster-dc1-dc1-a-0",
+					"--node-address=cluster-dc1-dc1-a-1",
+					"--cluster-name=cluster",
+					"--external-datacenter=vm-dc",
+					"--external-seed=10.0.0.1",
+					"--external-seed=10.0.0.2",
+				}),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "no jobs while waiting for clients migration",
+			importStatus: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+				Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration,
+			},
+			sdc:          sdc,
+			expectedJobs: nil,
+			expectedErr:  nil,
+		},
+		{
+			name: "replication job removing external datacenter while dropping it",
+			importStatus: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+				Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter,
+			},
+			sdc: sdc,
+			expectedJobs: []*batchv1.Job{
+				newJob("cluster-import-replication", "Replication", []string{
+					"replication-job",
+					"--node-address=cluster-dc1-dc1-a-0",
+					"--node-address=cluster-dc1-dc1-a-1",
+					"--keyspace-replication-factor=ks=3",
+					"--datacenter=dc1",
+					"--removed-datacenter=vm-dc",
+				}),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.ScyllaDB.ExternalSeeds = []string{"10.0.0.1", "10.0.0.2"}
+			sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+				ExternalDatacenter: "vm-dc",
+			}
+			sc.Spec.ReplicationOptions = &scyllav1alpha1.ScyllaDBClusterReplicationOptions{
+				Keyspaces: []scyllav1alpha1.ScyllaDBClusterKeyspaceReplication{
+					{
+						Name:              "ks",
+						ReplicationFactor: 3,
+					},
+				},
+			}
+
+			jobs, err := MakeRemoteImportJobs(sc, &sc.Spec.Datacenters[0], tc.importStatus, []string{"dc1"}, tc.sdc, remoteNamespace, remoteController, "scylladb/scylla-operator:latest", testClusterDomain)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !apiequality.Semantic.DeepEqual(jobs, tc.expectedJobs) {
+				t.Errorf("expected and got jobs differ:\n%s\n", cmp.Diff(tc.expectedJobs, jobs))
+			}
+		})
+	}
+}
+
+func TestMakeRemoteConnectivityProbeJob(t *testing.T) {
+	t.Parallel()
+
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "scylla-abc",
+		},
+	}
+
+	remoteController := &scyllav1alpha1.RemoteOwner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-111",
+			Namespace: "scylla-abc",
+			UID:       "1234",
+		},
+	}
+
+	newJob := func(args []string) *batchv1.Job {
+		labels := map[string]string{
+			"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "dc1",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "cluster",
+			"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+			"scylla-operator.scylladb.com/managed-by-cluster":                     "test-cluster.local",
+			"app.kubernetes.io/managed-by":                                        "remote.scylla-operator.scylladb.com",
+			"scylla-operator.scylladb.com/node-job-type":                          "ConnectivityProbe",
+		}
+
+		podLabels := maps.Clone(labels)
+		podLabels["scylla-operator.scylladb.com/pod-type"] = "connectivity-probe-job"
+
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "cluster-dc1-connectivityprobe",
+				Namespace:   "scylla-abc",
+				Labels:      labels,
+				Annotations: map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(remoteController, remoteControllerGVK),
+				},
+			},
+			Spec: batchv1.JobSpec{
+				ManualSelector: pointer.Ptr(false),
+				Template: corev1.PodTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "cluster-dc3-external-617i6",
-						Namespace: "dc1-rkc-remote-namespace",
-						Labels: map[string]string{
-							"app.kubernetes.io/managed-by":                                                        "remote.scylla-operator.scylladb.com",
-							"scylla-operator.scylladb.com/managed-by-cluster":                                     "test-cluster.local",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name":                 "dc1",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-name":                            "cluster",
-							"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":                       "scylla",
-							"scylla-operator.scylladb.com/remote-cluster-scylladb-datacenter-nodes-status-report": "cluster",
-							"scylla-operator.scylladb.com/scylladb-datacenter-nodes-status-report-selector":       "cluster-dc1",
-						},
-						Annotations: map[string]string{},
-						OwnerReferences: []metav1.OwnerReference{
-							{
-								APIVersion:         "scylla.scylladb.com/v1alpha1",
-								Kind:               "RemoteOwner",
-								Name:               "remote-owner",
-								UID:                "1234",
-								Controller:         pointer.Ptr(true),
-								BlockOwnerDeletion: pointer.Ptr(true),
-							},
-						},
+						Labels: podLabels,
 					},
-					DatacenterName: "dc3",
-					Racks: []scyllav1alpha1.RackNodesStatusReport{
-						{
-							Name: "rack1",
-							Nodes: []scyllav1alpha1.NodeStatusReport{
-								{
-									Ordinal: 0,
-									HostID:  pointer.Ptr("host-id-dc3-rack1-0"),
-									ObservedNodes: []scyllav1alpha1.ObservedNodeStatus{
-										{
-											HostID: "host-id-dc1-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
-										{
-											HostID: "host-id-dc2-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
-										{
-											HostID: "host-id-dc3-rack1-0",
-											Status: scyllav1alpha1.NodeStatusUp,
-										},
-									},
-								},
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+						Containers: []corev1.Container{
+							{
+								Name:                     "connectivity-probe",
+								Image:                    "scylladb/scylla-operator:latest",
+								ImagePullPolicy:          corev1.PullIfNotPresent,
+								Args:                     args,
+								TerminationMessagePath:   "/dev/termination-log",
+								TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 							},
 						},
 					},
 				},
 			},
-			expectedProgressingConditions: []metav1.Condition{},
-			expectedErr:                   nil,
+		}
+	}
+
+	connectivityProbes := &scyllav1alpha1.ScyllaDBClusterConnectivityProbes{
+		PeriodSeconds:  300,
+		TimeoutSeconds: 5,
+	}
+
+	tt := []struct {
+		name               string
+		connectivityProbes *scyllav1alpha1.ScyllaDBClusterConnectivityProbes
+		externalSeeds      []string
+		targetAddresses    map[string][]string
+		expectedJob        *batchv1.Job
+	}{
+		{
+			name:               "no targets when other datacenters have no addresses",
+			connectivityProbes: connectivityProbes,
+			targetAddresses:    map[string][]string{},
+			expectedJob: newJob([]string{
+				"connectivity-probe",
+				"--timeout=5s",
+				"--port=inter-node=7000",
+				"--port=cql=9042",
+				"--port=agent-api=10001",
+			}),
+		},
+		{
+			name:               "targets are ordered by datacenters in spec",
+			connectivityProbes: connectivityProbes,
+			targetAddresses: map[string][]string{
+				"dc3": {"10.0.3.1"},
+				"dc2": {"10.0.2.1", "10.0.2.2"},
+			},
+			expectedJob: newJob([]string{
+				"connectivity-probe",
+				"--timeout=5s",
+				"--port=inter-node=7000",
+				"--port=cql=9042",
+				"--port=agent-api=10001",
+				"--target=dc2=10.0.2.1",
+				"--target=dc2=10.0.2.2",
+				"--target=dc3=10.0.3.1",
+			}),
+		},
+		{
+			name: "external seeds are probed at the inter-node port",
+			connectivityProbes: &scyllav1alpha1.ScyllaDBClusterConnectivityProbes{
+				PeriodSeconds:  60,
+				TimeoutSeconds: 2,
+			},
+			externalSeeds: []string{"10.1.0.1", "seed.example.com"},
+			targetAddresses: map[string][]string{
+				"dc2": {"10.0.2.1"},
+			},
+			expectedJob: newJob([]string{
+				"connectivity-probe",
+				"--timeout=2s",
+				"--port=inter-node=7000",
+				"--port=cql=9042",
+				"--port=agent-api=10001",
+				"--target=dc2=10.0.2.1",
+				"--external-seed-port=7000",
+				"--external-seed=10.1.0.1",
+				"--external-seed=seed.example.com",
+			}),
+		},
+		{
+			name:               "default timeout is used for external seeds when connectivity probes aren't configured",
+			connectivityProbes: nil,
+			externalSeeds:      []string{"10.1.0.1"},
+			targetAddresses:    nil,
+			expectedJob: newJob([]string{
+				"connectivity-probe",
+				"--timeout=5s",
+				"--port=inter-node=7000",
+				"--port=cql=9042",
+				"--port=agent-api=10001",
+				"--external-seed-port=7000",
+				"--external-seed=10.1.0.1",
+			}),
 		},
 	}
 
@@ -6183,30 +7905,260 @@ func Test_makeExternalScyllaDBDatacenterNodesStatusReports(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			fakeClusterIndexer := newFakeClusterIndexer(t, tc.existing)
-			remoteScyllaDBDatacenterNodesStatusReportLister := remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterNodesStatusReportLister, fakeClusterIndexer)
-
-			progressingConditions, scyllaDBDatacenterNodesStatusReports, err := makeExternalScyllaDBDatacenterNodesStatusReports(
-				tc.sc,
-				tc.dc,
-				tc.remoteNamespace,
-				tc.remoteController,
-				tc.remoteNamespaces,
-				tc.remoteScyllaDBDatacenters,
-				remoteScyllaDBDatacenterNodesStatusReportLister,
-				testClusterDomain,
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.ConnectivityProbes = tc.connectivityProbes
+			sc.Spec.ScyllaDB.ExternalSeeds = tc.externalSeeds
+			sc.Spec.Datacenters = append(sc.Spec.Datacenters,
+				scyllav1alpha1.ScyllaDBClusterDatacenter{
+					Name:                        "dc2",
+					RemoteKubernetesClusterName: "dc2-rkc",
+				},
+				scyllav1alpha1.ScyllaDBClusterDatacenter{
+					Name:                        "dc3",
+					RemoteKubernetesClusterName: "dc3-rkc",
+				},
 			)
 
-			if !reflect.DeepEqual(err, tc.expectedErr) {
-				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			job := MakeRemoteConnectivityProbeJob(sc, &sc.Spec.Datacenters[0], tc.targetAddresses, remoteNamespace, remoteController, "scylladb/scylla-operator:latest", testClusterDomain)
+			if !apiequality.Semantic.DeepEqual(job, tc.expectedJob) {
+				t.Errorf("expected and got job differ:\n%s\n", cmp.Diff(tc.expectedJob, job))
 			}
+		})
+	}
+}
 
-			if !equality.Semantic.DeepEqual(progressingConditions, tc.expectedProgressingConditions) {
-				t.Errorf("expected and got progressing conditions differ: %s", cmp.Diff(tc.expectedProgressingConditions, progressingConditions))
+func Test_makeDatacenterIPFamilies(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                   string
+		broadcastOptions       *scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions
+		expectedIPFamilyPolicy *corev1.IPFamilyPolicy
+		expectedIPFamilies     []corev1.IPFamily
+	}{
+		{
+			name:                   "datacenter defaults are used when broadcast options aren't set",
+			broadcastOptions:       nil,
+			expectedIPFamilyPolicy: nil,
+			expectedIPFamilies:     nil,
+		},
+		{
+			name: "datacenter defaults are used when IP families aren't chosen",
+			broadcastOptions: &scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions{
+				Nodes: scyllav1alpha1.BroadcastOptions{
+					Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+				},
+				Clients: scyllav1alpha1.BroadcastOptions{
+					Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+				},
+			},
+			expectedIPFamilyPolicy: nil,
+			expectedIPFamilies:     nil,
+		},
+		{
+			name: "single-stack datacenter when only nodes IP family is chosen",
+			broadcastOptions: &scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions{
+				Nodes: scyllav1alpha1.BroadcastOptions{
+					Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+					IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+				},
+				Clients: scyllav1alpha1.BroadcastOptions{
+					Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+				},
+			},
+			expectedIPFamilyPolicy: pointer.Ptr(corev1.IPFamilyPolicySingleStack),
+			expectedIPFamilies:     []corev1.IPFamily{corev1.IPv6Protocol},
+		},
+		{
+			name: "dual-stack datacenter with IPv4 first when only clients choose IPv6",
+			broadcastOptions: &scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions{
+				Nodes: scyllav1alpha1.BroadcastOptions{
+					Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+				},
+				Clients: scyllav1alpha1.BroadcastOptions{
+					Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+					IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+				},
+			},
+			expectedIPFamilyPolicy: pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack),
+			expectedIPFamilies:     []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+		},
+		{
+			name: "dual-stack datacenter with nodes IP family first when nodes and clients IP families differ",
+			broadcastOptions: &scyllav1alpha1.ScyllaDBClusterNodeBroadcastOptions{
+				Nodes: scyllav1alpha1.BroadcastOptions{
+					Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+					IPFamily: pointer.Ptr(corev1.IPv6Protocol),
+				},
+				Clients: scyllav1alpha1.BroadcastOptions{
+					Type:     scyllav1alpha1.BroadcastAddressTypePodIP,
+					IPFamily: pointer.Ptr(corev1.IPv4Protocol),
+				},
+			},
+			expectedIPFamilyPolicy: pointer.Ptr(corev1.IPFamilyPolicyRequireDualStack),
+			expectedIPFamilies:     []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.ExposeOptions = &scyllav1alpha1.ScyllaDBClusterExposeOptions{
+				BroadcastOptions: tc.broadcastOptions,
 			}
 
-			if !equality.Semantic.DeepEqual(scyllaDBDatacenterNodesStatusReports, tc.expected) {
-				t.Errorf("expected and got ScyllaDBDatacenterNodesStatusReports differ: %s", cmp.Diff(tc.expected, scyllaDBDatacenterNodesStatusReports))
+			ipFamilyPolicy, ipFamilies := makeDatacenterIPFamilies(sc)
+			if !reflect.DeepEqual(ipFamilyPolicy, tc.expectedIPFamilyPolicy) {
+				t.Errorf("expected and got IP family policies differ: %s", cmp.Diff(tc.expectedIPFamilyPolicy, ipFamilyPolicy))
+			}
+
+			if !reflect.DeepEqual(ipFamilies, tc.expectedIPFamilies) {
+				t.Errorf("expected and got IP families differ: %s", cmp.Diff(tc.expectedIPFamilies, ipFamilies))
+			}
+		})
+	}
+}
+
+func Test_makeServiceClusterIPEndpoints(t *testing.T) {
+	t.Parallel()
+
+	newMemberService := func(clusterIPs ...string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dc1-rkc-a-0",
+				Namespace: "dc1-rkc-ns",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/scylla-service-type": "member",
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:  clusterIPs[0],
+				ClusterIPs: clusterIPs,
+			},
+		}
+	}
+
+	newEndpoints := func(addresses ...string) []discoveryv1.Endpoint {
+		return []discoveryv1.Endpoint{
+			{
+				Addresses: addresses,
+				Conditions: discoveryv1.EndpointConditions{
+					Ready:       pointer.Ptr(true),
+					Serving:     pointer.Ptr(true),
+					Terminating: pointer.Ptr(false),
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                string
+		service             *corev1.Service
+		includeSecondaryIPs bool
+		expected            []discoveryv1.Endpoint
+	}{
+		{
+			name:                "single-stack service",
+			service:             newMemberService("10.0.0.1"),
+			includeSecondaryIPs: true,
+			expected:            newEndpoints("10.0.0.1"),
+		},
+		{
+			name:                "dual-stack service without secondary IPs",
+			service:             newMemberService("10.0.0.1", "2001:db8::1"),
+			includeSecondaryIPs: false,
+			expected:            newEndpoints("10.0.0.1"),
+		},
+		{
+			name:                "dual-stack service with secondary IPs",
+			service:             newMemberService("10.0.0.1", "2001:db8::1"),
+			includeSecondaryIPs: true,
+			expected:            newEndpoints("10.0.0.1", "2001:db8::1"),
+		},
+		{
+			name:                "headless service",
+			service:             newMemberService(corev1.ClusterIPNone),
+			includeSecondaryIPs: true,
+			expected:            nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeServiceClusterIPEndpoints(tc.service, tc.includeSecondaryIPs)
+			if !equality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got endpoints differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_splitEndpointsByIPFamily(t *testing.T) {
+	t.Parallel()
+
+	conditions := discoveryv1.EndpointConditions{
+		Ready:       pointer.Ptr(true),
+		Serving:     pointer.Ptr(true),
+		Terminating: pointer.Ptr(false),
+	}
+
+	tt := []struct {
+		name            string
+		endpoints       []discoveryv1.Endpoint
+		defaultIPFamily corev1.IPFamily
+		expected        map[corev1.IPFamily][]discoveryv1.Endpoint
+	}{
+		{
+			name:            "no endpoints",
+			endpoints:       nil,
+			defaultIPFamily: corev1.IPv4Protocol,
+			expected:        map[corev1.IPFamily][]discoveryv1.Endpoint{},
+		},
+		{
+			name: "dual-stack addresses are split keeping endpoint conditions and hostnames stay with the default IP family",
+			endpoints: []discoveryv1.Endpoint{
+				{
+					Addresses:  []string{"10.0.0.1", "2001:db8::1"},
+					Conditions: conditions,
+				},
+				{
+					Addresses:  []string{"2001:db8::2", "lb.example.com"},
+					Conditions: conditions,
+				},
+			},
+			defaultIPFamily: corev1.IPv6Protocol,
+			expected: map[corev1.IPFamily][]discoveryv1.Endpoint{
+				corev1.IPv4Protocol: {
+					{
+						Addresses:  []string{"10.0.0.1"},
+						Conditions: conditions,
+					},
+				},
+				corev1.IPv6Protocol: {
+					{
+						Addresses:  []string{"2001:db8::1"},
+						Conditions: conditions,
+					},
+					{
+						Addresses:  []string{"2001:db8::2", "lb.example.com"},
+						Conditions: conditions,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := splitEndpointsByIPFamily(tc.endpoints, tc.defaultIPFamily)
+			if !equality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got endpoints differ: %s", cmp.Diff(tc.expected, got))
 			}
 		})
 	}
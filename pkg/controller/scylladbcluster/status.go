@@ -4,12 +4,21 @@ package scylladbcluster
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
 
@@ -43,8 +52,26 @@ func (scc *Controller) calculateStatus(sc *scyllav1alpha1.ScyllaDBCluster, datac
 		sdc := scyllaDatacenters[naming.ScyllaDBDatacenterName(sc, &dc)]
 		dcStatus := *scc.calculateDatacenterStatus(&dc, sdc)
 
+		// Datacenter operations are driven by the controller and can't be recomputed from the remote objects.
+		previousDCStatus, _, ok := oslices.Find(sc.Status.Datacenters, func(previousDCStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return previousDCStatus.Name == dc.Name
+		})
+		if ok && previousDCStatus.Operation != nil {
+			dcStatus.Operation = previousDCStatus.Operation.DeepCopy()
+		}
+
 		status.Datacenters = append(status.Datacenters, dcStatus)
 
+		// Probe results are only refreshed periodically, so they are carried over between syncs.
+		if isConnectivityProbeJobRequired(sc) {
+			previousConnectivityStatus, _, ok := oslices.Find(sc.Status.Connectivity, func(cs scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) bool {
+				return cs.Datacenter == dc.Name
+			})
+			if ok {
+				status.Connectivity = append(status.Connectivity, *previousConnectivityStatus.DeepCopy())
+			}
+		}
+
 		if dcStatus.Nodes != nil {
 			nodes += *dcStatus.Nodes
 		}
@@ -132,3 +159,255 @@ func (scc *Controller) calculateDatacenterStatus(dc *scyllav1alpha1.ScyllaDBClus
 
 	return dcStatus
 }
+
+// calculateLostDatacentersStatus calculates the status of lost datacenters.
+// Host IDs of the lost datacenter nodes are collected from its ScyllaDBDatacenterNodesStatusReports mirrored
+// to the remaining datacenters.
+func calculateLostDatacentersStatus(sc *scyllav1alpha1.ScyllaDBCluster, remoteScyllaDBDatacenterNodesStatusReports map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) ([]scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus, error) {
+	var lostDatacenterStatuses []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus
+
+	for _, lostDC := range sc.Spec.LostDatacenters {
+		previousLostDCStatus, _, ok := oslices.Find(sc.Status.LostDatacenters, func(lostDCStatus scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus) bool {
+			return lostDCStatus.Name == lostDC.Name
+		})
+		if ok && len(previousLostDCStatus.HostIDs) > 0 {
+			lostDatacenterStatuses = append(lostDatacenterStatuses, *previousLostDCStatus.DeepCopy())
+			continue
+		}
+
+		lostDCStatus := scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+			Name:  lostDC.Name,
+			Phase: scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs,
+		}
+
+		reportName, err := naming.ExternalScyllaDBDatacenterNodesStatusReportName(sc, &scyllav1alpha1.ScyllaDBClusterDatacenter{Name: lostDC.Name})
+		if err != nil {
+			return nil, fmt.Errorf("can't get external ScyllaDBDatacenterNodesStatusReport name for lost datacenter %q: %w", lostDC.Name, err)
+		}
+
+		hostIDs := apimachineryutilsets.New[string]()
+		for _, reports := range remoteScyllaDBDatacenterNodesStatusReports {
+			report, ok := reports[reportName]
+			if !ok {
+				continue
+			}
+
+			for _, rack := range report.Racks {
+				for _, node := range rack.Nodes {
+					if node.HostID != nil && len(*node.HostID) > 0 {
+						hostIDs.Insert(*node.HostID)
+					}
+				}
+			}
+		}
+
+		if hostIDs.Len() > 0 {
+			lostDCStatus.HostIDs = apimachineryutilsets.List(hostIDs)
+			lostDCStatus.Phase = scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication
+		}
+
+		lostDatacenterStatuses = append(lostDatacenterStatuses, lostDCStatus)
+	}
+
+	return lostDatacenterStatuses, nil
+}
+
+func makeImportMessage(sc *scyllav1alpha1.ScyllaDBCluster, phase scyllav1alpha1.ScyllaDBClusterImportPhase) string {
+	switch phase {
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseValidating:
+		return "Validating compatibility of datacenters with the imported cluster."
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding:
+		return fmt.Sprintf("Rebuilding datacenters from %q datacenter.", sc.Spec.Import.ExternalDatacenter)
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration:
+		return "Datacenters hold the data of the imported cluster. Move clients over to them and set spec.import.clientsMigrated."
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter:
+		return fmt.Sprintf("Removing %q datacenter from the replication of managed keyspaces.", sc.Spec.Import.ExternalDatacenter)
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseCompleted:
+		return fmt.Sprintf("Datacenter %q no longer replicates managed keyspaces and can be shut down.", sc.Spec.Import.ExternalDatacenter)
+	default:
+		return ""
+	}
+}
+
+func makeImportStatus(sc *scyllav1alpha1.ScyllaDBCluster, phase scyllav1alpha1.ScyllaDBClusterImportPhase) *scyllav1alpha1.ScyllaDBClusterImportStatus {
+	return &scyllav1alpha1.ScyllaDBClusterImportStatus{
+		Phase:              phase,
+		Message:            makeImportMessage(sc, phase),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// areImportedDatacentersRebuilt returns whether all datacenters rebuilt from the external datacenter finished their operations.
+func areImportedDatacentersRebuilt(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) bool {
+	for _, dc := range sc.Spec.Datacenters {
+		if dc.RebuildFrom == nil || *dc.RebuildFrom != sc.Spec.Import.ExternalDatacenter {
+			continue
+		}
+
+		dcStatus, _, ok := oslices.Find(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == dc.Name
+		})
+		if !ok || dcStatus.Operation == nil ||
+			dcStatus.Operation.Type != scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd ||
+			dcStatus.Operation.Phase != scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// calculateImportStatus calculates the status of importing an existing cluster.
+// Phases finished by Jobs are advanced when the Jobs complete, the remaining ones are advanced here
+// based on the spec and the operations of datacenters.
+func calculateImportStatus(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) *scyllav1alpha1.ScyllaDBClusterImportStatus {
+	if sc.Spec.Import == nil {
+		return nil
+	}
+
+	if sc.Status.Import == nil {
+		return makeImportStatus(sc, scyllav1alpha1.ScyllaDBClusterImportPhaseValidating)
+	}
+
+	switch sc.Status.Import.Phase {
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding:
+		if areImportedDatacentersRebuilt(sc, status) {
+			return makeImportStatus(sc, scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration)
+		}
+
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration:
+		if sc.Spec.Import.ClientsMigrated {
+			return makeImportStatus(sc, scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter)
+		}
+	}
+
+	return sc.Status.Import.DeepCopy()
+}
+
+// getRolloutOrderedDatacenters returns datacenters in the order they are updated in.
+// Datacenters listed in the rollout strategy go first, followed by the rest in the order of the spec.
+func getRolloutOrderedDatacenters(sc *scyllav1alpha1.ScyllaDBCluster) []scyllav1alpha1.ScyllaDBClusterDatacenter {
+	var orderedDCs []scyllav1alpha1.ScyllaDBClusterDatacenter
+	if sc.Spec.RolloutStrategy != nil {
+		for _, dcName := range sc.Spec.RolloutStrategy.DatacenterOrder {
+			dc, _, ok := oslices.Find(sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+				return dc.Name == dcName
+			})
+			if ok {
+				orderedDCs = append(orderedDCs, dc)
+			}
+		}
+	}
+
+	for _, dc := range sc.Spec.Datacenters {
+		if slices.ContainsFunc(orderedDCs, func(orderedDC scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return orderedDC.Name == dc.Name
+		}) {
+			continue
+		}
+		orderedDCs = append(orderedDCs, dc)
+	}
+
+	return orderedDCs
+}
+
+// calculateRolloutStatus determines which datacenters can be updated according to the rollout strategy.
+// A datacenter is updated once its ScyllaDBDatacenter matches the required one and it is rolled out.
+// Datacenters which are not yet created, or are being removed, are not subject to the rollout.
+func calculateRolloutStatus(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	remoteNamespaces map[string]*corev1.Namespace,
+	remoteControllers map[string]metav1.Object,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+	remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister],
+	managingClusterDomain string,
+) (*scyllav1alpha1.ScyllaDBClusterRolloutStatus, error) {
+	if sc.Spec.RolloutStrategy == nil {
+		return nil, nil
+	}
+
+	rolloutStatus := &scyllav1alpha1.ScyllaDBClusterRolloutStatus{}
+
+	type datacenterRolloutState struct {
+		name      string
+		upToDate  bool
+		rolledOut bool
+	}
+	var states []datacenterRolloutState
+	for _, dc := range getRolloutOrderedDatacenters(sc) {
+		if dc.Decommission {
+			continue
+		}
+
+		dcStatus, _, ok := oslices.Find(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == dc.Name
+		})
+		if ok && dcStatus.Operation != nil && dcStatus.Operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove {
+			continue
+		}
+
+		remoteNamespace, ok := remoteNamespaces[dc.RemoteKubernetesClusterName]
+		if !ok {
+			continue
+		}
+
+		remoteController, ok := remoteControllers[dc.RemoteKubernetesClusterName]
+		if !ok {
+			continue
+		}
+
+		existingSDC, ok := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dc)]
+		if !ok {
+			continue
+		}
+
+		requiredSDC, err := MakeRemoteScyllaDBDatacenters(sc, &dc, remoteScyllaDBDatacenters, remoteNamespace, remoteController, remoteNamespaces, remoteServiceLister, managingClusterDomain)
+		if err != nil {
+			return nil, fmt.Errorf("can't make remote ScyllaDBDatacenter for datacenter %q: %w", dc.Name, err)
+		}
+
+		err = resourceapply.SetHashAnnotation(requiredSDC)
+		if err != nil {
+			return nil, fmt.Errorf("can't set hash annotation on ScyllaDBDatacenter %q: %w", naming.ObjRef(requiredSDC), err)
+		}
+
+		rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(existingSDC)
+		if err != nil {
+			return nil, fmt.Errorf("can't check if scylladbdatacenter is rolled out: %w", err)
+		}
+
+		states = append(states, datacenterRolloutState{
+			name:      dc.Name,
+			upToDate:  existingSDC.Annotations[naming.ManagedHash] == requiredSDC.Annotations[naming.ManagedHash],
+			rolledOut: rolledOut,
+		})
+	}
+
+	for _, state := range states {
+		if state.upToDate && !state.rolledOut {
+			rolloutStatus.UpdatingDatacenters = append(rolloutStatus.UpdatingDatacenters, state.name)
+		}
+	}
+
+	blocked := false
+	for _, state := range states {
+		switch {
+		case state.upToDate && state.rolledOut:
+			rolloutStatus.UpdatedDatacenters = append(rolloutStatus.UpdatedDatacenters, state.name)
+
+		case state.upToDate:
+			// Already accounted for as updating.
+
+		case !blocked && int32(len(rolloutStatus.UpdatingDatacenters)) < sc.Spec.RolloutStrategy.MaxConcurrentDatacenters:
+			rolloutStatus.UpdatingDatacenters = append(rolloutStatus.UpdatingDatacenters, state.name)
+
+		default:
+			// Keep the order by holding all the following datacenters as well.
+			blocked = true
+			rolloutStatus.PendingDatacenters = append(rolloutStatus.PendingDatacenters, state.name)
+		}
+	}
+
+	return rolloutStatus, nil
+}
@@ -0,0 +1,505 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+func Test_calculateLostDatacentersStatus(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		sc := newBasicScyllaDBCluster()
+		sc.Spec.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenter{
+			{
+				Name: "dc2",
+			},
+		}
+		return sc
+	}
+
+	newExternalReport := func(name string, hostIDs ...string) *scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport {
+		report := &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "scylla-abc",
+			},
+			DatacenterName: "dc2",
+			Racks: []scyllav1alpha1.RackNodesStatusReport{
+				{
+					Name: "a",
+				},
+			},
+		}
+
+		for i, hostID := range hostIDs {
+			report.Racks[0].Nodes = append(report.Racks[0].Nodes, scyllav1alpha1.NodeStatusReport{
+				Ordinal: i,
+				HostID:  pointer.Ptr(hostID),
+			})
+		}
+
+		return report
+	}
+
+	// Name of the dc2 report mirrored to other datacenters.
+	const externalReportName = "cluster-dc2-external-hglri"
+
+	tt := []struct {
+		name                          string
+		sc                            *scyllav1alpha1.ScyllaDBCluster
+		remoteReports                 map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		expectedLostDatacentersStatus []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus
+		expectedErr                   error
+	}{
+		{
+			name:                          "no lost datacenters",
+			sc:                            newBasicScyllaDBCluster(),
+			remoteReports:                 nil,
+			expectedLostDatacentersStatus: nil,
+			expectedErr:                   nil,
+		},
+		{
+			name: "host IDs are collected from mirrored reports",
+			sc:   newScyllaDBCluster(),
+			remoteReports: map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				"dc1-rkc": {
+					externalReportName: newExternalReport(externalReportName, "host-b", "host-a"),
+				},
+				"dc3-rkc": {
+					externalReportName: newExternalReport(externalReportName, "host-a", "host-c"),
+				},
+			},
+			expectedLostDatacentersStatus: []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+				{
+					Name:    "dc2",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication,
+					HostIDs: []string{"host-a", "host-b", "host-c"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "host IDs are being collected when mirrored report is missing",
+			sc:   newScyllaDBCluster(),
+			remoteReports: map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				"dc1-rkc": {},
+			},
+			expectedLostDatacentersStatus: []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+				{
+					Name:  "dc2",
+					Phase: scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "persisted status is preserved",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Status.LostDatacenters = []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+					{
+						Name:    "dc2",
+						Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCompleted,
+						HostIDs: []string{"host-a"},
+					},
+				}
+				return sc
+			}(),
+			remoteReports: map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				"dc1-rkc": {
+					externalReportName: newExternalReport(externalReportName, "host-a", "host-b"),
+				},
+			},
+			expectedLostDatacentersStatus: []scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus{
+				{
+					Name:    "dc2",
+					Phase:   scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCompleted,
+					HostIDs: []string{"host-a"},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := calculateLostDatacentersStatus(tc.sc, tc.remoteReports)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+
+			if !reflect.DeepEqual(got, tc.expectedLostDatacentersStatus) {
+				t.Errorf("expected and got lost datacenters status differ:\n%s\n", cmp.Diff(tc.expectedLostDatacentersStatus, got))
+			}
+		})
+	}
+}
+
+func Test_calculateImportStatus(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		sc := newBasicScyllaDBCluster()
+		sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+			ExternalDatacenter: "vm-dc",
+		}
+		sc.Spec.Datacenters[0].RebuildFrom = pointer.Ptr("vm-dc")
+		return sc
+	}
+
+	newImportStatus := func(phase scyllav1alpha1.ScyllaDBClusterImportPhase, message string) *scyllav1alpha1.ScyllaDBClusterImportStatus {
+		return &scyllav1alpha1.ScyllaDBClusterImportStatus{
+			Phase:   phase,
+			Message: message,
+		}
+	}
+
+	newStatus := func(operationPhase scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase) *scyllav1alpha1.ScyllaDBClusterStatus {
+		return &scyllav1alpha1.ScyllaDBClusterStatus{
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+				{
+					Name: "dc1",
+					Operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+						Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+						Phase: operationPhase,
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                 string
+		sc                   *scyllav1alpha1.ScyllaDBCluster
+		status               *scyllav1alpha1.ScyllaDBClusterStatus
+		expectedImportStatus *scyllav1alpha1.ScyllaDBClusterImportStatus
+	}{
+		{
+			name:                 "no status without import",
+			sc:                   newBasicScyllaDBCluster(),
+			status:               &scyllav1alpha1.ScyllaDBClusterStatus{},
+			expectedImportStatus: nil,
+		},
+		{
+			name:                 "import starts with validation",
+			sc:                   newScyllaDBCluster(),
+			status:               &scyllav1alpha1.ScyllaDBClusterStatus{},
+			expectedImportStatus: newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseValidating, "Validating compatibility of datacenters with the imported cluster."),
+		},
+		{
+			name: "rebuilding continues until datacenters finish their operations",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Status.Import = newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding, `Rebuilding datacenters from "vm-dc" datacenter.`)
+				return sc
+			}(),
+			status:               newStatus(scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing),
+			expectedImportStatus: newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding, `Rebuilding datacenters from "vm-dc" datacenter.`),
+		},
+		{
+			name: "waits for clients migration once datacenters are rebuilt",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Status.Import = newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding, `Rebuilding datacenters from "vm-dc" datacenter.`)
+				return sc
+			}(),
+			status:               newStatus(scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted),
+			expectedImportStatus: newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration, "Datacenters hold the data of the imported cluster. Move clients over to them and set spec.import.clientsMigrated."),
+		},
+		{
+			name: "external datacenter is dropped once clients are migrated",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.Import.ClientsMigrated = true
+				sc.Status.Import = newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration, "")
+				return sc
+			}(),
+			status:               newStatus(scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted),
+			expectedImportStatus: newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter, `Removing "vm-dc" datacenter from the replication of managed keyspaces.`),
+		},
+		{
+			name: "phases advanced by jobs are preserved",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.Import.ClientsMigrated = true
+				sc.Status.Import = newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter, `Removing "vm-dc" datacenter from the replication of managed keyspaces.`)
+				return sc
+			}(),
+			status:               newStatus(scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted),
+			expectedImportStatus: newImportStatus(scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter, `Removing "vm-dc" datacenter from the replication of managed keyspaces.`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := calculateImportStatus(tc.sc, tc.status)
+			if !cmp.Equal(got, tc.expectedImportStatus, cmpopts.IgnoreFields(scyllav1alpha1.ScyllaDBClusterImportStatus{}, "LastTransitionTime")) {
+				t.Errorf("expected and got import status differ:\n%s\n", cmp.Diff(tc.expectedImportStatus, got, cmpopts.IgnoreFields(scyllav1alpha1.ScyllaDBClusterImportStatus{}, "LastTransitionTime")))
+			}
+		})
+	}
+}
+
+func Test_getRolloutOrderedDatacenters(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		rolloutStrategy *scyllav1alpha1.ScyllaDBClusterRolloutStrategy
+		expectedNames   []string
+	}{
+		{
+			name:            "spec order without rollout strategy",
+			rolloutStrategy: nil,
+			expectedNames:   []string{"dc1", "dc2", "dc3"},
+		},
+		{
+			name: "ordered datacenters go first followed by the rest in spec order",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				DatacenterOrder:          []string{"dc3"},
+				MaxConcurrentDatacenters: 1,
+			},
+			expectedNames: []string{"dc3", "dc1", "dc2"},
+		},
+		{
+			name: "unknown datacenters are ignored",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				DatacenterOrder:          []string{"dc4", "dc2", "dc1"},
+				MaxConcurrentDatacenters: 1,
+			},
+			expectedNames: []string{"dc2", "dc1", "dc3"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.RolloutStrategy = tc.rolloutStrategy
+			sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{Name: "dc1", RemoteKubernetesClusterName: "dc1-rkc"},
+				{Name: "dc2", RemoteKubernetesClusterName: "dc2-rkc"},
+				{Name: "dc3", RemoteKubernetesClusterName: "dc3-rkc"},
+			}
+
+			var got []string
+			for _, dc := range getRolloutOrderedDatacenters(sc) {
+				got = append(got, dc.Name)
+			}
+
+			if !reflect.DeepEqual(got, tc.expectedNames) {
+				t.Errorf("expected and got datacenters differ:\n%s\n", cmp.Diff(tc.expectedNames, got))
+			}
+		})
+	}
+}
+
+func Test_calculateRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	type datacenterState struct {
+		upToDate  bool
+		rolledOut bool
+	}
+
+	newSDC := func(name string, rolledOut bool) *scyllav1alpha1.ScyllaDBDatacenter {
+		progressing := metav1.ConditionTrue
+		if rolledOut {
+			progressing = metav1.ConditionFalse
+		}
+
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "scylla-abc",
+				Generation:  1,
+				Annotations: map[string]string{},
+			},
+			Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               scyllav1alpha1.AvailableCondition,
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+					{
+						Type:               scyllav1alpha1.ProgressingCondition,
+						Status:             progressing,
+						ObservedGeneration: 1,
+					},
+					{
+						Type:               scyllav1alpha1.DegradedCondition,
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                  string
+		rolloutStrategy       *scyllav1alpha1.ScyllaDBClusterRolloutStrategy
+		datacenterStates      map[string]datacenterState
+		expectedRolloutStatus *scyllav1alpha1.ScyllaDBClusterRolloutStatus
+	}{
+		{
+			name:            "no rollout status without rollout strategy",
+			rolloutStrategy: nil,
+			datacenterStates: map[string]datacenterState{
+				"dc1": {upToDate: false, rolledOut: true},
+				"dc2": {upToDate: false, rolledOut: true},
+				"dc3": {upToDate: false, rolledOut: true},
+			},
+			expectedRolloutStatus: nil,
+		},
+		{
+			name: "all datacenters are updated",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				MaxConcurrentDatacenters: 1,
+			},
+			datacenterStates: map[string]datacenterState{
+				"dc1": {upToDate: true, rolledOut: true},
+				"dc2": {upToDate: true, rolledOut: true},
+				"dc3": {upToDate: true, rolledOut: true},
+			},
+			expectedRolloutStatus: &scyllav1alpha1.ScyllaDBClusterRolloutStatus{
+				UpdatedDatacenters: []string{"dc1", "dc2", "dc3"},
+			},
+		},
+		{
+			name: "first datacenter in the rollout order is updated first",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				DatacenterOrder:          []string{"dc3"},
+				MaxConcurrentDatacenters: 1,
+			},
+			datacenterStates: map[string]datacenterState{
+				"dc1": {upToDate: false, rolledOut: true},
+				"dc2": {upToDate: false, rolledOut: true},
+				"dc3": {upToDate: false, rolledOut: true},
+			},
+			expectedRolloutStatus: &scyllav1alpha1.ScyllaDBClusterRolloutStatus{
+				UpdatingDatacenters: []string{"dc3"},
+				PendingDatacenters:  []string{"dc1", "dc2"},
+			},
+		},
+		{
+			name: "datacenters wait for the updating datacenter to roll out",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				MaxConcurrentDatacenters: 1,
+			},
+			datacenterStates: map[string]datacenterState{
+				"dc1": {upToDate: true, rolledOut: true},
+				"dc2": {upToDate: true, rolledOut: false},
+				"dc3": {upToDate: false, rolledOut: true},
+			},
+			expectedRolloutStatus: &scyllav1alpha1.ScyllaDBClusterRolloutStatus{
+				UpdatedDatacenters:  []string{"dc1"},
+				UpdatingDatacenters: []string{"dc2"},
+				PendingDatacenters:  []string{"dc3"},
+			},
+		},
+		{
+			name: "multiple datacenters are updated concurrently",
+			rolloutStrategy: &scyllav1alpha1.ScyllaDBClusterRolloutStrategy{
+				MaxConcurrentDatacenters: 2,
+			},
+			datacenterStates: map[string]datacenterState{
+				"dc1": {upToDate: false, rolledOut: true},
+				"dc2": {upToDate: false, rolledOut: true},
+				"dc3": {upToDate: false, rolledOut: true},
+			},
+			expectedRolloutStatus: &scyllav1alpha1.ScyllaDBClusterRolloutStatus{
+				UpdatingDatacenters: []string{"dc1", "dc2"},
+				PendingDatacenters:  []string{"dc3"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newBasicScyllaDBCluster()
+			sc.Spec.RolloutStrategy = tc.rolloutStrategy
+			sc.Spec.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{Name: "dc1", RemoteKubernetesClusterName: "dc1-rkc"},
+				{Name: "dc2", RemoteKubernetesClusterName: "dc2-rkc"},
+				{Name: "dc3", RemoteKubernetesClusterName: "dc3-rkc"},
+			}
+
+			remoteNamespaces := map[string]*corev1.Namespace{}
+			remoteControllers := map[string]metav1.Object{}
+			remoteServiceLister := remotelister.NewClusterLister(corev1listers.NewServiceLister, newFakeClusterIndexer(t, nil))
+			remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+			for _, dc := range sc.Spec.Datacenters {
+				remoteNamespaces[dc.RemoteKubernetesClusterName] = &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "scylla-abc",
+					},
+				}
+				remoteControllers[dc.RemoteKubernetesClusterName] = &scyllav1alpha1.RemoteOwner{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster-111",
+						Namespace: "scylla-abc",
+						UID:       "1234",
+					},
+				}
+
+				sdcName := naming.ScyllaDBDatacenterName(sc, &dc)
+				remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+					sdcName: newSDC(sdcName, tc.datacenterStates[dc.Name].rolledOut),
+				}
+			}
+
+			for _, dc := range sc.Spec.Datacenters {
+				sdc := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dc)]
+				if !tc.datacenterStates[dc.Name].upToDate {
+					sdc.Annotations[naming.ManagedHash] = "outdated"
+					continue
+				}
+
+				requiredSDC, err := MakeRemoteScyllaDBDatacenters(sc, &dc, remoteScyllaDBDatacenters, remoteNamespaces[dc.RemoteKubernetesClusterName], remoteControllers[dc.RemoteKubernetesClusterName], remoteNamespaces, remoteServiceLister, testClusterDomain)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = resourceapply.SetHashAnnotation(requiredSDC)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				sdc.Annotations[naming.ManagedHash] = requiredSDC.Annotations[naming.ManagedHash]
+			}
+
+			status := &scyllav1alpha1.ScyllaDBClusterStatus{}
+			got, err := calculateRolloutStatus(sc, status, remoteNamespaces, remoteControllers, remoteScyllaDBDatacenters, remoteServiceLister, testClusterDomain)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expectedRolloutStatus) {
+				t.Errorf("expected and got rollout status differ:\n%s\n", cmp.Diff(tc.expectedRolloutStatus, got))
+			}
+		})
+	}
+}
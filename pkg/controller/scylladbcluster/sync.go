@@ -14,6 +14,7 @@ import (
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -331,8 +332,38 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 		objectErrMaps[remoteClusterName] = append(objectErrMaps[remoteClusterName], fmt.Errorf("can't get remote scyllaDBDatacenterNodesStatusReports for %q remote cluster: %w", remoteClusterName, err))
 	}
 
+	remoteJobMap, errMap := controllerhelpers.GetRemoteObjects[remoteCT, *batchv1.Job](ctx, remoteClusterNames, remoteControllers, remoteControllerGVK, scRemoteSelector, &controllerhelpers.ClusterControlleeManagerGetObjectsFuncs[remoteCT, *batchv1.Job]{
+		ClusterFunc: func(clusterName string) (controllerhelpers.ControlleeManagerGetObjectsInterface[remoteCT, *batchv1.Job], error) {
+			ns, ok := remoteNamespaces[clusterName]
+			if !ok {
+				return nil, nil
+			}
+
+			kubeClusterClient, scyllaClusterClient, err := scc.getClusterClients(clusterName)
+			if err != nil {
+				return nil, fmt.Errorf("can't get cluster %q clients: %w", clusterName, err)
+			}
+
+			return &controllerhelpers.ControlleeManagerGetObjectsFuncs[remoteCT, *batchv1.Job]{
+				GetControllerUncachedFunc: scyllaClusterClient.ScyllaV1alpha1().RemoteOwners(ns.Name).Get,
+				ListObjectsFunc:           scc.remoteJobLister.Cluster(clusterName).Jobs(ns.Name).List,
+				PatchObjectFunc:           kubeClusterClient.BatchV1().Jobs(ns.Name).Patch,
+			}, nil
+		},
+	})
+	for remoteClusterName, err := range errMap {
+		objectErrMaps[remoteClusterName] = append(objectErrMaps[remoteClusterName], fmt.Errorf("can't get remote jobs for %q remote cluster: %w", remoteClusterName, err))
+	}
+
 	status := scc.calculateStatus(sc, remoteScyllaDBDatacenterMap)
 
+	status.LostDatacenters, err = calculateLostDatacentersStatus(sc, remoteScyllaDBDatacenterNodesStatusReportMap)
+	if err != nil {
+		return fmt.Errorf("can't calculate status of lost datacenters: %w", err)
+	}
+
+	status.Import = calculateImportStatus(sc, status)
+
 	if sc.DeletionTimestamp != nil {
 		err = controllerhelpers.RunSync(
 			&status.Conditions,
@@ -363,6 +394,11 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	status.Rollout, err = calculateRolloutStatus(sc, status, remoteNamespaces, remoteControllers, remoteScyllaDBDatacenterMap, scc.remoteServiceLister, managingClusterDomain)
+	if err != nil {
+		return fmt.Errorf("can't calculate rollout status: %w", err)
+	}
+
 	type remoteNamespacedOwnedResourceSyncParameters struct {
 		kind                 string
 		progressingCondition string
@@ -477,7 +513,23 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 				progressingCondition: makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
 				degradedCondition:    makeRemoteScyllaDBDatacenterControllerDatacenterDegradedCondition(dc.Name),
 				syncFn: func(remoteNamespace *corev1.Namespace, remoteController metav1.Object) ([]metav1.Condition, error) {
-					return scc.syncRemoteScyllaDBDatacenters(ctx, sc, &dc, status, remoteNamespace, remoteController, remoteScyllaDBDatacenterMap, managingClusterDomain)
+					return scc.syncRemoteScyllaDBDatacenters(ctx, sc, &dc, status, remoteNamespace, remoteController, remoteNamespaces, remoteScyllaDBDatacenterMap, managingClusterDomain)
+				},
+			},
+			{
+				kind:                 "Job",
+				progressingCondition: makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+				degradedCondition:    makeRemoteJobControllerDatacenterDegradedCondition(dc.Name),
+				syncFn: func(remoteNamespace *corev1.Namespace, remoteController metav1.Object) ([]metav1.Condition, error) {
+					return scc.syncRemoteJobs(ctx, key, sc, &dc, status, remoteNamespace, remoteController, remoteScyllaDBDatacenterMap, remoteJobMap[dc.RemoteKubernetesClusterName], managingClusterDomain)
+				},
+			},
+			{
+				kind:                 "ConnectivityProbe",
+				progressingCondition: makeRemoteConnectivityProbeControllerDatacenterProgressingCondition(dc.Name),
+				degradedCondition:    makeRemoteConnectivityProbeControllerDatacenterDegradedCondition(dc.Name),
+				syncFn: func(remoteNamespace *corev1.Namespace, remoteController metav1.Object) ([]metav1.Condition, error) {
+					return scc.syncRemoteConnectivityProbes(ctx, key, sc, &dc, status, remoteNamespace, remoteController, remoteNamespaces, remoteJobMap[dc.RemoteKubernetesClusterName], managingClusterDomain)
 				},
 			},
 		}
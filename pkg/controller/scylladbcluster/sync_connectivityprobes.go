@@ -0,0 +1,301 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+func isConnectivityProbeJob(job *batchv1.Job) bool {
+	return job.Labels[naming.NodeJobTypeLabel] == string(naming.JobTypeConnectivityProbe)
+}
+
+// getJobFinishedTime returns the time the Job completed or failed.
+func getJobFinishedTime(job *batchv1.Job) (time.Time, bool) {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) && cond.Status == corev1.ConditionTrue {
+			return cond.LastTransitionTime.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// getConnectivityProbeTargetAddresses returns broadcast addresses of nodes of the other datacenters, keyed by the datacenter name.
+func getConnectivityProbeTargetAddresses(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	remoteNamespaces map[string]*corev1.Namespace,
+	remotePodLister remotelister.GenericClusterLister[corev1listers.PodLister],
+	remoteServiceLister remotelister.GenericClusterLister[corev1listers.ServiceLister],
+) (map[string][]string, error) {
+	nodeBroadcastType := scyllav1alpha1.ScyllaDBClusterDefaultNodesBroadcastAddressType
+	if sc.Spec.ExposeOptions != nil && sc.Spec.ExposeOptions.BroadcastOptions != nil {
+		nodeBroadcastType = sc.Spec.ExposeOptions.BroadcastOptions.Nodes.Type
+	}
+
+	targetAddresses := map[string][]string{}
+	for _, otherDC := range sc.Spec.Datacenters {
+		if otherDC.Name == dc.Name {
+			continue
+		}
+
+		otherDCNamespace, ok := remoteNamespaces[otherDC.RemoteKubernetesClusterName]
+		if !ok {
+			continue
+		}
+
+		endpoints, err := calculateEndpointsForRemoteDCPods(sc, nodeBroadcastType, otherDC, otherDCNamespace, naming.DatacenterPodsSelector(sc, &otherDC), nil, remotePodLister, remoteServiceLister)
+		if err != nil {
+			return nil, fmt.Errorf("can't calculate endpoints of datacenter %q: %w", otherDC.Name, err)
+		}
+
+		// Nodes communicate over addresses of the nodes IP family, when it's chosen explicitly.
+		if nodesIPFamily, _ := getBroadcastIPFamilies(sc); nodesIPFamily != nil {
+			endpoints = splitEndpointsByIPFamily(endpoints, *nodesIPFamily)[*nodesIPFamily]
+		}
+
+		for _, ep := range endpoints {
+			if len(ep.Addresses) == 0 {
+				continue
+			}
+
+			targetAddresses[otherDC.Name] = append(targetAddresses[otherDC.Name], ep.Addresses[0])
+		}
+	}
+
+	return targetAddresses, nil
+}
+
+// getConnectivityProbeReport returns the report of the latest successful run of the connectivity probe container.
+func getConnectivityProbeReport(pods []*corev1.Pod) (*scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus, bool, error) {
+	var latest *corev1.ContainerStateTerminated
+	for _, pod := range pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name != naming.ConnectivityProbeContainerName {
+				continue
+			}
+
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.ExitCode != 0 {
+				continue
+			}
+
+			if latest == nil || terminated.FinishedAt.After(latest.FinishedAt.Time) {
+				latest = terminated
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, false, nil
+	}
+
+	report := &scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus{}
+	err := json.Unmarshal([]byte(latest.Message), report)
+	if err != nil {
+		return nil, false, fmt.Errorf("can't unmarshal connectivity probe report: %w", err)
+	}
+
+	return report, true, nil
+}
+
+func getDatacenterConnectivityStatus(status *scyllav1alpha1.ScyllaDBClusterStatus, dcName string) (*scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus, bool) {
+	idx := slices.IndexFunc(status.Connectivity, func(cs scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) bool {
+		return cs.Datacenter == dcName
+	})
+	if idx < 0 {
+		return nil, false
+	}
+
+	return &status.Connectivity[idx], true
+}
+
+// getNewlyUnreachableExternalSeeds returns addresses of external seeds which are unreachable in the report,
+// but weren't reported unreachable before.
+func getNewlyUnreachableExternalSeeds(previous *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus, report *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) []string {
+	var unreachable []string
+	for _, seed := range report.ExternalSeeds {
+		if seed.Reachable {
+			continue
+		}
+
+		if previous != nil && slices.Contains(previous.ExternalSeeds, seed) {
+			continue
+		}
+
+		unreachable = append(unreachable, seed.Address)
+	}
+
+	return unreachable
+}
+
+func setDatacenterConnectivityStatus(status *scyllav1alpha1.ScyllaDBClusterStatus, dcName string, probeTime time.Time, report *scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) {
+	connectivityStatus := scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus{
+		Datacenter:    dcName,
+		LastProbeTime: pointer.Ptr(metav1.NewTime(probeTime)),
+		Targets:       report.Targets,
+		ExternalSeeds: report.ExternalSeeds,
+		Truncated:     report.Truncated,
+	}
+
+	idx := slices.IndexFunc(status.Connectivity, func(cs scyllav1alpha1.ScyllaDBClusterDatacenterConnectivityStatus) bool {
+		return cs.Datacenter == dcName
+	})
+	if idx < 0 {
+		status.Connectivity = append(status.Connectivity, connectivityStatus)
+		return
+	}
+
+	status.Connectivity[idx] = connectivityStatus
+}
+
+func (scc *Controller) syncRemoteConnectivityProbes(
+	ctx context.Context,
+	key string,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	remoteNamespaces map[string]*corev1.Namespace,
+	remoteJobs map[string]*batchv1.Job,
+	managingClusterDomain string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	probeJobs := maps.Clone(remoteJobs)
+	maps.DeleteFunc(probeJobs, func(_ string, job *batchv1.Job) bool {
+		return !isConnectivityProbeJob(job)
+	})
+
+	var requiredJob *batchv1.Job
+	var requiredJobs []*batchv1.Job
+	if isConnectivityProbeJobRequired(sc) {
+		var targetAddresses map[string][]string
+		if sc.Spec.ConnectivityProbes != nil {
+			var err error
+			targetAddresses, err = getConnectivityProbeTargetAddresses(sc, dc, remoteNamespaces, scc.remotePodLister, scc.remoteServiceLister)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't get connectivity probe target addresses: %w", err)
+			}
+		}
+
+		requiredJob = MakeRemoteConnectivityProbeJob(sc, dc, targetAddresses, remoteNamespace, remoteController, scc.operatorImage, managingClusterDomain)
+		requiredJobs = append(requiredJobs, requiredJob)
+	}
+
+	clusterClient, err := scc.kubeRemoteClient.Cluster(dc.RemoteKubernetesClusterName)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
+	}
+
+	err = controllerhelpers.Prune(ctx,
+		requiredJobs,
+		probeJobs,
+		&controllerhelpers.PruneControlFuncs{
+			DeleteFunc: clusterClient.BatchV1().Jobs(remoteNamespace.Name).Delete,
+		},
+		scc.eventRecorder,
+	)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't prune connectivity probe job(s) in %q Datacenter of %q ScyllaDBCluster: %w", dc.Name, naming.ObjRef(sc), err)
+	}
+
+	if requiredJob == nil {
+		return progressingConditions, nil
+	}
+
+	// Probes run periodically and don't affect the rollout of the cluster, so applying the Job doesn't report progress.
+	job, _, err := resourceapply.ApplyJob(ctx, clusterClient.BatchV1(), scc.remoteJobLister.Cluster(dc.RemoteKubernetesClusterName), scc.eventRecorder, requiredJob, resourceapply.ApplyOptions{})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply connectivity probe job: %w", err)
+	}
+
+	finishedTime, finished := getJobFinishedTime(job)
+	if !finished {
+		return progressingConditions, nil
+	}
+
+	var jobErr error
+	if isJobConditionTrue(job.Status.Conditions, batchv1.JobFailed) {
+		jobErr = fmt.Errorf("connectivity probe job %q failed", naming.ObjRef(job))
+	} else {
+		// Pods are selected by our own labels, the labels set by the Job controller aren't available on all supported Kubernetes versions.
+		pods, err := scc.remoteJobPodLister.Cluster(dc.RemoteKubernetesClusterName).Pods(remoteNamespace.Name).List(labels.SelectorFromSet(requiredJob.Spec.Template.Labels))
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't list pods of connectivity probe job %q: %w", naming.ObjRef(job), err)
+		}
+
+		pods = slices.DeleteFunc(pods, func(pod *corev1.Pod) bool {
+			return !metav1.IsControlledBy(pod, job)
+		})
+
+		report, found, err := getConnectivityProbeReport(pods)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get report of connectivity probe job %q: %w", naming.ObjRef(job), err)
+		}
+
+		if !found {
+			// The Job can complete before its Pod's terminated state is observed. The Job is kept until then, so the report isn't lost.
+			klog.V(2).InfoS("Waiting for connectivity probe report", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Job", klog.KObj(job))
+			return progressingConditions, nil
+		}
+
+		previousConnectivityStatus, _ := getDatacenterConnectivityStatus(status, dc.Name)
+		unreachableExternalSeeds := getNewlyUnreachableExternalSeeds(previousConnectivityStatus, report)
+		if len(unreachableExternalSeeds) > 0 {
+			scc.eventRecorder.Eventf(
+				sc,
+				corev1.EventTypeWarning,
+				"ExternalSeedsUnreachable",
+				"External seed(s) %s aren't reachable from %q datacenter",
+				strings.Join(unreachableExternalSeeds, ", "),
+				dc.Name,
+			)
+		}
+
+		setDatacenterConnectivityStatus(status, dc.Name, finishedTime, report)
+	}
+
+	period, _ := getConnectivityProbesPeriodAndTimeout(sc)
+	elapsed := time.Since(finishedTime)
+	if elapsed < period {
+		scc.queue.AddAfter(key, period-elapsed)
+		return progressingConditions, jobErr
+	}
+
+	// Probes are rerun by recreating the Job. Its deletion triggers another sync which creates it again.
+	klog.V(2).InfoS("Rerunning connectivity probes", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Job", klog.KObj(job))
+	err = clusterClient.BatchV1().Jobs(remoteNamespace.Name).Delete(ctx, job.Name, metav1.DeleteOptions{
+		PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+		Preconditions: &metav1.Preconditions{
+			UID: &job.UID,
+		},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return progressingConditions, fmt.Errorf("can't delete connectivity probe job %q: %w", naming.ObjRef(job), err)
+	}
+
+	return progressingConditions, jobErr
+}
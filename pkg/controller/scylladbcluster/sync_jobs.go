@@ -0,0 +1,614 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// remoteJobRetryDelay is how long a failed datacenter operation Job is kept before it's recreated.
+	// Pods of the Job are already retried with an exponential backoff, so the delay spaces out the retries of the whole Job.
+	remoteJobRetryDelay = 5 * time.Minute
+)
+
+// isDatacenterOperationRunning returns whether the operation is in a phase that changes data placement in the cluster.
+func isDatacenterOperationRunning(operation *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus) bool {
+	if operation == nil {
+		return false
+	}
+
+	switch operation.Phase {
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+		scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+		scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing,
+		scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning:
+		return true
+	default:
+		return false
+	}
+}
+
+// getRunningDatacenterOperation returns the name of another datacenter running an operation.
+func getRunningDatacenterOperation(status *scyllav1alpha1.ScyllaDBClusterStatus, dcName string) (string, bool) {
+	for _, dcStatus := range status.Datacenters {
+		if dcStatus.Name == dcName {
+			continue
+		}
+
+		if isDatacenterOperationRunning(dcStatus.Operation) {
+			return dcStatus.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func nextDatacenterOperationPhase(operation *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus) scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase {
+	var phases []scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase
+	switch operation.Type {
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd:
+		phases = []scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase{
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		}
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove:
+		phases = []scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase{
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		}
+	}
+
+	idx := slices.Index(phases, operation.Phase)
+	if idx < 0 || idx+1 >= len(phases) {
+		return scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted
+	}
+
+	return phases[idx+1]
+}
+
+func makeDatacenterOperationMessage(dc *scyllav1alpha1.ScyllaDBClusterDatacenter, phase scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase) string {
+	switch phase {
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping:
+		return "Waiting for datacenter nodes to join the cluster."
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication:
+		return "Altering replication of managed keyspaces."
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding:
+		return fmt.Sprintf("Rebuilding datacenter nodes from %q datacenter.", *dc.RebuildFrom)
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing:
+		return "Repairing managed keyspaces."
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning:
+		return "Decommissioning datacenter nodes."
+	case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted:
+		return "Operation completed."
+	default:
+		return ""
+	}
+}
+
+func (scc *Controller) setDatacenterOperationPhase(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	dcStatus *scyllav1alpha1.ScyllaDBClusterDatacenterStatus,
+	operationType scyllav1alpha1.ScyllaDBClusterDatacenterOperationType,
+	phase scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase,
+) {
+	dcStatus.Operation = &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+		Type:               operationType,
+		Phase:              phase,
+		Message:            makeDatacenterOperationMessage(dc, phase),
+		LastTransitionTime: metav1.Now(),
+	}
+
+	klog.V(2).InfoS("Datacenter operation entered a new phase", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Operation", operationType, "Phase", phase)
+	scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "DatacenterOperation", "%s operation of datacenter %q entered %s phase", operationType, dc.Name, phase)
+}
+
+// getReplicatingDatacenters returns the names of datacenters which should replicate managed keyspaces
+// when the replication is altered by the operation of the given datacenter.
+func getReplicatingDatacenters(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+) []string {
+	var dcNames []string
+	for _, otherDC := range sc.Spec.Datacenters {
+		if otherDC.Decommission {
+			continue
+		}
+
+		if otherDC.Name == dc.Name {
+			dcNames = append(dcNames, otherDC.Name)
+			continue
+		}
+
+		_, ok := remoteScyllaDBDatacenters[otherDC.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &otherDC)]
+		if !ok {
+			continue
+		}
+
+		// Datacenters which are still being added receive their replicas within their own operation.
+		otherDCStatus, _, ok := oslices.Find(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == otherDC.Name
+		})
+		if ok && otherDCStatus.Operation != nil &&
+			otherDCStatus.Operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd &&
+			otherDCStatus.Operation.Phase != scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted {
+			continue
+		}
+
+		dcNames = append(dcNames, otherDC.Name)
+	}
+
+	// The external datacenter of an imported cluster keeps its replicas until it's dropped.
+	if sc.Spec.Import != nil && !isExternalDatacenterDropped(status.Import) {
+		dcNames = append(dcNames, sc.Spec.Import.ExternalDatacenter)
+	}
+
+	return dcNames
+}
+
+func isExternalDatacenterDropped(importStatus *scyllav1alpha1.ScyllaDBClusterImportStatus) bool {
+	if importStatus == nil {
+		return false
+	}
+
+	switch importStatus.Phase {
+	case scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter,
+		scyllav1alpha1.ScyllaDBClusterImportPhaseCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// isImportValidationPending returns whether datacenters joining an imported cluster have to wait for its validation.
+func isImportValidationPending(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) bool {
+	return sc.Spec.Import != nil && (status.Import == nil || status.Import.Phase == scyllav1alpha1.ScyllaDBClusterImportPhaseValidating)
+}
+
+func nextImportPhase(phase scyllav1alpha1.ScyllaDBClusterImportPhase) scyllav1alpha1.ScyllaDBClusterImportPhase {
+	phases := []scyllav1alpha1.ScyllaDBClusterImportPhase{
+		scyllav1alpha1.ScyllaDBClusterImportPhaseValidating,
+		scyllav1alpha1.ScyllaDBClusterImportPhaseRebuilding,
+		scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration,
+		scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter,
+		scyllav1alpha1.ScyllaDBClusterImportPhaseCompleted,
+	}
+
+	idx := slices.Index(phases, phase)
+	if idx < 0 || idx+1 >= len(phases) {
+		return scyllav1alpha1.ScyllaDBClusterImportPhaseCompleted
+	}
+
+	return phases[idx+1]
+}
+
+func (scc *Controller) setImportPhase(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus, phase scyllav1alpha1.ScyllaDBClusterImportPhase) {
+	status.Import = makeImportStatus(sc, phase)
+
+	klog.V(2).InfoS("Import entered a new phase", "ScyllaDBCluster", klog.KObj(sc), "Phase", phase)
+	scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "Import", "Import of %q datacenter entered %s phase", sc.Spec.Import.ExternalDatacenter, phase)
+}
+
+// getCoordinatorDatacenter returns the datacenter through which cluster-wide operations, like removing nodes
+// of lost datacenters or importing an existing cluster, are run.
+func getCoordinatorDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter) *scyllav1alpha1.ScyllaDBClusterDatacenter {
+	for i := range sc.Spec.Datacenters {
+		dc := &sc.Spec.Datacenters[i]
+		if dc.Decommission {
+			continue
+		}
+
+		_, ok := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, dc)]
+		if ok {
+			return dc
+		}
+	}
+
+	return nil
+}
+
+// advanceLostDatacenterPhase moves the removal of the lost datacenter to the phase following the one whose Job completed.
+func (scc *Controller) advanceLostDatacenterPhase(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus, lostDCName string) {
+	for i := range status.LostDatacenters {
+		lostDCStatus := &status.LostDatacenters[i]
+		if lostDCStatus.Name != lostDCName {
+			continue
+		}
+
+		switch lostDCStatus.Phase {
+		case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication:
+			lostDCStatus.Phase = scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "LostDatacenterDroppedFromReplication", "Lost datacenter %q was dropped from replication of managed keyspaces", lostDCName)
+
+		case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes:
+			lostDCStatus.Phase = scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCompleted
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "LostDatacenterRemoved", "Nodes of lost datacenter %q were removed from the cluster", lostDCName)
+		}
+	}
+}
+
+func isJobConditionTrue(conditions []batchv1.JobCondition, conditionType batchv1.JobConditionType) bool {
+	return slices.ContainsFunc(conditions, func(c batchv1.JobCondition) bool {
+		return c.Type == conditionType && c.Status == corev1.ConditionTrue
+	})
+}
+
+func isScyllaDBDatacenterDecommissioned(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	if sdc.Status.ObservedGeneration == nil || *sdc.Status.ObservedGeneration < sdc.Generation {
+		return false
+	}
+
+	for _, rack := range sdc.Spec.Racks {
+		if rack.Nodes == nil || *rack.Nodes != 0 {
+			return false
+		}
+	}
+
+	for _, rackStatus := range sdc.Status.Racks {
+		if rackStatus.CurrentNodes != nil && *rackStatus.CurrentNodes != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// makeRemoteImportJobs returns the Jobs importing an existing cluster through the coordinator datacenter.
+// The imported cluster is only validated once the datacenter joined it.
+func (scc *Controller) makeRemoteImportJobs(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+	managingClusterDomain string,
+	progressingConditions *[]metav1.Condition,
+) ([]*batchv1.Job, error) {
+	if status.Import == nil || sdc == nil {
+		return nil, nil
+	}
+
+	if status.Import.Phase == scyllav1alpha1.ScyllaDBClusterImportPhaseValidating {
+		rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(sdc)
+		if err != nil {
+			return nil, fmt.Errorf("can't check if scylladbdatacenter is rolled out: %w", err)
+		}
+
+		if !rolledOut {
+			*progressingConditions = append(*progressingConditions, metav1.Condition{
+				Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForScyllaDBDatacenterRollout",
+				Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to roll out before validating the imported cluster.", naming.ObjRef(sdc)),
+				ObservedGeneration: sc.Generation,
+			})
+			return nil, nil
+		}
+	}
+
+	return MakeRemoteImportJobs(sc, dc, status.Import, getReplicatingDatacenters(sc, dc, status, remoteScyllaDBDatacenters), sdc, remoteNamespace, remoteController, scc.operatorImage, managingClusterDomain)
+}
+
+func (scc *Controller) syncRemoteJobs(
+	ctx context.Context,
+	key string,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	remoteNamespace *corev1.Namespace,
+	remoteController metav1.Object,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+	remoteJobs map[string]*batchv1.Job,
+	managingClusterDomain string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	dcStatusIdx := slices.IndexFunc(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+		return dcStatus.Name == dc.Name
+	})
+	if dcStatusIdx < 0 {
+		return progressingConditions, fmt.Errorf("can't find status of datacenter %q", dc.Name)
+	}
+	dcStatus := &status.Datacenters[dcStatusIdx]
+
+	sdc := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, dc)]
+
+	switch {
+	case dc.Decommission:
+		if dcStatus.Operation != nil && dcStatus.Operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove {
+			break
+		}
+
+		runningDCName, ok := getRunningDatacenterOperation(status, dc.Name)
+		if ok {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForDatacenterOperation",
+				Message:            fmt.Sprintf("Waiting for operation of %q datacenter to finish.", runningDCName),
+				ObservedGeneration: sc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		scc.setDatacenterOperationPhase(sc, dc, dcStatus, scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)
+
+	case dc.RebuildFrom != nil:
+		if dcStatus.Operation == nil {
+			scc.setDatacenterOperationPhase(sc, dc, dcStatus, scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping)
+		}
+	}
+
+	operation := dcStatus.Operation
+	if operation != nil {
+		switch operation.Phase {
+		case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping:
+			if sdc == nil {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForScyllaDBDatacenter",
+					Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter of %q datacenter to be created.", dc.Name),
+					ObservedGeneration: sc.Generation,
+				})
+				break
+			}
+
+			rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(sdc)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't check if scylladbdatacenter is rolled out: %w", err)
+			}
+
+			if !rolledOut {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForScyllaDBDatacenterRollout",
+					Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to roll out.", naming.ObjRef(sdc)),
+					ObservedGeneration: sc.Generation,
+				})
+				break
+			}
+
+			if isImportValidationPending(sc, status) {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForImportValidation",
+					Message:            "Waiting for validation of the imported cluster to finish.",
+					ObservedGeneration: sc.Generation,
+				})
+				break
+			}
+
+			runningDCName, ok := getRunningDatacenterOperation(status, dc.Name)
+			if ok {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForDatacenterOperation",
+					Message:            fmt.Sprintf("Waiting for operation of %q datacenter to finish.", runningDCName),
+					ObservedGeneration: sc.Generation,
+				})
+				break
+			}
+
+			scc.setDatacenterOperationPhase(sc, dc, dcStatus, operation.Type, nextDatacenterOperationPhase(operation))
+
+		case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+			scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing:
+			if sdc == nil && operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove {
+				// A removed datacenter without a ScyllaDBDatacenter has no nodes to run the operation through, nor to decommission.
+				klog.V(2).InfoS("ScyllaDBDatacenter of removed datacenter doesn't exist, skipping the phase", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Phase", operation.Phase)
+				scc.setDatacenterOperationPhase(sc, dc, dcStatus, operation.Type, nextDatacenterOperationPhase(operation))
+				break
+			}
+
+			if sdc == nil {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForScyllaDBDatacenter",
+					Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter of %q datacenter to be created.", dc.Name),
+					ObservedGeneration: sc.Generation,
+				})
+			}
+
+		case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning:
+			if sdc != nil && !isScyllaDBDatacenterDecommissioned(sdc) {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForDecommission",
+					Message:            fmt.Sprintf("Waiting for nodes of ScyllaDBDatacenter %q to be decommissioned.", naming.ObjRef(sdc)),
+					ObservedGeneration: sc.Generation,
+				})
+				break
+			}
+
+			scc.setDatacenterOperationPhase(sc, dc, dcStatus, operation.Type, nextDatacenterOperationPhase(operation))
+		}
+	}
+
+	requiredJobs, err := MakeRemoteDatacenterOperationJobs(
+		sc,
+		dc,
+		dcStatus.Operation,
+		getReplicatingDatacenters(sc, dc, status, remoteScyllaDBDatacenters),
+		sdc,
+		remoteNamespace,
+		remoteController,
+		scc.operatorImage,
+		managingClusterDomain,
+	)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make remote jobs: %w", err)
+	}
+
+	// Lost datacenters are dropped from replication and their nodes are removed through a single remaining datacenter.
+	lostDatacenterJobNames := map[string]string{}
+	importJobNames := apimachineryutilsets.New[string]()
+	coordinatorDC := getCoordinatorDatacenter(sc, remoteScyllaDBDatacenters)
+	if coordinatorDC != nil && coordinatorDC.Name == dc.Name {
+		for _, lostDCStatus := range status.LostDatacenters {
+			switch lostDCStatus.Phase {
+			case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseCollectingHostIDs:
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForLostDatacenterHostIDs",
+					Message:            fmt.Sprintf("Waiting for host IDs of nodes of lost %q datacenter.", lostDCStatus.Name),
+					ObservedGeneration: sc.Generation,
+				})
+
+			case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseAlteringReplication:
+				lostDatacenterJobNames[naming.LostDatacenterJobName(sc, lostDCStatus.Name, naming.JobTypeReplication)] = lostDCStatus.Name
+
+			case scyllav1alpha1.ScyllaDBClusterLostDatacenterPhaseRemovingNodes:
+				lostDatacenterJobNames[naming.LostDatacenterJobName(sc, lostDCStatus.Name, naming.JobTypeRemoveNodes)] = lostDCStatus.Name
+			}
+		}
+
+		lostDatacenterJobs, err := MakeRemoteLostDatacenterJobs(sc, dc, status.LostDatacenters, getReplicatingDatacenters(sc, dc, status, remoteScyllaDBDatacenters), sdc, remoteNamespace, remoteController, scc.operatorImage, managingClusterDomain)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make remote lost datacenter jobs: %w", err)
+		}
+
+		requiredJobs = append(requiredJobs, lostDatacenterJobs...)
+
+		importJobs, err := scc.makeRemoteImportJobs(sc, dc, status, sdc, remoteNamespace, remoteController, remoteScyllaDBDatacenters, managingClusterDomain, &progressingConditions)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make remote import jobs: %w", err)
+		}
+
+		for _, importJob := range importJobs {
+			importJobNames.Insert(importJob.Name)
+		}
+		requiredJobs = append(requiredJobs, importJobs...)
+	}
+
+	clusterClient, err := scc.kubeRemoteClient.Cluster(dc.RemoteKubernetesClusterName)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
+	}
+
+	// Connectivity probe Jobs are managed separately.
+	operationJobs := maps.Clone(remoteJobs)
+	maps.DeleteFunc(operationJobs, func(_ string, job *batchv1.Job) bool {
+		return isConnectivityProbeJob(job)
+	})
+
+	// Delete has to be the first action to avoid getting stuck on quota.
+	err = controllerhelpers.Prune(ctx,
+		requiredJobs,
+		operationJobs,
+		&controllerhelpers.PruneControlFuncs{
+			DeleteFunc: clusterClient.BatchV1().Jobs(remoteNamespace.Name).Delete,
+		},
+		scc.eventRecorder,
+	)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't prune job(s) in %q Datacenter of %q ScyllaDBCluster: %w", dc.Name, naming.ObjRef(sc), err)
+	}
+
+	var errs []error
+	for _, rj := range requiredJobs {
+		job, changed, err := resourceapply.ApplyJob(ctx, clusterClient.BatchV1(), scc.remoteJobLister.Cluster(dc.RemoteKubernetesClusterName), scc.eventRecorder, rj, resourceapply.ApplyOptions{})
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, makeRemoteJobControllerDatacenterProgressingCondition(dc.Name), rj, "apply", sc.Generation)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't apply job: %w", err))
+			continue
+		}
+
+		lostDCName, isLostDatacenterJob := lostDatacenterJobNames[job.Name]
+
+		switch {
+		case isJobConditionTrue(job.Status.Conditions, batchv1.JobComplete) && importJobNames.Has(job.Name):
+			scc.setImportPhase(sc, status, nextImportPhase(status.Import.Phase))
+
+		case isJobConditionTrue(job.Status.Conditions, batchv1.JobComplete) && isLostDatacenterJob:
+			scc.advanceLostDatacenterPhase(sc, status, lostDCName)
+
+		case isJobConditionTrue(job.Status.Conditions, batchv1.JobComplete):
+			scc.setDatacenterOperationPhase(sc, dc, dcStatus, dcStatus.Operation.Type, nextDatacenterOperationPhase(dcStatus.Operation))
+
+		case isJobConditionTrue(job.Status.Conditions, batchv1.JobFailed):
+			failedTime, _ := getJobFinishedTime(job)
+			retryTime := failedTime.Add(remoteJobRetryDelay)
+			retryDelay := time.Until(retryTime)
+			if retryDelay > 0 {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForJobRetry",
+					Message:            fmt.Sprintf("Job %q failed, it will be retried at %s. Inspect logs of its Pods for the cause.", naming.ObjRef(job), retryTime.UTC().Format(time.RFC3339)),
+					ObservedGeneration: sc.Generation,
+				})
+				scc.queue.AddAfter(key, retryDelay)
+				continue
+			}
+
+			// Jobs are retried by recreating them. The deletion triggers another sync which creates the Job again.
+			klog.V(2).InfoS("Retrying failed job", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Job", klog.KObj(job))
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeWarning, "RetryingJob", "Retrying failed Job %q", naming.ObjRef(job))
+			err = clusterClient.BatchV1().Jobs(remoteNamespace.Name).Delete(ctx, job.Name, metav1.DeleteOptions{
+				PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+				Preconditions: &metav1.Preconditions{
+					UID: &job.UID,
+				},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("can't delete failed job %q: %w", naming.ObjRef(job), err))
+				continue
+			}
+
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, makeRemoteJobControllerDatacenterProgressingCondition(dc.Name), job, "delete", sc.Generation)
+
+		default:
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               makeRemoteJobControllerDatacenterProgressingCondition(dc.Name),
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForJob",
+				Message:            fmt.Sprintf("Waiting for Job %q to complete.", naming.ObjRef(job)),
+				ObservedGeneration: sc.Generation,
+			})
+		}
+	}
+
+	err = apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply job(s): %w", err)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,547 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	batchv1listers "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeClusterClient[CT any] map[string]CT
+
+func (c fakeClusterClient[CT]) Cluster(name string) (CT, error) {
+	client, ok := c[name]
+	if !ok {
+		return *new(CT), fmt.Errorf("client for cluster %q doesn't exist", name)
+	}
+
+	return client, nil
+}
+
+func Test_nextDatacenterOperationPhase(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name      string
+		operation *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus
+		expected  scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase
+	}{
+		{
+			name: "add operation alters replication after bootstrapping",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+		},
+		{
+			name: "add operation rebuilds after altering replication",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+		},
+		{
+			name: "add operation completes after repairing",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRepairing,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		},
+		{
+			name: "remove operation decommissions after altering replication",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning,
+		},
+		{
+			name: "remove operation completes after decommissioning",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		},
+		{
+			name: "completed operation stays completed",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		},
+		{
+			name: "phase not belonging to the operation completes it",
+			operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+				Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove,
+				Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+			},
+			expected: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := nextDatacenterOperationPhase(tc.operation)
+			if got != tc.expected {
+				t.Errorf("expected phase %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func Test_getReplicatingDatacenters(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		sc := newBasicScyllaDBCluster()
+		sc.Spec.Datacenters = append(sc.Spec.Datacenters,
+			scyllav1alpha1.ScyllaDBClusterDatacenter{
+				Name:                        "dc2",
+				RemoteKubernetesClusterName: "dc2-rkc",
+			},
+			scyllav1alpha1.ScyllaDBClusterDatacenter{
+				Name:                        "dc3",
+				RemoteKubernetesClusterName: "dc3-rkc",
+			},
+		)
+		return sc
+	}
+
+	newRemoteScyllaDBDatacenters := func(sc *scyllav1alpha1.ScyllaDBCluster) map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter {
+		remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+		for _, dc := range sc.Spec.Datacenters {
+			sdcName := naming.ScyllaDBDatacenterName(sc, &dc)
+			remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+				sdcName: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: sdcName,
+					},
+				},
+			}
+		}
+		return remoteScyllaDBDatacenters
+	}
+
+	tt := []struct {
+		name                      string
+		sc                        *scyllav1alpha1.ScyllaDBCluster
+		dcName                    string
+		status                    *scyllav1alpha1.ScyllaDBClusterStatus
+		remoteScyllaDBDatacenters func(*scyllav1alpha1.ScyllaDBCluster) map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter
+		expected                  []string
+	}{
+		{
+			name:                      "all existing datacenters replicate",
+			sc:                        newScyllaDBCluster(),
+			dcName:                    "dc1",
+			status:                    &scyllav1alpha1.ScyllaDBClusterStatus{},
+			remoteScyllaDBDatacenters: newRemoteScyllaDBDatacenters,
+			expected:                  []string{"dc1", "dc2", "dc3"},
+		},
+		{
+			name: "decommissioned datacenters don't replicate",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.Datacenters[2].Decommission = true
+				return sc
+			}(),
+			dcName:                    "dc1",
+			status:                    &scyllav1alpha1.ScyllaDBClusterStatus{},
+			remoteScyllaDBDatacenters: newRemoteScyllaDBDatacenters,
+			expected:                  []string{"dc1", "dc2"},
+		},
+		{
+			name:   "other datacenters without ScyllaDBDatacenter don't replicate",
+			sc:     newScyllaDBCluster(),
+			dcName: "dc3",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{},
+			remoteScyllaDBDatacenters: func(sc *scyllav1alpha1.ScyllaDBCluster) map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter {
+				remoteScyllaDBDatacenters := newRemoteScyllaDBDatacenters(sc)
+				delete(remoteScyllaDBDatacenters, "dc2-rkc")
+				delete(remoteScyllaDBDatacenters, "dc3-rkc")
+				return remoteScyllaDBDatacenters
+			},
+			expected: []string{"dc1", "dc3"},
+		},
+		{
+			name:   "other datacenters being added don't replicate",
+			sc:     newScyllaDBCluster(),
+			dcName: "dc3",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+					{
+						Name: "dc1",
+						Operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+							Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+							Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+						},
+					},
+					{
+						Name: "dc2",
+						Operation: &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+							Type:  scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+							Phase: scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseBootstrapping,
+						},
+					},
+				},
+			},
+			remoteScyllaDBDatacenters: newRemoteScyllaDBDatacenters,
+			expected:                  []string{"dc1", "dc3"},
+		},
+		{
+			name: "external datacenter of imported cluster replicates until it's dropped",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "external",
+				}
+				return sc
+			}(),
+			dcName: "dc1",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Import: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+					Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseWaitingForClientsMigration,
+				},
+			},
+			remoteScyllaDBDatacenters: newRemoteScyllaDBDatacenters,
+			expected:                  []string{"dc1", "dc2", "dc3", "external"},
+		},
+		{
+			name: "dropped external datacenter of imported cluster doesn't replicate",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.Import = &scyllav1alpha1.ScyllaDBClusterImport{
+					ExternalDatacenter: "external",
+				}
+				return sc
+			}(),
+			dcName: "dc1",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Import: &scyllav1alpha1.ScyllaDBClusterImportStatus{
+					Phase: scyllav1alpha1.ScyllaDBClusterImportPhaseDroppingExternalDatacenter,
+				},
+			},
+			remoteScyllaDBDatacenters: newRemoteScyllaDBDatacenters,
+			expected:                  []string{"dc1", "dc2", "dc3"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dcIdx := slices.IndexFunc(tc.sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+				return dc.Name == tc.dcName
+			})
+			if dcIdx < 0 {
+				t.Fatalf("datacenter %q doesn't exist", tc.dcName)
+			}
+
+			got := getReplicatingDatacenters(tc.sc, &tc.sc.Spec.Datacenters[dcIdx], tc.status, tc.remoteScyllaDBDatacenters(tc.sc))
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got datacenters differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestController_syncRemoteJobs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		operatorImage = "scylladb/scylla-operator:latest"
+	)
+
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "scylla-abc",
+		},
+	}
+
+	remoteController := &scyllav1alpha1.RemoteOwner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-111",
+			Namespace: "scylla-abc",
+			UID:       "1234",
+		},
+	}
+
+	newScyllaDBCluster := func(operation *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus) *scyllav1alpha1.ScyllaDBCluster {
+		sc := newBasicScyllaDBCluster()
+		sc.Spec.Datacenters = append(sc.Spec.Datacenters, scyllav1alpha1.ScyllaDBClusterDatacenter{
+			Name:                        "dc2",
+			RemoteKubernetesClusterName: "dc2-rkc",
+			RebuildFrom:                 pointer.Ptr("dc1"),
+		})
+		sc.Status.Datacenters = append(sc.Status.Datacenters, scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+			Name:      "dc2",
+			Operation: operation,
+		})
+		return sc
+	}
+
+	newScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-dc2",
+				Namespace: "scylla-abc",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				DatacenterName: pointer.Ptr("dc2"),
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							Nodes: pointer.Ptr[int32](1),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newOperation := func(operationType scyllav1alpha1.ScyllaDBClusterDatacenterOperationType, phase scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase) *scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus {
+		return &scyllav1alpha1.ScyllaDBClusterDatacenterOperationStatus{
+			Type:  operationType,
+			Phase: phase,
+		}
+	}
+
+	// newExistingReplicationJob returns the replication Job of the altering replication phase as if it was already created.
+	newExistingReplicationJob := func(t *testing.T, conditionType batchv1.JobConditionType, finishedAgo time.Duration) *batchv1.Job {
+		t.Helper()
+
+		sc := newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication))
+		sdc := newScyllaDBDatacenter()
+		jobs, err := MakeRemoteDatacenterOperationJobs(
+			sc,
+			&sc.Spec.Datacenters[1],
+			sc.Status.Datacenters[1].Operation,
+			getReplicatingDatacenters(sc, &sc.Spec.Datacenters[1], &sc.Status, map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{"dc2-rkc": {sdc.Name: sdc}}),
+			sdc,
+			remoteNamespace,
+			remoteController,
+			operatorImage,
+			testClusterDomain,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs) != 1 {
+			t.Fatalf("expected 1 job, got %d", len(jobs))
+		}
+
+		job := jobs[0]
+		err = resourceapply.SetHashAnnotation(job)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		job.UID = "job-uid"
+		job.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:               conditionType,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-finishedAgo)),
+			},
+		}
+
+		return job
+	}
+
+	replicationJobName := naming.DatacenterOperationJobName("cluster-dc2", naming.JobTypeReplication)
+
+	tt := []struct {
+		name                      string
+		sc                        *scyllav1alpha1.ScyllaDBCluster
+		decommission              bool
+		sdc                       *scyllav1alpha1.ScyllaDBDatacenter
+		existingJobs              func(t *testing.T) []*batchv1.Job
+		expectedOperationType     scyllav1alpha1.ScyllaDBClusterDatacenterOperationType
+		expectedOperationPhase    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhase
+		expectedConditionReasons  []string
+		expectedRemainingJobNames []string
+	}{
+		{
+			name:                      "removed datacenter without ScyllaDBDatacenter skips altering replication",
+			sc:                        newScyllaDBCluster(nil),
+			decommission:              true,
+			sdc:                       nil,
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning,
+			expectedConditionReasons:  nil,
+			expectedRemainingJobNames: nil,
+		},
+		{
+			name:                      "removed datacenter without ScyllaDBDatacenter completes decommissioning",
+			sc:                        newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning)),
+			decommission:              true,
+			sdc:                       nil,
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted,
+			expectedConditionReasons:  nil,
+			expectedRemainingJobNames: nil,
+		},
+		{
+			name:                      "added datacenter waits for ScyllaDBDatacenter when altering replication",
+			sc:                        newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)),
+			sdc:                       nil,
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			expectedConditionReasons:  []string{"WaitingForScyllaDBDatacenter"},
+			expectedRemainingJobNames: nil,
+		},
+		{
+			name:                      "replication job is created when altering replication",
+			sc:                        newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)),
+			sdc:                       newScyllaDBDatacenter(),
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			expectedConditionReasons:  []string{internalapi.ProgressingReason, "WaitingForJob"},
+			expectedRemainingJobNames: []string{replicationJobName},
+		},
+		{
+			name: "completed replication job advances the operation to rebuilding",
+			sc:   newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)),
+			sdc:  newScyllaDBDatacenter(),
+			existingJobs: func(t *testing.T) []*batchv1.Job {
+				return []*batchv1.Job{newExistingReplicationJob(t, batchv1.JobComplete, time.Minute)}
+			},
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseRebuilding,
+			expectedConditionReasons:  nil,
+			expectedRemainingJobNames: []string{replicationJobName},
+		},
+		{
+			name: "recently failed replication job is kept until it's retried",
+			sc:   newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)),
+			sdc:  newScyllaDBDatacenter(),
+			existingJobs: func(t *testing.T) []*batchv1.Job {
+				return []*batchv1.Job{newExistingReplicationJob(t, batchv1.JobFailed, time.Minute)}
+			},
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			expectedConditionReasons:  []string{"WaitingForJobRetry"},
+			expectedRemainingJobNames: []string{replicationJobName},
+		},
+		{
+			name: "failed replication job is deleted to be retried after the retry delay",
+			sc:   newScyllaDBCluster(newOperation(scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication)),
+			sdc:  newScyllaDBDatacenter(),
+			existingJobs: func(t *testing.T) []*batchv1.Job {
+				return []*batchv1.Job{newExistingReplicationJob(t, batchv1.JobFailed, remoteJobRetryDelay+time.Minute)}
+			},
+			expectedOperationType:     scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeAdd,
+			expectedOperationPhase:    scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseAlteringReplication,
+			expectedConditionReasons:  []string{internalapi.ProgressingReason},
+			expectedRemainingJobNames: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := tc.sc.DeepCopy()
+			sc.Spec.Datacenters[1].Decommission = tc.decommission
+			dc := &sc.Spec.Datacenters[1]
+
+			remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+			if tc.sdc != nil {
+				remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+					tc.sdc.Name: tc.sdc,
+				}
+			}
+
+			var existingJobs []*batchv1.Job
+			if tc.existingJobs != nil {
+				existingJobs = tc.existingJobs(t)
+			}
+
+			var existingObjects []apimachineryruntime.Object
+			remoteJobs := map[string]*batchv1.Job{}
+			for _, job := range existingJobs {
+				existingObjects = append(existingObjects, job)
+				remoteJobs[job.Name] = job
+			}
+
+			kubeClient := fake.NewSimpleClientset(existingObjects...)
+
+			scc := &Controller{
+				kubeRemoteClient: fakeClusterClient[kubernetes.Interface]{
+					dc.RemoteKubernetesClusterName: kubeClient,
+				},
+				remoteJobLister: remotelister.NewClusterLister(batchv1listers.NewJobLister, newFakeClusterIndexer(t, map[string][]apimachineryruntime.Object{
+					dc.RemoteKubernetesClusterName: existingObjects,
+				})),
+				operatorImage: operatorImage,
+				eventRecorder: record.NewFakeRecorder(10),
+				queue:         workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+			}
+			defer scc.queue.ShutDown()
+
+			status := sc.Status.DeepCopy()
+			progressingConditions, err := scc.syncRemoteJobs(context.Background(), "scylla/cluster", sc, dc, status, remoteNamespace, remoteController, remoteScyllaDBDatacenters, remoteJobs, testClusterDomain)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			operation := status.Datacenters[1].Operation
+			if operation == nil {
+				t.Fatalf("expected operation of datacenter %q to be set", dc.Name)
+			}
+			if operation.Type != tc.expectedOperationType || operation.Phase != tc.expectedOperationPhase {
+				t.Errorf("expected %s operation in %s phase, got %s operation in %s phase", tc.expectedOperationType, tc.expectedOperationPhase, operation.Type, operation.Phase)
+			}
+
+			var gotConditionReasons []string
+			for _, cond := range progressingConditions {
+				gotConditionReasons = append(gotConditionReasons, cond.Reason)
+			}
+			if !reflect.DeepEqual(gotConditionReasons, tc.expectedConditionReasons) {
+				t.Errorf("expected and got progressing condition reasons differ: %s", cmp.Diff(tc.expectedConditionReasons, gotConditionReasons))
+			}
+
+			jobList, err := kubeClient.BatchV1().Jobs(remoteNamespace.Name).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotRemainingJobNames []string
+			for _, job := range jobList.Items {
+				gotRemainingJobNames = append(gotRemainingJobNames, job.Name)
+			}
+			if !reflect.DeepEqual(gotRemainingJobNames, tc.expectedRemainingJobNames) {
+				t.Errorf("expected and got remaining jobs differ: %s", cmp.Diff(tc.expectedRemainingJobNames, gotRemainingJobNames))
+			}
+		})
+	}
+}
@@ -5,10 +5,13 @@ package scylladbcluster
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -23,15 +26,33 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 	status *scyllav1alpha1.ScyllaDBClusterStatus,
 	remoteNamespace *corev1.Namespace,
 	remoteController metav1.Object,
+	remoteNamespaces map[string]*corev1.Namespace,
 	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
 	managingClusterDomain string,
 ) ([]metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
-	requiredScyllaDBDatacenter, err := MakeRemoteScyllaDBDatacenters(sc, dc, remoteScyllaDBDatacenters, remoteNamespace, remoteController, managingClusterDomain)
+	requiredScyllaDBDatacenter, err := MakeRemoteScyllaDBDatacenters(sc, dc, remoteScyllaDBDatacenters, remoteNamespace, remoteController, remoteNamespaces, scc.remoteServiceLister, managingClusterDomain)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't make remote ScyllaDBDatacenters: %w", err)
 	}
 
+	dcStatus, _, ok := oslices.Find(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+		return dcStatus.Name == dc.Name
+	})
+	if ok && dcStatus.Operation != nil && dcStatus.Operation.Type == scyllav1alpha1.ScyllaDBClusterDatacenterOperationTypeRemove {
+		switch dcStatus.Operation.Phase {
+		case scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseDecommissioning, scyllav1alpha1.ScyllaDBClusterDatacenterOperationPhaseCompleted:
+			// Replicas were already moved out of the datacenter, scale all racks down to decommission the nodes gracefully.
+			racks := make([]scyllav1alpha1.RackSpec, 0, len(requiredScyllaDBDatacenter.Spec.Racks))
+			for _, rack := range requiredScyllaDBDatacenter.Spec.Racks {
+				rack := *rack.DeepCopy()
+				rack.Nodes = pointer.Ptr[int32](0)
+				racks = append(racks, rack)
+			}
+			requiredScyllaDBDatacenter.Spec.Racks = racks
+		}
+	}
+
 	clusterClient, err := scc.scyllaRemoteClient.Cluster(dc.RemoteKubernetesClusterName)
 	if err != nil {
 		return nil, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
@@ -134,6 +155,17 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 		}
 	}
 
+	if sdcExists && status.Rollout != nil && slices.Contains(status.Rollout.PendingDatacenters, dc.Name) {
+		klog.V(4).InfoS("Waiting for preceding datacenters to be updated", "ScyllaDBCluster", klog.KObj(sc), "ScyllaDBDatacenter", klog.KObj(existingSDC), "Datacenter", dc.Name)
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForDatacenterRollout",
+			Message:            fmt.Sprintf("Waiting for datacenters preceding %q datacenter to be updated and available.", dc.Name),
+			ObservedGeneration: sc.Generation,
+		})
+	}
+
 	if len(progressingConditions) > 0 {
 		return progressingConditions, nil
 	}
@@ -5,9 +5,11 @@ package scylladbcluster
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
 	corev1 "k8s.io/api/core/v1"
@@ -35,10 +37,29 @@ func (scc *Controller) syncRemoteScyllaDBDatacenterNodesStatusReports(
 		return nil, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
 	}
 
+	// Mirrored reports of lost datacenters are the only source of their host IDs.
+	// Keep them until the host IDs are persisted in the status.
+	prunableScyllaDBDatacenterNodesStatusReports := maps.Clone(remoteScyllaDBDatacenterNodesStatusReports)
+	for _, lostDC := range sc.Spec.LostDatacenters {
+		lostDCStatus, _, ok := oslices.Find(sc.Status.LostDatacenters, func(lostDCStatus scyllav1alpha1.ScyllaDBClusterLostDatacenterStatus) bool {
+			return lostDCStatus.Name == lostDC.Name
+		})
+		if ok && len(lostDCStatus.HostIDs) > 0 {
+			continue
+		}
+
+		name, err := naming.ExternalScyllaDBDatacenterNodesStatusReportName(sc, &scyllav1alpha1.ScyllaDBClusterDatacenter{Name: lostDC.Name})
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get external ScyllaDBDatacenterNodesStatusReport name for lost datacenter %q: %w", lostDC.Name, err)
+		}
+
+		delete(prunableScyllaDBDatacenterNodesStatusReports, name)
+	}
+
 	err = controllerhelpers.Prune(
 		ctx,
 		requiredScyllaDBDatacenterNodesStatusReports,
-		remoteScyllaDBDatacenterNodesStatusReports,
+		prunableScyllaDBDatacenterNodesStatusReports,
 		&controllerhelpers.PruneControlFuncs{
 			DeleteFunc: clusterClient.ScyllaV1alpha1().ScyllaDBDatacenterNodesStatusReports(remoteNamespace.Name).Delete,
 		},
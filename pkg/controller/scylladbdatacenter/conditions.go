@@ -24,4 +24,14 @@ const (
 	configControllerDegradedCondition                                 = "ConfigControllerDegraded"
 	scyllaDBDatacenterNodesStatusReportControllerProgressingCondition = "ScyllaDBDatacenterNodesStatusReportControllerProgressing"
 	scyllaDBDatacenterNodesStatusReportControllerDegradedCondition    = "ScyllaDBDatacenterNodesStatusReportControllerDegraded"
+	restoreControllerAvailableCondition                               = "RestoreControllerAvailable"
+	restoreControllerProgressingCondition                             = "RestoreControllerProgressing"
+	restoreControllerDegradedCondition                                = "RestoreControllerDegraded"
+	alternatorControllerProgressingCondition                          = "AlternatorControllerProgressing"
+	alternatorControllerDegradedCondition                             = "AlternatorControllerDegraded"
+	nodeBootstrapDegradedCondition                                    = "NodeBootstrapDegraded"
+	gatewayControllerProgressingCondition                             = "GatewayControllerProgressing"
+	gatewayControllerDegradedCondition                                = "GatewayControllerDegraded"
+	clientConnectionBundleControllerProgressingCondition              = "ClientConnectionBundleControllerProgressing"
+	clientConnectionBundleControllerDegradedCondition                 = "ClientConnectionBundleControllerDegraded"
 )
@@ -12,6 +12,7 @@ import (
 	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/crypto"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
 	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
@@ -23,10 +24,12 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	appsv1informers "k8s.io/client-go/informers/apps/v1"
 	batchv1informers "k8s.io/client-go/informers/batch/v1"
 	corev1informers "k8s.io/client-go/informers/core/v1"
@@ -77,8 +80,17 @@ type Controller struct {
 	scyllaDBDatacenterLister                  scyllav1alpha1listers.ScyllaDBDatacenterLister
 	jobLister                                 batchv1listers.JobLister
 	scyllaDBDatacenterNodesStatusReportLister scyllav1alpha1listers.ScyllaDBDatacenterNodesStatusReportLister
+	scyllaDBManagerTaskLister                 scyllav1alpha1listers.ScyllaDBManagerTaskLister
 	scyllaOperatorConfigLister                scyllav1alpha1listers.ScyllaOperatorConfigLister
 
+	// gatewayClient and gatewayAPIInformers are nil when the operator doesn't manage Gateway API objects.
+	gatewayClient          dynamic.Interface
+	gatewayAPIInformers    *gatewayapi.Informers
+	gatewayLister          *gatewayapi.ResourceLister
+	httpRouteLister        *gatewayapi.ResourceLister
+	backendTLSPolicyLister *gatewayapi.ResourceLister
+	tlsRouteLister         *gatewayapi.ResourceLister
+
 	cachesToSync []cache.InformerSynced
 
 	eventRecorder record.EventRecorder
@@ -104,7 +116,10 @@ func NewController(
 	jobInformer batchv1informers.JobInformer,
 	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
 	scyllaDBDatacenterNodesStatusReportInformer scyllav1alpha1informers.ScyllaDBDatacenterNodesStatusReportInformer,
+	scyllaDBManagerTaskInformer scyllav1alpha1informers.ScyllaDBManagerTaskInformer,
 	scyllaOperatorConfigInformer scyllav1alpha1informers.ScyllaOperatorConfigInformer,
+	gatewayClient dynamic.Interface,
+	gatewayAPIInformers *gatewayapi.Informers,
 	operatorImage string,
 	cqlsIngressPort int,
 	keyGetter crypto.KeyGenerator,
@@ -132,6 +147,7 @@ func NewController(
 		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
 		jobLister:                jobInformer.Lister(),
 		scyllaDBDatacenterNodesStatusReportLister: scyllaDBDatacenterNodesStatusReportInformer.Lister(),
+		scyllaDBManagerTaskLister:                 scyllaDBManagerTaskInformer.Lister(),
 		scyllaOperatorConfigLister:                scyllaOperatorConfigInformer.Lister(),
 
 		cachesToSync: []cache.InformerSynced{
@@ -147,6 +163,7 @@ func NewController(
 			scyllaDBDatacenterInformer.Informer().HasSynced,
 			jobInformer.Informer().HasSynced,
 			scyllaDBDatacenterNodesStatusReportInformer.Informer().HasSynced,
+			scyllaDBManagerTaskInformer.Informer().HasSynced,
 			scyllaOperatorConfigInformer.Informer().HasSynced,
 		},
 
@@ -162,6 +179,17 @@ func NewController(
 		keyGetter: keyGetter,
 	}
 
+	if gatewayAPIInformers != nil {
+		// Gateway API informers are only started once the cluster serves their resources,
+		// so they aren't waited for, and their resources are only used after their informers have synced.
+		sdcc.gatewayClient = gatewayClient
+		sdcc.gatewayAPIInformers = gatewayAPIInformers
+		sdcc.gatewayLister = gatewayAPIInformers.Gateways.Lister()
+		sdcc.httpRouteLister = gatewayAPIInformers.HTTPRoutes.Lister()
+		sdcc.backendTLSPolicyLister = gatewayAPIInformers.BackendTLSPolicies.Lister()
+		sdcc.tlsRouteLister = gatewayAPIInformers.TLSRoutes.Lister()
+	}
+
 	var err error
 	sdcc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBDatacenter](
 		sdcc.queue,
@@ -256,12 +284,33 @@ func NewController(
 		DeleteFunc: sdcc.deleteScyllaDBDatacenterNodesStatusReport,
 	})
 
+	scyllaDBManagerTaskInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcc.addScyllaDBManagerTask,
+		UpdateFunc: sdcc.updateScyllaDBManagerTask,
+		DeleteFunc: sdcc.deleteScyllaDBManagerTask,
+	})
+
 	scyllaOperatorConfigInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    sdcc.addScyllaOperatorConfig,
 		UpdateFunc: sdcc.updateScyllaOperatorConfig,
 		DeleteFunc: sdcc.deleteScyllaOperatorConfig,
 	})
 
+	if gatewayAPIInformers != nil {
+		for _, informer := range []*gatewayapi.ResourceInformer{
+			gatewayAPIInformers.Gateways,
+			gatewayAPIInformers.HTTPRoutes,
+			gatewayAPIInformers.BackendTLSPolicies,
+			gatewayAPIInformers.TLSRoutes,
+		} {
+			informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc:    sdcc.addGatewayAPIObject,
+				UpdateFunc: sdcc.updateGatewayAPIObject,
+				DeleteFunc: sdcc.deleteGatewayAPIObject,
+			})
+		}
+	}
+
 	return sdcc, nil
 }
 
@@ -426,7 +475,7 @@ func (sdcc *Controller) deleteService(obj interface{}) {
 func (sdcc *Controller) addSecret(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*corev1.Secret),
-		sdcc.enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotationOrOwner,
+		sdcc.enqueueThroughSecretReferenceOrOwner,
 	)
 }
 
@@ -434,7 +483,7 @@ func (sdcc *Controller) updateSecret(old, cur interface{}) {
 	sdcc.handlers.HandleUpdate(
 		old.(*corev1.Secret),
 		cur.(*corev1.Secret),
-		sdcc.enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotationOrOwner,
+		sdcc.enqueueThroughSecretReferenceOrOwner,
 		sdcc.deleteSecret,
 	)
 }
@@ -442,7 +491,7 @@ func (sdcc *Controller) updateSecret(old, cur interface{}) {
 func (sdcc *Controller) deleteSecret(obj interface{}) {
 	sdcc.handlers.HandleDelete(
 		obj,
-		sdcc.enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotationOrOwner,
+		sdcc.enqueueThroughSecretReferenceOrOwner,
 	)
 }
 
@@ -607,6 +656,29 @@ func (sdcc *Controller) deleteIngress(obj interface{}) {
 	)
 }
 
+func (sdcc *Controller) addGatewayAPIObject(obj interface{}) {
+	sdcc.handlers.HandleAdd(
+		obj.(*unstructured.Unstructured),
+		sdcc.handlers.EnqueueOwner,
+	)
+}
+
+func (sdcc *Controller) updateGatewayAPIObject(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*unstructured.Unstructured),
+		cur.(*unstructured.Unstructured),
+		sdcc.handlers.EnqueueOwner,
+		sdcc.deleteGatewayAPIObject,
+	)
+}
+
+func (sdcc *Controller) deleteGatewayAPIObject(obj interface{}) {
+	sdcc.handlers.HandleDelete(
+		obj,
+		sdcc.handlers.EnqueueOwner,
+	)
+}
+
 func (sdcc *Controller) addScyllaDBDatacenter(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
@@ -653,10 +725,12 @@ func (sdcc *Controller) deleteJob(obj interface{}) {
 	)
 }
 
-func (sdcc *Controller) enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotationOrOwner(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+func (sdcc *Controller) enqueueThroughSecretReferenceOrOwner(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
 	secret := obj.(*corev1.Secret)
 
 	sdcc.enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecretRefAnnotation(secret)(depth+1, secret, op)
+	sdcc.enqueueThroughAlternatorCQLCredentialsSecretRef(secret)(depth+1, secret, op)
+	sdcc.enqueueThroughClientConnectionBundleCredentialsSecretRef(secret)(depth+1, secret, op)
 	sdcc.handlers.EnqueueOwner(depth+1, obj, op)
 }
 
@@ -666,6 +740,28 @@ func (sdcc *Controller) enqueueThroughScyllaDBManagerAgentAuthTokenOverrideSecre
 	}))
 }
 
+func (sdcc *Controller) enqueueThroughAlternatorCQLCredentialsSecretRef(secret *corev1.Secret) controllerhelpers.EnqueueFuncType {
+	return sdcc.handlers.EnqueueAllFunc(sdcc.handlers.EnqueueWithFilterFunc(func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		if secret.Namespace != sdc.Namespace {
+			return false
+		}
+
+		alternatorOptions := sdc.Spec.ScyllaDB.AlternatorOptions
+		return alternatorOptions != nil && alternatorOptions.CQLCredentialsSecretRef != nil && alternatorOptions.CQLCredentialsSecretRef.Name == secret.Name
+	}))
+}
+
+func (sdcc *Controller) enqueueThroughClientConnectionBundleCredentialsSecretRef(secret *corev1.Secret) controllerhelpers.EnqueueFuncType {
+	return sdcc.handlers.EnqueueAllFunc(sdcc.handlers.EnqueueWithFilterFunc(func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		if secret.Namespace != sdc.Namespace {
+			return false
+		}
+
+		bundleOptions := sdc.Spec.ClientConnectionBundle
+		return bundleOptions != nil && bundleOptions.CredentialsSecretRef != nil && bundleOptions.CredentialsSecretRef.Name == secret.Name
+	}))
+}
+
 func (sdcc *Controller) addScyllaDBDatacenterNodesStatusReport(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport),
@@ -689,6 +785,29 @@ func (sdcc *Controller) deleteScyllaDBDatacenterNodesStatusReport(obj interface{
 	)
 }
 
+func (sdcc *Controller) addScyllaDBManagerTask(obj interface{}) {
+	sdcc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBManagerTask),
+		sdcc.handlers.EnqueueOwner,
+	)
+}
+
+func (sdcc *Controller) updateScyllaDBManagerTask(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBManagerTask),
+		cur.(*scyllav1alpha1.ScyllaDBManagerTask),
+		sdcc.handlers.EnqueueOwner,
+		sdcc.deleteScyllaDBManagerTask,
+	)
+}
+
+func (sdcc *Controller) deleteScyllaDBManagerTask(obj interface{}) {
+	sdcc.handlers.HandleDelete(
+		obj,
+		sdcc.handlers.EnqueueOwner,
+	)
+}
+
 func (sdcc *Controller) addScyllaOperatorConfig(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*scyllav1alpha1.ScyllaOperatorConfig),
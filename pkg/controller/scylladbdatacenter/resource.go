@@ -16,6 +16,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/cmdutil"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/features"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
@@ -32,11 +33,15 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilintstr "k8s.io/apimachinery/pkg/util/intstr"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -55,10 +60,10 @@ const (
 )
 
 const (
-	portNameCQL              = "cql"
-	portNameCQLSSL           = "cql-ssl"
-	portNameCQLShardAware    = "cql-shard-aware"
-	portNameCQLSSLShardAware = "cql-ssl-shard-aware"
+	portNameCQL              = naming.CQLPortName
+	portNameCQLSSL           = naming.CQLSSLPortName
+	portNameCQLShardAware    = naming.CQLShardAwarePortName
+	portNameCQLSSLShardAware = naming.CQLSSLShardAwarePortName
 	portNameThrift           = "thrift"
 
 	alternatorInsecurePort     = 8000
@@ -215,6 +220,12 @@ func MemberService(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName, name string
 		case scyllav1alpha1.NodeServiceTypeHeadless:
 			svc.Spec.Type = corev1.ServiceTypeClusterIP
 			svc.Spec.ClusterIP = corev1.ClusterIPNone
+		case scyllav1alpha1.NodeServiceTypeNodePort:
+			svc.Spec.Type = corev1.ServiceTypeNodePort
+			err = setMemberServiceNodePorts(sdc, rackName, name, svc.Spec.Ports)
+			if err != nil {
+				return nil, fmt.Errorf("can't set node ports: %w", err)
+			}
 		default:
 			return nil, fmt.Errorf("unsupported node service type %q", ns.Type)
 		}
@@ -243,6 +254,125 @@ func MemberService(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName, name string
 	return svc, nil
 }
 
+// setMemberServiceNodePorts allocates a deterministic block of node ports to the CQL ports of a member Service.
+// The block is based on the position of the rack in the spec and the ordinal of the node within the rack.
+// ScyllaDB keeps serving regular CQL on the default ports, which the allocated node ports are mapped to.
+// ScyllaDB advertises shard-aware ports to drivers, so they are exposed on the same port number
+// inside and outside the cluster and ScyllaDB is configured to listen on them.
+func setMemberServiceNodePorts(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName, name string, ports []corev1.ServicePort) error {
+	_, rackIndex, ok := oslices.Find(sdc.Spec.Racks, func(rs scyllav1alpha1.RackSpec) bool {
+		return rs.Name == rackName
+	})
+	if !ok {
+		return fmt.Errorf("can't find rack spec having %q name", rackName)
+	}
+
+	nodeOrdinal, err := naming.IndexFromName(name)
+	if err != nil {
+		return fmt.Errorf("can't get node ordinal from name %q: %w", name, err)
+	}
+
+	nodePorts, err := getNodePorts(sdc.Spec.ExposeOptions.NodeService, rackIndex, int(nodeOrdinal))
+	if err != nil {
+		return err
+	}
+
+	for i := range ports {
+		switch ports[i].Name {
+		case portNameCQL:
+			ports[i].NodePort = nodePorts[0]
+			ports[i].TargetPort = apimachineryutilintstr.FromInt32(scylla.DefaultNativeTransportPort)
+		case portNameCQLSSL:
+			ports[i].NodePort = nodePorts[1]
+			ports[i].TargetPort = apimachineryutilintstr.FromInt32(scylla.DefaultNativeTransportPortSSL)
+		case portNameCQLShardAware:
+			ports[i].Port = nodePorts[2]
+			ports[i].NodePort = nodePorts[2]
+		case portNameCQLSSLShardAware:
+			ports[i].Port = nodePorts[3]
+			ports[i].NodePort = nodePorts[3]
+		}
+	}
+
+	return nil
+}
+
+// getNodePorts returns node ports allocated to a node, in order: CQL, CQL SSL, shard-aware CQL and shard-aware CQL SSL.
+func getNodePorts(nodeService *scyllav1alpha1.NodeServiceTemplate, rackIndex, nodeOrdinal int) ([scyllav1alpha1.NodePortsPerNode]int32, error) {
+	var nodePorts [scyllav1alpha1.NodePortsPerNode]int32
+
+	basePort, maxNodesPerRack := nodeService.GetNodePortAllocation()
+	if nodeOrdinal >= int(maxNodesPerRack) {
+		return nodePorts, fmt.Errorf("node ordinal %d exceeds the number of nodes node ports are reserved for (%d)", nodeOrdinal, maxNodesPerRack)
+	}
+
+	firstPort := int(basePort) + (rackIndex*int(maxNodesPerRack)+nodeOrdinal)*scyllav1alpha1.NodePortsPerNode
+	if firstPort+scyllav1alpha1.NodePortsPerNode-1 > 65535 {
+		return nodePorts, fmt.Errorf("node ports starting at %d exceed the port range", firstPort)
+	}
+
+	for i := range nodePorts {
+		nodePorts[i] = int32(firstPort + i)
+	}
+
+	return nodePorts, nil
+}
+
+// AlternatorService returns a Service load-balancing Alternator traffic across ScyllaDB nodes.
+// It returns nil when Alternator endpoint isn't requested.
+func AlternatorService(sdc *scyllav1alpha1.ScyllaDBDatacenter) *corev1.Service {
+	if sdc.Spec.ScyllaDB.AlternatorOptions == nil || sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint == nil {
+		return nil
+	}
+
+	serviceOptions := sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Service
+	if serviceOptions == nil {
+		serviceOptions = &scyllav1alpha1.AlternatorEndpointServiceOptions{}
+	}
+
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+	maps.Copy(labels, serviceOptions.Labels)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+	labels[naming.ScyllaServiceTypeLabel] = string(naming.ScyllaServiceTypeAlternator)
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+	maps.Copy(annotations, serviceOptions.Annotations)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        naming.AlternatorServiceName(sdc),
+			Namespace:   sdc.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: naming.ScyllaDBNodePodsSelectorLabels(sdc),
+			Ports: []corev1.ServicePort{
+				{
+					Name: alternatorTLSPortName,
+					Port: alternatorTLSPort,
+				},
+			},
+			LoadBalancerClass: copyReferencedValue(serviceOptions.LoadBalancerClass),
+		},
+	}
+
+	if len(serviceOptions.Type) != 0 {
+		svc.Spec.Type = serviceOptions.Type
+	}
+
+	if len(sdc.Spec.IPFamilies) > 0 {
+		svc.Spec.IPFamilies = sdc.Spec.IPFamilies
+		svc.Spec.IPFamilyPolicy = sdc.Spec.IPFamilyPolicy
+	}
+
+	return svc
+}
+
 func getServicePorts(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]corev1.ServicePort, error) {
 	ports := []corev1.ServicePort{
 		{
@@ -343,16 +473,26 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 		return nil, fmt.Errorf("can't get selector labels: %w", err)
 	}
 
-	scyllaDBVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
-	if err != nil {
-		return nil, fmt.Errorf("can't get version of image %q: %w", sdc.Spec.ScyllaDB.Image, err)
-	}
-	scyllaDBSemver := semver.NewScyllaVersion(scyllaDBVersion)
-
 	if sdc.Spec.RackTemplate != nil {
 		rack = applyRackTemplateOnRackSpec(sdc.Spec.RackTemplate, rack)
 	}
 
+	scyllaDBImage := sdc.Spec.ScyllaDB.Image
+	if rack.ScyllaDB != nil && rack.ScyllaDB.Image != nil {
+		scyllaDBImage = *rack.ScyllaDB.Image
+	}
+
+	additionalScyllaDBArguments := sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments
+	if rack.ScyllaDB != nil && rack.ScyllaDB.AdditionalScyllaDBArguments != nil {
+		additionalScyllaDBArguments = rack.ScyllaDB.AdditionalScyllaDBArguments
+	}
+
+	scyllaDBVersion, err := naming.ImageToVersion(scyllaDBImage)
+	if err != nil {
+		return nil, fmt.Errorf("can't get version of image %q: %w", scyllaDBImage, err)
+	}
+	scyllaDBSemver := semver.NewScyllaVersion(scyllaDBVersion)
+
 	requiredLabels := map[string]string{}
 	requiredLabels[naming.RackOrdinalLabel] = strconv.Itoa(rackOrdinal)
 	requiredLabels[naming.ScyllaVersionLabel] = scyllaDBVersion
@@ -483,7 +623,7 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 		return nil, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
 	}
 
-	initContainers, err := makeInitContainers(sdc, sidecarImage)
+	initContainers, err := makeInitContainers(sdc, sidecarImage, scyllaDBImage)
 	if err != nil {
 		return nil, fmt.Errorf("can't make init containers: %w", err)
 	}
@@ -675,15 +815,15 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 						// ScyllaDB container depends on the availability of the operator binary in the shared volume.
 						{
 							Name:            naming.ScyllaContainerName,
-							Image:           sdc.Spec.ScyllaDB.Image,
+							Image:           scyllaDBImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
 							Ports:           scyllaContainerPorts,
 							// TODO: unprivileged entrypoint
 							Command: func() []string {
 								var positionalArgs []string
 
-								if len(sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments) > 0 {
-									positionalArgs = append(positionalArgs, sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments...)
+								if len(additionalScyllaDBArguments) > 0 {
+									positionalArgs = append(positionalArgs, additionalScyllaDBArguments...)
 								}
 
 								if sdc.Spec.ScyllaDB.EnableDeveloperMode != nil && *sdc.Spec.ScyllaDB.EnableDeveloperMode {
@@ -744,6 +884,17 @@ exec /mnt/shared/scylla-operator sidecar \
 												optionalArgs = append(optionalArgs, fmt.Sprintf("--external-seeds=%s", strings.Join(sdc.Spec.ScyllaDB.ExternalSeeds, ",")))
 											}
 
+											// Broadcast IP families are only passed when they're set explicitly, so existing nodes aren't rolled out.
+											if sdc.Spec.ExposeOptions != nil && sdc.Spec.ExposeOptions.BroadcastOptions != nil {
+												if sdc.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily != nil {
+													optionalArgs = append(optionalArgs, fmt.Sprintf("--nodes-broadcast-ip-family=%s", *sdc.Spec.ExposeOptions.BroadcastOptions.Nodes.IPFamily))
+												}
+
+												if sdc.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily != nil {
+													optionalArgs = append(optionalArgs, fmt.Sprintf("--clients-broadcast-ip-family=%s", *sdc.Spec.ExposeOptions.BroadcastOptions.Clients.IPFamily))
+												}
+											}
+
 											return strings.Join(optionalArgs, ` \`)
 										}() +
 										` -- "$@"`,
@@ -863,7 +1014,7 @@ exec /mnt/shared/scylla-operator sidecar \
 								ProbeHandler: corev1.ProbeHandler{
 									HTTPGet: &corev1.HTTPGetAction{
 										Port: apimachineryutilintstr.FromInt(naming.ScyllaDBAPIStatusProbePort),
-										Path: naming.LivenessProbePath,
+										Path: naming.StartupProbePath,
 									},
 								},
 							},
@@ -924,23 +1075,26 @@ wait
 						{
 							// ScyllaDB doesn't provide readiness or liveness probe,
 							// so we use our own probe sidecar to expose such endpoints.
-							Name:            "scylladb-api-status-probe",
+							Name:            naming.ScyllaDBAPIStatusProbeContainerName,
 							Image:           sidecarImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Command: []string{
-								"/usr/bin/scylla-operator",
-								"serve-probes",
-								"scylladb-api-status",
-								fmt.Sprintf("--port=%d", naming.ScyllaDBAPIStatusProbePort),
-								"--service-name=$(SERVICE_NAME)",
-								fmt.Sprintf("--scylla-localhost-address=%s", func() string {
-									if sdc.Spec.GetIPFamily() == corev1.IPv6Protocol {
-										return "::1"
-									}
-									return "127.0.0.1"
-								}()),
-								fmt.Sprintf("--loglevel=%d", cmdutil.GetLoglevelOrDefaultOrDie()),
-							},
+							Command: append(
+								[]string{
+									"/usr/bin/scylla-operator",
+									"serve-probes",
+									"scylladb-api-status",
+									fmt.Sprintf("--port=%d", naming.ScyllaDBAPIStatusProbePort),
+									"--service-name=$(SERVICE_NAME)",
+									fmt.Sprintf("--scylla-localhost-address=%s", func() string {
+										if sdc.Spec.GetIPFamily() == corev1.IPv6Protocol {
+											return "::1"
+										}
+										return "127.0.0.1"
+									}()),
+									fmt.Sprintf("--loglevel=%d", cmdutil.GetLoglevelOrDefaultOrDie()),
+								},
+								makeScyllaDBReadinessArgs(sdc.Spec.ScyllaDB.Readiness)...,
+							),
 							Env: []corev1.EnvVar{
 								{
 									Name: "SERVICE_NAME",
@@ -1040,6 +1194,10 @@ wait
 						PodAffinity:     placement.PodAffinity,
 						PodAntiAffinity: placement.PodAntiAffinity,
 					},
+					TopologySpreadConstraints:     placement.TopologySpreadConstraints,
+					PriorityClassName:             placement.PriorityClassName,
+					SchedulerName:                 placement.SchedulerName,
+					RuntimeClassName:              placement.RuntimeClassName,
 					ImagePullSecrets:              sdc.Spec.ImagePullSecrets,
 					TerminationGracePeriodSeconds: pointer.Ptr(int64(900)),
 				},
@@ -1117,9 +1275,72 @@ wait
 		sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers, *nodeExporterContainer)
 	}
 
+	if rack.PodTemplate != nil {
+		applyPodTemplate(&sts.Spec.Template.Spec, rack.PodTemplate)
+	}
+
 	return sts, nil
 }
 
+// applyPodTemplate merges the user provided Pod template into the Pod spec generated by the operator.
+func applyPodTemplate(podSpec *corev1.PodSpec, podTemplate *scyllav1alpha1.PodTemplate) {
+	if podTemplate.SecurityContext != nil {
+		podSpec.SecurityContext = podTemplate.SecurityContext.DeepCopy()
+	}
+
+	for _, ha := range podTemplate.HostAliases {
+		podSpec.HostAliases = append(podSpec.HostAliases, *ha.DeepCopy())
+	}
+
+	for _, co := range podTemplate.ContainerOverrides {
+		for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+			_, idx, ok := oslices.Find(containers, func(c corev1.Container) bool {
+				return c.Name == co.Name
+			})
+			if !ok {
+				continue
+			}
+
+			container := &containers[idx]
+
+			if co.SecurityContext != nil {
+				container.SecurityContext = co.SecurityContext.DeepCopy()
+			}
+
+			for _, env := range co.Env {
+				container.Env = append(container.Env, *env.DeepCopy())
+			}
+
+			if co.Lifecycle != nil {
+				if container.Lifecycle == nil {
+					container.Lifecycle = &corev1.Lifecycle{}
+				}
+				if co.Lifecycle.PostStart != nil {
+					container.Lifecycle.PostStart = co.Lifecycle.PostStart.DeepCopy()
+				}
+				if co.Lifecycle.PreStop != nil {
+					container.Lifecycle.PreStop = co.Lifecycle.PreStop.DeepCopy()
+				}
+			}
+		}
+	}
+
+	for _, c := range podTemplate.SidecarContainers {
+		sc := c.DeepCopy()
+		podSpec.Containers = append(podSpec.Containers, corev1.Container{
+			Name:            sc.Name,
+			Image:           sc.Image,
+			ImagePullPolicy: sc.ImagePullPolicy,
+			Command:         sc.Command,
+			Args:            sc.Args,
+			Env:             sc.Env,
+			Resources:       sc.Resources,
+			VolumeMounts:    sc.VolumeMounts,
+			SecurityContext: sc.SecurityContext,
+		})
+	}
+}
+
 func containerPorts(sdc *scyllav1alpha1.ScyllaDBDatacenter, sv semver.ScyllaVersion) ([]corev1.ContainerPort, error) {
 	ports := []corev1.ContainerPort{
 		{
@@ -1199,7 +1420,7 @@ func containerPorts(sdc *scyllav1alpha1.ScyllaDBDatacenter, sv semver.ScyllaVers
 	return ports, nil
 }
 
-func makeInitContainers(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage string) ([]corev1.Container, error) {
+func makeInitContainers(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage string, scyllaDBImage string) ([]corev1.Container, error) {
 	var initContainers []corev1.Container
 
 	sidecarInjectionCointainer := makeSidecarInjectionContainer(sidecarImage)
@@ -1213,7 +1434,7 @@ func makeInitContainers(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage str
 		initContainers = append(initContainers, *sysctlContainer)
 	}
 
-	bootstrapBarrierContainer, ok, err := makeScyllaDBBootstrapBarrierInitContainer(sdc, sidecarImage)
+	bootstrapBarrierContainer, ok, err := makeScyllaDBBootstrapBarrierInitContainer(sdc, scyllaDBImage)
 	if err != nil {
 		return nil, fmt.Errorf("can't make ScyllaDB bootstrap barrier init container: %w", err)
 	}
@@ -1270,7 +1491,7 @@ func makeSysctlInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImag
 
 	opt := true
 	return &corev1.Container{
-		Name:            "sysctl-buddy",
+		Name:            naming.SysctlBuddyContainerName,
 		Image:           sidecarImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		SecurityContext: &corev1.SecurityContext{
@@ -1296,14 +1517,14 @@ func makeSysctlInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImag
 
 // makeScyllaDBBootstrapBarrierInitContainer creates an init container that blocks proceeding with ScyllaDB startup until bootstrap preconditions are met.
 // It depends on the availability of the operator binary in a shared volume, as well as `scylla sstable query` command in the ScyllaDB container image.
-func makeScyllaDBBootstrapBarrierInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) (*corev1.Container, bool, error) {
+func makeScyllaDBBootstrapBarrierInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, scyllaDBImage string) (*corev1.Container, bool, error) {
 	if !utilfeature.DefaultMutableFeatureGate.Enabled(features.BootstrapSynchronisation) {
 		return nil, false, nil
 	}
 
-	scyllaDBVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+	scyllaDBVersion, err := naming.ImageToVersion(scyllaDBImage)
 	if err != nil {
-		return nil, false, fmt.Errorf("can't get version of image %q: %w", sdc.Spec.ScyllaDB.Image, err)
+		return nil, false, fmt.Errorf("can't get version of image %q: %w", scyllaDBImage, err)
 	}
 	sv := semver.NewScyllaVersion(scyllaDBVersion)
 	if !sv.SupportFeatureUnsafe(semver.ScyllaDBVersionRequiredForBootstrapSynchronisation) {
@@ -1312,8 +1533,8 @@ func makeScyllaDBBootstrapBarrierInitContainer(sdc *scyllav1alpha1.ScyllaDBDatac
 	}
 
 	c := &corev1.Container{
-		Name:            "scylladb-bootstrap-barrier",
-		Image:           sdc.Spec.ScyllaDB.Image,
+		Name:            naming.ScyllaDBBootstrapBarrierContainerName,
+		Image:           scyllaDBImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Command: []string{
 			"/mnt/shared/scylla-operator",
@@ -1469,6 +1690,15 @@ exec scylla-manager-agent \
 		}
 	}
 
+	// ScyllaDB Manager Agent accesses the backup location when the datacenter is restored from a backup.
+	if sdc.Spec.BootstrapFrom != nil && sdc.Spec.BootstrapFrom.Backup != nil && sdc.Spec.BootstrapFrom.Backup.CredentialsSecretRef != nil {
+		cnt.EnvFrom = append(cnt.EnvFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: *sdc.Spec.BootstrapFrom.Backup.CredentialsSecretRef,
+			},
+		})
+	}
+
 	return cnt, nil
 }
 
@@ -1549,11 +1779,6 @@ func MakePodDisruptionBudget(sdc *scyllav1alpha1.ScyllaDBDatacenter) *policyv1.P
 }
 
 func MakeIngresses(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) []*networkingv1.Ingress {
-	// Don't create Ingresses if cluster isn't exposed.
-	if sdc.Spec.ExposeOptions == nil {
-		return nil
-	}
-
 	type params struct {
 		ingressNameSuffix     string
 		portName              string
@@ -1563,7 +1788,8 @@ func MakeIngresses(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*
 	}
 	var ingressParams []params
 
-	if sdc.Spec.ExposeOptions.CQL != nil && sdc.Spec.ExposeOptions.CQL.Ingress != nil {
+	// Don't create CQL Ingresses if cluster isn't exposed.
+	if sdc.Spec.ExposeOptions != nil && sdc.Spec.ExposeOptions.CQL != nil && sdc.Spec.ExposeOptions.CQL.Ingress != nil {
 		ingressParams = append(ingressParams, params{
 			ingressNameSuffix:     "cql",
 			portName:              portNameCQLSSL,
@@ -1618,6 +1844,9 @@ func MakeIngresses(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*
 				}
 				labels[naming.ScyllaIngressTypeLabel] = string(naming.ScyllaIngressTypeNode)
 
+			case naming.ScyllaServiceTypeAlternator:
+				continue
+
 			default:
 				klog.Warningf("Unsupported Scylla service type %q, not creating Ingress for it", service.Labels[naming.ScyllaServiceTypeLabel])
 				continue
@@ -1667,6 +1896,11 @@ func MakeIngresses(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*
 		}
 	}
 
+	alternatorIngress := makeAlternatorIngress(sdc)
+	if alternatorIngress != nil {
+		ingresses = append(ingresses, alternatorIngress)
+	}
+
 	slices.SortFunc(ingresses, func(a, b *networkingv1.Ingress) int {
 		return cmp.Compare(a.GetName(), b.GetName())
 	})
@@ -1674,6 +1908,98 @@ func MakeIngresses(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*
 	return ingresses
 }
 
+// makeAlternatorIngress returns an Ingress routing the requested hosts to the Alternator Service.
+// It returns nil when Alternator isn't exposed through an Ingress.
+func makeAlternatorIngress(sdc *scyllav1alpha1.ScyllaDBDatacenter) *networkingv1.Ingress {
+	if sdc.Spec.ScyllaDB.AlternatorOptions == nil ||
+		sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint == nil ||
+		sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Type != scyllav1alpha1.AlternatorEndpointTypeIngress ||
+		sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Ingress == nil {
+		return nil
+	}
+
+	ingressOptions := sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Ingress
+
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+	maps.Copy(labels, ingressOptions.Labels)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+	labels[naming.ScyllaIngressTypeLabel] = string(naming.ScyllaIngressTypeAlternator)
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+	maps.Copy(annotations, ingressOptions.Annotations)
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        naming.AlternatorServiceName(sdc),
+			Namespace:   sdc.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+		},
+		Spec: networkingv1.IngressSpec{},
+	}
+
+	if len(ingressOptions.IngressClassName) != 0 {
+		ingress.Spec.IngressClassName = pointer.Ptr(ingressOptions.IngressClassName)
+	}
+
+	pathPrefix := networkingv1.PathTypePrefix
+	for _, host := range ingressOptions.Hosts {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{
+			Host: host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{
+							Path:     "/",
+							PathType: &pathPrefix,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: naming.AlternatorServiceName(sdc),
+									Port: networkingv1.ServiceBackendPort{
+										Name: alternatorTLSPortName,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return ingress
+}
+
+// makeAlternatorUserCredentialsSecret returns a Secret holding Alternator credentials of the user.
+// Alternator uses the role name as the access key ID and its salted hash as the secret access key.
+func makeAlternatorUserCredentialsSecret(sdc *scyllav1alpha1.ScyllaDBDatacenter, userName string, secretAccessKey string) *corev1.Secret {
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+	labels[naming.AlternatorUserNameLabel] = userName
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        naming.AlternatorUserCredentialsSecretName(sdc, userName),
+			Namespace:   sdc.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			naming.AlternatorAccessKeyIDKey:     []byte(userName),
+			naming.AlternatorSecretAccessKeyKey: []byte(secretAccessKey),
+		},
+	}
+}
+
 func makeAgentAuthTokenSecret(sdc *scyllav1alpha1.ScyllaDBDatacenter, agentAuthToken string) (*corev1.Secret, error) {
 	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
 	maps.Copy(labels, naming.ClusterLabels(sdc))
@@ -1949,6 +2275,34 @@ func MakeJobs(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev
 	return jobs, progressingConditions, nil
 }
 
+// makeScyllaDBReadinessArgs returns the probe server arguments configuring the readiness of the node.
+// The default readiness mode doesn't add any arguments, so that it doesn't change the Pod template of existing datacenters.
+func makeScyllaDBReadinessArgs(readiness *scyllav1alpha1.ScyllaDBReadinessOptions) []string {
+	if readiness == nil {
+		return nil
+	}
+
+	switch readiness.Mode {
+	case scyllav1alpha1.ScyllaDBReadinessModeCQLQuery:
+		return []string{
+			fmt.Sprintf("--readiness-mode=%s", readiness.Mode),
+		}
+
+	case scyllav1alpha1.ScyllaDBReadinessModePeerQuorum:
+		args := []string{
+			fmt.Sprintf("--readiness-mode=%s", readiness.Mode),
+		}
+		if readiness.MinUpPeersPercent != nil {
+			args = append(args, fmt.Sprintf("--min-up-peers-percent=%d", *readiness.MinUpPeersPercent))
+		}
+		return args
+
+	default:
+		return nil
+
+	}
+}
+
 func MakeManagedScyllaDBConfigMaps(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]*corev1.ConfigMap, error) {
 	var managedCMs []*corev1.ConfigMap
 
@@ -2066,6 +2420,21 @@ func MakeManagedScyllaDBConfig(sdc *scyllav1alpha1.ScyllaDBDatacenter) (*corev1.
 		return nil, fmt.Errorf("can't render managed scylladb config: %w", err)
 	}
 
+	// Typed config is kept under a separate key, so it can be merged on top of the custom config.
+	// It's only set when there are options, so that ScyllaDB nodes aren't rolled out when no typed config is used.
+	configOptions := helpers.ScyllaDBConfigOptions(sdc.Spec.ScyllaDB.Config)
+	if len(configOptions) != 0 {
+		configBytes, err := yaml.Marshal(configOptions)
+		if err != nil {
+			return nil, fmt.Errorf("can't marshal scylladb config: %w", err)
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[naming.ScyllaDBConfigName] = string(configBytes)
+	}
+
 	cm.SetOwnerReferences([]metav1.OwnerReference{
 		{
 			APIVersion:         scyllav1alpha1.ScyllaDBDatacenterGVK.GroupVersion().String(),
@@ -2149,6 +2518,24 @@ func applyRackTemplateOnRackSpec(rackTemplate *scyllav1alpha1.RackTemplate, rack
 			}(),
 			ScyllaDB: func() *scyllav1alpha1.ScyllaDBTemplate {
 				return &scyllav1alpha1.ScyllaDBTemplate{
+					Image: func() *string {
+						if rack.ScyllaDB != nil && rack.ScyllaDB.Image != nil {
+							return rack.ScyllaDB.Image
+						}
+						if rackTemplate.ScyllaDB != nil && rackTemplate.ScyllaDB.Image != nil {
+							return rackTemplate.ScyllaDB.Image
+						}
+						return nil
+					}(),
+					AdditionalScyllaDBArguments: func() []string {
+						if rack.ScyllaDB != nil && rack.ScyllaDB.AdditionalScyllaDBArguments != nil {
+							return rack.ScyllaDB.AdditionalScyllaDBArguments
+						}
+						if rackTemplate.ScyllaDB != nil && rackTemplate.ScyllaDB.AdditionalScyllaDBArguments != nil {
+							return rackTemplate.ScyllaDB.AdditionalScyllaDBArguments
+						}
+						return nil
+					}(),
 					Resources: func() *corev1.ResourceRequirements {
 						limits := make(corev1.ResourceList)
 						requests := make(corev1.ResourceList)
@@ -2341,6 +2728,7 @@ func applyRackTemplateOnRackSpec(rackTemplate *scyllav1alpha1.RackTemplate, rack
 
 				return dst
 			}(),
+			PodTemplate: controllerhelpers.MergePodTemplates(rackTemplate.PodTemplate, rack.PodTemplate),
 		},
 	}
 }
@@ -2385,6 +2773,90 @@ func cloneMapExcludingKeysOrEmpty[M ~map[K]V, S ~[]K, K comparable, V any](m M,
 	return r
 }
 
+func MakeRestoreScyllaDBManagerTask(sdc *scyllav1alpha1.ScyllaDBDatacenter, phase scyllav1alpha1.RestorePhase) (*scyllav1alpha1.ScyllaDBManagerTask, error) {
+	if sdc.Spec.BootstrapFrom == nil || sdc.Spec.BootstrapFrom.Backup == nil {
+		return nil, fmt.Errorf("ScyllaDBDatacenter %q isn't bootstrapped from a backup", naming.ObjRef(sdc))
+	}
+
+	backup := sdc.Spec.BootstrapFrom.Backup
+	restoreOptions := &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+		Location:    slices.Clone(backup.Location),
+		SnapshotTag: backup.SnapshotTag,
+	}
+
+	var target string
+	switch phase {
+	case scyllav1alpha1.RestorePhaseRestoringSchema:
+		target = "schema"
+		restoreOptions.RestoreSchema = pointer.Ptr(true)
+
+	case scyllav1alpha1.RestorePhaseRestoringTables:
+		target = "tables"
+		restoreOptions.RestoreTables = pointer.Ptr(true)
+		// The schema is restored in full, keyspace filters only select the tables which content is restored.
+		restoreOptions.Keyspace = slices.Clone(backup.Keyspace)
+
+	default:
+		return nil, fmt.Errorf("unsupported restore phase %q", phase)
+
+	}
+
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+
+	return &scyllav1alpha1.ScyllaDBManagerTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.RestoreScyllaDBManagerTaskName(sdc, target),
+			Namespace: sdc.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+				Kind: scyllav1alpha1.ScyllaDBDatacenterGVK.Kind,
+				Name: sdc.Name,
+			},
+			Type:    scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+			Restore: restoreOptions,
+		},
+	}, nil
+}
+
+// makeRestartRequiredManagedConfigData returns the managed config data without the options that ScyllaDB nodes reload live,
+// so that changing them doesn't roll out the nodes.
+func makeRestartRequiredManagedConfigData(data map[string]string) (map[string]string, error) {
+	configData, ok := data[naming.ScyllaDBConfigName]
+	if !ok {
+		return data, nil
+	}
+
+	var configOptions map[string]any
+	err := yaml.Unmarshal([]byte(configData), &configOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal %q: %w", naming.ScyllaDBConfigName, err)
+	}
+
+	maps.DeleteFunc(configOptions, func(option string, _ any) bool {
+		return helpers.IsScyllaDBConfigOptionLiveUpdatable(option)
+	})
+
+	restartRequiredData := maps.Clone(data)
+	if len(configOptions) == 0 {
+		delete(restartRequiredData, naming.ScyllaDBConfigName)
+		return restartRequiredData, nil
+	}
+
+	configBytes, err := yaml.Marshal(configOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal %q: %w", naming.ScyllaDBConfigName, err)
+	}
+	restartRequiredData[naming.ScyllaDBConfigName] = string(configBytes)
+
+	return restartRequiredData, nil
+}
+
 func makeScyllaDBDatacenterNodesStatusReport(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, podLister corev1listers.PodLister) (*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport, error) {
 	var err error
 
@@ -2538,7 +3010,267 @@ func makeNodeStatusReport(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackSpec *scyl
 	})
 
 	nodeStatusReport.ObservedNodes = internalNodeStatusReport.ObservedNodes
+	nodeStatusReport.OperationMode = internalNodeStatusReport.OperationMode
+	nodeStatusReport.LoadBytes = internalNodeStatusReport.LoadBytes
+	nodeStatusReport.TokenCount = internalNodeStatusReport.TokenCount
+	nodeStatusReport.Version = internalNodeStatusReport.Version
+	nodeStatusReport.BootstrapStalled = internalNodeStatusReport.BootstrapStalled
 
 	klog.V(5).InfoS("Successfully built a node status report for an expected node", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc), "Pod", klog.KObj(pod))
 	return nodeStatusReport, true, nil
 }
+
+// makeGatewayAPIObject returns an unstructured Gateway API object of the given kind with the metadata common to all
+// Gateway API objects created for the ScyllaDBDatacenter.
+func makeGatewayAPIObject(sdc *scyllav1alpha1.ScyllaDBDatacenter, gvk schema.GroupVersionKind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	gatewayOptions := sdc.Spec.ExposeOptions.Gateway
+
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+	maps.Copy(labels, gatewayOptions.Labels)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+	maps.Copy(annotations, gatewayOptions.Annotations)
+
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(sdc.Namespace)
+	obj.SetLabels(labels)
+	obj.SetAnnotations(annotations)
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+	})
+
+	return obj
+}
+
+func isExposedThroughGateway(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Spec.ExposeOptions != nil && sdc.Spec.ExposeOptions.Gateway != nil
+}
+
+var (
+	// gatewayCQLResources holds Gateway API resources needed to route CQL connections through a Gateway.
+	// TLSRoute is only available in the experimental channel of Gateway API.
+	gatewayCQLResources = []schema.GroupVersionResource{gatewayapi.TLSRouteGVR}
+
+	// gatewayAlternatorResources holds Gateway API resources needed to route Alternator connections through a Gateway.
+	gatewayAlternatorResources = []schema.GroupVersionResource{gatewayapi.HTTPRouteGVR, gatewayapi.BackendTLSPolicyGVR}
+)
+
+func isCQLExposedThroughGateway(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return isExposedThroughGateway(sdc) && sdc.Spec.ExposeOptions.Gateway.CQL != nil
+}
+
+func isAlternatorExposedThroughGateway(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return isExposedThroughGateway(sdc) && sdc.Spec.ExposeOptions.Gateway.Alternator != nil && sdc.Spec.ScyllaDB.AlternatorOptions != nil
+}
+
+func gatewayListenerPort(port int32, defaultPort int32) int64 {
+	if port == 0 {
+		return int64(defaultPort)
+	}
+
+	return int64(port)
+}
+
+// MakeGateway returns a Gateway accepting CQL and Alternator connections.
+// CQL connections are passed through to ScyllaDB nodes, while TLS of Alternator connections is terminated
+// using the operator-managed Alternator serving certificate.
+// Listeners are only added for protocols whose routes are served by the cluster.
+// It returns nil when ScyllaDBDatacenter isn't exposed through the Gateway API, or none of its listeners can be routed.
+func MakeGateway(sdc *scyllav1alpha1.ScyllaDBDatacenter, servedResources apimachineryutilsets.Set[schema.GroupVersionResource]) *unstructured.Unstructured {
+	if !isExposedThroughGateway(sdc) {
+		return nil
+	}
+
+	gatewayOptions := sdc.Spec.ExposeOptions.Gateway
+
+	var listeners []interface{}
+	if gatewayOptions.CQL != nil && servedResources.HasAll(gatewayCQLResources...) {
+		listeners = append(listeners, map[string]interface{}{
+			"name":     naming.CQLProtocolDNSLabel,
+			"protocol": "TLS",
+			"port":     gatewayListenerPort(gatewayOptions.CQL.Port, scylla.DefaultNativeTransportPortSSL),
+			"tls": map[string]interface{}{
+				"mode": "Passthrough",
+			},
+			"allowedRoutes": map[string]interface{}{
+				"kinds": []interface{}{
+					map[string]interface{}{
+						"group": gatewayapi.GroupName,
+						"kind":  gatewayapi.TLSRouteGVK.Kind,
+					},
+				},
+			},
+		})
+	}
+
+	if gatewayOptions.Alternator != nil && servedResources.HasAll(gatewayAlternatorResources...) {
+		listeners = append(listeners, map[string]interface{}{
+			"name":     naming.AlternatorProtocolDNSLabel,
+			"protocol": "HTTPS",
+			"port":     gatewayListenerPort(gatewayOptions.Alternator.Port, alternatorTLSPort),
+			"tls": map[string]interface{}{
+				"mode": "Terminate",
+				"certificateRefs": []interface{}{
+					map[string]interface{}{
+						"kind": "Secret",
+						"name": naming.GetScyllaClusterAlternatorLocalServingCertName(sdc.Name),
+					},
+				},
+			},
+			"allowedRoutes": map[string]interface{}{
+				"kinds": []interface{}{
+					map[string]interface{}{
+						"group": gatewayapi.GroupName,
+						"kind":  gatewayapi.HTTPRouteGVK.Kind,
+					},
+				},
+			},
+		})
+	}
+
+	if len(listeners) == 0 {
+		return nil
+	}
+
+	return makeGatewayAPIObject(sdc, gatewayapi.GatewayGVK, naming.GatewayName(sdc), map[string]interface{}{
+		"gatewayClassName": gatewayOptions.GatewayClassName,
+		"listeners":        listeners,
+	})
+}
+
+// MakeTLSRoutes returns TLSRoutes routing the any-node and per-node CQL hostnames to the corresponding Services.
+// The hostnames follow the same DNS domain naming as CQL Ingresses.
+func MakeTLSRoutes(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) []*unstructured.Unstructured {
+	if !isCQLExposedThroughGateway(sdc) {
+		return nil
+	}
+
+	var tlsRoutes []*unstructured.Unstructured
+	for _, service := range services {
+		var hostnames []interface{}
+
+		switch naming.ScyllaServiceType(service.Labels[naming.ScyllaServiceTypeLabel]) {
+		case naming.ScyllaServiceTypeIdentity:
+			for _, domain := range sdc.Spec.DNSDomains {
+				hostnames = append(hostnames, naming.GetCQLProtocolSubDomain(domain))
+			}
+
+		case naming.ScyllaServiceTypeMember:
+			hostID, ok := service.Annotations[naming.HostIDAnnotation]
+			if !ok {
+				klog.V(4).Infof("Service %q is missing HostID annotation, postponing TLSRoute creation until it's available", naming.ObjRef(service))
+				continue
+			}
+
+			if len(hostID) == 0 {
+				klog.Warningf("Can't create TLSRoute for Service %s because it has unexpected empty HostID annotation", klog.KObj(service))
+				continue
+			}
+
+			for _, domain := range sdc.Spec.DNSDomains {
+				hostnames = append(hostnames, naming.GetCQLHostIDSubDomain(hostID, domain))
+			}
+
+		default:
+			continue
+		}
+
+		tlsRoutes = append(tlsRoutes, makeGatewayAPIObject(sdc, gatewayapi.TLSRouteGVK, fmt.Sprintf("%s-%s", service.Name, naming.CQLProtocolDNSLabel), map[string]interface{}{
+			"parentRefs": []interface{}{
+				map[string]interface{}{
+					"name":        naming.GatewayName(sdc),
+					"sectionName": naming.CQLProtocolDNSLabel,
+				},
+			},
+			"hostnames": hostnames,
+			"rules": []interface{}{
+				map[string]interface{}{
+					"backendRefs": []interface{}{
+						map[string]interface{}{
+							"name": service.Name,
+							"port": int64(scylla.DefaultNativeTransportPortSSL),
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	slices.SortFunc(tlsRoutes, func(a, b *unstructured.Unstructured) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	return tlsRoutes
+}
+
+// MakeHTTPRoutes returns HTTPRoutes routing the Alternator hostnames to ScyllaDB nodes.
+func MakeHTTPRoutes(sdc *scyllav1alpha1.ScyllaDBDatacenter) []*unstructured.Unstructured {
+	if !isAlternatorExposedThroughGateway(sdc) {
+		return nil
+	}
+
+	var hostnames []interface{}
+	for _, domain := range sdc.Spec.DNSDomains {
+		hostnames = append(hostnames, naming.GetAlternatorProtocolSubDomain(domain))
+	}
+
+	return []*unstructured.Unstructured{
+		makeGatewayAPIObject(sdc, gatewayapi.HTTPRouteGVK, fmt.Sprintf("%s-%s", naming.IdentityServiceName(sdc), naming.AlternatorProtocolDNSLabel), map[string]interface{}{
+			"parentRefs": []interface{}{
+				map[string]interface{}{
+					"name":        naming.GatewayName(sdc),
+					"sectionName": naming.AlternatorProtocolDNSLabel,
+				},
+			},
+			"hostnames": hostnames,
+			"rules": []interface{}{
+				map[string]interface{}{
+					"backendRefs": []interface{}{
+						map[string]interface{}{
+							"name": naming.IdentityServiceName(sdc),
+							"port": int64(alternatorTLSPort),
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// MakeBackendTLSPolicies returns BackendTLSPolicies making the Gateway connect to the Alternator backends of HTTPRoutes
+// using TLS, verified with the operator-managed Alternator serving CA.
+func MakeBackendTLSPolicies(sdc *scyllav1alpha1.ScyllaDBDatacenter) []*unstructured.Unstructured {
+	if !isAlternatorExposedThroughGateway(sdc) {
+		return nil
+	}
+
+	return []*unstructured.Unstructured{
+		makeGatewayAPIObject(sdc, gatewayapi.BackendTLSPolicyGVK, fmt.Sprintf("%s-%s", naming.IdentityServiceName(sdc), naming.AlternatorProtocolDNSLabel), map[string]interface{}{
+			"targetRefs": []interface{}{
+				map[string]interface{}{
+					"group":       "",
+					"kind":        "Service",
+					"name":        naming.IdentityServiceName(sdc),
+					"sectionName": alternatorTLSPortName,
+				},
+			},
+			"validation": map[string]interface{}{
+				"caCertificateRefs": []interface{}{
+					map[string]interface{}{
+						"group": "",
+						"kind":  "ConfigMap",
+						"name":  naming.GetScyllaClusterAlternatorLocalServingCAName(sdc.Name),
+					},
+				},
+				"hostname": fmt.Sprintf("%s.%s.svc", naming.IdentityServiceName(sdc), sdc.Namespace),
+			},
+		}),
+	}
+}
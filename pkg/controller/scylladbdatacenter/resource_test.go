@@ -13,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/features"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -25,7 +26,10 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apimachineryutilintstr "k8s.io/apimachinery/pkg/util/intstr"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -627,6 +631,64 @@ func TestMemberService(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "NodePort service with node ports allocated by rack position and node ordinal",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := basicSC.DeepCopy()
+				sdc.Spec.Racks = append([]scyllav1alpha1.RackSpec{{Name: "other"}}, sdc.Spec.Racks...)
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					NodeService: &scyllav1alpha1.NodeServiceTemplate{
+						Type:                  scyllav1alpha1.NodeServiceTypeNodePort,
+						ExternalTrafficPolicy: pointer.Ptr(corev1.ServiceExternalTrafficPolicyLocal),
+						NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+							BasePort:        31000,
+							MaxNodesPerRack: 4,
+						},
+					},
+				}
+				return sdc
+			}(),
+			rackName:   basicRackName,
+			svcName:    "member-2",
+			oldService: nil,
+			jobs:       nil,
+			expectedService: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "member-2",
+					Labels:          basicSVCLabels(),
+					Annotations:     basicSVCAnnotations(),
+					OwnerReferences: basicSCOwnerRefs,
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeNodePort,
+					Selector: map[string]string{
+						"statefulset.kubernetes.io/pod-name": "member-2",
+					},
+					PublishNotReadyAddresses: true,
+					ExternalTrafficPolicy:    corev1.ServiceExternalTrafficPolicyLocal,
+					Ports: func() []corev1.ServicePort {
+						ports := slices.Clone(basicPorts)
+						for i := range ports {
+							switch ports[i].Name {
+							case "cql":
+								ports[i].NodePort = 31024
+								ports[i].TargetPort = apimachineryutilintstr.FromInt32(9042)
+							case "cql-ssl":
+								ports[i].NodePort = 31025
+								ports[i].TargetPort = apimachineryutilintstr.FromInt32(9142)
+							case "cql-shard-aware":
+								ports[i].Port = 31026
+								ports[i].NodePort = 31026
+							case "cql-ssl-shard-aware":
+								ports[i].Port = 31027
+								ports[i].NodePort = 31027
+							}
+						}
+						return ports
+					}(),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -643,6 +705,84 @@ func TestMemberService(t *testing.T) {
 	}
 }
 
+func Test_getNodePorts(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		nodeService       *scyllav1alpha1.NodeServiceTemplate
+		rackIndex         int
+		nodeOrdinal       int
+		expectedNodePorts [scyllav1alpha1.NodePortsPerNode]int32
+		expectedErr       error
+	}{
+		{
+			name: "default maximum number of nodes per rack",
+			nodeService: &scyllav1alpha1.NodeServiceTemplate{
+				Type: scyllav1alpha1.NodeServiceTypeNodePort,
+				NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+					BasePort: 30000,
+				},
+			},
+			rackIndex:         1,
+			nodeOrdinal:       3,
+			expectedNodePorts: [scyllav1alpha1.NodePortsPerNode]int32{30076, 30077, 30078, 30079},
+		},
+		{
+			name: "custom allocation options",
+			nodeService: &scyllav1alpha1.NodeServiceTemplate{
+				Type: scyllav1alpha1.NodeServiceTypeNodePort,
+				NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+					BasePort:        32000,
+					MaxNodesPerRack: 2,
+				},
+			},
+			rackIndex:         2,
+			nodeOrdinal:       1,
+			expectedNodePorts: [scyllav1alpha1.NodePortsPerNode]int32{32020, 32021, 32022, 32023},
+		},
+		{
+			name: "node ordinal exceeding reserved nodes",
+			nodeService: &scyllav1alpha1.NodeServiceTemplate{
+				Type: scyllav1alpha1.NodeServiceTypeNodePort,
+				NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+					MaxNodesPerRack: 2,
+				},
+			},
+			rackIndex:   0,
+			nodeOrdinal: 2,
+			expectedErr: fmt.Errorf("node ordinal 2 exceeds the number of nodes node ports are reserved for (2)"),
+		},
+		{
+			name: "node ports exceeding port range",
+			nodeService: &scyllav1alpha1.NodeServiceTemplate{
+				Type: scyllav1alpha1.NodeServiceTypeNodePort,
+				NodePort: &scyllav1alpha1.NodePortAllocationOptions{
+					BasePort: 65530,
+				},
+			},
+			rackIndex:   0,
+			nodeOrdinal: 1,
+			expectedErr: fmt.Errorf("node ports starting at 65534 exceed the port range"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getNodePorts(tc.nodeService, tc.rackIndex, tc.nodeOrdinal)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if got != tc.expectedNodePorts {
+				t.Errorf("expected and actual node ports differ: %s", cmp.Diff(tc.expectedNodePorts, got))
+			}
+		})
+	}
+}
+
 func runTestStatefulSetForRack(t *testing.T) {
 	logEnabledFeatures(t)
 
@@ -1123,7 +1263,7 @@ exec /mnt/shared/scylla-operator sidecar \
 									ProbeHandler: corev1.ProbeHandler{
 										HTTPGet: &corev1.HTTPGetAction{
 											Port: apimachineryutilintstr.FromInt(8080),
-											Path: "/healthz",
+											Path: "/startupz",
 										},
 									},
 								},
@@ -1564,6 +1704,128 @@ exec scylla-manager-agent \
 			}(),
 			expectedError: nil,
 		},
+		{
+			name: "new StatefulSet with scheduling options",
+			rack: func() scyllav1alpha1.RackSpec {
+				r := newBasicRack()
+				r.Placement = &scyllav1alpha1.Placement{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       corev1.LabelHostname,
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+						},
+					},
+					PriorityClassName: "scylladb-critical",
+					SchedulerName:     "custom-scheduler",
+					RuntimeClassName:  pointer.Ptr("custom-runtime"),
+				}
+				return r
+			}(),
+			scyllaDBDatacenter:  newBasicScyllaDBDatacenter(),
+			existingStatefulSet: nil,
+			expectedStatefulSet: func() *appsv1.StatefulSet {
+				s := newBasicStatefulSet()
+				s.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       corev1.LabelHostname,
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+					},
+				}
+				s.Spec.Template.Spec.PriorityClassName = "scylladb-critical"
+				s.Spec.Template.Spec.SchedulerName = "custom-scheduler"
+				s.Spec.Template.Spec.RuntimeClassName = pointer.Ptr("custom-runtime")
+				return s
+			}(),
+			expectedError: nil,
+		},
+		{
+			name: "new StatefulSet with pod template overrides",
+			rack: func() scyllav1alpha1.RackSpec {
+				r := newBasicRack()
+				r.PodTemplate = &scyllav1alpha1.PodTemplate{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Ptr(int64(1000)),
+					},
+					HostAliases: []corev1.HostAlias{
+						{
+							IP:        "10.0.0.1",
+							Hostnames: []string{"foo.local"},
+						},
+					},
+					ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+						{
+							Name: naming.ScyllaContainerName,
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: pointer.Ptr(false),
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name:  "FOO",
+									Value: "bar",
+								},
+							},
+							Lifecycle: &corev1.Lifecycle{
+								PostStart: &corev1.LifecycleHandler{
+									Exec: &corev1.ExecAction{
+										Command: []string{"true"},
+									},
+								},
+							},
+						},
+						{
+							Name: "not-present",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "FOO",
+									Value: "bar",
+								},
+							},
+						},
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "log-shipper",
+							Image: "log-shipper:latest",
+						},
+					},
+				}
+				return r
+			}(),
+			scyllaDBDatacenter:  newBasicScyllaDBDatacenter(),
+			existingStatefulSet: nil,
+			expectedStatefulSet: func() *appsv1.StatefulSet {
+				s := newBasicStatefulSet()
+				s.Spec.Template.Spec.SecurityContext = &corev1.PodSecurityContext{
+					FSGroup: pointer.Ptr(int64(1000)),
+				}
+				s.Spec.Template.Spec.HostAliases = []corev1.HostAlias{
+					{
+						IP:        "10.0.0.1",
+						Hostnames: []string{"foo.local"},
+					},
+				}
+				s.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+					AllowPrivilegeEscalation: pointer.Ptr(false),
+				}
+				s.Spec.Template.Spec.Containers[0].Env = append(s.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+					Name:  "FOO",
+					Value: "bar",
+				})
+				s.Spec.Template.Spec.Containers[0].Lifecycle.PostStart = &corev1.LifecycleHandler{
+					Exec: &corev1.ExecAction{
+						Command: []string{"true"},
+					},
+				}
+				s.Spec.Template.Spec.Containers = append(s.Spec.Template.Spec.Containers, corev1.Container{
+					Name:  "log-shipper",
+					Image: "log-shipper:latest",
+				})
+				return s
+			}(),
+			expectedError: nil,
+		},
 		{
 			name: "new StatefulSet with non-nil ImagePullSecrets",
 			rack: newBasicRack(),
@@ -2129,6 +2391,45 @@ exec scylla-manager-agent \
 			}(),
 			expectedError: nil,
 		},
+		{
+			name: "new StatefulSet with ScyllaDB image and additional arguments overridden on rack level",
+			rack: func() scyllav1alpha1.RackSpec {
+				r := newBasicRack()
+				r.ScyllaDB.AdditionalScyllaDBArguments = []string{
+					"--io-properties-file=/etc/scylla.d/io_properties.yaml",
+				}
+				return r
+			}(),
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AdditionalScyllaDBArguments = []string{
+					"--batch-size-warn-threshold-in-kb=128",
+				}
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Image: pointer.Ptr(unit.ScyllaDBImageBelowNodeExporterThreshold),
+					},
+				}
+				return sdc
+			}(),
+			existingStatefulSet: nil,
+			expectedStatefulSet: func() *appsv1.StatefulSet {
+				sts := newBasicStatefulSet()
+
+				sts.Labels["scylla/scylla-version"] = unit.ScyllaDBImageBelowNodeExporterThresholdTag
+				sts.Spec.Template.Labels["scylla/scylla-version"] = unit.ScyllaDBImageBelowNodeExporterThresholdTag
+				sts.Spec.Template.Spec.Containers[scyllaContainerIndex].Image = unit.ScyllaDBImageBelowNodeExporterThreshold
+
+				if utilfeature.DefaultMutableFeatureGate.Enabled(features.BootstrapSynchronisation) {
+					sts.Spec.Template.Spec.InitContainers[runBootstrapBarrierInitContainerIndex].Image = unit.ScyllaDBImageBelowNodeExporterThreshold
+				}
+
+				sts.Spec.Template.Spec.Containers[scyllaContainerIndex].Command = append(sts.Spec.Template.Spec.Containers[scyllaContainerIndex].Command[:len(sts.Spec.Template.Spec.Containers[scyllaContainerIndex].Command)-1], "--io-properties-file=/etc/scylla.d/io_properties.yaml", "--developer-mode=0")
+
+				return sts
+			}(),
+			expectedError: nil,
+		},
 	}
 
 	for _, tc := range tt {
@@ -2833,6 +3134,94 @@ func TestMakeIngresses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "alternator ingress routes hosts to alternator service",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := basicScyllaCluster.DeepCopy()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type: scyllav1alpha1.AlternatorEndpointTypeIngress,
+						Ingress: &scyllav1alpha1.AlternatorEndpointIngressOptions{
+							ObjectTemplateMetadata: scyllav1alpha1.ObjectTemplateMetadata{
+								Annotations: map[string]string{
+									"nginx.ingress.kubernetes.io/backend-protocol": "HTTPS",
+								},
+							},
+							IngressClassName: "nginx",
+							Hosts:            []string{"alternator.scylladb.com"},
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			services: map[string]*corev1.Service{
+				"basic-client": newIdentityService("basic-client"),
+				"basic-alternator": &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "basic-alternator",
+						Labels: map[string]string{
+							"scylla-operator.scylladb.com/scylla-service-type": "alternator",
+						},
+					},
+				},
+			},
+			expectedIngresses: []*networkingv1.Ingress{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "basic-alternator",
+						Labels: map[string]string{
+							"app":                          "scylla",
+							"app.kubernetes.io/name":       "scylla",
+							"app.kubernetes.io/managed-by": "scylla-operator",
+							"default-sc-label":             "foo",
+							"scylla/cluster":               "basic",
+							"scylla-operator.scylladb.com/scylla-ingress-type": "Alternator",
+						},
+						Annotations: map[string]string{
+							"default-sc-annotation":                        "bar",
+							"nginx.ingress.kubernetes.io/backend-protocol": "HTTPS",
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "scylla.scylladb.com/v1alpha1",
+								Kind:               "ScyllaDBDatacenter",
+								Name:               "basic",
+								UID:                "the-uid",
+								Controller:         pointer.Ptr(true),
+								BlockOwnerDeletion: pointer.Ptr(true),
+							},
+						},
+					},
+					Spec: networkingv1.IngressSpec{
+						IngressClassName: pointer.Ptr("nginx"),
+						Rules: []networkingv1.IngressRule{
+							{
+								Host: "alternator.scylladb.com",
+								IngressRuleValue: networkingv1.IngressRuleValue{
+									HTTP: &networkingv1.HTTPIngressRuleValue{
+										Paths: []networkingv1.HTTPIngressPath{
+											{
+												Path:     "/",
+												PathType: &pathTypePrefix,
+												Backend: networkingv1.IngressBackend{
+													Service: &networkingv1.IngressServiceBackend{
+														Name: "basic-alternator",
+														Port: networkingv1.ServiceBackendPort{
+															Name: "alternator-tls",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -2847,52 +3236,782 @@ func TestMakeIngresses(t *testing.T) {
 	}
 }
 
-func TestIdentityService(t *testing.T) {
-	basicSDC := &scyllav1alpha1.ScyllaDBDatacenter{
+func newGatewayTestScyllaDBDatacenter() *scyllav1alpha1.ScyllaDBDatacenter {
+	return &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+			UID:       "the-uid",
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+			Labels: map[string]string{
+				"default-sc-label": "foo",
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			DNSDomains:     []string{"public.scylladb.com", "private.scylladb.com"},
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				AlternatorOptions: &scyllav1alpha1.AlternatorOptions{},
+			},
+			ExposeOptions: &scyllav1alpha1.ExposeOptions{
+				Gateway: &scyllav1alpha1.GatewayExposeOptions{
+					ObjectTemplateMetadata: scyllav1alpha1.ObjectTemplateMetadata{
+						Labels: map[string]string{
+							"gateway-label": "foo",
+						},
+						Annotations: map[string]string{
+							"gateway-annotation": "bar",
+						},
+					},
+					GatewayClassName: "gateway-class",
+					CQL: &scyllav1alpha1.GatewayCQLOptions{
+						Port: 9142,
+					},
+					Alternator: &scyllav1alpha1.GatewayAlternatorOptions{
+						Port: 8043,
+					},
+				},
+			},
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "rack",
+				},
+			},
+		},
+	}
+}
+
+func newExpectedGatewayAPIObjectMetadata(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      name,
+		"namespace": "scylla",
+		"labels": map[string]interface{}{
+			"app":                          "scylla",
+			"app.kubernetes.io/name":       "scylla",
+			"app.kubernetes.io/managed-by": "scylla-operator",
+			"default-sc-label":             "foo",
+			"gateway-label":                "foo",
+			"scylla/cluster":               "basic",
+		},
+		"annotations": map[string]interface{}{
+			"default-sc-annotation": "bar",
+			"gateway-annotation":    "bar",
+		},
+		"ownerReferences": []interface{}{
+			map[string]interface{}{
+				"apiVersion":         "scylla.scylladb.com/v1alpha1",
+				"kind":               "ScyllaDBDatacenter",
+				"name":               "basic",
+				"uid":                "the-uid",
+				"controller":         true,
+				"blockOwnerDeletion": true,
+			},
+		},
+	}
+}
+
+func TestMakeGateway(t *testing.T) {
+	t.Parallel()
+
+	allResources := apimachineryutilsets.New(gatewayapi.GatewayGVR, gatewayapi.HTTPRouteGVR, gatewayapi.BackendTLSPolicyGVR, gatewayapi.TLSRouteGVR)
+
+	tt := []struct {
+		name               string
+		scyllaDBDatacenter *scyllav1alpha1.ScyllaDBDatacenter
+		servedResources    apimachineryutilsets.Set[schema.GroupVersionResource]
+		expectedGateway    *unstructured.Unstructured
+	}{
+		{
+			name: "no Gateway when ScyllaDBDatacenter isn't exposed through the Gateway API",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway = nil
+				return sdc
+			}(),
+			servedResources: allResources,
+			expectedGateway: nil,
+		},
+		{
+			name:               "Gateway with CQL passthrough and Alternator terminating listeners",
+			scyllaDBDatacenter: newGatewayTestScyllaDBDatacenter(),
+			servedResources:    allResources,
+			expectedGateway: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "gateway.networking.k8s.io/v1",
+					"kind":       "Gateway",
+					"metadata":   newExpectedGatewayAPIObjectMetadata("basic"),
+					"spec": map[string]interface{}{
+						"gatewayClassName": "gateway-class",
+						"listeners": []interface{}{
+							map[string]interface{}{
+								"name":     "cql",
+								"protocol": "TLS",
+								"port":     int64(9142),
+								"tls": map[string]interface{}{
+									"mode": "Passthrough",
+								},
+								"allowedRoutes": map[string]interface{}{
+									"kinds": []interface{}{
+										map[string]interface{}{
+											"group": "gateway.networking.k8s.io",
+											"kind":  "TLSRoute",
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":     "alternator",
+								"protocol": "HTTPS",
+								"port":     int64(8043),
+								"tls": map[string]interface{}{
+									"mode": "Terminate",
+									"certificateRefs": []interface{}{
+										map[string]interface{}{
+											"kind": "Secret",
+											"name": "basic-alternator-local-serving-certs",
+										},
+									},
+								},
+								"allowedRoutes": map[string]interface{}{
+									"kinds": []interface{}{
+										map[string]interface{}{
+											"group": "gateway.networking.k8s.io",
+											"kind":  "HTTPRoute",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Gateway with only CQL listener on a custom port",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway.CQL.Port = 443
+				sdc.Spec.ExposeOptions.Gateway.Alternator = nil
+				return sdc
+			}(),
+			servedResources: allResources,
+			expectedGateway: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "gateway.networking.k8s.io/v1",
+					"kind":       "Gateway",
+					"metadata":   newExpectedGatewayAPIObjectMetadata("basic"),
+					"spec": map[string]interface{}{
+						"gatewayClassName": "gateway-class",
+						"listeners": []interface{}{
+							map[string]interface{}{
+								"name":     "cql",
+								"protocol": "TLS",
+								"port":     int64(443),
+								"tls": map[string]interface{}{
+									"mode": "Passthrough",
+								},
+								"allowedRoutes": map[string]interface{}{
+									"kinds": []interface{}{
+										map[string]interface{}{
+											"group": "gateway.networking.k8s.io",
+											"kind":  "TLSRoute",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:               "Gateway with only Alternator listener when TLSRoute isn't served",
+			scyllaDBDatacenter: newGatewayTestScyllaDBDatacenter(),
+			servedResources:    apimachineryutilsets.New(gatewayapi.GatewayGVR, gatewayapi.HTTPRouteGVR, gatewayapi.BackendTLSPolicyGVR),
+			expectedGateway: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "gateway.networking.k8s.io/v1",
+					"kind":       "Gateway",
+					"metadata":   newExpectedGatewayAPIObjectMetadata("basic"),
+					"spec": map[string]interface{}{
+						"gatewayClassName": "gateway-class",
+						"listeners": []interface{}{
+							map[string]interface{}{
+								"name":     "alternator",
+								"protocol": "HTTPS",
+								"port":     int64(8043),
+								"tls": map[string]interface{}{
+									"mode": "Terminate",
+									"certificateRefs": []interface{}{
+										map[string]interface{}{
+											"kind": "Secret",
+											"name": "basic-alternator-local-serving-certs",
+										},
+									},
+								},
+								"allowedRoutes": map[string]interface{}{
+									"kinds": []interface{}{
+										map[string]interface{}{
+											"group": "gateway.networking.k8s.io",
+											"kind":  "HTTPRoute",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no Gateway when none of its listeners can be routed",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway.Alternator = nil
+				return sdc
+			}(),
+			servedResources: apimachineryutilsets.New(gatewayapi.GatewayGVR, gatewayapi.HTTPRouteGVR, gatewayapi.BackendTLSPolicyGVR),
+			expectedGateway: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeGateway(tc.scyllaDBDatacenter, tc.servedResources)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedGateway) {
+				t.Errorf("expected and actual Gateways differ: %s", cmp.Diff(tc.expectedGateway, got))
+			}
+		})
+	}
+}
+
+func TestMakeTLSRoutes(t *testing.T) {
+	t.Parallel()
+
+	services := map[string]*corev1.Service{
+		"basic-client": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-client",
+				Labels: map[string]string{
+					naming.ScyllaServiceTypeLabel: string(naming.ScyllaServiceTypeIdentity),
+				},
+			},
+		},
+		"basic-dc-rack-0": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-dc-rack-0",
+				Labels: map[string]string{
+					naming.ScyllaServiceTypeLabel: string(naming.ScyllaServiceTypeMember),
+				},
+				Annotations: map[string]string{
+					naming.HostIDAnnotation: "host-id-0",
+				},
+			},
+		},
+		"basic-dc-rack-1": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-dc-rack-1",
+				Labels: map[string]string{
+					naming.ScyllaServiceTypeLabel: string(naming.ScyllaServiceTypeMember),
+				},
+			},
+		},
+		"basic-alternator": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-alternator",
+				Labels: map[string]string{
+					naming.ScyllaServiceTypeLabel: string(naming.ScyllaServiceTypeAlternator),
+				},
+			},
+		},
+	}
+
+	newExpectedTLSRoute := func(name string, serviceName string, hostnames ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+				"kind":       "TLSRoute",
+				"metadata":   newExpectedGatewayAPIObjectMetadata(name),
+				"spec": map[string]interface{}{
+					"parentRefs": []interface{}{
+						map[string]interface{}{
+							"name":        "basic",
+							"sectionName": "cql",
+						},
+					},
+					"hostnames": hostnames,
+					"rules": []interface{}{
+						map[string]interface{}{
+							"backendRefs": []interface{}{
+								map[string]interface{}{
+									"name": serviceName,
+									"port": int64(9142),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name               string
+		scyllaDBDatacenter *scyllav1alpha1.ScyllaDBDatacenter
+		services           map[string]*corev1.Service
+		expectedTLSRoutes  []*unstructured.Unstructured
+	}{
+		{
+			name: "no TLSRoutes when CQL isn't exposed through the Gateway API",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway.CQL = nil
+				return sdc
+			}(),
+			services:          services,
+			expectedTLSRoutes: nil,
+		},
+		{
+			name:               "any-node and per-node TLSRoutes for services with host ID",
+			scyllaDBDatacenter: newGatewayTestScyllaDBDatacenter(),
+			services:           services,
+			expectedTLSRoutes: []*unstructured.Unstructured{
+				newExpectedTLSRoute(
+					"basic-client-cql",
+					"basic-client",
+					"cql.public.scylladb.com",
+					"cql.private.scylladb.com",
+				),
+				newExpectedTLSRoute(
+					"basic-dc-rack-0-cql",
+					"basic-dc-rack-0",
+					"host-id-0.cql.public.scylladb.com",
+					"host-id-0.cql.private.scylladb.com",
+				),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeTLSRoutes(tc.scyllaDBDatacenter, tc.services)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedTLSRoutes) {
+				t.Errorf("expected and actual TLSRoutes differ: %s", cmp.Diff(tc.expectedTLSRoutes, got))
+			}
+		})
+	}
+}
+
+func TestMakeHTTPRoutes(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name               string
+		scyllaDBDatacenter *scyllav1alpha1.ScyllaDBDatacenter
+		expectedHTTPRoutes []*unstructured.Unstructured
+	}{
+		{
+			name: "no HTTPRoutes when Alternator isn't exposed through the Gateway API",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway.Alternator = nil
+				return sdc
+			}(),
+			expectedHTTPRoutes: nil,
+		},
+		{
+			name: "no HTTPRoutes when Alternator isn't enabled",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = nil
+				return sdc
+			}(),
+			expectedHTTPRoutes: nil,
+		},
+		{
+			name:               "HTTPRoute routing Alternator hostnames to the identity service",
+			scyllaDBDatacenter: newGatewayTestScyllaDBDatacenter(),
+			expectedHTTPRoutes: []*unstructured.Unstructured{
+				{
+					Object: map[string]interface{}{
+						"apiVersion": "gateway.networking.k8s.io/v1",
+						"kind":       "HTTPRoute",
+						"metadata":   newExpectedGatewayAPIObjectMetadata("basic-client-alternator"),
+						"spec": map[string]interface{}{
+							"parentRefs": []interface{}{
+								map[string]interface{}{
+									"name":        "basic",
+									"sectionName": "alternator",
+								},
+							},
+							"hostnames": []interface{}{
+								"alternator.public.scylladb.com",
+								"alternator.private.scylladb.com",
+							},
+							"rules": []interface{}{
+								map[string]interface{}{
+									"backendRefs": []interface{}{
+										map[string]interface{}{
+											"name": "basic-client",
+											"port": int64(8043),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeHTTPRoutes(tc.scyllaDBDatacenter)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedHTTPRoutes) {
+				t.Errorf("expected and actual HTTPRoutes differ: %s", cmp.Diff(tc.expectedHTTPRoutes, got))
+			}
+		})
+	}
+}
+
+func TestMakeBackendTLSPolicies(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                       string
+		scyllaDBDatacenter         *scyllav1alpha1.ScyllaDBDatacenter
+		expectedBackendTLSPolicies []*unstructured.Unstructured
+	}{
+		{
+			name: "no BackendTLSPolicies when Alternator isn't exposed through the Gateway API",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ExposeOptions.Gateway.Alternator = nil
+				return sdc
+			}(),
+			expectedBackendTLSPolicies: nil,
+		},
+		{
+			name: "no BackendTLSPolicies when Alternator isn't enabled",
+			scyllaDBDatacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newGatewayTestScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.AlternatorOptions = nil
+				return sdc
+			}(),
+			expectedBackendTLSPolicies: nil,
+		},
+		{
+			name:               "BackendTLSPolicy validating the Alternator port of the identity service with the Alternator serving CA",
+			scyllaDBDatacenter: newGatewayTestScyllaDBDatacenter(),
+			expectedBackendTLSPolicies: []*unstructured.Unstructured{
+				{
+					Object: map[string]interface{}{
+						"apiVersion": "gateway.networking.k8s.io/v1",
+						"kind":       "BackendTLSPolicy",
+						"metadata":   newExpectedGatewayAPIObjectMetadata("basic-client-alternator"),
+						"spec": map[string]interface{}{
+							"targetRefs": []interface{}{
+								map[string]interface{}{
+									"group":       "",
+									"kind":        "Service",
+									"name":        "basic-client",
+									"sectionName": "alternator-tls",
+								},
+							},
+							"validation": map[string]interface{}{
+								"caCertificateRefs": []interface{}{
+									map[string]interface{}{
+										"group": "",
+										"kind":  "ConfigMap",
+										"name":  "basic-alternator-local-serving-ca",
+									},
+								},
+								"hostname": "basic-client.scylla.svc",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeBackendTLSPolicies(tc.scyllaDBDatacenter)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedBackendTLSPolicies) {
+				t.Errorf("expected and actual BackendTLSPolicies differ: %s", cmp.Diff(tc.expectedBackendTLSPolicies, got))
+			}
+		})
+	}
+}
+
+func TestIdentityService(t *testing.T) {
+	basicSDC := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "basic",
+			UID:  "the-uid",
+			Labels: map[string]string{
+				"default-sc-label": "foo",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				Image: "scylladb/scylla:latest",
+			},
+			RackTemplate: &scyllav1alpha1.RackTemplate{},
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "rack",
+				},
+			},
+		},
+	}
+
+	got, err := IdentityService(basicSDC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "basic-client",
+			Labels: map[string]string{
+				"app":                          "scylla",
+				"app.kubernetes.io/name":       "scylla",
+				"app.kubernetes.io/managed-by": "scylla-operator",
+				"scylla/cluster":               "basic",
+				"default-sc-label":             "foo",
+				"scylla-operator.scylladb.com/scylla-service-type": "identity",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "scylla.scylladb.com/v1alpha1",
+					Kind:               "ScyllaDBDatacenter",
+					Name:               "basic",
+					UID:                "the-uid",
+					Controller:         pointer.Ptr(true),
+					BlockOwnerDeletion: pointer.Ptr(true),
+				},
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{
+				"app":                                   "scylla",
+				"app.kubernetes.io/name":                "scylla",
+				"app.kubernetes.io/managed-by":          "scylla-operator",
+				"scylla/cluster":                        "basic",
+				"scylla-operator.scylladb.com/pod-type": string(naming.PodTypeScyllaDBNode),
+			},
+			Ports: []corev1.ServicePort{
+				{Name: "inter-node-communication", Port: 7000},
+				{Name: "ssl-inter-node-communication", Port: 7001},
+				{Name: "cql", Port: 9042},
+				{Name: "cql-ssl", Port: 9142},
+				{Name: "cql-shard-aware", Port: 19042},
+				{Name: "cql-ssl-shard-aware", Port: 19142},
+				{Name: "jmx-monitoring", Port: 7199},
+				{Name: "agent-api", Port: 10001},
+				{Name: "prometheus", Port: 9180},
+				{Name: "agent-prometheus", Port: 5090},
+				{Name: nodeExporterPortName, Port: nodeExporterPort},
+				{Name: "thrift", Port: 9160},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("identity service differs from expected:\n%s", cmp.Diff(expected, got))
+	}
+}
+
+func TestAlternatorService(t *testing.T) {
+	t.Parallel()
+
+	newBasicSDC := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic",
+				UID:  "the-uid",
+				Labels: map[string]string{
+					"default-sc-label": "foo",
+				},
+				Annotations: map[string]string{
+					"default-sc-annotation": "bar",
+				},
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc"),
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: "scylladb/scylla:latest",
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "rack",
+					},
+				},
+			},
+		}
+	}
+
+	newExpectedService := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-alternator",
+				Labels: map[string]string{
+					"app":                          "scylla",
+					"app.kubernetes.io/name":       "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"scylla/cluster":               "basic",
+					"default-sc-label":             "foo",
+					"scylla-operator.scylladb.com/scylla-service-type": "alternator",
+				},
+				Annotations: map[string]string{
+					"default-sc-annotation": "bar",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "scylla.scylladb.com/v1alpha1",
+						Kind:               "ScyllaDBDatacenter",
+						Name:               "basic",
+						UID:                "the-uid",
+						Controller:         pointer.Ptr(true),
+						BlockOwnerDeletion: pointer.Ptr(true),
+					},
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Type: corev1.ServiceTypeClusterIP,
+				Selector: map[string]string{
+					"app":                                   "scylla",
+					"app.kubernetes.io/name":                "scylla",
+					"app.kubernetes.io/managed-by":          "scylla-operator",
+					"scylla/cluster":                        "basic",
+					"scylla-operator.scylladb.com/pod-type": string(naming.PodTypeScyllaDBNode),
+				},
+				Ports: []corev1.ServicePort{
+					{Name: "alternator-tls", Port: 8043},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name            string
+		sdc             *scyllav1alpha1.ScyllaDBDatacenter
+		expectedService *corev1.Service
+	}{
+		{
+			name: "no service when alternator endpoint isn't requested",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicSDC()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{}
+				return sdc
+			}(),
+			expectedService: nil,
+		},
+		{
+			name: "ClusterIP service is created by default",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicSDC()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type: scyllav1alpha1.AlternatorEndpointTypeService,
+					},
+				}
+				return sdc
+			}(),
+			expectedService: newExpectedService(),
+		},
+		{
+			name: "service options are applied",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicSDC()
+				sdc.Spec.ScyllaDB.AlternatorOptions = &scyllav1alpha1.AlternatorOptions{
+					Endpoint: &scyllav1alpha1.AlternatorEndpointOptions{
+						Type: scyllav1alpha1.AlternatorEndpointTypeService,
+						Service: &scyllav1alpha1.AlternatorEndpointServiceOptions{
+							ObjectTemplateMetadata: scyllav1alpha1.ObjectTemplateMetadata{
+								Labels: map[string]string{
+									"custom-label": "foo",
+								},
+								Annotations: map[string]string{
+									"custom-annotation": "bar",
+								},
+							},
+							Type:              corev1.ServiceTypeLoadBalancer,
+							LoadBalancerClass: pointer.Ptr("lb-class"),
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedService: func() *corev1.Service {
+				svc := newExpectedService()
+				svc.Labels["custom-label"] = "foo"
+				svc.Annotations["custom-annotation"] = "bar"
+				svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+				svc.Spec.LoadBalancerClass = pointer.Ptr("lb-class")
+				return svc
+			}(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := AlternatorService(tc.sdc)
+			if !reflect.DeepEqual(got, tc.expectedService) {
+				t.Errorf("expected and got services differ:\n%s", cmp.Diff(tc.expectedService, got))
+			}
+		})
+	}
+}
+
+func Test_makeAlternatorUserCredentialsSecret(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "basic",
-			UID:  "the-uid",
-			Labels: map[string]string{
-				"default-sc-label": "foo",
-			},
-			Annotations: map[string]string{
-				"default-sc-annotation": "bar",
-			},
-		},
-		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
-			ClusterName:    "basic",
-			DatacenterName: pointer.Ptr("dc"),
-			ScyllaDB: scyllav1alpha1.ScyllaDB{
-				Image: "scylladb/scylla:latest",
-			},
-			RackTemplate: &scyllav1alpha1.RackTemplate{},
-			Racks: []scyllav1alpha1.RackSpec{
-				{
-					Name: "rack",
-				},
-			},
+			Name:      "basic",
+			Namespace: "default",
+			UID:       "the-uid",
 		},
 	}
 
-	got, err := IdentityService(basicSDC)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-
-	expected := &corev1.Service{
+	expected := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "basic-client",
+			Name:      "basic-alternator-user-app",
+			Namespace: "default",
 			Labels: map[string]string{
 				"app":                          "scylla",
 				"app.kubernetes.io/name":       "scylla",
 				"app.kubernetes.io/managed-by": "scylla-operator",
 				"scylla/cluster":               "basic",
-				"default-sc-label":             "foo",
-				"scylla-operator.scylladb.com/scylla-service-type": "identity",
-			},
-			Annotations: map[string]string{
-				"default-sc-annotation": "bar",
+				"scylla-operator.scylladb.com/alternator-user-name": "app",
 			},
+			Annotations: map[string]string{},
 			OwnerReferences: []metav1.OwnerReference{
 				{
 					APIVersion:         "scylla.scylladb.com/v1alpha1",
@@ -2904,33 +4023,16 @@ func TestIdentityService(t *testing.T) {
 				},
 			},
 		},
-		Spec: corev1.ServiceSpec{
-			Type: corev1.ServiceTypeClusterIP,
-			Selector: map[string]string{
-				"app":                                   "scylla",
-				"app.kubernetes.io/name":                "scylla",
-				"app.kubernetes.io/managed-by":          "scylla-operator",
-				"scylla/cluster":                        "basic",
-				"scylla-operator.scylladb.com/pod-type": string(naming.PodTypeScyllaDBNode),
-			},
-			Ports: []corev1.ServicePort{
-				{Name: "inter-node-communication", Port: 7000},
-				{Name: "ssl-inter-node-communication", Port: 7001},
-				{Name: "cql", Port: 9042},
-				{Name: "cql-ssl", Port: 9142},
-				{Name: "cql-shard-aware", Port: 19042},
-				{Name: "cql-ssl-shard-aware", Port: 19142},
-				{Name: "jmx-monitoring", Port: 7199},
-				{Name: "agent-api", Port: 10001},
-				{Name: "prometheus", Port: 9180},
-				{Name: "agent-prometheus", Port: 5090},
-				{Name: nodeExporterPortName, Port: nodeExporterPort},
-				{Name: "thrift", Port: 9160},
-			},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     []byte("app"),
+			"AWS_SECRET_ACCESS_KEY": []byte("salted-hash"),
 		},
 	}
+
+	got := makeAlternatorUserCredentialsSecret(sdc, "app", "salted-hash")
 	if !reflect.DeepEqual(got, expected) {
-		t.Errorf("identity service differs from expected:\n%s", cmp.Diff(expected, got))
+		t.Errorf("expected and got secrets differ:\n%s", cmp.Diff(expected, got))
 	}
 }
 
@@ -4800,6 +5902,77 @@ seed_provider:
       - seeds: "127.0.0.1"
 endpoint_snitch: "GossipingPropertyFileSnitch"
 internode_compression: "all"
+`, "\n"),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "typed config is rendered under a separate key",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Config = &scyllav1alpha1.ScyllaDBConfig{
+					Compaction: &scyllav1alpha1.ScyllaDBCompactionConfig{
+						ThroughputMBPerSecond: pointer.Ptr[int32](64),
+					},
+					Timeouts: &scyllav1alpha1.ScyllaDBTimeoutsConfig{
+						ReadRequestMilliseconds: pointer.Ptr[int32](5000),
+					},
+					HintedHandoff: &scyllav1alpha1.ScyllaDBHintedHandoffConfig{
+						Enabled: pointer.Ptr(false),
+					},
+					Tablets: &scyllav1alpha1.ScyllaDBTabletsConfig{
+						Enabled: pointer.Ptr(true),
+					},
+				}
+				return sdc
+			}(),
+			enableTLSFeatureGate: false,
+			expectedCM: &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "foo-ns",
+					Name:        "foo-managed-config",
+					Annotations: map[string]string{},
+					Labels: map[string]string{
+						"app":                          "scylla",
+						"app.kubernetes.io/managed-by": "scylla-operator",
+						"app.kubernetes.io/name":       "scylla",
+						"scylla/cluster":               "foo",
+						"user-label":                   "user-label-value",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBDatacenter",
+							Name:               "foo",
+							UID:                "uid-42",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Data: map[string]string{
+					"scylladb-managed-config.yaml": strings.TrimPrefix(`
+cluster_name: "foo-cluster"
+rpc_address: "0.0.0.0"
+api_address: "127.0.0.1"
+listen_address: "0.0.0.0"
+seed_provider:
+  - class_name: org.apache.cassandra.locator.SimpleSeedProvider
+    parameters:
+      - seeds: "127.0.0.1"
+endpoint_snitch: "GossipingPropertyFileSnitch"
+internode_compression: "all"
+`, "\n"),
+					"scylladb-config.yaml": strings.TrimPrefix(`
+compaction_throughput_mb_per_sec: 64
+enable_tablets: true
+hinted_handoff_enabled: false
+read_request_timeout_in_ms: 5000
 `, "\n"),
 				},
 			},
@@ -5450,6 +6623,194 @@ func Test_cloneMapExcludingKeysOrEmpty(t *testing.T) {
 	}
 }
 
+func TestMakeRestoreScyllaDBManagerTask(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "default",
+				UID:       "the-uid",
+				Labels: map[string]string{
+					"default-sc-label": "foo",
+					"scylla-operator.scylladb.com/register-with-global-scylladb-manager": "true",
+				},
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc"),
+				BootstrapFrom: &scyllav1alpha1.BootstrapFromOptions{
+					Backup: &scyllav1alpha1.BootstrapFromBackupOptions{
+						Location:    []string{"s3:backups"},
+						SnapshotTag: "sm_20250101000000UTC",
+						Keyspace:    []string{"keyspace1"},
+					},
+				},
+			},
+		}
+	}
+
+	newExpectedScyllaDBManagerTask := func(name string, restoreOptions *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions) *scyllav1alpha1.ScyllaDBManagerTask {
+		return &scyllav1alpha1.ScyllaDBManagerTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					"default-sc-label":             "foo",
+					"app":                          "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"app.kubernetes.io/name":       "scylla",
+					"scylla/cluster":               "basic",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "scylla.scylladb.com/v1alpha1",
+						Kind:               "ScyllaDBDatacenter",
+						Name:               "basic",
+						UID:                "the-uid",
+						Controller:         pointer.Ptr(true),
+						BlockOwnerDeletion: pointer.Ptr(true),
+					},
+				},
+			},
+			Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+				ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+					Kind: "ScyllaDBDatacenter",
+					Name: "basic",
+				},
+				Type:    scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+				Restore: restoreOptions,
+			},
+		}
+	}
+
+	tt := []struct {
+		name          string
+		sdc           *scyllav1alpha1.ScyllaDBDatacenter
+		phase         scyllav1alpha1.RestorePhase
+		expected      *scyllav1alpha1.ScyllaDBManagerTask
+		expectedError error
+	}{
+		{
+			name:  "schema restore task in RestoringSchema phase",
+			sdc:   newScyllaDBDatacenter(),
+			phase: scyllav1alpha1.RestorePhaseRestoringSchema,
+			expected: newExpectedScyllaDBManagerTask("basic-restore-schema", &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				Location:      []string{"s3:backups"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				RestoreSchema: pointer.Ptr(true),
+			}),
+			expectedError: nil,
+		},
+		{
+			name:  "tables restore task with keyspace filters in RestoringTables phase",
+			sdc:   newScyllaDBDatacenter(),
+			phase: scyllav1alpha1.RestorePhaseRestoringTables,
+			expected: newExpectedScyllaDBManagerTask("basic-restore-tables", &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				Location:      []string{"s3:backups"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				Keyspace:      []string{"keyspace1"},
+				RestoreTables: pointer.Ptr(true),
+			}),
+			expectedError: nil,
+		},
+		{
+			name:          "error in Completed phase",
+			sdc:           newScyllaDBDatacenter(),
+			phase:         scyllav1alpha1.RestorePhaseCompleted,
+			expected:      nil,
+			expectedError: fmt.Errorf(`unsupported restore phase "Completed"`),
+		},
+		{
+			name: "error when not bootstrapped from a backup",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter()
+				sdc.Spec.BootstrapFrom = nil
+				return sdc
+			}(),
+			phase:         scyllav1alpha1.RestorePhaseRestoringSchema,
+			expected:      nil,
+			expectedError: fmt.Errorf(`ScyllaDBDatacenter "default/basic" isn't bootstrapped from a backup`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MakeRestoreScyllaDBManagerTask(tc.sdc, tc.phase)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedError, err, cmpopts.EquateErrors()))
+			}
+
+			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got ScyllaDBManagerTasks differ:\n%s\n", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_makeRestartRequiredManagedConfigData(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		data          map[string]string
+		expected      map[string]string
+		expectedError error
+	}{
+		{
+			name: "data without typed config is kept",
+			data: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+			},
+			expected: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "typed config with only live-updatable options is removed",
+			data: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+				"scylladb-config.yaml":         "compaction_throughput_mb_per_sec: 64\nread_request_timeout_in_ms: 5000\n",
+			},
+			expected: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "live-updatable options are removed from typed config",
+			data: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+				"scylladb-config.yaml":         "compaction_throughput_mb_per_sec: 64\nenable_tablets: true\n",
+			},
+			expected: map[string]string{
+				"scylladb-managed-config.yaml": "cluster_name: foo\n",
+				"scylladb-config.yaml":         "enable_tablets: true\n",
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeRestartRequiredManagedConfigData(tc.data)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedError, err, cmpopts.EquateErrors()))
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got data differ:\n%s\n", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
 func Test_makeScyllaDBDatacenterNodesStatusReport(t *testing.T) {
 	t.Parallel()
 
@@ -6012,6 +7373,10 @@ func Test_makeScyllaDBDatacenterNodesStatusReport(t *testing.T) {
 							Status: scyllav1alpha1.NodeStatusUp,
 						},
 					},
+					OperationMode: scyllav1alpha1.NodeOperationModeNormal,
+					LoadBytes:     pointer.Ptr[int64](1200000),
+					TokenCount:    pointer.Ptr[int32](256),
+					Version:       "2025.1.0",
 				}),
 			},
 			expected: &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
@@ -6054,6 +7419,10 @@ func Test_makeScyllaDBDatacenterNodesStatusReport(t *testing.T) {
 										Status: scyllav1alpha1.NodeStatusUp,
 									},
 								},
+								OperationMode: scyllav1alpha1.NodeOperationModeNormal,
+								LoadBytes:     pointer.Ptr[int64](1200000),
+								TokenCount:    pointer.Ptr[int32](256),
+								Version:       "2025.1.0",
 							},
 						},
 					},
@@ -6984,3 +8353,59 @@ func logEnabledFeatures(t *testing.T) {
 		return fmt.Sprintf("%s=%t", f, utilfeature.DefaultMutableFeatureGate.Enabled(f))
 	}), ","))
 }
+
+func Test_makeScyllaDBReadinessArgs(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		readiness    *scyllav1alpha1.ScyllaDBReadinessOptions
+		expectedArgs []string
+	}{
+		{
+			name:         "no arguments when readiness isn't set",
+			readiness:    nil,
+			expectedArgs: nil,
+		},
+		{
+			name: "no arguments for the default readiness mode",
+			readiness: &scyllav1alpha1.ScyllaDBReadinessOptions{
+				Mode:              scyllav1alpha1.ScyllaDBReadinessModeNodeStatus,
+				MinUpPeersPercent: pointer.Ptr[int32](50),
+			},
+			expectedArgs: nil,
+		},
+		{
+			name: "CQL query readiness mode",
+			readiness: &scyllav1alpha1.ScyllaDBReadinessOptions{
+				Mode:              scyllav1alpha1.ScyllaDBReadinessModeCQLQuery,
+				MinUpPeersPercent: pointer.Ptr[int32](50),
+			},
+			expectedArgs: []string{
+				"--readiness-mode=CQLQuery",
+			},
+		},
+		{
+			name: "peer quorum readiness mode",
+			readiness: &scyllav1alpha1.ScyllaDBReadinessOptions{
+				Mode:              scyllav1alpha1.ScyllaDBReadinessModePeerQuorum,
+				MinUpPeersPercent: pointer.Ptr[int32](75),
+			},
+			expectedArgs: []string{
+				"--readiness-mode=PeerQuorum",
+				"--min-up-peers-percent=75",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeScyllaDBReadinessArgs(tc.readiness)
+			if !reflect.DeepEqual(got, tc.expectedArgs) {
+				t.Errorf("expected and got args differ:\n%s", cmp.Diff(tc.expectedArgs, got))
+			}
+		})
+	}
+}
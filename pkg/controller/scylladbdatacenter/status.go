@@ -3,12 +3,19 @@ package scylladbdatacenter
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -66,6 +73,7 @@ func (sdcc *Controller) calculateRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacent
 		UpdatedNodes:   pointer.Ptr(int32(0)),
 		ReadyNodes:     pointer.Ptr(int32(0)),
 		AvailableNodes: pointer.Ptr(int32(0)),
+		DegradedNodes:  pointer.Ptr(int32(0)),
 		Stale:          pointer.Ptr(true),
 	}
 
@@ -80,13 +88,28 @@ func (sdcc *Controller) calculateRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacent
 	status.CurrentNodes = pointer.Ptr(sts.Status.CurrentReplicas)
 	status.Stale = pointer.Ptr(sts.Status.ObservedGeneration < sts.Generation)
 
-	scyllaDBImageVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+	var scyllaDBImageVersion string
+	scyllaDBImage, err := controllerhelpers.GetRackScyllaDBImage(sdc, rackName)
 	if err != nil {
-		klog.ErrorS(err, "can't get version of image", "Image", sdc.Spec.ScyllaDB.Image)
+		klog.ErrorS(err, "can't get rack image", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackName)
+	} else {
+		scyllaDBImageVersion, err = naming.ImageToVersion(scyllaDBImage)
+		if err != nil {
+			klog.ErrorS(err, "can't get version of image", "Image", scyllaDBImage)
+		}
 	}
 
 	status.UpdatedVersion = scyllaDBImageVersion
 
+	status.RestartRequiredNodes = sdcc.getRestartRequiredNodes(sdc, rackName, *sts.Spec.Replicas)
+
+	status.NodeStatuses = sdcc.getNodeRuntimeStatuses(sdc, rackName)
+	for _, nodeStatus := range status.NodeStatuses {
+		if nodeStatus.Degraded {
+			*status.DegradedNodes++
+		}
+	}
+
 	// Update Rack Version
 	if status.Nodes != nil && *status.Nodes == 0 {
 		status.CurrentVersion = scyllaDBImageVersion
@@ -102,10 +125,265 @@ func (sdcc *Controller) calculateRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacent
 	return status
 }
 
+// getRestartRequiredNodes returns the names of rack nodes which reported config changes requiring a restart.
+func (sdcc *Controller) getRestartRequiredNodes(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string, nodes int32) []string {
+	rackIdx := slices.IndexFunc(sdc.Spec.Racks, func(rack scyllav1alpha1.RackSpec) bool {
+		return rack.Name == rackName
+	})
+	if rackIdx < 0 {
+		return nil
+	}
+	rack := sdc.Spec.Racks[rackIdx]
+
+	var restartRequiredNodes []string
+	for i := range nodes {
+		svcName := naming.MemberServiceName(rack, sdc, int(i))
+		svc, err := sdcc.serviceLister.Services(sdc.Namespace).Get(svcName)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "can't get member Service", "Service", klog.KRef(sdc.Namespace, svcName))
+			}
+			continue
+		}
+
+		if len(svc.Annotations[naming.RestartRequiredConfigOptionsAnnotation]) != 0 {
+			restartRequiredNodes = append(restartRequiredNodes, naming.PodNameFromService(svc))
+		}
+	}
+
+	return restartRequiredNodes
+}
+
+// getNodeRuntimeStatuses returns the runtime statuses of rack nodes from the ScyllaDBDatacenterNodesStatusReport.
+func (sdcc *Controller) getNodeRuntimeStatuses(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string) []scyllav1alpha1.NodeRuntimeStatus {
+	name, err := naming.ScyllaDBDatacenterNodesStatusReportName(sdc)
+	if err != nil {
+		klog.ErrorS(err, "can't get ScyllaDBDatacenterNodesStatusReport name", "ScyllaDBDatacenter", klog.KObj(sdc))
+		return nil
+	}
+
+	nodesStatusReport, err := sdcc.scyllaDBDatacenterNodesStatusReportLister.ScyllaDBDatacenterNodesStatusReports(sdc.Namespace).Get(name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "can't get ScyllaDBDatacenterNodesStatusReport", "ScyllaDBDatacenterNodesStatusReport", klog.KRef(sdc.Namespace, name))
+		}
+		return nil
+	}
+
+	return calculateNodeRuntimeStatuses(sdc, rackName, nodesStatusReport)
+}
+
+// calculateNodeRuntimeStatuses calculates the runtime statuses of rack nodes from the nodes status report.
+// A node is degraded when it isn't observed as up by all nodes reporting it, or when it isn't in the normal operation mode.
+func calculateNodeRuntimeStatuses(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string, nodesStatusReport *scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) []scyllav1alpha1.NodeRuntimeStatus {
+	rackSpec, _, ok := oslices.Find(sdc.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+		return spec.Name == rackName
+	})
+	if !ok {
+		return nil
+	}
+
+	rackNodesStatusReport, _, ok := oslices.Find(nodesStatusReport.Racks, func(report scyllav1alpha1.RackNodesStatusReport) bool {
+		return report.Name == rackName
+	})
+	if !ok {
+		return nil
+	}
+
+	gossipStatuses := map[string]scyllav1alpha1.NodeStatus{}
+	for _, rackReport := range nodesStatusReport.Racks {
+		for _, nodeReport := range rackReport.Nodes {
+			for _, observedNode := range nodeReport.ObservedNodes {
+				if gossipStatuses[observedNode.HostID] != scyllav1alpha1.NodeStatusDown {
+					gossipStatuses[observedNode.HostID] = observedNode.Status
+				}
+			}
+		}
+	}
+
+	var statuses []scyllav1alpha1.NodeRuntimeStatus
+	for _, nodeReport := range rackNodesStatusReport.Nodes {
+		nodeReport := nodeReport.DeepCopy()
+
+		status := scyllav1alpha1.NodeRuntimeStatus{
+			Name:             naming.MemberServiceName(rackSpec, sdc, nodeReport.Ordinal),
+			OperationMode:    nodeReport.OperationMode,
+			LoadBytes:        nodeReport.LoadBytes,
+			TokenCount:       nodeReport.TokenCount,
+			Version:          nodeReport.Version,
+			BootstrapStalled: nodeReport.BootstrapStalled,
+		}
+
+		if nodeReport.HostID != nil {
+			status.HostID = *nodeReport.HostID
+			status.GossipStatus = gossipStatuses[*nodeReport.HostID]
+		}
+
+		status.Degraded = status.GossipStatus != scyllav1alpha1.NodeStatusUp || status.OperationMode != scyllav1alpha1.NodeOperationModeNormal
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// setNodeBootstrapDegradedCondition reports nodes which bootstrap doesn't make any progress.
+func setNodeBootstrapDegradedCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus) {
+	var stalledNodes []string
+	for _, rackStatus := range status.Racks {
+		for _, nodeStatus := range rackStatus.NodeStatuses {
+			if nodeStatus.BootstrapStalled {
+				stalledNodes = append(stalledNodes, nodeStatus.Name)
+			}
+		}
+	}
+
+	if len(stalledNodes) != 0 {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               nodeBootstrapDegradedCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "BootstrapStalled",
+			Message:            fmt.Sprintf("Bootstrap of node(s) %q hasn't made any progress.", stalledNodes),
+			ObservedGeneration: sdc.Generation,
+		})
+		return
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               nodeBootstrapDegradedCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             internalapi.AsExpectedReason,
+		ObservedGeneration: sdc.Generation,
+	})
+}
+
+// nodeReplacementFailedRestartThreshold is the number of ScyllaDB container restarts of a replacing node
+// after which the replacement is considered failed.
+const nodeReplacementFailedRestartThreshold = 5
+
+// calculateNodeReplacementStatus calculates a status for the node replacement request.
+// svc, pod and oldStatus may be nil.
+func calculateNodeReplacementStatus(nr *scyllav1alpha1.NodeReplacement, svc *corev1.Service, pod *corev1.Pod, oldStatus *scyllav1alpha1.NodeReplacementStatus) *scyllav1alpha1.NodeReplacementStatus {
+	status := &scyllav1alpha1.NodeReplacementStatus{
+		Name:     nr.Name,
+		RackName: nr.RackName,
+		Ordinal:  nr.Ordinal,
+		Phase:    scyllav1alpha1.NodeReplacementPhasePending,
+	}
+
+	if oldStatus != nil && oldStatus.RackName == nr.RackName && oldStatus.Ordinal == nr.Ordinal {
+		status.ReplacedHostID = oldStatus.ReplacedHostID
+
+		// Completed requests are never restarted, so they stay completed even if the node is replaced again later.
+		if oldStatus.Phase == scyllav1alpha1.NodeReplacementPhaseCompleted {
+			status.Phase = scyllav1alpha1.NodeReplacementPhaseCompleted
+			status.Message = "Node has been replaced."
+			return status
+		}
+	}
+
+	if svc == nil {
+		status.Message = "Waiting for the member Service to be created."
+		return status
+	}
+
+	if svc.Annotations[naming.NodeReplacementNameAnnotation] != nr.Name {
+		status.Message = "Waiting for the replacement to be started."
+		if len(status.ReplacedHostID) == 0 {
+			status.ReplacedHostID = svc.Annotations[naming.HostIDAnnotation]
+		}
+		return status
+	}
+
+	if _, ok := svc.Labels[naming.ReplaceLabel]; !ok {
+		status.Phase = scyllav1alpha1.NodeReplacementPhaseCompleted
+		status.Message = "Node has been replaced."
+		return status
+	}
+
+	replacingNodeHostID, ok := svc.Labels[naming.ReplacingNodeHostIDLabel]
+	if !ok {
+		status.Phase = scyllav1alpha1.NodeReplacementPhaseRemovingPodAndPVC
+		status.Message = "Removing the Pod and the PersistentVolumeClaim of the node."
+		if len(status.ReplacedHostID) == 0 {
+			status.ReplacedHostID = svc.Annotations[naming.HostIDAnnotation]
+		}
+		return status
+	}
+	status.ReplacedHostID = replacingNodeHostID
+
+	if pod == nil || pod.DeletionTimestamp != nil {
+		status.Phase = scyllav1alpha1.NodeReplacementPhaseWaitingForReplacementBoot
+		status.Message = "Waiting for the replacing Pod to be created."
+		return status
+	}
+
+	if !controllerhelpers.IsPodReady(pod) {
+		scyllaContainerStatus, _, ok := oslices.Find(pod.Status.ContainerStatuses, func(cs corev1.ContainerStatus) bool {
+			return cs.Name == naming.ScyllaContainerName
+		})
+		if ok && scyllaContainerStatus.RestartCount >= nodeReplacementFailedRestartThreshold {
+			status.Phase = scyllav1alpha1.NodeReplacementPhaseFailed
+			status.Message = fmt.Sprintf("ScyllaDB container of the replacing node has been restarted %d times. Follow the procedure for recovering from a failed node replace.", scyllaContainerStatus.RestartCount)
+			return status
+		}
+	}
+
+	if svc.Annotations[naming.HostIDAnnotation] == replacingNodeHostID {
+		status.Phase = scyllav1alpha1.NodeReplacementPhaseWaitingForReplacementBoot
+		status.Message = "Waiting for the replacing node to boot."
+		return status
+	}
+
+	status.Phase = scyllav1alpha1.NodeReplacementPhaseStreaming
+	status.Message = "Waiting for the replacing node to finish streaming data and become ready."
+	return status
+}
+
+func (sdcc *Controller) calculateNodeReplacementStatuses(sdc *scyllav1alpha1.ScyllaDBDatacenter, serviceMap map[string]*corev1.Service) []scyllav1alpha1.NodeReplacementStatus {
+	var statuses []scyllav1alpha1.NodeReplacementStatus
+
+	for i := range sdc.Spec.NodeReplacements {
+		nr := &sdc.Spec.NodeReplacements[i]
+
+		var oldStatus *scyllav1alpha1.NodeReplacementStatus
+		for j := range sdc.Status.NodeReplacements {
+			if sdc.Status.NodeReplacements[j].Name == nr.Name {
+				oldStatus = &sdc.Status.NodeReplacements[j]
+				break
+			}
+		}
+
+		var svc *corev1.Service
+		var pod *corev1.Pod
+		rackSpec, _, ok := oslices.Find(sdc.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+			return spec.Name == nr.RackName
+		})
+		if ok {
+			svcName := naming.MemberServiceName(rackSpec, sdc, int(nr.Ordinal))
+			svc = serviceMap[svcName]
+
+			var err error
+			pod, err = sdcc.podLister.Pods(sdc.Namespace).Get(svcName)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					klog.ErrorS(err, "can't get Pod", "Pod", klog.KRef(sdc.Namespace, svcName))
+				}
+				pod = nil
+			}
+		}
+
+		statuses = append(statuses, *calculateNodeReplacementStatus(nr, svc, pod, oldStatus))
+	}
+
+	return statuses
+}
+
 func updateAggregatedStatusFields(status *scyllav1alpha1.ScyllaDBDatacenterStatus) {
 	status.Nodes = pointer.Ptr(int32(0))
 	status.ReadyNodes = pointer.Ptr(int32(0))
 	status.AvailableNodes = pointer.Ptr(int32(0))
+	status.DegradedNodes = pointer.Ptr(int32(0))
 
 	for rackName := range status.Racks {
 		rackStatus := status.Racks[rackName]
@@ -113,13 +391,16 @@ func updateAggregatedStatusFields(status *scyllav1alpha1.ScyllaDBDatacenterStatu
 		*status.Nodes += *rackStatus.Nodes
 		*status.ReadyNodes += *rackStatus.ReadyNodes
 		*status.AvailableNodes += *rackStatus.AvailableNodes
+		if rackStatus.DegradedNodes != nil {
+			*status.DegradedNodes += *rackStatus.DegradedNodes
+		}
 	}
 }
 
 // calculateStatus calculates the ScyllaCluster status.
 // This function should always succeed. Do not return an error.
 // If a particular object can be missing, it should be reflected in the value itself, like "Unknown" or "".
-func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSetMap map[string]*appsv1.StatefulSet) *scyllav1alpha1.ScyllaDBDatacenterStatus {
+func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSetMap map[string]*appsv1.StatefulSet, serviceMap map[string]*corev1.Service) *scyllav1alpha1.ScyllaDBDatacenterStatus {
 	status := sdc.Status.DeepCopy()
 	status.ObservedGeneration = pointer.Ptr(sdc.Generation)
 
@@ -132,6 +413,8 @@ func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter,
 		status.Racks = append(status.Racks, *sdcc.calculateRackStatus(sdc, rack.Name, statefulSetMap[stsName]))
 	}
 
+	status.NodeReplacements = sdcc.calculateNodeReplacementStatuses(sdc, serviceMap)
+
 	updateAggregatedStatusFields(status)
 
 	return status
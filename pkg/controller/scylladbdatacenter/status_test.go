@@ -0,0 +1,507 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_calculateNodeReplacementStatus(t *testing.T) {
+	t.Parallel()
+
+	nodeReplacement := &scyllav1alpha1.NodeReplacement{
+		Name:     "replace",
+		RackName: "rack",
+		Ordinal:  1,
+		Reason:   "disk failure",
+	}
+
+	newService := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic-dc-rack-1",
+				Namespace: "default",
+				Labels:    map[string]string{},
+				Annotations: map[string]string{
+					naming.HostIDAnnotation: "old-host-id",
+				},
+			},
+		}
+	}
+
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic-dc-rack-1",
+				Namespace: "default",
+			},
+		}
+	}
+
+	newStatus := func(phase scyllav1alpha1.NodeReplacementPhase, replacedHostID, message string) *scyllav1alpha1.NodeReplacementStatus {
+		return &scyllav1alpha1.NodeReplacementStatus{
+			Name:           "replace",
+			RackName:       "rack",
+			Ordinal:        1,
+			Phase:          phase,
+			ReplacedHostID: replacedHostID,
+			Message:        message,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		svc            *corev1.Service
+		pod            *corev1.Pod
+		oldStatus      *scyllav1alpha1.NodeReplacementStatus
+		expectedStatus *scyllav1alpha1.NodeReplacementStatus
+	}{
+		{
+			name:           "pending when service is missing",
+			svc:            nil,
+			pod:            nil,
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhasePending, "", "Waiting for the member Service to be created."),
+		},
+		{
+			name:           "pending when replacement wasn't started",
+			svc:            newService(),
+			pod:            newPod(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhasePending, "old-host-id", "Waiting for the replacement to be started."),
+		},
+		{
+			name: "pending when service was replaced by a different request",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "other"
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhasePending, "old-host-id", "Waiting for the replacement to be started."),
+		},
+		{
+			name: "removing pod and pvc when replace label is set",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Labels[naming.ReplaceLabel] = ""
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseRemovingPodAndPVC, "old-host-id", "Removing the Pod and the PersistentVolumeClaim of the node."),
+		},
+		{
+			name: "waiting for replacement boot when pod is missing",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Labels[naming.ReplaceLabel] = ""
+				svc.Labels[naming.ReplacingNodeHostIDLabel] = "old-host-id"
+				return svc
+			}(),
+			pod:            nil,
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseWaitingForReplacementBoot, "old-host-id", "Waiting for the replacing Pod to be created."),
+		},
+		{
+			name: "waiting for replacement boot when host ID wasn't updated",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Labels[naming.ReplaceLabel] = ""
+				svc.Labels[naming.ReplacingNodeHostIDLabel] = "old-host-id"
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseWaitingForReplacementBoot, "old-host-id", "Waiting for the replacing node to boot."),
+		},
+		{
+			name: "streaming when host ID was updated",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Annotations[naming.HostIDAnnotation] = "new-host-id"
+				svc.Labels[naming.ReplaceLabel] = ""
+				svc.Labels[naming.ReplacingNodeHostIDLabel] = "old-host-id"
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseStreaming, "old-host-id", "Waiting for the replacing node to finish streaming data and become ready."),
+		},
+		{
+			name: "failed when ScyllaDB container of replacing node keeps restarting",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Labels[naming.ReplaceLabel] = ""
+				svc.Labels[naming.ReplacingNodeHostIDLabel] = "old-host-id"
+				return svc
+			}(),
+			pod: func() *corev1.Pod {
+				pod := newPod()
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:         naming.ScyllaContainerName,
+						RestartCount: 5,
+					},
+				}
+				return pod
+			}(),
+			oldStatus:      nil,
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseFailed, "old-host-id", "ScyllaDB container of the replacing node has been restarted 5 times. Follow the procedure for recovering from a failed node replace."),
+		},
+		{
+			name: "completed when replace label was removed, replaced host ID is kept from old status",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "replace"
+				svc.Annotations[naming.HostIDAnnotation] = "new-host-id"
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      newStatus(scyllav1alpha1.NodeReplacementPhaseStreaming, "old-host-id", ""),
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseCompleted, "old-host-id", "Node has been replaced."),
+		},
+		{
+			name: "completed request stays completed when service was replaced by a different request",
+			svc: func() *corev1.Service {
+				svc := newService()
+				svc.Annotations[naming.NodeReplacementNameAnnotation] = "other"
+				svc.Annotations[naming.HostIDAnnotation] = "new-host-id"
+				svc.Labels[naming.ReplaceLabel] = ""
+				return svc
+			}(),
+			pod:            newPod(),
+			oldStatus:      newStatus(scyllav1alpha1.NodeReplacementPhaseCompleted, "old-host-id", "Node has been replaced."),
+			expectedStatus: newStatus(scyllav1alpha1.NodeReplacementPhaseCompleted, "old-host-id", "Node has been replaced."),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := calculateNodeReplacementStatus(nodeReplacement, tc.svc, tc.pod, tc.oldStatus)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedStatus) {
+				t.Errorf("expected and got statuses differ:\n%s", cmp.Diff(tc.expectedStatus, got))
+			}
+		})
+	}
+}
+
+func Test_calculateNodeRuntimeStatuses(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "default",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+				},
+				{
+					Name: "b",
+				},
+			},
+		},
+	}
+
+	newNodeStatusReport := func(ordinal int, hostID string, operationMode scyllav1alpha1.NodeOperationMode, observedNodes ...scyllav1alpha1.ObservedNodeStatus) scyllav1alpha1.NodeStatusReport {
+		return scyllav1alpha1.NodeStatusReport{
+			Ordinal:       ordinal,
+			HostID:        pointer.Ptr(hostID),
+			ObservedNodes: observedNodes,
+			OperationMode: operationMode,
+			LoadBytes:     pointer.Ptr[int64](1200000),
+			TokenCount:    pointer.Ptr[int32](256),
+			Version:       "2025.1.0",
+		}
+	}
+
+	tests := []struct {
+		name              string
+		rackName          string
+		nodesStatusReport *scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		expectedStatuses  []scyllav1alpha1.NodeRuntimeStatus
+	}{
+		{
+			name:     "no statuses when the rack isn't reported",
+			rackName: "a",
+			nodesStatusReport: &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				Racks: []scyllav1alpha1.RackNodesStatusReport{
+					{
+						Name: "b",
+					},
+				},
+			},
+			expectedStatuses: nil,
+		},
+		{
+			name:     "node without a host ID is degraded",
+			rackName: "a",
+			nodesStatusReport: &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				Racks: []scyllav1alpha1.RackNodesStatusReport{
+					{
+						Name: "a",
+						Nodes: []scyllav1alpha1.NodeStatusReport{
+							{
+								Ordinal: 0,
+							},
+						},
+					},
+				},
+			},
+			expectedStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+				{
+					Name:     "basic-dc-a-0",
+					Degraded: true,
+				},
+			},
+		},
+		{
+			name:     "gossip status is aggregated across racks and nodes that are down or not in normal operation mode are degraded",
+			rackName: "a",
+			nodesStatusReport: &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				Racks: []scyllav1alpha1.RackNodesStatusReport{
+					{
+						Name: "a",
+						Nodes: []scyllav1alpha1.NodeStatusReport{
+							newNodeStatusReport(
+								0,
+								"host-id-0",
+								scyllav1alpha1.NodeOperationModeNormal,
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-0", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-1", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-2", Status: scyllav1alpha1.NodeStatusUp},
+							),
+							newNodeStatusReport(
+								1,
+								"host-id-1",
+								scyllav1alpha1.NodeOperationModeJoining,
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-0", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-1", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-2", Status: scyllav1alpha1.NodeStatusUp},
+							),
+							newNodeStatusReport(
+								2,
+								"host-id-2",
+								scyllav1alpha1.NodeOperationModeNormal,
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-0", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-1", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-2", Status: scyllav1alpha1.NodeStatusUp},
+							),
+						},
+					},
+					{
+						Name: "b",
+						Nodes: []scyllav1alpha1.NodeStatusReport{
+							newNodeStatusReport(
+								0,
+								"host-id-3",
+								scyllav1alpha1.NodeOperationModeNormal,
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-0", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-1", Status: scyllav1alpha1.NodeStatusUp},
+								scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-2", Status: scyllav1alpha1.NodeStatusDown},
+							),
+						},
+					},
+				},
+			},
+			expectedStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+				{
+					Name:          "basic-dc-a-0",
+					HostID:        "host-id-0",
+					GossipStatus:  scyllav1alpha1.NodeStatusUp,
+					OperationMode: scyllav1alpha1.NodeOperationModeNormal,
+					LoadBytes:     pointer.Ptr[int64](1200000),
+					TokenCount:    pointer.Ptr[int32](256),
+					Version:       "2025.1.0",
+					Degraded:      false,
+				},
+				{
+					Name:          "basic-dc-a-1",
+					HostID:        "host-id-1",
+					GossipStatus:  scyllav1alpha1.NodeStatusUp,
+					OperationMode: scyllav1alpha1.NodeOperationModeJoining,
+					LoadBytes:     pointer.Ptr[int64](1200000),
+					TokenCount:    pointer.Ptr[int32](256),
+					Version:       "2025.1.0",
+					Degraded:      true,
+				},
+				{
+					Name:          "basic-dc-a-2",
+					HostID:        "host-id-2",
+					GossipStatus:  scyllav1alpha1.NodeStatusDown,
+					OperationMode: scyllav1alpha1.NodeOperationModeNormal,
+					LoadBytes:     pointer.Ptr[int64](1200000),
+					TokenCount:    pointer.Ptr[int32](256),
+					Version:       "2025.1.0",
+					Degraded:      true,
+				},
+			},
+		},
+		{
+			name:     "stalled bootstrap is propagated",
+			rackName: "a",
+			nodesStatusReport: &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				Racks: []scyllav1alpha1.RackNodesStatusReport{
+					{
+						Name: "a",
+						Nodes: []scyllav1alpha1.NodeStatusReport{
+							func() scyllav1alpha1.NodeStatusReport {
+								report := newNodeStatusReport(
+									0,
+									"host-id-0",
+									scyllav1alpha1.NodeOperationModeJoining,
+									scyllav1alpha1.ObservedNodeStatus{HostID: "host-id-0", Status: scyllav1alpha1.NodeStatusUp},
+								)
+								report.BootstrapStalled = true
+								return report
+							}(),
+						},
+					},
+				},
+			},
+			expectedStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+				{
+					Name:             "basic-dc-a-0",
+					HostID:           "host-id-0",
+					GossipStatus:     scyllav1alpha1.NodeStatusUp,
+					OperationMode:    scyllav1alpha1.NodeOperationModeJoining,
+					LoadBytes:        pointer.Ptr[int64](1200000),
+					TokenCount:       pointer.Ptr[int32](256),
+					Version:          "2025.1.0",
+					BootstrapStalled: true,
+					Degraded:         true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := calculateNodeRuntimeStatuses(sdc, tc.rackName, tc.nodesStatusReport)
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedStatuses) {
+				t.Errorf("expected and got statuses differ:\n%s", cmp.Diff(tc.expectedStatuses, got))
+			}
+		})
+	}
+}
+
+func Test_setNodeBootstrapDegradedCondition(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "basic",
+			Namespace:  "default",
+			Generation: 2,
+		},
+	}
+
+	tests := []struct {
+		name               string
+		status             *scyllav1alpha1.ScyllaDBDatacenterStatus
+		expectedConditions []metav1.Condition
+	}{
+		{
+			name: "condition is false when no node bootstrap has stalled",
+			status: &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				Racks: []scyllav1alpha1.RackStatus{
+					{
+						Name: "a",
+						NodeStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+							{
+								Name:          "basic-dc-a-0",
+								OperationMode: scyllav1alpha1.NodeOperationModeJoining,
+							},
+						},
+					},
+				},
+			},
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               "NodeBootstrapDegraded",
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 2,
+				},
+			},
+		},
+		{
+			name: "condition is true and lists nodes which bootstrap has stalled",
+			status: &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				Racks: []scyllav1alpha1.RackStatus{
+					{
+						Name: "a",
+						NodeStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+							{
+								Name:             "basic-dc-a-0",
+								OperationMode:    scyllav1alpha1.NodeOperationModeJoining,
+								BootstrapStalled: true,
+							},
+							{
+								Name:          "basic-dc-a-1",
+								OperationMode: scyllav1alpha1.NodeOperationModeNormal,
+							},
+						},
+					},
+					{
+						Name: "b",
+						NodeStatuses: []scyllav1alpha1.NodeRuntimeStatus{
+							{
+								Name:             "basic-dc-b-0",
+								OperationMode:    scyllav1alpha1.NodeOperationModeJoining,
+								BootstrapStalled: true,
+							},
+						},
+					},
+				},
+			},
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               "NodeBootstrapDegraded",
+					Status:             metav1.ConditionTrue,
+					Reason:             "BootstrapStalled",
+					Message:            `Bootstrap of node(s) ["basic-dc-a-0" "basic-dc-b-0"] hasn't made any progress.`,
+					ObservedGeneration: 2,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := tc.status.DeepCopy()
+			setNodeBootstrapDegradedCondition(sdc, status)
+
+			for i := range status.Conditions {
+				status.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+
+			if !apiequality.Semantic.DeepEqual(status.Conditions, tc.expectedConditions) {
+				t.Errorf("expected and got conditions differ:\n%s", cmp.Diff(tc.expectedConditions, status.Conditions))
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -16,6 +17,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
@@ -176,6 +178,77 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		objectErrs = append(objectErrs, err)
 	}
 
+	// Objects of Gateway API resources are only listed once the cluster serves them and their informers have synced.
+	servedGatewayAPIResources := sdcc.getServedGatewayAPIResources()
+	var gatewayMap, httpRouteMap, backendTLSPolicyMap, tlsRouteMap map[string]*unstructured.Unstructured
+	if servedGatewayAPIResources.Has(gatewayapi.GatewayGVR) {
+		gatewayMap, err = controllerhelpers.GetObjects[CT, *unstructured.Unstructured](
+			ctx,
+			sdc,
+			scyllav1alpha1.ScyllaDBDatacenterGVK,
+			sdcSelector,
+			controllerhelpers.ControlleeManagerGetObjectsFuncs[CT, *unstructured.Unstructured]{
+				GetControllerUncachedFunc: sdcc.scyllaClient.ScyllaDBDatacenters(sdc.Namespace).Get,
+				ListObjectsFunc:           sdcc.gatewayLister.Namespace(sdc.Namespace).List,
+				PatchObjectFunc:           sdcc.gatewayClient.Resource(gatewayapi.GatewayGVR).Namespace(sdc.Namespace).Patch,
+			},
+		)
+		if err != nil {
+			objectErrs = append(objectErrs, err)
+		}
+	}
+
+	if servedGatewayAPIResources.Has(gatewayapi.HTTPRouteGVR) {
+		httpRouteMap, err = controllerhelpers.GetObjects[CT, *unstructured.Unstructured](
+			ctx,
+			sdc,
+			scyllav1alpha1.ScyllaDBDatacenterGVK,
+			sdcSelector,
+			controllerhelpers.ControlleeManagerGetObjectsFuncs[CT, *unstructured.Unstructured]{
+				GetControllerUncachedFunc: sdcc.scyllaClient.ScyllaDBDatacenters(sdc.Namespace).Get,
+				ListObjectsFunc:           sdcc.httpRouteLister.Namespace(sdc.Namespace).List,
+				PatchObjectFunc:           sdcc.gatewayClient.Resource(gatewayapi.HTTPRouteGVR).Namespace(sdc.Namespace).Patch,
+			},
+		)
+		if err != nil {
+			objectErrs = append(objectErrs, err)
+		}
+	}
+
+	if servedGatewayAPIResources.Has(gatewayapi.BackendTLSPolicyGVR) {
+		backendTLSPolicyMap, err = controllerhelpers.GetObjects[CT, *unstructured.Unstructured](
+			ctx,
+			sdc,
+			scyllav1alpha1.ScyllaDBDatacenterGVK,
+			sdcSelector,
+			controllerhelpers.ControlleeManagerGetObjectsFuncs[CT, *unstructured.Unstructured]{
+				GetControllerUncachedFunc: sdcc.scyllaClient.ScyllaDBDatacenters(sdc.Namespace).Get,
+				ListObjectsFunc:           sdcc.backendTLSPolicyLister.Namespace(sdc.Namespace).List,
+				PatchObjectFunc:           sdcc.gatewayClient.Resource(gatewayapi.BackendTLSPolicyGVR).Namespace(sdc.Namespace).Patch,
+			},
+		)
+		if err != nil {
+			objectErrs = append(objectErrs, err)
+		}
+	}
+
+	if servedGatewayAPIResources.Has(gatewayapi.TLSRouteGVR) {
+		tlsRouteMap, err = controllerhelpers.GetObjects[CT, *unstructured.Unstructured](
+			ctx,
+			sdc,
+			scyllav1alpha1.ScyllaDBDatacenterGVK,
+			sdcSelector,
+			controllerhelpers.ControlleeManagerGetObjectsFuncs[CT, *unstructured.Unstructured]{
+				GetControllerUncachedFunc: sdcc.scyllaClient.ScyllaDBDatacenters(sdc.Namespace).Get,
+				ListObjectsFunc:           sdcc.tlsRouteLister.Namespace(sdc.Namespace).List,
+				PatchObjectFunc:           sdcc.gatewayClient.Resource(gatewayapi.TLSRouteGVR).Namespace(sdc.Namespace).Patch,
+			},
+		)
+		if err != nil {
+			objectErrs = append(objectErrs, err)
+		}
+	}
+
 	jobMap, err := controllerhelpers.GetObjects[CT, *batchv1.Job](
 		ctx,
 		sdc,
@@ -206,12 +279,27 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		objectErrs = append(objectErrs, err)
 	}
 
+	scyllaDBManagerTaskMap, err := controllerhelpers.GetObjects[CT, *scyllav1alpha1.ScyllaDBManagerTask](
+		ctx,
+		sdc,
+		scyllav1alpha1.ScyllaDBDatacenterGVK,
+		sdcSelector,
+		controllerhelpers.ControlleeManagerGetObjectsFuncs[CT, *scyllav1alpha1.ScyllaDBManagerTask]{
+			GetControllerUncachedFunc: sdcc.scyllaClient.ScyllaDBDatacenters(sdc.Namespace).Get,
+			ListObjectsFunc:           sdcc.scyllaDBManagerTaskLister.ScyllaDBManagerTasks(sdc.Namespace).List,
+			PatchObjectFunc:           sdcc.scyllaClient.ScyllaDBManagerTasks(sdc.Namespace).Patch,
+		},
+	)
+	if err != nil {
+		objectErrs = append(objectErrs, err)
+	}
+
 	objectErr := apimachineryutilerrors.NewAggregate(objectErrs)
 	if objectErr != nil {
 		return objectErr
 	}
 
-	status := sdcc.calculateStatus(sdc, statefulSetMap)
+	status := sdcc.calculateStatus(sdc, statefulSetMap, serviceMap)
 
 	if sdc.DeletionTimestamp != nil {
 		return sdcc.updateStatus(ctx, sdc, status)
@@ -271,6 +359,19 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync certificates: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		clientConnectionBundleControllerProgressingCondition,
+		clientConnectionBundleControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncClientConnectionBundle(ctx, sdc, secretMap, configMapMap, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync client connection bundle: %w", err))
+	}
+
 	err = controllerhelpers.RunSync(
 		&status.Conditions,
 		configControllerProgressingCondition,
@@ -311,6 +412,7 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 	// field (to allow determining cluster status without conditions) and wait for the status to be updated
 	// in a single place, on the next resync.
 	sdcc.setStatefulSetsAvailableStatusCondition(sdc, status)
+	setNodeBootstrapDegradedCondition(sdc, status)
 
 	err = controllerhelpers.RunSync(
 		&status.Conditions,
@@ -351,6 +453,19 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync ingresses: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		gatewayControllerProgressingCondition,
+		gatewayControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncGatewayAPI(ctx, sdc, servedGatewayAPIResources, gatewayMap, httpRouteMap, backendTLSPolicyMap, tlsRouteMap, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync Gateway API objects: %w", err))
+	}
+
 	err = controllerhelpers.RunSync(
 		&status.Conditions,
 		jobControllerProgressingCondition,
@@ -364,6 +479,32 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync jobs: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		alternatorControllerProgressingCondition,
+		alternatorControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncAlternatorUserCredentials(ctx, sdc, status, secretMap, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync Alternator user credentials: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		restoreControllerProgressingCondition,
+		restoreControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncRestore(ctx, sdc, status, scyllaDBManagerTaskMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync restore: %w", err))
+	}
+
 	// Aggregate conditions.
 	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sdc.Generation)
 	if err != nil {
@@ -0,0 +1,376 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/scylla"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	alternatorCQLTimeout = 10 * time.Second
+)
+
+// alternatorRolesTables holds the tables storing CQL roles, starting with the one used by the current ScyllaDB versions.
+var alternatorRolesTables = []string{
+	"system.roles",
+	"system_auth.roles",
+}
+
+func (sdcc *Controller) syncAlternatorUserCredentials(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	secrets map[string]*corev1.Secret,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	var users []scyllav1alpha1.AlternatorUser
+	if sdc.Spec.ScyllaDB.AlternatorOptions != nil {
+		users = sdc.Spec.ScyllaDB.AlternatorOptions.Users
+	}
+
+	// Credentials Secrets also record that the operator owns the roles of the users,
+	// so they are only deleted after the roles are dropped.
+	var staleSecrets []*corev1.Secret
+	for _, secret := range secrets {
+		if secret.DeletionTimestamp != nil {
+			continue
+		}
+
+		userName, ok := secret.Labels[naming.AlternatorUserNameLabel]
+		if !ok {
+			continue
+		}
+
+		isRequired := slices.ContainsFunc(users, func(user scyllav1alpha1.AlternatorUser) bool {
+			return user.Name == userName
+		})
+		if isRequired {
+			continue
+		}
+
+		staleSecrets = append(staleSecrets, secret)
+	}
+
+	var requiredSecrets []*corev1.Secret
+	var pendingUserNames []string
+	for _, user := range users {
+		secret, ok := secrets[naming.AlternatorUserCredentialsSecretName(sdc, user.Name)]
+		if !ok || len(secret.Data[naming.AlternatorSecretAccessKeyKey]) == 0 {
+			pendingUserNames = append(pendingUserNames, user.Name)
+			continue
+		}
+
+		requiredSecrets = append(requiredSecrets, makeAlternatorUserCredentialsSecret(sdc, user.Name, string(secret.Data[naming.AlternatorSecretAccessKeyKey])))
+	}
+
+	// Roles can't be dropped without CQL credentials, which is the case when Alternator is disabled.
+	canManageRoles := sdc.Spec.ScyllaDB.AlternatorOptions != nil && sdc.Spec.ScyllaDB.AlternatorOptions.CQLCredentialsSecretRef != nil
+
+	var roleManager *cqlAlternatorRoleManager
+	if canManageRoles && (len(staleSecrets) != 0 || len(pendingUserNames) != 0) {
+		var pcs []metav1.Condition
+		var err error
+		roleManager, pcs, err = sdcc.newAlternatorRoleManager(sdc, status, services)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't create Alternator role manager: %w", err)
+		}
+		if roleManager != nil {
+			defer roleManager.Close()
+		}
+	}
+
+	// Delete credentials of users that are no longer declared.
+	// Delete has to be the first action to avoid getting stuck on quota.
+	var deletionErrors []error
+	for _, secret := range staleSecrets {
+		if canManageRoles {
+			if roleManager == nil {
+				continue
+			}
+
+			userName := secret.Labels[naming.AlternatorUserNameLabel]
+			klog.V(2).InfoS("Dropping Alternator role", "ScyllaDBDatacenter", klog.KObj(sdc), "Role", userName)
+			err := roleManager.DropRole(ctx, userName)
+			if err != nil {
+				deletionErrors = append(deletionErrors, fmt.Errorf("can't drop role %q: %w", userName, err))
+				continue
+			}
+		}
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, alternatorControllerProgressingCondition, secret, "delete", sdc.Generation)
+		err := sdcc.kubeClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &secret.UID,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			deletionErrors = append(deletionErrors, err)
+		}
+	}
+	err := apimachineryutilerrors.NewAggregate(deletionErrors)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't delete Alternator user(s): %w", err)
+	}
+
+	applySecret := func(requiredSecret *corev1.Secret) error {
+		_, changed, err := resourceapply.ApplySecret(ctx, sdcc.kubeClient.CoreV1(), sdcc.secretLister, sdcc.eventRecorder, requiredSecret, resourceapply.ApplyOptions{})
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, alternatorControllerProgressingCondition, requiredSecret, "apply", sdc.Generation)
+		}
+		if err != nil {
+			return fmt.Errorf("can't apply secret %q: %w", naming.ObjRef(requiredSecret), err)
+		}
+
+		return nil
+	}
+
+	if roleManager != nil {
+		for _, userName := range pendingUserNames {
+			_, isOwned := secrets[naming.AlternatorUserCredentialsSecretName(sdc, userName)]
+
+			klog.V(2).InfoS("Ensuring Alternator role", "ScyllaDBDatacenter", klog.KObj(sdc), "Role", userName, "Owned", isOwned)
+			secretAccessKey, err := ensureAlternatorRole(ctx, roleManager, userName, isOwned, func() error {
+				// The Secret is persisted without the secret access key to record the ownership before the role is created.
+				return applySecret(makeAlternatorUserCredentialsSecret(sdc, userName, ""))
+			})
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't ensure role %q: %w", userName, err)
+			}
+
+			requiredSecrets = append(requiredSecrets, makeAlternatorUserCredentialsSecret(sdc, userName, secretAccessKey))
+		}
+	}
+
+	for _, requiredSecret := range requiredSecrets {
+		err := applySecret(requiredSecret)
+		if err != nil {
+			return progressingConditions, err
+		}
+	}
+
+	return progressingConditions, nil
+}
+
+// newAlternatorRoleManager connects to ScyllaDB using the CQL credentials of Alternator options.
+// It returns nil when the connection can't be established yet, in which case progressing conditions are returned.
+func (sdcc *Controller) newAlternatorRoleManager(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	services map[string]*corev1.Service,
+) (*cqlAlternatorRoleManager, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.AvailableNodes == nil || *status.AvailableNodes == 0 {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               alternatorControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForAvailableNodes",
+			Message:            "Waiting for at least one ScyllaDB node to be available to manage Alternator users.",
+			ObservedGeneration: sdc.Generation,
+		})
+		return nil, progressingConditions, nil
+	}
+
+	secretName := sdc.Spec.ScyllaDB.AlternatorOptions.CQLCredentialsSecretRef.Name
+	credentialsSecret, err := sdcc.secretLister.Secrets(sdc.Namespace).Get(secretName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, progressingConditions, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(sdc.Namespace, secretName), err)
+		}
+
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               alternatorControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForSecret",
+			Message:            fmt.Sprintf("Waiting for Secret %q to exist.", naming.ManualRef(sdc.Namespace, secretName)),
+			ObservedGeneration: sdc.Generation,
+		})
+		return nil, progressingConditions, nil
+	}
+
+	identityService, ok := services[naming.IdentityServiceName(sdc)]
+	if !ok || len(identityService.Spec.ClusterIP) == 0 || identityService.Spec.ClusterIP == corev1.ClusterIPNone {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               alternatorControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForService",
+			Message:            fmt.Sprintf("Waiting for Service %q to have a ClusterIP assigned.", naming.ManualRef(sdc.Namespace, naming.IdentityServiceName(sdc))),
+			ObservedGeneration: sdc.Generation,
+		})
+		return nil, progressingConditions, nil
+	}
+
+	clusterConfig := gocql.NewCluster(net.JoinHostPort(identityService.Spec.ClusterIP, strconv.Itoa(scylla.DefaultNativeTransportPort)))
+	clusterConfig.DisableInitialHostLookup = true
+	clusterConfig.ConnectTimeout = alternatorCQLTimeout
+	clusterConfig.Timeout = alternatorCQLTimeout
+	clusterConfig.NumConns = 1
+	clusterConfig.Authenticator = gocql.PasswordAuthenticator{
+		Username: strings.TrimSpace(string(credentialsSecret.Data[corev1.BasicAuthUsernameKey])),
+		Password: strings.TrimSpace(string(credentialsSecret.Data[corev1.BasicAuthPasswordKey])),
+	}
+
+	session, err := clusterConfig.CreateSession()
+	if err != nil {
+		return nil, progressingConditions, fmt.Errorf("can't create CQL session: %w", err)
+	}
+
+	return &cqlAlternatorRoleManager{
+		session: session,
+	}, progressingConditions, nil
+}
+
+type alternatorRoleManager interface {
+	RoleExists(ctx context.Context, roleName string) (bool, error)
+	CreateRole(ctx context.Context, roleName string, password string) error
+	SetPassword(ctx context.Context, roleName string, password string) error
+	GetSaltedHash(ctx context.Context, roleName string) (string, error)
+}
+
+// ensureAlternatorRole makes sure a CQL role that can log in exists and returns its salted hash which Alternator uses as the secret access key.
+// isOwned reports whether the operator has already recorded the ownership of the role. Roles that exist and aren't owned
+// weren't created by the operator, e.g. the superuser, and are refused so their secret access keys never end up in a Secret.
+// Otherwise, claimRole is called to record the ownership before the role is created. Owned roles that already exist,
+// e.g. because their secret access key failed to be persisted, get a new password, which rotates their secret access key.
+func ensureAlternatorRole(ctx context.Context, roleManager alternatorRoleManager, roleName string, isOwned bool, claimRole func() error) (string, error) {
+	exists, err := roleManager.RoleExists(ctx, roleName)
+	if err != nil {
+		return "", fmt.Errorf("can't check whether role exists: %w", err)
+	}
+
+	if !isOwned {
+		if exists {
+			return "", fmt.Errorf("role already exists and wasn't created by the operator, refusing to expose its secret access key: drop the role or choose a different user name")
+		}
+
+		err = claimRole()
+		if err != nil {
+			return "", fmt.Errorf("can't claim role: %w", err)
+		}
+	}
+
+	if exists {
+		err = roleManager.SetPassword(ctx, roleName, rand.Text())
+		if err != nil {
+			return "", fmt.Errorf("can't set password: %w", err)
+		}
+	} else {
+		err = roleManager.CreateRole(ctx, roleName, rand.Text())
+		if err != nil {
+			return "", fmt.Errorf("can't create role: %w", err)
+		}
+	}
+
+	saltedHash, err := roleManager.GetSaltedHash(ctx, roleName)
+	if err != nil {
+		return "", fmt.Errorf("can't get salted hash: %w", err)
+	}
+
+	return saltedHash, nil
+}
+
+type cqlAlternatorRoleManager struct {
+	session *gocql.Session
+}
+
+var _ alternatorRoleManager = &cqlAlternatorRoleManager{}
+
+func (m *cqlAlternatorRoleManager) RoleExists(ctx context.Context, roleName string) (bool, error) {
+	var errs []error
+	for _, table := range alternatorRolesTables {
+		var role string
+		err := m.session.Query(fmt.Sprintf("SELECT role FROM %s WHERE role = ?", table), roleName).WithContext(ctx).Scan(&role)
+		if err == nil {
+			return true, nil
+		}
+
+		if errors.Is(err, gocql.ErrNotFound) {
+			return false, nil
+		}
+
+		errs = append(errs, fmt.Errorf("can't get role from %q: %w", table, err))
+	}
+
+	return false, apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (m *cqlAlternatorRoleManager) CreateRole(ctx context.Context, roleName string, password string) error {
+	// The statement intentionally lacks "IF NOT EXISTS" so a role created concurrently by someone else isn't adopted.
+	return m.session.Query(makeCreateAlternatorRoleStatement(roleName, password)).WithContext(ctx).Exec()
+}
+
+func (m *cqlAlternatorRoleManager) SetPassword(ctx context.Context, roleName string, password string) error {
+	return m.session.Query(makeAlterAlternatorRolePasswordStatement(roleName, password)).WithContext(ctx).Exec()
+}
+
+func (m *cqlAlternatorRoleManager) DropRole(ctx context.Context, roleName string) error {
+	return m.session.Query(makeDropAlternatorRoleStatement(roleName)).WithContext(ctx).Exec()
+}
+
+func (m *cqlAlternatorRoleManager) Close() {
+	m.session.Close()
+}
+
+func (m *cqlAlternatorRoleManager) GetSaltedHash(ctx context.Context, roleName string) (string, error) {
+	var errs []error
+	for _, table := range alternatorRolesTables {
+		var saltedHash string
+		err := m.session.Query(fmt.Sprintf("SELECT salted_hash FROM %s WHERE role = ?", table), roleName).WithContext(ctx).Scan(&saltedHash)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't get salted hash from %q: %w", table, err))
+			continue
+		}
+
+		if len(saltedHash) == 0 {
+			errs = append(errs, fmt.Errorf("role has an empty salted hash in %q", table))
+			continue
+		}
+
+		return saltedHash, nil
+	}
+
+	return "", apimachineryutilerrors.NewAggregate(errs)
+}
+
+func makeCreateAlternatorRoleStatement(roleName string, password string) string {
+	return fmt.Sprintf(
+		`CREATE ROLE "%s" WITH PASSWORD = '%s' AND LOGIN = true`,
+		strings.ReplaceAll(roleName, `"`, `""`),
+		strings.ReplaceAll(password, `'`, `''`),
+	)
+}
+
+func makeAlterAlternatorRolePasswordStatement(roleName string, password string) string {
+	return fmt.Sprintf(
+		`ALTER ROLE "%s" WITH PASSWORD = '%s'`,
+		strings.ReplaceAll(roleName, `"`, `""`),
+		strings.ReplaceAll(password, `'`, `''`),
+	)
+}
+
+func makeDropAlternatorRoleStatement(roleName string) string {
+	return fmt.Sprintf(`DROP ROLE IF EXISTS "%s"`, strings.ReplaceAll(roleName, `"`, `""`))
+}
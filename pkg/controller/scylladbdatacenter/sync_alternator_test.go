@@ -0,0 +1,234 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_makeCreateAlternatorRoleStatement(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		roleName          string
+		password          string
+		expectedStatement string
+	}{
+		{
+			name:              "simple role",
+			roleName:          "app",
+			password:          "secret",
+			expectedStatement: `CREATE ROLE "app" WITH PASSWORD = 'secret' AND LOGIN = true`,
+		},
+		{
+			name:              "quotes are escaped",
+			roleName:          `a"p"p`,
+			password:          `se'cr'et`,
+			expectedStatement: `CREATE ROLE "a""p""p" WITH PASSWORD = 'se''cr''et' AND LOGIN = true`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeCreateAlternatorRoleStatement(tc.roleName, tc.password)
+			if got != tc.expectedStatement {
+				t.Errorf("expected statement %q, got %q", tc.expectedStatement, got)
+			}
+		})
+	}
+}
+
+func Test_makeAlterAlternatorRolePasswordStatement(t *testing.T) {
+	t.Parallel()
+
+	got := makeAlterAlternatorRolePasswordStatement(`a"p"p`, `se'cr'et`)
+	expected := `ALTER ROLE "a""p""p" WITH PASSWORD = 'se''cr''et'`
+	if got != expected {
+		t.Errorf("expected statement %q, got %q", expected, got)
+	}
+}
+
+func Test_makeDropAlternatorRoleStatement(t *testing.T) {
+	t.Parallel()
+
+	got := makeDropAlternatorRoleStatement(`a"p"p`)
+	expected := `DROP ROLE IF EXISTS "a""p""p"`
+	if got != expected {
+		t.Errorf("expected statement %q, got %q", expected, got)
+	}
+}
+
+type fakeAlternatorRoleManager struct {
+	saltedHashes map[string]string
+	existsErr    error
+	createdRoles []string
+	rotatedRoles []string
+}
+
+var _ alternatorRoleManager = &fakeAlternatorRoleManager{}
+
+func (m *fakeAlternatorRoleManager) RoleExists(ctx context.Context, roleName string) (bool, error) {
+	if m.existsErr != nil {
+		return false, m.existsErr
+	}
+
+	_, ok := m.saltedHashes[roleName]
+	return ok, nil
+}
+
+func (m *fakeAlternatorRoleManager) CreateRole(ctx context.Context, roleName string, password string) error {
+	m.createdRoles = append(m.createdRoles, roleName)
+	m.saltedHashes[roleName] = fmt.Sprintf("hash-of-%s", roleName)
+	return nil
+}
+
+func (m *fakeAlternatorRoleManager) SetPassword(ctx context.Context, roleName string, password string) error {
+	m.rotatedRoles = append(m.rotatedRoles, roleName)
+	m.saltedHashes[roleName] = fmt.Sprintf("rotated-hash-of-%s", roleName)
+	return nil
+}
+
+func (m *fakeAlternatorRoleManager) GetSaltedHash(ctx context.Context, roleName string) (string, error) {
+	saltedHash, ok := m.saltedHashes[roleName]
+	if !ok {
+		return "", fmt.Errorf("role %q not found", roleName)
+	}
+
+	return saltedHash, nil
+}
+
+func Test_ensureAlternatorRole(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                    string
+		existingSaltedHashes    map[string]string
+		existsErr               error
+		claimErr                error
+		roleName                string
+		isOwned                 bool
+		expectedSecretAccessKey string
+		expectedClaimed         bool
+		expectedCreatedRoles    []string
+		expectedRotatedRoles    []string
+		expectedErr             error
+	}{
+		{
+			name:                    "role that doesn't exist is claimed and created",
+			existingSaltedHashes:    map[string]string{},
+			roleName:                "app",
+			isOwned:                 false,
+			expectedSecretAccessKey: "hash-of-app",
+			expectedClaimed:         true,
+			expectedCreatedRoles:    []string{"app"},
+			expectedRotatedRoles:    nil,
+			expectedErr:             nil,
+		},
+		{
+			name:                    "owned role that doesn't exist is created without claiming it again",
+			existingSaltedHashes:    map[string]string{},
+			roleName:                "app",
+			isOwned:                 true,
+			expectedSecretAccessKey: "hash-of-app",
+			expectedClaimed:         false,
+			expectedCreatedRoles:    []string{"app"},
+			expectedRotatedRoles:    nil,
+			expectedErr:             nil,
+		},
+		{
+			name: "owned role that already exists gets its secret access key rotated",
+			existingSaltedHashes: map[string]string{
+				"app": "hash-of-app",
+			},
+			roleName:                "app",
+			isOwned:                 true,
+			expectedSecretAccessKey: "rotated-hash-of-app",
+			expectedClaimed:         false,
+			expectedCreatedRoles:    nil,
+			expectedRotatedRoles:    []string{"app"},
+			expectedErr:             nil,
+		},
+		{
+			name: "existing role is refused without exposing its secret access key",
+			existingSaltedHashes: map[string]string{
+				"cassandra": "superuser-hash",
+			},
+			roleName:                "cassandra",
+			isOwned:                 false,
+			expectedSecretAccessKey: "",
+			expectedClaimed:         false,
+			expectedCreatedRoles:    nil,
+			expectedRotatedRoles:    nil,
+			expectedErr:             errors.New("role already exists and wasn't created by the operator, refusing to expose its secret access key: drop the role or choose a different user name"),
+		},
+		{
+			name:                    "role isn't created when it can't be claimed",
+			existingSaltedHashes:    map[string]string{},
+			claimErr:                errors.New("forbidden"),
+			roleName:                "app",
+			isOwned:                 false,
+			expectedSecretAccessKey: "",
+			expectedClaimed:         true,
+			expectedCreatedRoles:    nil,
+			expectedRotatedRoles:    nil,
+			expectedErr:             fmt.Errorf("can't claim role: %w", errors.New("forbidden")),
+		},
+		{
+			name:                    "role isn't created when its existence can't be checked",
+			existingSaltedHashes:    map[string]string{},
+			existsErr:               errors.New("connection refused"),
+			roleName:                "app",
+			isOwned:                 false,
+			expectedSecretAccessKey: "",
+			expectedClaimed:         false,
+			expectedCreatedRoles:    nil,
+			expectedRotatedRoles:    nil,
+			expectedErr:             fmt.Errorf("can't check whether role exists: %w", errors.New("connection refused")),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleManager := &fakeAlternatorRoleManager{
+				saltedHashes: tc.existingSaltedHashes,
+				existsErr:    tc.existsErr,
+			}
+
+			claimed := false
+			got, err := ensureAlternatorRole(context.Background(), roleManager, tc.roleName, tc.isOwned, func() error {
+				claimed = true
+				return tc.claimErr
+			})
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected and got errors differ:\n%s\n", cmp.Diff(tc.expectedErr, err))
+			}
+
+			if got != tc.expectedSecretAccessKey {
+				t.Errorf("expected secret access key %q, got %q", tc.expectedSecretAccessKey, got)
+			}
+
+			if claimed != tc.expectedClaimed {
+				t.Errorf("expected claimed %t, got %t", tc.expectedClaimed, claimed)
+			}
+
+			if !reflect.DeepEqual(roleManager.createdRoles, tc.expectedCreatedRoles) {
+				t.Errorf("expected and got created roles differ:\n%s\n", cmp.Diff(tc.expectedCreatedRoles, roleManager.createdRoles))
+			}
+
+			if !reflect.DeepEqual(roleManager.rotatedRoles, tc.expectedRotatedRoles) {
+				t.Errorf("expected and got rotated roles differ:\n%s\n", cmp.Diff(tc.expectedRotatedRoles, roleManager.rotatedRoles))
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	ocrypto "github.com/scylladb/scylla-operator/pkg/crypto"
 	"github.com/scylladb/scylla-operator/pkg/features"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	okubecrypto "github.com/scylladb/scylla-operator/pkg/kubecrypto"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -149,6 +150,39 @@ func (sdcc *Controller) syncCerts(
 
 	if utilfeature.DefaultMutableFeatureGate.Enabled(features.AutomaticTLSCertificates) {
 		// Manage client certificates.
+		clientCertConfigs := []*okubecrypto.CertificateConfig{
+			{
+				MetaConfig: okubecrypto.MetaConfig{
+					Name:   naming.GetScyllaClusterLocalUserAdminCertName(sdc.Name),
+					Labels: clusterLabels,
+				},
+				Validity: 10 * 365 * 24 * time.Hour,
+				Refresh:  8 * 365 * 24 * time.Hour,
+				CertCreator: (&ocrypto.ClientCertCreatorConfig{
+					Subject: pkix.Name{
+						CommonName: "",
+					},
+					DNSNames: []string{"admin"},
+				}).ToCreator(),
+			},
+		}
+		if sdc.Spec.ClientConnectionBundle != nil {
+			clientCertConfigs = append(clientCertConfigs, &okubecrypto.CertificateConfig{
+				MetaConfig: okubecrypto.MetaConfig{
+					Name:   naming.GetScyllaClusterLocalUserClientCertName(sdc.Name),
+					Labels: clusterLabels,
+				},
+				Validity: 10 * 365 * 24 * time.Hour,
+				Refresh:  8 * 365 * 24 * time.Hour,
+				CertCreator: (&ocrypto.ClientCertCreatorConfig{
+					Subject: pkix.Name{
+						CommonName: "",
+					},
+					DNSNames: []string{"client"},
+				}).ToCreator(),
+			})
+		}
+
 		errs = append(errs, cm.ManageCertificates(
 			ctx,
 			time.Now,
@@ -168,27 +202,28 @@ func (sdcc *Controller) syncCerts(
 					Labels: clusterLabels,
 				},
 			},
-			[]*okubecrypto.CertificateConfig{
-				{
-					MetaConfig: okubecrypto.MetaConfig{
-						Name:   naming.GetScyllaClusterLocalUserAdminCertName(sdc.Name),
-						Labels: clusterLabels,
-					},
-					Validity: 10 * 365 * 24 * time.Hour,
-					Refresh:  8 * 365 * 24 * time.Hour,
-					CertCreator: (&ocrypto.ClientCertCreatorConfig{
-						Subject: pkix.Name{
-							CommonName: "",
-						},
-						DNSNames: []string{"admin"},
-					}).ToCreator(),
-				},
-			},
+			clientCertConfigs,
 			secrets,
 			configMaps,
 		))
 	}
 
+	// The client certificate of the client connection bundle isn't needed once the bundle is no longer requested.
+	if sdc.Spec.ClientConnectionBundle == nil {
+		clientCertSecret, ok := secrets[naming.GetScyllaClusterLocalUserClientCertName(sdc.Name)]
+		if ok && clientCertSecret.DeletionTimestamp == nil {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, certControllerProgressingCondition, clientCertSecret, "delete", sdc.Generation)
+			err = sdcc.kubeClient.CoreV1().Secrets(clientCertSecret.Namespace).Delete(ctx, clientCertSecret.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &clientCertSecret.UID,
+				},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("can't delete secret %q: %w", naming.ObjRef(clientCertSecret), err))
+			}
+		}
+	}
+
 	// Manage serving certificates.
 	// We are currently using StatefulSet that allows only a uniform config so we have to create a multi-SAN certificate.
 	// TODO: Create dedicated certs when we get rid of StatefulSets.
@@ -205,17 +240,25 @@ func (sdcc *Controller) syncCerts(
 	if utilfeature.DefaultMutableFeatureGate.Enabled(features.AutomaticTLSCertificates) || sdc.Spec.ScyllaDB.AlternatorOptions != nil {
 		for _, svc := range serviceMap {
 			svcType := svc.Labels[naming.ScyllaServiceTypeLabel]
-			if svcType != string(naming.ScyllaServiceTypeMember) && svcType != string(naming.ScyllaServiceTypeIdentity) {
+			if svcType != string(naming.ScyllaServiceTypeMember) && svcType != string(naming.ScyllaServiceTypeIdentity) && svcType != string(naming.ScyllaServiceTypeAlternator) {
 				continue
 			}
 
 			if svc.Spec.ClusterIP != corev1.ClusterIPNone {
-				parsedIP, err := helpers.ParseIP(svc.Spec.ClusterIP)
-				if err != nil {
-					return progressingConditions, fmt.Errorf("can't parse Service %q ClusterIP %q: %w", naming.ObjRef(svc), svc.Spec.ClusterIP, err)
+				// Dual-stack Services have a ClusterIP for every IP family.
+				clusterIPs := svc.Spec.ClusterIPs
+				if len(clusterIPs) == 0 && len(svc.Spec.ClusterIP) != 0 {
+					clusterIPs = []string{svc.Spec.ClusterIP}
 				}
 
-				ipAddresses = append(ipAddresses, parsedIP)
+				for _, clusterIP := range clusterIPs {
+					parsedIP, err := helpers.ParseIP(clusterIP)
+					if err != nil {
+						return progressingConditions, fmt.Errorf("can't parse Service %q ClusterIP %q: %w", naming.ObjRef(svc), clusterIP, err)
+					}
+
+					ipAddresses = append(ipAddresses, parsedIP)
+				}
 			}
 
 			if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
@@ -281,12 +324,22 @@ func (sdcc *Controller) syncCerts(
 				continue
 			}
 
-			parsedIP := net.ParseIP(pod.Status.PodIP)
-			if parsedIP == nil {
-				return progressingConditions, fmt.Errorf("can't parse Pod %q IP %q", naming.ObjRef(pod), pod.Status.PodIP)
+			// Dual-stack Pods have an IP for every IP family.
+			podIPs := oslices.ConvertSlice(pod.Status.PodIPs, func(podIP corev1.PodIP) string {
+				return podIP.IP
+			})
+			if !slices.Contains(podIPs, pod.Status.PodIP) {
+				podIPs = append(podIPs, pod.Status.PodIP)
 			}
 
-			ipAddresses = append(ipAddresses, parsedIP)
+			for _, podIP := range podIPs {
+				parsedIP := net.ParseIP(podIP)
+				if parsedIP == nil {
+					return progressingConditions, fmt.Errorf("can't parse Pod %q IP %q", naming.ObjRef(pod), podIP)
+				}
+
+				ipAddresses = append(ipAddresses, parsedIP)
+			}
 		}
 
 		// Make sure ipAddresses are always sorted and can be reconciled in a declarative way.
@@ -328,7 +381,7 @@ func (sdcc *Controller) syncCerts(
 		// Sign for every node DNS name and discovery endpoint.
 		for _, svc := range serviceMap {
 			svcType := svc.Labels[naming.ScyllaServiceTypeLabel]
-			if svcType != string(naming.ScyllaServiceTypeIdentity) && svcType != string(naming.ScyllaServiceTypeMember) {
+			if svcType != string(naming.ScyllaServiceTypeIdentity) && svcType != string(naming.ScyllaServiceTypeMember) && svcType != string(naming.ScyllaServiceTypeAlternator) {
 				return progressingConditions, fmt.Errorf("can't sign certificate for DNS name of unknown service type %q", svcType)
 			}
 			servingDNSNames = append(servingDNSNames, fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace))
@@ -412,6 +465,15 @@ func (sdcc *Controller) syncCerts(
 		if sdc.Spec.ScyllaDB.AlternatorOptions.ServingCertificate.OperatorManagedOptions != nil && sdc.Spec.ScyllaDB.AlternatorOptions.ServingCertificate.OperatorManagedOptions.AdditionalDNSNames != nil {
 			additionalDNSNames = sdc.Spec.ScyllaDB.AlternatorOptions.ServingCertificate.OperatorManagedOptions.AdditionalDNSNames
 		}
+		if sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint != nil && sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Ingress != nil {
+			additionalDNSNames = append(slices.Clone(additionalDNSNames), sdc.Spec.ScyllaDB.AlternatorOptions.Endpoint.Ingress.Hosts...)
+		}
+		if sdc.Spec.ExposeOptions != nil && sdc.Spec.ExposeOptions.Gateway != nil && sdc.Spec.ExposeOptions.Gateway.Alternator != nil {
+			additionalDNSNames = slices.Clone(additionalDNSNames)
+			for _, domain := range sdc.Spec.DNSDomains {
+				additionalDNSNames = append(additionalDNSNames, naming.GetAlternatorProtocolSubDomain(domain))
+			}
+		}
 		alternatorDNSNames := make([]string, 0, len(servingDNSNames)+len(additionalDNSNames))
 		alternatorDNSNames = append(alternatorDNSNames, servingDNSNames...)
 		alternatorDNSNames = append(alternatorDNSNames, additionalDNSNames...)
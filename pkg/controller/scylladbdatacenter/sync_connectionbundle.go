@@ -0,0 +1,368 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/features"
+	okubecrypto "github.com/scylladb/scylla-operator/pkg/kubecrypto"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	"github.com/scylladb/scylla-operator/pkg/scylla"
+	cqlclientv1alpha1 "github.com/scylladb/scylla-operator/pkg/scylla/api/cqlclient/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"sigs.k8s.io/yaml"
+)
+
+// clientConnectionBundleTLS holds TLS materials of the client connection bundle.
+type clientConnectionBundleTLS struct {
+	caBytes   []byte
+	certBytes []byte
+	keyBytes  []byte
+}
+
+// clientConnectionBundleCredentials holds credentials of the CQL role included in the client connection bundle.
+type clientConnectionBundleCredentials struct {
+	username string
+	password string
+}
+
+// clientConnection is a driver-agnostic description of how to connect to ScyllaDB.
+type clientConnection struct {
+	LocalDatacenter string                       `json:"localDatacenter"`
+	Datacenters     []clientConnectionDatacenter `json:"datacenters"`
+	TLS             *clientConnectionTLS         `json:"tls,omitempty"`
+	Auth            *clientConnectionAuth        `json:"auth,omitempty"`
+}
+
+type clientConnectionDatacenter struct {
+	Name          string   `json:"name"`
+	ContactPoints []string `json:"contactPoints"`
+	Port          int32    `json:"port"`
+	TLSPort       int32    `json:"tlsPort"`
+}
+
+type clientConnectionTLS struct {
+	CAFile   string `json:"caFile"`
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+}
+
+type clientConnectionAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// getClientContactPoints returns addresses clients can use to discover the ScyllaDBDatacenter.
+// The identity Service is always included, external addresses are included when clients are given load balancer addresses.
+func getClientContactPoints(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) []string {
+	contactPoints := []string{naming.CrossNamespaceServiceName(sdc)}
+
+	if sdc.Spec.ExposeOptions == nil || sdc.Spec.ExposeOptions.BroadcastOptions == nil ||
+		sdc.Spec.ExposeOptions.BroadcastOptions.Clients.Type != scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress {
+		return contactPoints
+	}
+
+	var externalContactPoints []string
+	for _, svc := range services {
+		if svc.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
+			continue
+		}
+
+		address, err := controllerhelpers.GetScyllaBroadcastAddress(scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress, svc, nil, nil)
+		if err != nil {
+			continue
+		}
+
+		externalContactPoints = append(externalContactPoints, address)
+	}
+
+	// Make sure contact points are always sorted and can be reconciled in a declarative way.
+	slices.Sort(externalContactPoints)
+
+	return append(contactPoints, externalContactPoints...)
+}
+
+func makeClientConnectionEnv(connection *clientConnection) []byte {
+	var sb strings.Builder
+
+	writeEnv := func(key, value string) {
+		sb.WriteString(fmt.Sprintf("%s=%s\n", key, strconv.Quote(value)))
+	}
+
+	dc := connection.Datacenters[0]
+	writeEnv("SCYLLADB_LOCAL_DATACENTER", connection.LocalDatacenter)
+	writeEnv("SCYLLADB_CONTACT_POINTS", strings.Join(dc.ContactPoints, ","))
+	writeEnv("SCYLLADB_PORT", strconv.Itoa(int(dc.Port)))
+	writeEnv("SCYLLADB_TLS_PORT", strconv.Itoa(int(dc.TLSPort)))
+
+	if connection.TLS != nil {
+		writeEnv("SCYLLADB_TLS_CA_FILE", connection.TLS.CAFile)
+		writeEnv("SCYLLADB_TLS_CERT_FILE", connection.TLS.CertFile)
+		writeEnv("SCYLLADB_TLS_KEY_FILE", connection.TLS.KeyFile)
+	}
+
+	if connection.Auth != nil {
+		writeEnv("SCYLLADB_USERNAME", connection.Auth.Username)
+		writeEnv("SCYLLADB_PASSWORD", connection.Auth.Password)
+	}
+
+	return []byte(sb.String())
+}
+
+func makeClientConnectionBundleSecret(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	services map[string]*corev1.Service,
+	tls *clientConnectionBundleTLS,
+	credentials *clientConnectionBundleCredentials,
+) (*corev1.Secret, error) {
+	dcName := naming.GetScyllaDBDatacenterGossipDatacenterName(sdc)
+	contactPoints := getClientContactPoints(sdc, services)
+
+	connection := &clientConnection{
+		LocalDatacenter: dcName,
+		Datacenters: []clientConnectionDatacenter{
+			{
+				Name:          dcName,
+				ContactPoints: contactPoints,
+				Port:          scylla.DefaultNativeTransportPort,
+				TLSPort:       scylla.DefaultNativeTransportPortSSL,
+			},
+		},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sdc.Namespace,
+			Name:      naming.ClientConnectionBundleSecretName(sdc),
+			Labels:    naming.ClusterLabels(sdc),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+		},
+		Data: map[string][]byte{},
+		Type: corev1.SecretTypeOpaque,
+	}
+
+	if credentials != nil {
+		connection.Auth = &clientConnectionAuth{
+			Username: credentials.username,
+			Password: credentials.password,
+		}
+		secret.Data[naming.ClientConnectionBundleUsernameKey] = []byte(credentials.username)
+		secret.Data[naming.ClientConnectionBundlePasswordKey] = []byte(credentials.password)
+	}
+
+	if tls != nil {
+		connection.TLS = &clientConnectionTLS{
+			CAFile:   naming.ClientConnectionBundleCAKey,
+			CertFile: naming.ClientConnectionBundleCertKey,
+			KeyFile:  naming.ClientConnectionBundleKeyKey,
+		}
+		secret.Data[naming.ClientConnectionBundleCAKey] = tls.caBytes
+		secret.Data[naming.ClientConnectionBundleCertKey] = tls.certBytes
+		secret.Data[naming.ClientConnectionBundleKeyKey] = tls.keyBytes
+
+		authInfo := &cqlclientv1alpha1.AuthInfo{
+			ClientCertificateData: tls.certBytes,
+			ClientKeyData:         tls.keyBytes,
+		}
+		if credentials != nil {
+			authInfo.Username = credentials.username
+			authInfo.Password = credentials.password
+		}
+
+		cqlConnectionConfig := &cqlclientv1alpha1.CQLConnectionConfig{
+			AuthInfos: map[string]*cqlclientv1alpha1.AuthInfo{
+				"client": authInfo,
+			},
+			Datacenters: map[string]*cqlclientv1alpha1.Datacenter{
+				dcName: {
+					Server:                   getHostPort(contactPoints[0], scylla.DefaultNativeTransportPortSSL),
+					CertificateAuthorityData: tls.caBytes,
+				},
+			},
+			Contexts: map[string]*cqlclientv1alpha1.Context{
+				"default": {
+					AuthInfoName:   "client",
+					DatacenterName: dcName,
+				},
+			},
+			CurrentContext: "default",
+			Parameters: &cqlclientv1alpha1.CQLParameters{
+				DefaultConsistency:       cqlclientv1alpha1.CQLDefaultQuorumConsistency,
+				DefaultSerialConsistency: cqlclientv1alpha1.CQLDefaultSerialConsistency,
+			},
+		}
+
+		encoder := scheme.Codecs.EncoderForVersion(scheme.DefaultYamlSerializer, schema.GroupVersions(scheme.Scheme.PrioritizedVersionsAllGroups()))
+		cqlConnectionConfigData, err := runtime.Encode(encoder, cqlConnectionConfig)
+		if err != nil {
+			return nil, fmt.Errorf("can't encode cql connection config: %w", err)
+		}
+		secret.Data[naming.ClientConnectionBundleCQLConnectionConfigKey] = cqlConnectionConfigData
+	}
+
+	connectionData, err := yaml.Marshal(connection)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal connection: %w", err)
+	}
+	secret.Data[naming.ClientConnectionBundleConnectionKey] = connectionData
+	secret.Data[naming.ClientConnectionBundleEnvKey] = makeClientConnectionEnv(connection)
+
+	return secret, nil
+}
+
+func (sdcc *Controller) getClientConnectionBundleTLS(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	secrets map[string]*corev1.Secret,
+	configMaps map[string]*corev1.ConfigMap,
+) (*clientConnectionBundleTLS, []string, error) {
+	var pendingMessages []string
+
+	clientCertSecretName := naming.GetScyllaClusterLocalUserClientCertName(sdc.Name)
+	clientCertSecret, found := secrets[clientCertSecretName]
+	if !found {
+		pendingMessages = append(pendingMessages, fmt.Sprintf("Waiting for Secret %q to exist.", naming.ManualRef(sdc.Namespace, clientCertSecretName)))
+	}
+
+	servingCAConfigMapName := naming.GetScyllaClusterLocalServingCAName(sdc.Name)
+	servingCAConfigMap, found := configMaps[servingCAConfigMapName]
+	if !found {
+		pendingMessages = append(pendingMessages, fmt.Sprintf("Waiting for ConfigMap %q to exist.", naming.ManualRef(sdc.Namespace, servingCAConfigMapName)))
+	}
+
+	if len(pendingMessages) != 0 {
+		return nil, pendingMessages, nil
+	}
+
+	certBytes, keyBytes, err := okubecrypto.GetCertKeyDataFromSecret(clientCertSecret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get cert and key bytes from secret %q: %w", clientCertSecretName, err)
+	}
+
+	caBytes, err := okubecrypto.GetCABundleDataFromConfigMap(servingCAConfigMap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get ca bundle bytes from configmap %q: %w", servingCAConfigMapName, err)
+	}
+
+	return &clientConnectionBundleTLS{
+		caBytes:   caBytes,
+		certBytes: certBytes,
+		keyBytes:  keyBytes,
+	}, nil, nil
+}
+
+func (sdcc *Controller) getClientConnectionBundleCredentials(sdc *scyllav1alpha1.ScyllaDBDatacenter) (*clientConnectionBundleCredentials, []string, error) {
+	secretName := sdc.Spec.ClientConnectionBundle.CredentialsSecretRef.Name
+	credentialsSecret, err := sdcc.secretLister.Secrets(sdc.Namespace).Get(secretName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(sdc.Namespace, secretName), err)
+		}
+
+		return nil, []string{fmt.Sprintf("Waiting for Secret %q to exist.", naming.ManualRef(sdc.Namespace, secretName))}, nil
+	}
+
+	username, password := credentialsSecret.Data[naming.ClientConnectionBundleUsernameKey], credentialsSecret.Data[naming.ClientConnectionBundlePasswordKey]
+	if len(username) == 0 || len(password) == 0 {
+		return nil, nil, fmt.Errorf("secret %q is missing %q or %q key", naming.ObjRef(credentialsSecret), naming.ClientConnectionBundleUsernameKey, naming.ClientConnectionBundlePasswordKey)
+	}
+
+	return &clientConnectionBundleCredentials{
+		username: string(username),
+		password: string(password),
+	}, nil, nil
+}
+
+func (sdcc *Controller) syncClientConnectionBundle(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	secrets map[string]*corev1.Secret,
+	configMaps map[string]*corev1.ConfigMap,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if sdc.Spec.ClientConnectionBundle == nil {
+		secret, ok := secrets[naming.ClientConnectionBundleSecretName(sdc)]
+		if !ok || secret.DeletionTimestamp != nil {
+			return progressingConditions, nil
+		}
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, clientConnectionBundleControllerProgressingCondition, secret, "delete", sdc.Generation)
+		err := sdcc.kubeClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &secret.UID,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't delete secret %q: %w", naming.ObjRef(secret), err)
+		}
+
+		return progressingConditions, nil
+	}
+
+	var pendingMessages []string
+
+	var tls *clientConnectionBundleTLS
+	if utilfeature.DefaultMutableFeatureGate.Enabled(features.AutomaticTLSCertificates) {
+		var pms []string
+		var err error
+		tls, pms, err = sdcc.getClientConnectionBundleTLS(sdc, secrets, configMaps)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get TLS materials: %w", err)
+		}
+		pendingMessages = append(pendingMessages, pms...)
+	}
+
+	var credentials *clientConnectionBundleCredentials
+	if sdc.Spec.ClientConnectionBundle.CredentialsSecretRef != nil {
+		var pms []string
+		var err error
+		credentials, pms, err = sdcc.getClientConnectionBundleCredentials(sdc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get credentials: %w", err)
+		}
+		pendingMessages = append(pendingMessages, pms...)
+	}
+
+	if len(pendingMessages) != 0 {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               clientConnectionBundleControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForDependencies",
+			Message:            strings.Join(pendingMessages, "\n"),
+			ObservedGeneration: sdc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	requiredSecret, err := makeClientConnectionBundleSecret(sdc, services, tls, credentials)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make client connection bundle secret: %w", err)
+	}
+
+	_, changed, err := resourceapply.ApplySecret(ctx, sdcc.kubeClient.CoreV1(), sdcc.secretLister, sdcc.eventRecorder, requiredSecret, resourceapply.ApplyOptions{})
+	if changed {
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, clientConnectionBundleControllerProgressingCondition, requiredSecret, "apply", sdc.Generation)
+	}
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply secret %q: %w", naming.ObjRef(requiredSecret), err)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeClientConnectionBundleSecret(t *testing.T) {
+	t.Parallel()
+
+	newBasicSDC := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo-ns",
+				Name:      "bar",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:            "bar",
+				DatacenterName:         pointer.Ptr("us-east-1"),
+				ClientConnectionBundle: &scyllav1alpha1.ClientConnectionBundleOptions{},
+			},
+		}
+	}
+
+	newExpectedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo-ns",
+				Name:      "bar-client-connection-bundle",
+				Labels: map[string]string{
+					"app":                          "scylla",
+					"app.kubernetes.io/managed-by": "scylla-operator",
+					"app.kubernetes.io/name":       "scylla",
+					"scylla/cluster":               "bar",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "scylla.scylladb.com/v1alpha1",
+						Kind:               "ScyllaDBDatacenter",
+						Name:               "bar",
+						Controller:         pointer.Ptr(true),
+						BlockOwnerDeletion: pointer.Ptr(true),
+					},
+				},
+			},
+			Type: "Opaque",
+			Data: data,
+		}
+	}
+
+	newMemberService := func(name string, ingress ...corev1.LoadBalancerIngress) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo-ns",
+				Name:      name,
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/scylla-service-type": "member",
+				},
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: ingress,
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name          string
+		sdc           *scyllav1alpha1.ScyllaDBDatacenter
+		services      map[string]*corev1.Service
+		tls           *clientConnectionBundleTLS
+		credentials   *clientConnectionBundleCredentials
+		expected      *corev1.Secret
+		expectedError error
+	}{
+		{
+			name:     "bundle without TLS and credentials contains only connection details",
+			sdc:      newBasicSDC(),
+			services: map[string]*corev1.Service{},
+			expected: newExpectedSecret(map[string][]byte{
+				"connection.yaml": []byte(strings.TrimPrefix(`
+datacenters:
+- contactPoints:
+  - bar-client.foo-ns.svc
+  name: us-east-1
+  port: 9042
+  tlsPort: 9142
+localDatacenter: us-east-1
+`, "\n")),
+				"connection.env": []byte(strings.TrimPrefix(`
+SCYLLADB_LOCAL_DATACENTER="us-east-1"
+SCYLLADB_CONTACT_POINTS="bar-client.foo-ns.svc"
+SCYLLADB_PORT="9042"
+SCYLLADB_TLS_PORT="9142"
+`, "\n")),
+			}),
+			expectedError: nil,
+		},
+		{
+			name: "load balancer ingress addresses of member services are appended to contact points in sorted order",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newBasicSDC()
+				sdc.Spec.ExposeOptions = &scyllav1alpha1.ExposeOptions{
+					BroadcastOptions: &scyllav1alpha1.NodeBroadcastOptions{
+						Clients: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress,
+						},
+						Nodes: scyllav1alpha1.BroadcastOptions{
+							Type: scyllav1alpha1.BroadcastAddressTypePodIP,
+						},
+					},
+				}
+				return sdc
+			}(),
+			services: map[string]*corev1.Service{
+				"bar-us-east-1-a-1": newMemberService("bar-us-east-1-a-1", corev1.LoadBalancerIngress{IP: "10.0.0.2"}),
+				"bar-us-east-1-a-0": newMemberService("bar-us-east-1-a-0", corev1.LoadBalancerIngress{IP: "10.0.0.1"}),
+				"bar-us-east-1-a-2": newMemberService("bar-us-east-1-a-2"),
+				"bar-client": {
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "foo-ns",
+						Name:      "bar-client",
+						Labels: map[string]string{
+							"scylla-operator.scylladb.com/scylla-service-type": "identity",
+						},
+					},
+				},
+			},
+			expected: newExpectedSecret(map[string][]byte{
+				"connection.yaml": []byte(strings.TrimPrefix(`
+datacenters:
+- contactPoints:
+  - bar-client.foo-ns.svc
+  - 10.0.0.1
+  - 10.0.0.2
+  name: us-east-1
+  port: 9042
+  tlsPort: 9142
+localDatacenter: us-east-1
+`, "\n")),
+				"connection.env": []byte(strings.TrimPrefix(`
+SCYLLADB_LOCAL_DATACENTER="us-east-1"
+SCYLLADB_CONTACT_POINTS="bar-client.foo-ns.svc,10.0.0.1,10.0.0.2"
+SCYLLADB_PORT="9042"
+SCYLLADB_TLS_PORT="9142"
+`, "\n")),
+			}),
+			expectedError: nil,
+		},
+		{
+			name:     "bundle with TLS and credentials contains certificates, credentials and cql connection config",
+			sdc:      newBasicSDC(),
+			services: map[string]*corev1.Service{},
+			tls: &clientConnectionBundleTLS{
+				caBytes:   []byte("serving-ca"),
+				certBytes: []byte("client-cert"),
+				keyBytes:  []byte("client-key"),
+			},
+			credentials: &clientConnectionBundleCredentials{
+				username: "alice",
+				password: "secret",
+			},
+			expected: newExpectedSecret(map[string][]byte{
+				"ca.crt":   []byte("serving-ca"),
+				"tls.crt":  []byte("client-cert"),
+				"tls.key":  []byte("client-key"),
+				"username": []byte("alice"),
+				"password": []byte("secret"),
+				"cql-connection-config.yaml": []byte(strings.TrimPrefix(`
+apiVersion: cqlclient.scylla.scylladb.com/v1alpha1
+authInfos:
+  client:
+    clientCertificateData: Y2xpZW50LWNlcnQ=
+    clientKeyData: Y2xpZW50LWtleQ==
+    password: secret
+    username: alice
+contexts:
+  default:
+    authInfoName: client
+    datacenterName: us-east-1
+currentContext: default
+datacenters:
+  us-east-1:
+    certificateAuthorityData: c2VydmluZy1jYQ==
+    nodeDomain: ""
+    server: bar-client.foo-ns.svc:9142
+kind: CQLConnectionConfig
+parameters:
+  defaultConsistency: QUORUM
+  defaultSerialConsistency: SERIAL
+`, "\n")),
+				"connection.yaml": []byte(strings.TrimPrefix(`
+auth:
+  password: secret
+  username: alice
+datacenters:
+- contactPoints:
+  - bar-client.foo-ns.svc
+  name: us-east-1
+  port: 9042
+  tlsPort: 9142
+localDatacenter: us-east-1
+tls:
+  caFile: ca.crt
+  certFile: tls.crt
+  keyFile: tls.key
+`, "\n")),
+				"connection.env": []byte(strings.TrimPrefix(`
+SCYLLADB_LOCAL_DATACENTER="us-east-1"
+SCYLLADB_CONTACT_POINTS="bar-client.foo-ns.svc"
+SCYLLADB_PORT="9042"
+SCYLLADB_TLS_PORT="9142"
+SCYLLADB_TLS_CA_FILE="ca.crt"
+SCYLLADB_TLS_CERT_FILE="tls.crt"
+SCYLLADB_TLS_KEY_FILE="tls.key"
+SCYLLADB_USERNAME="alice"
+SCYLLADB_PASSWORD="secret"
+`, "\n")),
+			}),
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeClientConnectionBundleSecret(tc.sdc, tc.services, tc.tls, tc.credentials)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Errorf("expected error %#v, got %#v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and actual secrets differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/gatewayapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+func (sdcc *Controller) syncGatewayAPI(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	servedResources apimachineryutilsets.Set[schema.GroupVersionResource],
+	gateways map[string]*unstructured.Unstructured,
+	httpRoutes map[string]*unstructured.Unstructured,
+	backendTLSPolicies map[string]*unstructured.Unstructured,
+	tlsRoutes map[string]*unstructured.Unstructured,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	// Protocols are only exposed when the cluster serves all Gateway API resources they need,
+	// so CQL, which needs the experimental TLSRoute, doesn't prevent exposing Alternator with the standard channel.
+	unservedResources := apimachineryutilsets.New[string]()
+	if isExposedThroughGateway(sdc) && !servedResources.Has(gatewayapi.GatewayGVR) {
+		unservedResources.Insert(getUnservedResourceNames(servedResources, []schema.GroupVersionResource{gatewayapi.GatewayGVR})...)
+	}
+
+	var requiredTLSRoutes []*unstructured.Unstructured
+	if isCQLExposedThroughGateway(sdc) {
+		if servedResources.HasAll(gatewayCQLResources...) {
+			requiredTLSRoutes = MakeTLSRoutes(sdc, services)
+		} else {
+			unservedResources.Insert(getUnservedResourceNames(servedResources, gatewayCQLResources)...)
+		}
+	}
+
+	var requiredHTTPRoutes, requiredBackendTLSPolicies []*unstructured.Unstructured
+	if isAlternatorExposedThroughGateway(sdc) {
+		if servedResources.HasAll(gatewayAlternatorResources...) {
+			requiredHTTPRoutes = MakeHTTPRoutes(sdc)
+			requiredBackendTLSPolicies = MakeBackendTLSPolicies(sdc)
+		} else {
+			unservedResources.Insert(getUnservedResourceNames(servedResources, gatewayAlternatorResources)...)
+		}
+	}
+
+	var requiredGateways []*unstructured.Unstructured
+	gateway := MakeGateway(sdc, servedResources)
+	if gateway != nil && servedResources.Has(gatewayapi.GatewayGVR) {
+		requiredGateways = append(requiredGateways, gateway)
+	}
+
+	var progressingConditions []metav1.Condition
+
+	// Routes are pruned before and applied after the Gateway they are attached to.
+	// BackendTLSPolicies are pruned after and applied before the HTTPRoutes whose backends they configure.
+	for _, p := range []struct {
+		gvr      schema.GroupVersionResource
+		existing map[string]*unstructured.Unstructured
+		required []*unstructured.Unstructured
+	}{
+		{gvr: gatewayapi.HTTPRouteGVR, existing: httpRoutes, required: requiredHTTPRoutes},
+		{gvr: gatewayapi.BackendTLSPolicyGVR, existing: backendTLSPolicies, required: requiredBackendTLSPolicies},
+		{gvr: gatewayapi.TLSRouteGVR, existing: tlsRoutes, required: requiredTLSRoutes},
+		{gvr: gatewayapi.GatewayGVR, existing: gateways, required: requiredGateways},
+	} {
+		if !servedResources.Has(p.gvr) {
+			continue
+		}
+
+		pcs, err := sdcc.pruneGatewayAPIObjects(ctx, sdc, p.gvr, p.existing, p.required)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't prune %s: %w", p.gvr.Resource, err)
+		}
+	}
+
+	for _, a := range []struct {
+		gvr      schema.GroupVersionResource
+		lister   *gatewayapi.ResourceLister
+		required []*unstructured.Unstructured
+	}{
+		{gvr: gatewayapi.GatewayGVR, lister: sdcc.gatewayLister, required: requiredGateways},
+		{gvr: gatewayapi.BackendTLSPolicyGVR, lister: sdcc.backendTLSPolicyLister, required: requiredBackendTLSPolicies},
+		{gvr: gatewayapi.HTTPRouteGVR, lister: sdcc.httpRouteLister, required: requiredHTTPRoutes},
+		{gvr: gatewayapi.TLSRouteGVR, lister: sdcc.tlsRouteLister, required: requiredTLSRoutes},
+	} {
+		if !servedResources.Has(a.gvr) {
+			continue
+		}
+
+		for _, required := range a.required {
+			client := sdcc.gatewayClient.Resource(a.gvr).Namespace(required.GetNamespace())
+			_, changed, err := resourceapply.ApplyGeneric[*unstructured.Unstructured](
+				ctx,
+				resourceapply.ApplyControlFuncs[*unstructured.Unstructured]{
+					GetCachedFunc: a.lister.Namespace(required.GetNamespace()).Get,
+					CreateFunc: func(ctx context.Context, obj *unstructured.Unstructured, opts metav1.CreateOptions) (*unstructured.Unstructured, error) {
+						return client.Create(ctx, obj, opts)
+					},
+					UpdateFunc: func(ctx context.Context, obj *unstructured.Unstructured, opts metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+						return client.Update(ctx, obj, opts)
+					},
+					DeleteFunc: func(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+						return client.Delete(ctx, name, opts)
+					},
+				},
+				sdcc.eventRecorder,
+				required,
+				resourceapply.ApplyOptions{},
+			)
+			if changed {
+				controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, gatewayControllerProgressingCondition, required, "apply", sdc.Generation)
+			}
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't apply %s %q: %w", required.GetKind(), naming.ObjRef(required), err)
+			}
+		}
+	}
+
+	if unservedResources.Len() != 0 {
+		return progressingConditions, fmt.Errorf(
+			"can't fully expose ScyllaDBDatacenter through the Gateway API because the cluster doesn't serve %s in %q group",
+			strings.Join(apimachineryutilsets.List(unservedResources), ", "),
+			gatewayapi.GroupName,
+		)
+	}
+
+	return progressingConditions, nil
+}
+
+func getUnservedResourceNames(servedResources apimachineryutilsets.Set[schema.GroupVersionResource], resources []schema.GroupVersionResource) []string {
+	var names []string
+	for _, gvr := range resources {
+		if !servedResources.Has(gvr) {
+			names = append(names, fmt.Sprintf("%s/%s", gvr.Version, gvr.Resource))
+		}
+	}
+
+	return names
+}
+
+func (sdcc *Controller) pruneGatewayAPIObjects(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	gvr schema.GroupVersionResource,
+	existing map[string]*unstructured.Unstructured,
+	required []*unstructured.Unstructured,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	// Delete has to be the first action to avoid getting stuck on quota.
+	var deletionErrors []error
+	for _, obj := range existing {
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		isRequired := false
+		for _, req := range required {
+			if obj.GetName() == req.GetName() {
+				isRequired = true
+			}
+		}
+		if isRequired {
+			continue
+		}
+
+		uid := obj.GetUID()
+		propagationPolicy := metav1.DeletePropagationBackground
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, gatewayControllerProgressingCondition, obj, "delete", sdc.Generation)
+		err := sdcc.gatewayClient.Resource(gvr).Namespace(obj.GetNamespace()).Delete(ctx, obj.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &uid,
+			},
+			PropagationPolicy: &propagationPolicy,
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			deletionErrors = append(deletionErrors, err)
+		}
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(deletionErrors)
+}
+
+// getServedGatewayAPIResources returns Gateway API resources that the cluster serves and whose informers have synced.
+func (sdcc *Controller) getServedGatewayAPIResources() apimachineryutilsets.Set[schema.GroupVersionResource] {
+	if sdcc.gatewayAPIInformers == nil {
+		return apimachineryutilsets.New[schema.GroupVersionResource]()
+	}
+
+	return sdcc.gatewayAPIInformers.SyncedResources()
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// nextRestorePhase returns the phase following the one whose ScyllaDB Manager task has finished.
+func nextRestorePhase(phase scyllav1alpha1.RestorePhase) scyllav1alpha1.RestorePhase {
+	switch phase {
+	case scyllav1alpha1.RestorePhaseRestoringSchema:
+		return scyllav1alpha1.RestorePhaseRestoringTables
+
+	default:
+		return scyllav1alpha1.RestorePhaseCompleted
+
+	}
+}
+
+func setRestoreAvailableCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus) {
+	if status.Restore.Phase == scyllav1alpha1.RestorePhaseCompleted {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               restoreControllerAvailableCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: sdc.Generation,
+		})
+		return
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               restoreControllerAvailableCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(status.Restore.Phase),
+		Message:            status.Restore.Message,
+		ObservedGeneration: sdc.Generation,
+	})
+}
+
+func (sdcc *Controller) syncRestore(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	scyllaDBManagerTasks map[string]*scyllav1alpha1.ScyllaDBManagerTask,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if sdc.Spec.BootstrapFrom == nil || sdc.Spec.BootstrapFrom.Backup == nil {
+		return progressingConditions, nil
+	}
+
+	if status.Restore == nil {
+		status.Restore = &scyllav1alpha1.RestoreStatus{
+			Phase: scyllav1alpha1.RestorePhaseRestoringSchema,
+		}
+	}
+	defer setRestoreAvailableCondition(sdc, status)
+
+	var requiredTasks []*scyllav1alpha1.ScyllaDBManagerTask
+	var requiredTask *scyllav1alpha1.ScyllaDBManagerTask
+	if status.Restore.Phase != scyllav1alpha1.RestorePhaseCompleted {
+		var err error
+		requiredTask, err = MakeRestoreScyllaDBManagerTask(sdc, status.Restore.Phase)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make restore ScyllaDBManagerTask: %w", err)
+		}
+		requiredTasks = append(requiredTasks, requiredTask)
+	}
+
+	// Tasks of finished phases are removed, which also removes them from ScyllaDB Manager state.
+	err := controllerhelpers.Prune(
+		ctx,
+		requiredTasks,
+		scyllaDBManagerTasks,
+		&controllerhelpers.PruneControlFuncs{
+			DeleteFunc: sdcc.scyllaClient.ScyllaDBManagerTasks(sdc.Namespace).Delete,
+		},
+		sdcc.eventRecorder,
+	)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't prune ScyllaDBManagerTask(s): %w", err)
+	}
+
+	if requiredTask == nil {
+		return progressingConditions, nil
+	}
+
+	// The backup can only be restored once all nodes have joined the cluster.
+	if !apimeta.IsStatusConditionTrue(status.Conditions, statefulSetControllerAvailableCondition) || apimeta.IsStatusConditionTrue(status.Conditions, statefulSetControllerProgressingCondition) {
+		status.Restore.Message = "Waiting for the datacenter to roll out."
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               restoreControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForRollout",
+			Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to roll out before restoring it from a backup.", naming.ObjRef(sdc)),
+			ObservedGeneration: sdc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	task, changed, err := resourceapply.ApplyScyllaDBManagerTask(ctx, sdcc.scyllaClient, sdcc.scyllaDBManagerTaskLister, sdcc.eventRecorder, requiredTask, resourceapply.ApplyOptions{})
+	if changed {
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, restoreControllerProgressingCondition, requiredTask, "apply", sdc.Generation)
+	}
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply ScyllaDBManagerTask: %w", err)
+	}
+
+	var lastRunStatus string
+	if task.Status.LastRunStatus != nil {
+		lastRunStatus = *task.Status.LastRunStatus
+	}
+
+	switch lastRunStatus {
+	case managerclient.TaskStatusDone:
+		finishedPhase := status.Restore.Phase
+		status.Restore.Phase = nextRestorePhase(finishedPhase)
+		status.Restore.Message = ""
+
+		klog.V(2).InfoS("Restore phase finished", "ScyllaDBDatacenter", klog.KObj(sdc), "Phase", finishedPhase, "NextPhase", status.Restore.Phase)
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "RestorePhaseFinished", "Restore phase %q finished, next phase is %q", finishedPhase, status.Restore.Phase)
+
+		if status.Restore.Phase != scyllav1alpha1.RestorePhaseCompleted {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               restoreControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "RestorePhaseFinished",
+				Message:            fmt.Sprintf("Restore phase %q finished.", finishedPhase),
+				ObservedGeneration: sdc.Generation,
+			})
+		}
+
+	case managerclient.TaskStatusError, managerclient.TaskStatusAborted:
+		// The restore doesn't progress past a failed task. Deleting the task makes us recreate it, which reruns the restore phase.
+		status.Restore.Message = fmt.Sprintf("ScyllaDBManagerTask %q failed with status %q. Delete it to retry the restore.", naming.ObjRef(task), lastRunStatus)
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "RestoreFailed", "ScyllaDBManagerTask %q failed with status %q", naming.ObjRef(task), lastRunStatus)
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               restoreControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RestoreFailed",
+			Message:            status.Restore.Message,
+			ObservedGeneration: sdc.Generation,
+		})
+
+	default:
+		status.Restore.Message = fmt.Sprintf("Waiting for ScyllaDBManagerTask %q to finish.", naming.ObjRef(task))
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               restoreControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForScyllaDBManagerTask",
+			Message:            status.Restore.Message,
+			ObservedGeneration: sdc.Generation,
+		})
+
+	}
+
+	return progressingConditions, nil
+}
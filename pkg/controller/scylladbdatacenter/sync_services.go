@@ -35,6 +35,11 @@ func (sdcc *Controller) makeServices(sdc *scyllav1alpha1.ScyllaDBDatacenter, old
 		identityService,
 	}
 
+	alternatorService := AlternatorService(sdc)
+	if alternatorService != nil {
+		services = append(services, alternatorService)
+	}
+
 	for _, rack := range sdc.Spec.Racks {
 		stsName := naming.StatefulSetNameForRack(rack, sdc)
 		rackNodes, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
@@ -80,6 +85,20 @@ func (sdcc *Controller) pruneServices(
 			continue
 		}
 
+		// Alternator Service doesn't back any node and can be deleted right away.
+		if svc.Labels[naming.ScyllaServiceTypeLabel] == string(naming.ScyllaServiceTypeAlternator) {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, serviceControllerProgressingCondition, svc, "delete", sdc.Generation)
+			err := sdcc.kubeClient.CoreV1().Services(svc.Namespace).Delete(ctx, svc.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &svc.UID,
+				},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
 		// Do not delete services for scale down.
 		rackName, ok := svc.Labels[naming.RackNameLabel]
 		if !ok {
@@ -229,6 +248,13 @@ func (sdcc *Controller) syncServices(
 		}
 	}
 
+	// Start replacements requested in the spec.
+	pcs, err := sdcc.startNodeReplacements(ctx, sdc, services)
+	progressingConditions = append(progressingConditions, pcs...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't start node replacements: %w", err)
+	}
+
 	// Replace members.
 	for _, svc := range services {
 		_, ok := svc.Labels[naming.ReplaceLabel]
@@ -238,7 +264,12 @@ func (sdcc *Controller) syncServices(
 
 		klog.V(4).InfoS("Node is labelled with replacement label and will be replaced", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc))
 
-		supportsReplaceUsingHostID, err := scyllafeatures.Supports(sdc, scyllafeatures.ReplacingNodeUsingHostID)
+		rackImage, err := controllerhelpers.GetRackScyllaDBImage(sdc, svc.Labels[naming.RackNameLabel])
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get ScyllaDB image of Service %q rack: %w", naming.ObjRef(svc), err)
+		}
+
+		supportsReplaceUsingHostID, err := scyllafeatures.VersionSupports(rackImage, scyllafeatures.ReplacingNodeUsingHostID)
 		if err != nil {
 			return progressingConditions, fmt.Errorf("can't determine if ScyllaCluster %q supports replacing using hostID: %w", naming.ObjRef(sdc), err)
 		}
@@ -258,6 +289,83 @@ func (sdcc *Controller) syncServices(
 	return progressingConditions, nil
 }
 
+// startNodeReplacements labels member Services of nodes requested to be replaced with the replace label.
+// Every member Service is annotated with the name of the request, so it's started only once.
+// Requests that were already completed are never restarted.
+func (sdcc *Controller) startNodeReplacements(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	var errs []error
+
+	for _, nr := range sdc.Spec.NodeReplacements {
+		_, _, completed := oslices.Find(sdc.Status.NodeReplacements, func(nrs scyllav1alpha1.NodeReplacementStatus) bool {
+			return nrs.Name == nr.Name && nrs.Phase == scyllav1alpha1.NodeReplacementPhaseCompleted
+		})
+		if completed {
+			continue
+		}
+
+		rackSpec, _, ok := oslices.Find(sdc.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+			return spec.Name == nr.RackName
+		})
+		if !ok {
+			errs = append(errs, fmt.Errorf("can't find rack %q of node replacement %q", nr.RackName, nr.Name))
+			continue
+		}
+
+		svcName := naming.MemberServiceName(rackSpec, sdc, int(nr.Ordinal))
+		svc, ok := services[svcName]
+		if !ok {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               serviceControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForMemberService",
+				Message:            fmt.Sprintf("Node replacement %q is waiting for Service %q to be created.", nr.Name, naming.ManualRef(sdc.Namespace, svcName)),
+				ObservedGeneration: sdc.Generation,
+			})
+			continue
+		}
+
+		if svc.Annotations[naming.NodeReplacementNameAnnotation] == nr.Name {
+			continue
+		}
+
+		if _, ok := svc.Labels[naming.ReplaceLabel]; ok {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               serviceControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForOngoingReplacement",
+				Message:            fmt.Sprintf("Node replacement %q is waiting for an ongoing replacement of Service %q to finish.", nr.Name, naming.ObjRef(svc)),
+				ObservedGeneration: sdc.Generation,
+			})
+			continue
+		}
+
+		klog.V(2).InfoS("Starting requested node replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc), "NodeReplacement", nr.Name, "Reason", nr.Reason)
+
+		svcCopy := svc.DeepCopy()
+		if svcCopy.Labels == nil {
+			svcCopy.Labels = map[string]string{}
+		}
+		svcCopy.Labels[naming.ReplaceLabel] = ""
+		if svcCopy.Annotations == nil {
+			svcCopy.Annotations = map[string]string{}
+		}
+		svcCopy.Annotations[naming.NodeReplacementNameAnnotation] = nr.Name
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, serviceControllerProgressingCondition, svcCopy, "update", sdc.Generation)
+		_, err := sdcc.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+		resourceapply.ReportUpdateEvent(sdcc.eventRecorder, svc, err)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "StartedReplacingNode", "Started replacing node %q as requested by %q: %s", naming.ObjRef(svc), nr.Name, nr.Reason)
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+}
+
 func (sdcc *Controller) replaceNodeUsingHostID(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service) ([]metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
 
@@ -130,13 +130,22 @@ func (sdcc *Controller) removeSnapshot(ctx context.Context, scyllaClient *scylla
 	})
 }
 
+// getUpgradeHosts returns the hosts of the nodes subject to the upgrade.
+func (sdcc *Controller) getUpgradeHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, upgradeContext *internalapi.DatacenterUpgradeContext) ([]string, error) {
+	if len(upgradeContext.RackName) == 0 {
+		return controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	}
+
+	return controllerhelpers.GetRequiredScyllaRackHosts(sdc, upgradeContext.RackName, services, sdcc.podLister)
+}
+
 // beforeUpgrade runs hooks before a cluster upgrade starts.
 // It returns true if the action is done, false if the caller should repeat later.
 func (sdcc *Controller) beforeUpgrade(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, upgradeContext *internalapi.DatacenterUpgradeContext) (bool, error) {
-	klog.V(2).InfoS("Running pre-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
-	defer klog.V(2).InfoS("Finished running pre-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
+	klog.V(2).InfoS("Running pre-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", upgradeContext.RackName)
+	defer klog.V(2).InfoS("Finished running pre-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", upgradeContext.RackName)
 
-	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	hosts, err := sdcc.getUpgradeHosts(sdc, services, upgradeContext)
 	if err != nil {
 		return true, err
 	}
@@ -172,10 +181,10 @@ func (sdcc *Controller) beforeUpgrade(ctx context.Context, sdc *scyllav1alpha1.S
 }
 
 func (sdcc *Controller) afterUpgrade(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, upgradeContext *internalapi.DatacenterUpgradeContext) error {
-	klog.V(2).InfoS("Running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
-	defer klog.V(2).InfoS("Finished running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
+	klog.V(2).InfoS("Running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", upgradeContext.RackName)
+	defer klog.V(2).InfoS("Finished running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", upgradeContext.RackName)
 
-	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	hosts, err := sdcc.getUpgradeHosts(sdc, services, upgradeContext)
 	if err != nil {
 		return err
 	}
@@ -509,7 +518,13 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, nil
 	}
 
-	inputsHash, err := hash.HashObjects(managedScyllaDBConfigCM.Data)
+	// Options which ScyllaDB nodes reload live are left out, so changing them doesn't roll out the nodes.
+	restartRequiredManagedConfigData, err := makeRestartRequiredManagedConfigData(managedScyllaDBConfigCM.Data)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make restart required managed config data: %w", err)
+	}
+
+	inputsHash, err := hash.HashObjects(restartRequiredManagedConfigData)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't hash inputs: %w", err)
 	}
@@ -774,6 +789,10 @@ func (sdcc *Controller) syncStatefulSets(
 			var errs []error
 			anyStsChanged := false
 			for _, required := range requiredStatefulSets {
+				if !currentUpgradeContext.IsUpgradingRack(required.Labels[naming.RackNameLabel]) {
+					continue
+				}
+
 				existing, ok := statefulSets[required.Name]
 				if !ok {
 					// At this point all missing statefulSets should have been created.
@@ -843,6 +862,10 @@ func (sdcc *Controller) syncStatefulSets(
 
 		case internalapi.RolloutRunUpgradePhase:
 			for _, sts := range requiredStatefulSets {
+				if !currentUpgradeContext.IsUpgradingRack(sts.Labels[naming.RackNameLabel]) {
+					continue
+				}
+
 				partition := *sts.Spec.UpdateStrategy.RollingUpdate.Partition
 
 				// Isolate the live values in a block to prevent accidental use.
@@ -1019,13 +1042,23 @@ func (sdcc *Controller) syncStatefulSets(
 				if requiredVersion.Major != existingVersion.Major ||
 					requiredVersion.Minor != existingVersion.Minor {
 					// We need to run hooks for version upgrades.
-					sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeStarted", "Version changed from %q to %q", existingVersionString, requiredVersionString)
+					// Racks can run different versions, so the upgrade is carried out for every rack separately.
+					rackName, ok := required.Labels[naming.RackNameLabel]
+					if !ok {
+						return progressingConditions, fmt.Errorf(
+							"can't determine rack name: statefulset %s is missing label %q",
+							naming.ObjRef(required),
+							naming.RackNameLabel,
+						)
+					}
+
+					sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeStarted", "Version of rack %q changed from %q to %q", rackName, existingVersionString, requiredVersionString)
 
 					progressingConditions = append(progressingConditions, metav1.Condition{
 						Type:               statefulSetControllerProgressingCondition,
 						Status:             metav1.ConditionTrue,
 						Reason:             "Upgrading",
-						Message:            "Starting cluster upgrade",
+						Message:            fmt.Sprintf("Starting upgrade of rack %q", rackName),
 						ObservedGeneration: sdc.Generation,
 					})
 
@@ -1034,6 +1067,7 @@ func (sdcc *Controller) syncStatefulSets(
 
 					cm, err := MakeUpgradeContextConfigMap(sdc, &internalapi.DatacenterUpgradeContext{
 						State:             internalapi.PreHooksUpgradePhase,
+						RackName:          rackName,
 						FromVersion:       existingVersionString,
 						ToVersion:         requiredVersionString,
 						SystemSnapshotTag: snapshotTag("system", now),
@@ -1130,9 +1164,15 @@ func (sdcc *Controller) setStatefulSetsAvailableStatusCondition(
 			continue
 		}
 
-		expectedVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+		rackImage, err := controllerhelpers.GetRackScyllaDBImage(sdc, rack.Name)
+		if err != nil {
+			klog.ErrorS(err, "can't get rack image", "ScyllaDBDatacenter", naming.ObjRef(sdc), "Rack", rack.Name)
+			continue
+		}
+
+		expectedVersion, err := naming.ImageToVersion(rackImage)
 		if err != nil {
-			klog.ErrorS(err, "can't get version from image", "Image", sdc.Spec.ScyllaDB.Image)
+			klog.ErrorS(err, "can't get version from image", "Image", rackImage)
 			continue
 		}
 
@@ -40,6 +40,9 @@ const (
 	// Contrary to what it should be, this needs to be quite high.
 	// FIXME: https://github.com/scylladb/scylla-operator/issues/2686
 	maxSyncDuration = 2 * time.Minute
+
+	// restoreTaskStatusPollInterval specifies how often the status of unfinished restore tasks is refreshed from ScyllaDB Manager state.
+	restoreTaskStatusPollInterval = 30 * time.Second
 )
 
 var (
@@ -53,6 +56,7 @@ type Controller struct {
 
 	scyllaDBManagerTaskLister                scyllav1alpha1listers.ScyllaDBManagerTaskLister
 	scyllaDBManagerClusterRegistrationLister scyllav1alpha1listers.ScyllaDBManagerClusterRegistrationLister
+	scyllaDBClusterLister                    scyllav1alpha1listers.ScyllaDBClusterLister
 
 	cachesToSync []cache.InformerSynced
 
@@ -67,6 +71,7 @@ func NewController(
 	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
 	scyllaDBManagerTaskInformer scyllav1alpha1informers.ScyllaDBManagerTaskInformer,
 	scylladbManagerClusterRegistrationInformer scyllav1alpha1informers.ScyllaDBManagerClusterRegistrationInformer,
+	scyllaDBClusterInformer scyllav1alpha1informers.ScyllaDBClusterInformer,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
@@ -78,10 +83,12 @@ func NewController(
 
 		scyllaDBManagerTaskLister:                scyllaDBManagerTaskInformer.Lister(),
 		scyllaDBManagerClusterRegistrationLister: scylladbManagerClusterRegistrationInformer.Lister(),
+		scyllaDBClusterLister:                    scyllaDBClusterInformer.Lister(),
 
 		cachesToSync: []cache.InformerSynced{
 			scyllaDBManagerTaskInformer.Informer().HasSynced,
 			scylladbManagerClusterRegistrationInformer.Informer().HasSynced,
+			scyllaDBClusterInformer.Informer().HasSynced,
 		},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbmanagertask-controller"}),
@@ -125,6 +132,12 @@ func NewController(
 		DeleteFunc: smtc.deleteScyllaDBManagerClusterRegistration,
 	})
 
+	scyllaDBClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    smtc.addScyllaDBCluster,
+		UpdateFunc: smtc.updateScyllaDBCluster,
+		DeleteFunc: smtc.deleteScyllaDBCluster,
+	})
+
 	return smtc, nil
 }
 
@@ -257,3 +270,34 @@ func (smtc *Controller) enqueueThroughScyllaDBManagerClusterRegistration(smcr *s
 		return smcr.Name == smcrName
 	}))
 }
+
+func (smtc *Controller) addScyllaDBCluster(obj interface{}) {
+	smtc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBCluster),
+		smtc.enqueueThroughScyllaDBCluster,
+	)
+}
+
+func (smtc *Controller) updateScyllaDBCluster(old, cur interface{}) {
+	smtc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBCluster),
+		cur.(*scyllav1alpha1.ScyllaDBCluster),
+		smtc.enqueueThroughScyllaDBCluster,
+		smtc.deleteScyllaDBCluster,
+	)
+}
+
+func (smtc *Controller) deleteScyllaDBCluster(obj interface{}) {
+	smtc.handlers.HandleDelete(
+		obj,
+		smtc.enqueueThroughScyllaDBCluster,
+	)
+}
+
+func (smtc *Controller) enqueueThroughScyllaDBCluster(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sc := obj.(*scyllav1alpha1.ScyllaDBCluster)
+
+	smtc.handlers.EnqueueAllFunc(smtc.handlers.EnqueueWithFilterFunc(func(smt *scyllav1alpha1.ScyllaDBManagerTask) bool {
+		return smt.Spec.ScyllaDBClusterRef.Kind == scyllav1alpha1.ScyllaDBClusterGVK.Kind && smt.Spec.ScyllaDBClusterRef.Name == sc.Name
+	}))(depth+1, obj, op)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
@@ -81,6 +82,11 @@ func (smtc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync manager: %w", err))
 	}
 
+	// ScyllaDB Manager doesn't notify about task runs finishing, so the status of restore tasks, which other controllers wait for, has to be polled.
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeRestore && (status.LastRunStatus == nil || *status.LastRunStatus != managerclient.TaskStatusDone) {
+		smtc.queue.AddAfter(key, restoreTaskStatusPollInterval)
+	}
+
 	var aggregationErrs []error
 	progressingCondition, err := controllerhelpers.AggregateStatusConditions(
 		controllerhelpers.FindStatusConditionsWithSuffix(status.Conditions, scyllav1alpha1.ProgressingCondition),
@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/mitchellh/mapstructure"
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
@@ -43,6 +44,40 @@ func (smtc *Controller) syncManager(
 ) ([]metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
 
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeBackup && smt.Spec.Backup != nil && len(smt.Spec.Backup.Datacenters) != 0 {
+		sc, err := smtc.scyllaDBClusterLister.ScyllaDBClusters(smt.Namespace).Get(smt.Spec.ScyllaDBClusterRef.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return progressingConditions, fmt.Errorf("can't get ScyllaDBCluster: %w", err)
+			}
+
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               managerControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: smt.Generation,
+				Reason:             "AwaitingScyllaDBClusterCreation",
+				Message:            fmt.Sprintf("Awaiting creation of ScyllaDBCluster: %q.", naming.ManualRef(smt.Namespace, smt.Spec.ScyllaDBClusterRef.Name)),
+			})
+
+			return progressingConditions, nil
+		}
+
+		unknownDCNames := getUnknownBackupDatacenterNames(smt.Spec.Backup, sc)
+		if len(unknownDCNames) != 0 {
+			// The task can't be fixed by retrying, it will be requeued when either the ScyllaDBManagerTask or the ScyllaDBCluster changes.
+			return progressingConditions, controllertools.NonRetriable(fmt.Errorf("backup options specify datacenters that are not a part of ScyllaDBCluster %q: %s", naming.ObjRef(sc), strings.Join(unknownDCNames, ", ")))
+		}
+
+		dcNamesWithoutLocation, err := getBackupDatacenterNamesWithoutLocation(smt.Spec.Backup, sc)
+		if err != nil {
+			return progressingConditions, controllertools.NonRetriable(fmt.Errorf("can't get backed up datacenters without a location: %w", err))
+		}
+		if len(dcNamesWithoutLocation) != 0 {
+			// The task can't be fixed by retrying, it will be requeued when either the ScyllaDBManagerTask or the ScyllaDBCluster changes.
+			return progressingConditions, controllertools.NonRetriable(fmt.Errorf("backup options don't specify a location for datacenters of ScyllaDBCluster %q: %s", naming.ObjRef(sc), strings.Join(dcNamesWithoutLocation, ", ")))
+		}
+	}
+
 	smcrName, err := naming.ScyllaDBManagerClusterRegistrationNameForScyllaDBManagerTask(smt)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't get ScyllaDBManagerClusterRegistration name: %w", err)
@@ -134,10 +169,14 @@ func (smtc *Controller) syncManager(
 	}
 
 	status.TaskID = &managerTask.ID
+	status.LastRunStatus = &managerTask.Status
 
-	err = smtc.syncScyllaV1TaskStatusAnnotation(ctx, smt, managerTask)
-	if err != nil {
-		return progressingConditions, fmt.Errorf("can't sync scyllav1 task status annotation for ScyllaDBManagerTask %q: %w", naming.ObjRef(smt), err)
+	// Restore tasks have no scyllav1 counterpart.
+	if smt.Spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeRestore {
+		err = smtc.syncScyllaV1TaskStatusAnnotation(ctx, smt, managerTask)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't sync scyllav1 task status annotation for ScyllaDBManagerTask %q: %w", naming.ObjRef(smt), err)
+		}
 	}
 
 	if ownerUIDLabelValue == string(smt.UID) && requiredManagerTask.Labels[naming.ManagedHash] == managerTask.Labels[naming.ManagedHash] {
@@ -377,6 +416,20 @@ func makeScyllaDBManagerClientTaskWithManagedHashFunc(smt *scyllav1alpha1.Scylla
 			return nil, fmt.Errorf("can't make ScyllaDB Manager client repair task properties: %w", err)
 		}
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		managerClientTaskType = managerclient.RestoreTask
+
+		// Restore tasks aren't scheduled, they run once as soon as they are created.
+		managerClientTaskSchedule, err = makeScyllaDBManagerClientSchedule(&scyllav1alpha1.ScyllaDBManagerTaskSchedule{}, scheduleOverrideOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client schedule: %w", err)
+		}
+
+		managerClientTaskProperties, err = makeScyllaDBManagerClientRestoreTaskProperties(smt.Spec.Restore)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client restore task properties: %w", err)
+		}
+
 	default:
 		return nil, fmt.Errorf("unsupported ScyllaDBManagerTaskType: %q", smt.Spec.Type)
 
@@ -504,8 +557,32 @@ func makeScyllaDBManagerClientSchedule(scyllaDBManagerTaskSchedule *scyllav1alph
 }
 
 func makeScyllaDBManagerClientBackupTaskProperties(options *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions) (map[string]any, error) {
+	location := slices.Clone(options.Location)
+	rateLimit := slices.Clone(options.RateLimit)
+	snapshotParallel := slices.Clone(options.SnapshotParallel)
+	uploadParallel := slices.Clone(options.UploadParallel)
+
+	// ScyllaDBCluster datacenter names are used as ScyllaDB datacenter names, so they can be used as the DC prefixes directly.
+	for _, dcOptions := range options.Datacenters {
+		for _, l := range dcOptions.Location {
+			location = append(location, fmt.Sprintf("%s:%s", dcOptions.Name, l))
+		}
+
+		if dcOptions.RateLimit != nil {
+			rateLimit = append(rateLimit, fmt.Sprintf("%s:%d", dcOptions.Name, *dcOptions.RateLimit))
+		}
+
+		if dcOptions.SnapshotParallel != nil {
+			snapshotParallel = append(snapshotParallel, fmt.Sprintf("%s:%d", dcOptions.Name, *dcOptions.SnapshotParallel))
+		}
+
+		if dcOptions.UploadParallel != nil {
+			uploadParallel = append(uploadParallel, fmt.Sprintf("%s:%d", dcOptions.Name, *dcOptions.UploadParallel))
+		}
+	}
+
 	managerClientTaskProperties := map[string]any{
-		"location": options.Location,
+		"location": location,
 	}
 
 	if options.DC != nil {
@@ -516,25 +593,91 @@ func makeScyllaDBManagerClientBackupTaskProperties(options *scyllav1alpha1.Scyll
 		managerClientTaskProperties["keyspace"] = unescapeFilters(options.Keyspace)
 	}
 
-	if options.RateLimit != nil {
-		managerClientTaskProperties["rate_limit"] = options.RateLimit
+	if rateLimit != nil {
+		managerClientTaskProperties["rate_limit"] = rateLimit
 	}
 
 	if options.Retention != nil {
 		managerClientTaskProperties["retention"] = options.Retention
 	}
 
-	if options.SnapshotParallel != nil {
-		managerClientTaskProperties["snapshot_parallel"] = options.SnapshotParallel
+	if snapshotParallel != nil {
+		managerClientTaskProperties["snapshot_parallel"] = snapshotParallel
 	}
 
-	if options.UploadParallel != nil {
-		managerClientTaskProperties["upload_parallel"] = options.UploadParallel
+	if uploadParallel != nil {
+		managerClientTaskProperties["upload_parallel"] = uploadParallel
 	}
 
 	return managerClientTaskProperties, nil
 }
 
+func getUnknownBackupDatacenterNames(options *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions, sc *scyllav1alpha1.ScyllaDBCluster) []string {
+	var unknownDCNames []string
+	for _, dcOptions := range options.Datacenters {
+		isKnown := slices.ContainsFunc(sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == dcOptions.Name
+		})
+		if !isKnown {
+			unknownDCNames = append(unknownDCNames, strconv.Quote(dcOptions.Name))
+		}
+	}
+
+	return unknownDCNames
+}
+
+// getBackupDatacenterNamesWithoutLocation returns the names of ScyllaDBCluster datacenters matched by the backup's datacenter filter
+// which neither have a location in their datacenter options nor are covered by the global locations.
+func getBackupDatacenterNamesWithoutLocation(options *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions, sc *scyllav1alpha1.ScyllaDBCluster) ([]string, error) {
+	// ScyllaDB Manager backs up all datacenters when the filter is empty.
+	dcFilter := []string{"*"}
+	if len(options.DC) != 0 {
+		dcFilter = unescapeFilters(slices.Clone(options.DC))
+	}
+
+	dcInExList, err := inexlist.ParseInExList(dcFilter)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse datacenter filter: %w", err)
+	}
+
+	hasGlobalLocation := false
+	dcsWithLocation := map[string]struct{}{}
+	for _, l := range options.Location {
+		// Locations are in `[<dc>:]<provider>:<name>` format.
+		parts := strings.Split(l, ":")
+		if len(parts) == 3 {
+			dcsWithLocation[parts[0]] = struct{}{}
+		} else {
+			hasGlobalLocation = true
+		}
+	}
+
+	if hasGlobalLocation {
+		return nil, nil
+	}
+
+	for _, dcOptions := range options.Datacenters {
+		if len(dcOptions.Location) != 0 {
+			dcsWithLocation[dcOptions.Name] = struct{}{}
+		}
+	}
+
+	dcNames := make([]string, 0, len(sc.Spec.Datacenters))
+	for _, dc := range sc.Spec.Datacenters {
+		dcNames = append(dcNames, dc.Name)
+	}
+
+	var dcNamesWithoutLocation []string
+	for _, dcName := range dcInExList.Filter(dcNames) {
+		_, ok := dcsWithLocation[dcName]
+		if !ok {
+			dcNamesWithoutLocation = append(dcNamesWithoutLocation, strconv.Quote(dcName))
+		}
+	}
+
+	return dcNamesWithoutLocation, nil
+}
+
 type scyllaDBManagerClientPropertiesOverrideOption func(map[string]any) error
 
 func withIntensityOverride(intensity string) func(map[string]any) error {
@@ -645,6 +788,28 @@ func makeScyllaDBManagerClientRepairTaskProperties(options *scyllav1alpha1.Scyll
 	return managerClientTaskProperties, nil
 }
 
+// makeScyllaDBManagerClientRestoreTaskProperties converts restore task options into ScyllaDB Manager client task properties.
+func makeScyllaDBManagerClientRestoreTaskProperties(options *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions) (map[string]any, error) {
+	managerClientTaskProperties := map[string]any{
+		"location":     slices.Clone(options.Location),
+		"snapshot_tag": options.SnapshotTag,
+	}
+
+	if options.Keyspace != nil {
+		managerClientTaskProperties["keyspace"] = unescapeFilters(options.Keyspace)
+	}
+
+	if options.RestoreSchema != nil {
+		managerClientTaskProperties["restore_schema"] = *options.RestoreSchema
+	}
+
+	if options.RestoreTables != nil {
+		managerClientTaskProperties["restore_tables"] = *options.RestoreTables
+	}
+
+	return managerClientTaskProperties, nil
+}
+
 // unescapeFilters handles escaping bash expansions.
 // '\' can be removed safely as it's not a valid character in the keyspace or table names.
 func unescapeFilters(strs []string) []string {
@@ -663,6 +828,9 @@ func scyllaDBManagerClientTaskType(smt *scyllav1alpha1.ScyllaDBManagerTask) (str
 	case scyllav1alpha1.ScyllaDBManagerTaskTypeRepair:
 		return managerclient.RepairTask, nil
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		return managerclient.RestoreTask, nil
+
 	default:
 		return "", fmt.Errorf("unsupported ScyllaDBManagerTask type: %q", smt.Spec.Type)
 
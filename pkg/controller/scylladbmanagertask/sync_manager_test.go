@@ -219,6 +219,55 @@ func Test_makeRequiredScyllaDBManagerClientTaskWithManagedHashFunc(t *testing.T)
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "backup, sc ref, with datacenter options",
+			smt: func() *scyllav1alpha1.ScyllaDBManagerTask {
+				smt := newBackupScyllaDBManagerTaskWithScyllaDBDatacenterRef()
+
+				smt.Spec.ScyllaDBClusterRef.Kind = scyllav1alpha1.ScyllaDBClusterGVK.Kind
+				smt.Spec.Backup = &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+					RateLimit: []string{"100"},
+					Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+						{
+							Name:             "dc1",
+							Location:         []string{"gcs:test-dc1"},
+							RateLimit:        pointer.Ptr[int64](10),
+							SnapshotParallel: pointer.Ptr[int64](2),
+						},
+						{
+							Name:           "dc2",
+							Location:       []string{"s3:test-dc2", "azure:test-dc2"},
+							UploadParallel: pointer.Ptr[int64](3),
+						},
+					},
+				}
+
+				return smt
+			}(),
+			clusterID:       "cluster-id",
+			managedHashFunc: getMockManagedHash,
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": mockManagedHash,
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "backup",
+				Properties: map[string]any{
+					"location":          []string{"dc1:gcs:test-dc1", "dc2:s3:test-dc2", "dc2:azure:test-dc2"},
+					"rate_limit":        []string{"100", "dc1:10"},
+					"snapshot_parallel": []string{"dc1:2"},
+					"upload_parallel":   []string{"dc2:3"},
+				},
+				Schedule: &managerclient.Schedule{},
+				Tags:     nil,
+				Type:     "backup",
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "backup, sdc ref, with name override annotation",
 			smt: func() *scyllav1alpha1.ScyllaDBManagerTask {
@@ -1188,6 +1237,67 @@ func Test_makeRequiredScyllaDBManagerClientTaskWithManagedHashFunc(t *testing.T)
 			expected:        nil,
 			expectedErr:     fmt.Errorf("can't make ScyllaDB Manager client repair task properties: %w", apimachineryutilerrors.NewAggregate([]error{fmt.Errorf("can't parse small table threshold override: %w", fmt.Errorf("invalid byte size string %q, it must be real number with unit suffix %q", "invalid size", "B,KiB,MiB,GiB,TiB,PiB,EiB"))})),
 		},
+		{
+			name:            "restore schema, sdc ref",
+			smt:             newRestoreScyllaDBManagerTaskWithScyllaDBDatacenterRef(),
+			clusterID:       "cluster-id",
+			managedHashFunc: getMockManagedHash,
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": mockManagedHash,
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "restore",
+				Properties: map[string]any{
+					"location":       []string{"s3:test"},
+					"snapshot_tag":   "sm_20250101000000UTC",
+					"restore_schema": true,
+				},
+				Schedule: &managerclient.Schedule{},
+				Tags:     nil,
+				Type:     "restore",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "restore tables, sdc ref, with keyspace filters",
+			smt: func() *scyllav1alpha1.ScyllaDBManagerTask {
+				smt := newRestoreScyllaDBManagerTaskWithScyllaDBDatacenterRef()
+
+				smt.Spec.Restore.RestoreSchema = nil
+				smt.Spec.Restore.RestoreTables = pointer.Ptr(true)
+				smt.Spec.Restore.Keyspace = []string{"keyspace", "!keyspace.table_prefix_*"}
+
+				return smt
+			}(),
+			clusterID:       "cluster-id",
+			managedHashFunc: getMockManagedHash,
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": mockManagedHash,
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "restore",
+				Properties: map[string]any{
+					"location":       []string{"s3:test"},
+					"snapshot_tag":   "sm_20250101000000UTC",
+					"keyspace":       []string{"keyspace", "!keyspace.table_prefix_*"},
+					"restore_tables": true,
+				},
+				Schedule: &managerclient.Schedule{},
+				Tags:     nil,
+				Type:     "restore",
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range tt {
@@ -1284,6 +1394,218 @@ func newRepairScyllaDBManagerTaskWithScyllaDBDatacenterRef() *scyllav1alpha1.Scy
 	}
 }
 
+func newRestoreScyllaDBManagerTaskWithScyllaDBDatacenterRef() *scyllav1alpha1.ScyllaDBManagerTask {
+	return &scyllav1alpha1.ScyllaDBManagerTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "restore",
+			Namespace: "scylla",
+			UID:       "uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+				Kind: scyllav1alpha1.ScyllaDBDatacenterGVK.Kind,
+				Name: "basic",
+			},
+			Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+			Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				Location:      []string{"s3:test"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				RestoreSchema: pointer.Ptr(true),
+			},
+		},
+	}
+}
+
+func Test_getUnknownBackupDatacenterNames(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func(dcNames ...string) *scyllav1alpha1.ScyllaDBCluster {
+		sc := &scyllav1alpha1.ScyllaDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "scylla",
+			},
+		}
+
+		for _, dcName := range dcNames {
+			sc.Spec.Datacenters = append(sc.Spec.Datacenters, scyllav1alpha1.ScyllaDBClusterDatacenter{
+				Name: dcName,
+			})
+		}
+
+		return sc
+	}
+
+	tt := []struct {
+		name     string
+		options  *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions
+		sc       *scyllav1alpha1.ScyllaDBCluster
+		expected []string
+	}{
+		{
+			name:     "no datacenter options",
+			options:  &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{},
+			sc:       newScyllaDBCluster("dc1"),
+			expected: nil,
+		},
+		{
+			name: "all datacenters are a part of ScyllaDBCluster",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name: "dc1",
+					},
+					{
+						Name: "dc2",
+					},
+				},
+			},
+			sc:       newScyllaDBCluster("dc1", "dc2", "dc3"),
+			expected: nil,
+		},
+		{
+			name: "datacenters missing from ScyllaDBCluster",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name: "dc1",
+					},
+					{
+						Name: "dc2",
+					},
+					{
+						Name: "dc3",
+					},
+				},
+			},
+			sc:       newScyllaDBCluster("dc2"),
+			expected: []string{`"dc1"`, `"dc3"`},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getUnknownBackupDatacenterNames(tc.options, tc.sc)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got unknown datacenter names differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_getBackupDatacenterNamesWithoutLocation(t *testing.T) {
+	t.Parallel()
+
+	sc := &scyllav1alpha1.ScyllaDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{
+					Name: "dc1",
+				},
+				{
+					Name: "dc2",
+				},
+				{
+					Name: "dc3",
+				},
+			},
+		},
+	}
+
+	tt := []struct {
+		name          string
+		options       *scyllav1alpha1.ScyllaDBManagerBackupTaskOptions
+		expected      []string
+		expectedError error
+	}{
+		{
+			name: "global location covers all datacenters",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				Location: []string{"gcs:backups"},
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name:     "dc1",
+						Location: []string{"s3:dc1-backups"},
+					},
+				},
+			},
+			expected:      nil,
+			expectedError: nil,
+		},
+		{
+			name: "every datacenter specifies its location",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				Location: []string{"dc3:gcs:dc3-backups"},
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name:     "dc1",
+						Location: []string{"s3:dc1-backups"},
+					},
+					{
+						Name:     "dc2",
+						Location: []string{"s3:dc2-backups"},
+					},
+				},
+			},
+			expected:      nil,
+			expectedError: nil,
+		},
+		{
+			name: "datacenters without location",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				Location: []string{"dc3:gcs:dc3-backups"},
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name:     "dc1",
+						Location: []string{"s3:dc1-backups"},
+					},
+					{
+						Name:      "dc2",
+						RateLimit: pointer.Ptr[int64](10),
+					},
+				},
+			},
+			expected:      []string{`"dc2"`},
+			expectedError: nil,
+		},
+		{
+			name: "datacenters excluded by the filter don't need location",
+			options: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+				DC: []string{"*", "!dc2", "!dc3"},
+				Datacenters: []scyllav1alpha1.ScyllaDBManagerBackupTaskDatacenterOptions{
+					{
+						Name:     "dc1",
+						Location: []string{"s3:dc1-backups"},
+					},
+				},
+			},
+			expected:      nil,
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getBackupDatacenterNamesWithoutLocation(tc.options, sc)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected and got errors differ: %s", cmp.Diff(tc.expectedError, err))
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got datacenter names differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
 func Test_parseByteCount(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/scylladb/scylla-operator/pkg/scheme"
 	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/sidecar/config"
 	"github.com/scylladb/scylla-operator/pkg/util/hash"
 	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
@@ -59,6 +60,8 @@ type Controller struct {
 	key   string
 
 	hostID hostID
+
+	scyllaYAMLReloader *config.ScyllaYAMLReloader
 }
 
 func NewController(
@@ -67,6 +70,7 @@ func NewController(
 	localhostAddress string,
 	kubeClient kubernetes.Interface,
 	singleServiceInformer corev1informers.ServiceInformer,
+	scyllaYAMLReloader *config.ScyllaYAMLReloader,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
@@ -121,6 +125,8 @@ func NewController(
 			},
 		),
 		key: key,
+
+		scyllaYAMLReloader: scyllaYAMLReloader,
 	}
 
 	singleServiceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
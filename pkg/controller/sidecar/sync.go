@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
@@ -191,6 +192,41 @@ func (c *Controller) syncAnnotations(ctx context.Context, svc *corev1.Service) e
 	return nil
 }
 
+func (c *Controller) syncScyllaDBConfig(ctx context.Context, svc *corev1.Service) error {
+	if c.scyllaYAMLReloader == nil {
+		return nil
+	}
+
+	restartRequiredOptions, err := c.scyllaYAMLReloader.Reload(ctx)
+	if err != nil {
+		return fmt.Errorf("can't reload scylla.yaml: %w", err)
+	}
+
+	svcCopy := svc.DeepCopy()
+	if len(restartRequiredOptions) != 0 {
+		if svcCopy.Annotations == nil {
+			svcCopy.Annotations = map[string]string{}
+		}
+		svcCopy.Annotations[naming.RestartRequiredConfigOptionsAnnotation] = strings.Join(restartRequiredOptions, ",")
+	} else {
+		delete(svcCopy.Annotations, naming.RestartRequiredConfigOptionsAnnotation)
+	}
+
+	if !equality.Semantic.DeepEqual(svc, svcCopy) {
+		_, err = c.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("can't update service %q: %w", naming.ObjRef(svc), err)
+		}
+
+		klog.V(2).InfoS("Updated options requiring a restart", "Service", klog.KObj(svc), "Options", restartRequiredOptions)
+		if len(restartRequiredOptions) != 0 {
+			c.eventRecorder.Eventf(svc, corev1.EventTypeNormal, "RestartRequired", "ScyllaDB node needs to be restarted for config options %s to take effect", strings.Join(restartRequiredOptions, ", "))
+		}
+	}
+
+	return nil
+}
+
 func (c *Controller) sync(ctx context.Context) error {
 	startTime := time.Now()
 	klog.V(4).InfoS("Started syncing Service", "Service", klog.KRef(c.namespace, c.serviceName), "startTime", startTime)
@@ -218,6 +254,11 @@ func (c *Controller) sync(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("can't sync the HostID annotation: %w", err))
 	}
 
+	err = c.syncScyllaDBConfig(ctx, svc)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync ScyllaDB config: %w", err))
+	}
+
 	decommissionValue, hasDecommissionLabel := svc.Labels[naming.DecommissionedLabel]
 	if hasDecommissionLabel && decommissionValue != "true" {
 		err := c.decommissionNode(ctx, svc)
@@ -10,8 +10,10 @@ import (
 	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/bootstrapprogress"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/controllertools"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
@@ -38,6 +40,8 @@ type Controller struct {
 	kubeClient      kubernetes.Interface
 	podLister       corev1listers.PodLister
 	newScyllaClient func() (*scyllaclient.Client, error)
+
+	bootstrapProgressTracker *bootstrapprogress.Tracker
 }
 
 func NewController(
@@ -54,6 +58,8 @@ func NewController(
 		kubeClient:      kubeClient,
 		podLister:       podInformer.Lister(),
 		newScyllaClient: newScyllaClient,
+
+		bootstrapProgressTracker: bootstrapprogress.NewTracker(bootstrapprogress.DefaultStallTimeout, time.Now),
 	}
 
 	observer := controllertools.NewObserver(
@@ -143,9 +149,76 @@ func (c *Controller) getNodeStatusReport(ctx context.Context) *internalapi.NodeS
 		return strings.Compare(a.HostID, b.HostID)
 	})
 
-	return &internalapi.NodeStatusReport{
+	nodeStatusReport := &internalapi.NodeStatusReport{
 		ObservedNodes: observedNodeStatuses,
 	}
+	c.setLocalNodeRuntimeStatus(ctx, scyllaClient, nodeStatuses, nodeStatusReport)
+
+	return nodeStatusReport
+}
+
+// setLocalNodeRuntimeStatus fills in the runtime status of the local node.
+// The runtime status is only informational, so failing to get any part of it doesn't fail the whole report.
+func (c *Controller) setLocalNodeRuntimeStatus(ctx context.Context, scyllaClient *scyllaclient.Client, nodeStatuses scyllaclient.NodeStatusInfoSlice, nodeStatusReport *internalapi.NodeStatusReport) {
+	operationMode, err := scyllaClient.OperationMode(ctx, localhost)
+	if err != nil {
+		klog.ErrorS(err, "Can't get operation mode of the local node")
+	} else {
+		nodeStatusReport.OperationMode = scyllaClientOperationalModeToScyllaV1Alpha1NodeOperationMode(operationMode)
+
+		progress, err := bootstrapprogress.GetProgress(ctx, scyllaClient, localhost)
+		if err != nil {
+			klog.ErrorS(err, "Can't get bootstrap progress of the local node")
+		} else {
+			nodeStatusReport.BootstrapStalled = c.bootstrapProgressTracker.Observe(operationMode, progress)
+		}
+	}
+
+	load, err := scyllaClient.Load(ctx, localhost)
+	if err != nil {
+		klog.ErrorS(err, "Can't get load of the local node")
+	} else {
+		nodeStatusReport.LoadBytes = pointer.Ptr(roundLoad(load))
+	}
+
+	version, err := scyllaClient.ScyllaVersion(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Can't get ScyllaDB version of the local node")
+	} else {
+		nodeStatusReport.Version = version
+	}
+
+	hostID, err := scyllaClient.GetLocalHostId(ctx, localhost, false)
+	if err != nil {
+		klog.ErrorS(err, "Can't get host ID of the local node")
+		return
+	}
+
+	localNodeStatus, _, ok := oslices.Find(nodeStatuses, func(ns scyllaclient.NodeStatusInfo) bool {
+		return ns.HostID == hostID
+	})
+	if !ok {
+		klog.V(4).InfoS("Local node is missing in the node status info, skipping token count", "HostID", hostID)
+		return
+	}
+
+	tokens, err := scyllaClient.GetNodeTokens(ctx, localhost, localNodeStatus.Addr)
+	if err != nil {
+		klog.ErrorS(err, "Can't get tokens of the local node")
+		return
+	}
+	nodeStatusReport.TokenCount = pointer.Ptr(int32(len(tokens)))
+}
+
+// roundLoad rounds the load down to two significant digits.
+// Data grows continuously, so reporting the exact value would make the report, and the Pod annotation, change on every sync.
+func roundLoad(load int64) int64 {
+	scale := int64(1)
+	for load/scale >= 100 {
+		scale *= 10
+	}
+
+	return load / scale * scale
 }
 
 func scyllaClientNodeStatusToScyllaV1Alpha1NodeStatus(status scyllaclient.NodeStatus) scyllav1alpha1.NodeStatus {
@@ -158,3 +231,32 @@ func scyllaClientNodeStatusToScyllaV1Alpha1NodeStatus(status scyllaclient.NodeSt
 
 	}
 }
+
+func scyllaClientOperationalModeToScyllaV1Alpha1NodeOperationMode(mode scyllaclient.OperationalMode) scyllav1alpha1.NodeOperationMode {
+	switch mode {
+	case scyllaclient.OperationalModeJoining:
+		return scyllav1alpha1.NodeOperationModeJoining
+
+	case scyllaclient.OperationalModeNormal:
+		return scyllav1alpha1.NodeOperationModeNormal
+
+	case scyllaclient.OperationalModeLeaving:
+		return scyllav1alpha1.NodeOperationModeLeaving
+
+	case scyllaclient.OperationalModeDecommissioning:
+		return scyllav1alpha1.NodeOperationModeDecommissioning
+
+	case scyllaclient.OperationalModeDecommissioned:
+		return scyllav1alpha1.NodeOperationModeDecommissioned
+
+	case scyllaclient.OperationalModeDraining:
+		return scyllav1alpha1.NodeOperationModeDraining
+
+	case scyllaclient.OperationalModeDrained:
+		return scyllav1alpha1.NodeOperationModeDrained
+
+	default:
+		return scyllav1alpha1.NodeOperationModeUnknown
+
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package statusreport
+
+import (
+	"testing"
+)
+
+func Test_roundLoad(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		load     int64
+		expected int64
+	}{
+		{
+			name:     "zero",
+			load:     0,
+			expected: 0,
+		},
+		{
+			name:     "two digit value is kept",
+			load:     99,
+			expected: 99,
+		},
+		{
+			name:     "value is rounded down to two significant digits",
+			load:     123456789,
+			expected: 120000000,
+		},
+		{
+			name:     "value with two significant digits is kept",
+			load:     1900000,
+			expected: 1900000,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := roundLoad(tc.load)
+			if got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
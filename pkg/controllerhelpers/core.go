@@ -279,6 +279,36 @@ func IsOrphanedPV(pv *corev1.PersistentVolume, nodes []*corev1.Node) (bool, erro
 	return true, nil
 }
 
+// FindNodeForPV returns the node the PV is bound to by its node affinity, or nil if there is no such node.
+func FindNodeForPV(pv *corev1.PersistentVolume, nodes []*corev1.Node) (*corev1.Node, error) {
+	if pv.Spec.NodeAffinity == nil {
+		return nil, nil
+	}
+
+	for _, node := range nodes {
+		match, err := corev1schedulinghelpers.MatchNodeSelectorTerms(node, pv.Spec.NodeAffinity.Required)
+		if err != nil {
+			return nil, err
+		}
+
+		if match {
+			return node, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func FindNodeCondition(conditions []corev1.NodeCondition, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
 func FindContainerStatus(pod *corev1.Pod, containerName string) *corev1.ContainerStatus {
 	for _, statusSet := range [][]corev1.ContainerStatus{
 		pod.Status.InitContainerStatuses,
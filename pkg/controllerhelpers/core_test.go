@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -544,3 +545,86 @@ func TestJoinWithLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestFindNodeForPV(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					corev1.LabelHostname: name,
+				},
+			},
+		}
+	}
+
+	newPV := func(hostname string) *corev1.PersistentVolume {
+		return &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pv",
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				NodeAffinity: &corev1.VolumeNodeAffinity{
+					Required: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      corev1.LabelHostname,
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{hostname},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name         string
+		pv           *corev1.PersistentVolume
+		nodes        []*corev1.Node
+		expectedNode *corev1.Node
+	}{
+		{
+			name: "PV without node affinity",
+			pv: &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv",
+				},
+			},
+			nodes:        []*corev1.Node{newNode("node-1")},
+			expectedNode: nil,
+		},
+		{
+			name:         "PV bound to an existing node",
+			pv:           newPV("node-2"),
+			nodes:        []*corev1.Node{newNode("node-1"), newNode("node-2")},
+			expectedNode: newNode("node-2"),
+		},
+		{
+			name:         "PV bound to a missing node",
+			pv:           newPV("node-3"),
+			nodes:        []*corev1.Node{newNode("node-1"), newNode("node-2")},
+			expectedNode: nil,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FindNodeForPV(tc.pv, tc.nodes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !apiequality.Semantic.DeepEqual(got, tc.expectedNode) {
+				t.Errorf("expected and got differ: %s", cmp.Diff(tc.expectedNode, got))
+			}
+		})
+	}
+}
@@ -143,15 +143,53 @@ func GetScyllaBroadcastAddress(broadcastAddressType scyllav1alpha1.BroadcastAddr
 		}
 		return pod.Status.PodIP, nil
 
+	case scyllav1alpha1.BroadcastAddressTypeServiceNodePort:
+		if preferredIPFamily != nil {
+			for _, hostIP := range pod.Status.HostIPs {
+				ip, err := helpers.ParseIP(hostIP.IP)
+				if err != nil {
+					continue
+				}
+				if helpers.GetIPFamily(ip) == *preferredIPFamily {
+					return ip.String(), nil
+				}
+			}
+		}
+
+		if len(pod.Status.HostIP) == 0 {
+			return "", fmt.Errorf("pod %q does not have a HostIP address", naming.ObjRef(pod))
+		}
+
+		if ip, err := helpers.ParseIP(pod.Status.HostIP); err == nil && helpers.IsIPv6(ip) {
+			return ip.String(), nil
+		}
+		return pod.Status.HostIP, nil
+
 	default:
 		return "", fmt.Errorf("unsupported broadcast address type: %q", broadcastAddressType)
 	}
 }
 
 func GetRequiredScyllaHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, podLister corev1listers.PodLister) ([]string, error) {
+	return getRequiredScyllaHostsForRacks(sdc, sdc.Spec.Racks, services, podLister)
+}
+
+// GetRequiredScyllaRackHosts returns the hosts of all nodes of the rack.
+func GetRequiredScyllaRackHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string, services map[string]*corev1.Service, podLister corev1listers.PodLister) ([]string, error) {
+	rackSpec, _, ok := oslices.Find(sdc.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+		return spec.Name == rackName
+	})
+	if !ok {
+		return nil, fmt.Errorf("can't find rack %q in rack spec of ScyllaDBDatacenter %q", rackName, naming.ObjRef(sdc))
+	}
+
+	return getRequiredScyllaHostsForRacks(sdc, []scyllav1alpha1.RackSpec{rackSpec}, services, podLister)
+}
+
+func getRequiredScyllaHostsForRacks(sdc *scyllav1alpha1.ScyllaDBDatacenter, racks []scyllav1alpha1.RackSpec, services map[string]*corev1.Service, podLister corev1listers.PodLister) ([]string, error) {
 	var hosts []string
 	var errs []error
-	for _, rack := range sdc.Spec.Racks {
+	for _, rack := range racks {
 		rackNodeCount, err := GetRackNodeCount(sdc, rack.Name)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err))
@@ -367,6 +405,27 @@ func GetRackNodeCount(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string) (
 	return pointer.Ptr[int32](0), nil
 }
 
+// GetRackScyllaDBImage returns the ScyllaDB image used by the rack.
+// Rack level image takes precedence over the rack template and datacenter level ones.
+func GetRackScyllaDBImage(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string) (string, error) {
+	rackSpec, _, ok := oslices.Find(sdc.Spec.Racks, func(spec scyllav1alpha1.RackSpec) bool {
+		return spec.Name == rackName
+	})
+	if !ok {
+		return "", fmt.Errorf("can't find rack %q in rack spec of ScyllaDBDatacenter %q", rackName, naming.ObjRef(sdc))
+	}
+
+	if rackSpec.ScyllaDB != nil && rackSpec.ScyllaDB.Image != nil {
+		return *rackSpec.ScyllaDB.Image, nil
+	}
+
+	if sdc.Spec.RackTemplate != nil && sdc.Spec.RackTemplate.ScyllaDB != nil && sdc.Spec.RackTemplate.ScyllaDB.Image != nil {
+		return *sdc.Spec.RackTemplate.ScyllaDB.Image, nil
+	}
+
+	return sdc.Spec.ScyllaDB.Image, nil
+}
+
 func IsScyllaDBDatacenterRolledOut(sdc *scyllav1alpha1.ScyllaDBDatacenter) (bool, error) {
 	if !helpers.IsStatusConditionPresentAndTrue(sdc.Status.Conditions, scyllav1alpha1.AvailableCondition, sdc.Generation) {
 		return false, nil
@@ -424,3 +483,84 @@ func GetScyllaDBClusterDatacenterNodeCount(sc *scyllav1alpha1.ScyllaDBCluster, d
 
 	return nodes
 }
+
+// MergePodTemplates merges the Pod templates, with the later templates taking precedence.
+// List items are merged by their keys, so that a lower level can override an item of an upper level
+// instead of producing a duplicate: containers and container overrides by name, and host aliases by IP.
+func MergePodTemplates(podTemplates ...*scyllav1alpha1.PodTemplate) *scyllav1alpha1.PodTemplate {
+	var merged *scyllav1alpha1.PodTemplate
+	for _, pt := range podTemplates {
+		if pt == nil {
+			continue
+		}
+
+		if merged == nil {
+			merged = &scyllav1alpha1.PodTemplate{}
+		}
+
+		if pt.SecurityContext != nil {
+			merged.SecurityContext = pt.SecurityContext.DeepCopy()
+		}
+
+		for _, ha := range pt.HostAliases {
+			merged.HostAliases = setItem(merged.HostAliases, *ha.DeepCopy(), func(existing corev1.HostAlias) bool {
+				return existing.IP == ha.IP
+			})
+		}
+
+		for _, co := range pt.ContainerOverrides {
+			_, idx, ok := oslices.Find(merged.ContainerOverrides, func(existing scyllav1alpha1.ContainerOverride) bool {
+				return existing.Name == co.Name
+			})
+			if !ok {
+				merged.ContainerOverrides = append(merged.ContainerOverrides, *co.DeepCopy())
+				continue
+			}
+
+			mergeContainerOverride(&merged.ContainerOverrides[idx], &co)
+		}
+
+		for _, c := range pt.SidecarContainers {
+			merged.SidecarContainers = setItem(merged.SidecarContainers, *c.DeepCopy(), func(existing scyllav1alpha1.SidecarContainer) bool {
+				return existing.Name == c.Name
+			})
+		}
+	}
+
+	return merged
+}
+
+func mergeContainerOverride(dst *scyllav1alpha1.ContainerOverride, src *scyllav1alpha1.ContainerOverride) {
+	if src.SecurityContext != nil {
+		dst.SecurityContext = src.SecurityContext.DeepCopy()
+	}
+
+	for _, env := range src.Env {
+		dst.Env = setItem(dst.Env, *env.DeepCopy(), func(existing corev1.EnvVar) bool {
+			return existing.Name == env.Name
+		})
+	}
+
+	if src.Lifecycle != nil {
+		if dst.Lifecycle == nil {
+			dst.Lifecycle = &corev1.Lifecycle{}
+		}
+		if src.Lifecycle.PostStart != nil {
+			dst.Lifecycle.PostStart = src.Lifecycle.PostStart.DeepCopy()
+		}
+		if src.Lifecycle.PreStop != nil {
+			dst.Lifecycle.PreStop = src.Lifecycle.PreStop.DeepCopy()
+		}
+	}
+}
+
+// setItem replaces the first item matching the predicate, or appends the item when there is no match.
+func setItem[T any](items []T, item T, matches func(T) bool) []T {
+	idx := slices.IndexFunc(items, matches)
+	if idx < 0 {
+		return append(items, item)
+	}
+
+	items[idx] = item
+	return items
+}
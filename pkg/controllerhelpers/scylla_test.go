@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
@@ -604,6 +605,45 @@ func TestGetScyllaNodeBroadcastAddress(t *testing.T) {
 			expected:        "10.0.0.1",
 			expectedError:   nil,
 		},
+		{
+			name:                     "ServiceNodePort broadcast address type",
+			nodeBroadcastAddressType: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+			pod: func() *corev1.Pod {
+				pod := pod.DeepCopy()
+				pod.Status.HostIP = "192.168.0.1"
+				return pod
+			}(),
+			svc:             svc,
+			preferredFamily: nil, // Auto-detect
+			expected:        "192.168.0.1",
+			expectedError:   nil,
+		},
+		{
+			name:                     "ServiceNodePort with explicit IPv6 preference",
+			nodeBroadcastAddressType: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+			pod: func() *corev1.Pod {
+				pod := pod.DeepCopy()
+				pod.Status.HostIP = "192.168.0.1"
+				pod.Status.HostIPs = []corev1.HostIP{
+					{IP: "192.168.0.1"},
+					{IP: "2001:db8::2"},
+				}
+				return pod
+			}(),
+			svc:             svc,
+			preferredFamily: pointer.Ptr(corev1.IPv6Protocol),
+			expected:        "2001:db8::2",
+			expectedError:   nil,
+		},
+		{
+			name:                     "error for ServiceNodePort broadcast address type and empty HostIP",
+			nodeBroadcastAddressType: scyllav1alpha1.BroadcastAddressTypeServiceNodePort,
+			pod:                      pod,
+			svc:                      svc,
+			preferredFamily:          nil, // Auto-detect
+			expected:                 "",
+			expectedError:            fmt.Errorf(`pod "simple-cluster-us-east1-us-east1-b-0" does not have a HostIP address`),
+		},
 	}
 
 	for _, tc := range tt {
@@ -1203,6 +1243,99 @@ func TestGetNodeCount(t *testing.T) {
 	}
 }
 
+func TestGetRackScyllaDBImage(t *testing.T) {
+	t.Parallel()
+
+	newSDC := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "default",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: "scylladb/scylla:2025.1.0",
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name          string
+		sdc           *scyllav1alpha1.ScyllaDBDatacenter
+		rackName      string
+		expectedImage string
+		expectedErr   error
+	}{
+		{
+			name:          "datacenter image is used when there are no overrides",
+			sdc:           newSDC(),
+			rackName:      "a",
+			expectedImage: "scylladb/scylla:2025.1.0",
+			expectedErr:   nil,
+		},
+		{
+			name: "rack template image overrides datacenter image",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newSDC()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Image: pointer.Ptr("scylladb/scylla:2025.1.1"),
+					},
+				}
+				return sdc
+			}(),
+			rackName:      "a",
+			expectedImage: "scylladb/scylla:2025.1.1",
+			expectedErr:   nil,
+		},
+		{
+			name: "rack image overrides rack template image",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newSDC()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Image: pointer.Ptr("scylladb/scylla:2025.1.1"),
+					},
+				}
+				sdc.Spec.Racks[0].ScyllaDB = &scyllav1alpha1.ScyllaDBTemplate{
+					Image: pointer.Ptr("scylladb/scylla:2025.1.2"),
+				}
+				return sdc
+			}(),
+			rackName:      "a",
+			expectedImage: "scylladb/scylla:2025.1.2",
+			expectedErr:   nil,
+		},
+		{
+			name:          "missing rack",
+			sdc:           newSDC(),
+			rackName:      "b",
+			expectedImage: "",
+			expectedErr:   fmt.Errorf(`can't find rack "b" in rack spec of ScyllaDBDatacenter "default/basic"`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			image, err := GetRackScyllaDBImage(tc.sdc, tc.rackName)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if image != tc.expectedImage {
+				t.Errorf("expected image %q, got %q", tc.expectedImage, image)
+			}
+		})
+	}
+}
+
 func TestIsScyllaPod(t *testing.T) {
 	t.Parallel()
 
@@ -1245,3 +1378,189 @@ func TestIsScyllaPod(t *testing.T) {
 		})
 	}
 }
+
+func TestMergePodTemplates(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		podTemplates []*scyllav1alpha1.PodTemplate
+		expected     *scyllav1alpha1.PodTemplate
+	}{
+		{
+			name:         "no templates",
+			podTemplates: []*scyllav1alpha1.PodTemplate{nil, nil},
+			expected:     nil,
+		},
+		{
+			name: "single template is copied",
+			podTemplates: []*scyllav1alpha1.PodTemplate{
+				nil,
+				{
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "sidecar",
+							Image: "sidecar:1",
+						},
+					},
+				},
+			},
+			expected: &scyllav1alpha1.PodTemplate{
+				SidecarContainers: []scyllav1alpha1.SidecarContainer{
+					{
+						Name:  "sidecar",
+						Image: "sidecar:1",
+					},
+				},
+			},
+		},
+		{
+			name: "items with the same keys are overridden by later templates",
+			podTemplates: []*scyllav1alpha1.PodTemplate{
+				{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Ptr(int64(1000)),
+					},
+					HostAliases: []corev1.HostAlias{
+						{
+							IP:        "10.0.0.1",
+							Hostnames: []string{"foo"},
+						},
+						{
+							IP:        "10.0.0.2",
+							Hostnames: []string{"bar"},
+						},
+					},
+					ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+						{
+							Name: "scylla",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "FOO",
+									Value: "dc",
+								},
+								{
+									Name:  "BAR",
+									Value: "dc",
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								RunAsUser: pointer.Ptr(int64(1000)),
+							},
+						},
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "sidecar",
+							Image: "sidecar:1",
+						},
+						{
+							Name:  "other",
+							Image: "other:1",
+						},
+					},
+				},
+				{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Ptr(int64(2000)),
+					},
+					HostAliases: []corev1.HostAlias{
+						{
+							IP:        "10.0.0.1",
+							Hostnames: []string{"baz"},
+						},
+					},
+					ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+						{
+							Name: "scylla",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "FOO",
+									Value: "rack",
+								},
+							},
+						},
+						{
+							Name: "scylladb-api-status-probe",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "FOO",
+									Value: "rack",
+								},
+							},
+						},
+					},
+					SidecarContainers: []scyllav1alpha1.SidecarContainer{
+						{
+							Name:  "sidecar",
+							Image: "sidecar:2",
+						},
+					},
+				},
+			},
+			expected: &scyllav1alpha1.PodTemplate{
+				SecurityContext: &corev1.PodSecurityContext{
+					FSGroup: pointer.Ptr(int64(2000)),
+				},
+				HostAliases: []corev1.HostAlias{
+					{
+						IP:        "10.0.0.1",
+						Hostnames: []string{"baz"},
+					},
+					{
+						IP:        "10.0.0.2",
+						Hostnames: []string{"bar"},
+					},
+				},
+				ContainerOverrides: []scyllav1alpha1.ContainerOverride{
+					{
+						Name: "scylla",
+						Env: []corev1.EnvVar{
+							{
+								Name:  "FOO",
+								Value: "rack",
+							},
+							{
+								Name:  "BAR",
+								Value: "dc",
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							RunAsUser: pointer.Ptr(int64(1000)),
+						},
+					},
+					{
+						Name: "scylladb-api-status-probe",
+						Env: []corev1.EnvVar{
+							{
+								Name:  "FOO",
+								Value: "rack",
+							},
+						},
+					},
+				},
+				SidecarContainers: []scyllav1alpha1.SidecarContainer{
+					{
+						Name:  "sidecar",
+						Image: "sidecar:2",
+					},
+					{
+						Name:  "other",
+						Image: "other:1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MergePodTemplates(tc.podTemplates...)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got pod templates differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package disks
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DeviceHealth describes the health of a block device as reported by the kernel in sysfs.
+type DeviceHealth struct {
+	// Problems holds problems that make the device unusable.
+	Problems []string
+
+	// IOErrorCounts holds cumulative I/O error counts keyed by the names of the underlying block devices.
+	// The counts are never reset while the device is present, so it's up to the caller to judge them.
+	IOErrorCounts map[string]uint64
+}
+
+// GetDeviceHealth returns the health of a block device reported by the kernel in sysfs.
+// For RAID devices, the array state and all member devices are checked.
+// Partitions are checked through the devices they belong to.
+func GetDeviceHealth(sysfsPath, device string) (*DeviceHealth, error) {
+	realDevice, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return nil, fmt.Errorf("can't evaluate device %q symlink: %w", device, err)
+	}
+
+	deviceSysfsPath, err := getBlockDeviceSysfsPath(sysfsPath, path.Base(realDevice))
+	if err != nil {
+		return nil, fmt.Errorf("can't get sysfs path of device %q: %w", realDevice, err)
+	}
+	deviceName := path.Base(deviceSysfsPath)
+
+	health := &DeviceHealth{
+		IOErrorCounts: map[string]uint64{},
+	}
+
+	mdPath := path.Join(deviceSysfsPath, "md")
+	_, err = os.Stat(mdPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("can't stat %q: %w", mdPath, err)
+	}
+	if err == nil {
+		mdProblems, err := getRAIDHealthProblems(deviceName, mdPath)
+		if err != nil {
+			return nil, fmt.Errorf("can't get health problems of RAID device %q: %w", deviceName, err)
+		}
+		health.Problems = append(health.Problems, mdProblems...)
+
+		slavePaths, err := filepath.Glob(path.Join(deviceSysfsPath, "slaves", "*"))
+		if err != nil {
+			return nil, fmt.Errorf("can't determine slaves of raid array: %w", err)
+		}
+
+		for _, slavePath := range slavePaths {
+			slaveSysfsPath, err := getBlockDeviceSysfsPath(sysfsPath, path.Base(slavePath))
+			if err != nil {
+				return nil, fmt.Errorf("can't get sysfs path of device %q: %w", path.Base(slavePath), err)
+			}
+
+			err = getBlockDeviceHealth(health, path.Base(slaveSysfsPath), slaveSysfsPath)
+			if err != nil {
+				return nil, fmt.Errorf("can't get health of device %q: %w", path.Base(slaveSysfsPath), err)
+			}
+		}
+
+		return health, nil
+	}
+
+	err = getBlockDeviceHealth(health, deviceName, deviceSysfsPath)
+	if err != nil {
+		return nil, fmt.Errorf("can't get health of device %q: %w", deviceName, err)
+	}
+
+	return health, nil
+}
+
+func getRAIDHealthProblems(deviceName, mdPath string) ([]string, error) {
+	var problems []string
+
+	arrayState, ok, err := readOptionalSysfsValue(path.Join(mdPath, "array_state"))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		switch arrayState {
+		case "inactive", "broken", "clear", "suspended":
+			problems = append(problems, fmt.Sprintf("RAID array %q is in %q state", deviceName, arrayState))
+		}
+	}
+
+	degradedRaw, ok, err := readOptionalSysfsValue(path.Join(mdPath, "degraded"))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		degraded, err := strconv.Atoi(degradedRaw)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse degraded devices count %q: %w", degradedRaw, err)
+		}
+		if degraded > 0 {
+			problems = append(problems, fmt.Sprintf("RAID array %q is degraded with %d missing device(s)", deviceName, degraded))
+		}
+	}
+
+	return problems, nil
+}
+
+// getBlockDeviceSysfsPath returns the sysfs directory of the block device.
+// Partitions don't report the state of the underlying hardware, so the directory of the device they belong to is returned for them.
+func getBlockDeviceSysfsPath(sysfsPath, deviceName string) (string, error) {
+	deviceSysfsPath, err := filepath.EvalSymlinks(path.Join(sysfsPath, "class", "block", deviceName))
+	if err != nil {
+		return "", fmt.Errorf("can't evaluate device %q sysfs symlink: %w", deviceName, err)
+	}
+
+	partitionPath := path.Join(deviceSysfsPath, "partition")
+	_, err = os.Stat(partitionPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return deviceSysfsPath, nil
+		}
+		return "", fmt.Errorf("can't stat %q: %w", partitionPath, err)
+	}
+
+	return path.Dir(deviceSysfsPath), nil
+}
+
+func getBlockDeviceHealth(health *DeviceHealth, deviceName, deviceSysfsPath string) error {
+	state, ok, err := readOptionalSysfsValue(path.Join(deviceSysfsPath, "device", "state"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		switch state {
+		case "running", "live":
+		default:
+			health.Problems = append(health.Problems, fmt.Sprintf("device %q is in %q state", deviceName, state))
+		}
+	}
+
+	ioErrCountRaw, ok, err := readOptionalSysfsValue(path.Join(deviceSysfsPath, "device", "ioerr_cnt"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		ioErrCount, err := strconv.ParseUint(strings.TrimPrefix(ioErrCountRaw, "0x"), 16, 64)
+		if err != nil {
+			return fmt.Errorf("can't parse I/O error count %q: %w", ioErrCountRaw, err)
+		}
+		health.IOErrorCounts[deviceName] = ioErrCount
+	}
+
+	return nil
+}
+
+func readOptionalSysfsValue(filePath string) (string, bool, error) {
+	raw, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("can't read %q: %w", filePath, err)
+	}
+
+	return strings.TrimSpace(string(raw)), true, nil
+}
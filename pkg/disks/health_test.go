@@ -0,0 +1,195 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package disks
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetDeviceHealth(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, filePath, content string) {
+		t.Helper()
+
+		err := os.MkdirAll(path.Dir(filePath), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(filePath, []byte(content), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// addBlockDevice creates the sysfs directory of a block device, linked from the block class like in the real sysfs.
+	addBlockDevice := func(t *testing.T, sysfsPath, devicePath string) {
+		t.Helper()
+
+		err := os.MkdirAll(path.Join(sysfsPath, devicePath), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.MkdirAll(path.Join(sysfsPath, "class/block"), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.Symlink(path.Join("../..", devicePath), path.Join(sysfsPath, "class/block", path.Base(devicePath)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tt := []struct {
+		name           string
+		deviceName     string
+		makeSysfs      func(t *testing.T, sysfsPath string)
+		expectedHealth *DeviceHealth
+		expectedErr    bool
+	}{
+		{
+			name:       "healthy device",
+			deviceName: "nvme0n1",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/nvme0/block/nvme0n1")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme0/block/nvme0n1/device/state"), "live\n")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme0/block/nvme0n1/device/ioerr_cnt"), "0x0\n")
+			},
+			expectedHealth: &DeviceHealth{
+				IOErrorCounts: map[string]uint64{
+					"nvme0n1": 0,
+				},
+			},
+		},
+		{
+			name:       "device without state files",
+			deviceName: "nvme0n1",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/nvme0/block/nvme0n1")
+			},
+			expectedHealth: &DeviceHealth{
+				IOErrorCounts: map[string]uint64{},
+			},
+		},
+		{
+			name:       "offline device with I/O errors",
+			deviceName: "sdb",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/host0/block/sdb")
+				writeFile(t, path.Join(sysfsPath, "devices/host0/block/sdb/device/state"), "offline\n")
+				writeFile(t, path.Join(sysfsPath, "devices/host0/block/sdb/device/ioerr_cnt"), "0x1a\n")
+			},
+			expectedHealth: &DeviceHealth{
+				Problems: []string{
+					`device "sdb" is in "offline" state`,
+				},
+				IOErrorCounts: map[string]uint64{
+					"sdb": 26,
+				},
+			},
+		},
+		{
+			name:       "partition is checked through its parent device",
+			deviceName: "sdb1",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/host0/block/sdb")
+				addBlockDevice(t, sysfsPath, "devices/host0/block/sdb/sdb1")
+				writeFile(t, path.Join(sysfsPath, "devices/host0/block/sdb/sdb1/partition"), "1\n")
+				writeFile(t, path.Join(sysfsPath, "devices/host0/block/sdb/device/state"), "offline\n")
+				writeFile(t, path.Join(sysfsPath, "devices/host0/block/sdb/device/ioerr_cnt"), "0x2\n")
+			},
+			expectedHealth: &DeviceHealth{
+				Problems: []string{
+					`device "sdb" is in "offline" state`,
+				},
+				IOErrorCounts: map[string]uint64{
+					"sdb": 2,
+				},
+			},
+		},
+		{
+			name:       "device missing in sysfs fails",
+			deviceName: "sdb",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/host0/block/sda")
+			},
+			expectedHealth: nil,
+			expectedErr:    true,
+		},
+		{
+			name:       "healthy RAID array",
+			deviceName: "md0",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/virtual/block/md0")
+				addBlockDevice(t, sysfsPath, "devices/nvme0/block/nvme0n1")
+				addBlockDevice(t, sysfsPath, "devices/nvme1/block/nvme1n1")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/md/array_state"), "clean\n")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/md/degraded"), "0\n")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/slaves/nvme0n1"), "")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/slaves/nvme1n1"), "")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme0/block/nvme0n1/device/state"), "live\n")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme1/block/nvme1n1/device/state"), "live\n")
+			},
+			expectedHealth: &DeviceHealth{
+				IOErrorCounts: map[string]uint64{},
+			},
+		},
+		{
+			name:       "degraded RAID array with a failed member",
+			deviceName: "md0",
+			makeSysfs: func(t *testing.T, sysfsPath string) {
+				addBlockDevice(t, sysfsPath, "devices/virtual/block/md0")
+				addBlockDevice(t, sysfsPath, "devices/nvme0/block/nvme0n1")
+				addBlockDevice(t, sysfsPath, "devices/nvme1/block/nvme1n1")
+				addBlockDevice(t, sysfsPath, "devices/nvme1/block/nvme1n1/nvme1n1p1")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/md/array_state"), "broken\n")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/md/degraded"), "1\n")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/slaves/nvme0n1"), "")
+				writeFile(t, path.Join(sysfsPath, "devices/virtual/block/md0/slaves/nvme1n1p1"), "")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme0/block/nvme0n1/device/state"), "dead\n")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme1/block/nvme1n1/nvme1n1p1/partition"), "1\n")
+				writeFile(t, path.Join(sysfsPath, "devices/nvme1/block/nvme1n1/device/state"), "dead\n")
+			},
+			expectedHealth: &DeviceHealth{
+				Problems: []string{
+					`RAID array "md0" is in "broken" state`,
+					`RAID array "md0" is degraded with 1 missing device(s)`,
+					`device "nvme0n1" is in "dead" state`,
+					`device "nvme1n1" is in "dead" state`,
+				},
+				IOErrorCounts: map[string]uint64{},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+
+			devicePath := path.Join(tempDir, "dev", tc.deviceName)
+			writeFile(t, devicePath, "")
+
+			sysfsPath := path.Join(tempDir, "sys")
+			tc.makeSysfs(t, sysfsPath)
+
+			health, err := GetDeviceHealth(sysfsPath, devicePath)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(health, tc.expectedHealth) {
+				t.Errorf("expected and got health differ:\n%s", cmp.Diff(tc.expectedHealth, health))
+			}
+		})
+	}
+}
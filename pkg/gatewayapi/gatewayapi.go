@@ -0,0 +1,218 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	GroupName = "gateway.networking.k8s.io"
+)
+
+var (
+	GroupVersion         = schema.GroupVersion{Group: GroupName, Version: "v1"}
+	GroupVersionV1Alpha2 = schema.GroupVersion{Group: GroupName, Version: "v1alpha2"}
+
+	GatewayGVR          = GroupVersion.WithResource("gateways")
+	GatewayGVK          = GroupVersion.WithKind("Gateway")
+	HTTPRouteGVR        = GroupVersion.WithResource("httproutes")
+	HTTPRouteGVK        = GroupVersion.WithKind("HTTPRoute")
+	BackendTLSPolicyGVR = GroupVersion.WithResource("backendtlspolicies")
+	BackendTLSPolicyGVK = GroupVersion.WithKind("BackendTLSPolicy")
+	TLSRouteGVR         = GroupVersionV1Alpha2.WithResource("tlsroutes")
+	TLSRouteGVK         = GroupVersionV1Alpha2.WithKind("TLSRoute")
+)
+
+func isResourceAvailable(discoveryClient discovery.DiscoveryInterface, gvr schema.GroupVersionResource) (bool, error) {
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("can't discover API group version %q: %w", gvr.GroupVersion(), err)
+	}
+
+	for _, resource := range resourceList.APIResources {
+		if resource.Name == gvr.Resource {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ResourceInformer is a shared informer for a single Gateway API resource, backed by a dynamic client.
+// It is only started once the cluster serves the resource.
+type ResourceInformer struct {
+	gvr      schema.GroupVersionResource
+	informer cache.SharedIndexInformer
+	started  atomic.Bool
+}
+
+func NewResourceInformer(client dynamic.Interface, gvr schema.GroupVersionResource, resyncPeriod time.Duration) *ResourceInformer {
+	resourceClient := client.Resource(gvr)
+
+	return &ResourceInformer{
+		gvr: gvr,
+		informer: cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+					return resourceClient.List(ctx, options)
+				},
+				WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+					return resourceClient.Watch(ctx, options)
+				},
+			},
+			&unstructured.Unstructured{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		),
+	}
+}
+
+func (ri *ResourceInformer) Informer() cache.SharedIndexInformer {
+	return ri.informer
+}
+
+// HasSynced returns whether the informer was started and has synced.
+func (ri *ResourceInformer) HasSynced() bool {
+	return ri.started.Load() && ri.informer.HasSynced()
+}
+
+func (ri *ResourceInformer) Lister() *ResourceLister {
+	return &ResourceLister{
+		lister: cache.NewGenericLister(ri.informer.GetIndexer(), ri.gvr.GroupResource()),
+	}
+}
+
+// ResourceLister lists objects of a single Gateway API resource from an informer cache.
+type ResourceLister struct {
+	lister cache.GenericLister
+}
+
+func (rl *ResourceLister) Namespace(namespace string) *ResourceNamespaceLister {
+	return &ResourceNamespaceLister{
+		lister: rl.lister.ByNamespace(namespace),
+	}
+}
+
+type ResourceNamespaceLister struct {
+	lister cache.GenericNamespaceLister
+}
+
+func (rnl *ResourceNamespaceLister) List(selector labels.Selector) ([]*unstructured.Unstructured, error) {
+	objs, err := rnl.lister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+func (rnl *ResourceNamespaceLister) Get(name string) (*unstructured.Unstructured, error) {
+	obj, err := rnl.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("unexpected object type %T", obj))
+	}
+
+	return u, nil
+}
+
+// Informers holds the informers of all Gateway API resources managed by the operator.
+type Informers struct {
+	Gateways           *ResourceInformer
+	HTTPRoutes         *ResourceInformer
+	BackendTLSPolicies *ResourceInformer
+	TLSRoutes          *ResourceInformer
+}
+
+func NewInformers(client dynamic.Interface, resyncPeriod time.Duration) *Informers {
+	return &Informers{
+		Gateways:           NewResourceInformer(client, GatewayGVR, resyncPeriod),
+		HTTPRoutes:         NewResourceInformer(client, HTTPRouteGVR, resyncPeriod),
+		BackendTLSPolicies: NewResourceInformer(client, BackendTLSPolicyGVR, resyncPeriod),
+		TLSRoutes:          NewResourceInformer(client, TLSRouteGVR, resyncPeriod),
+	}
+}
+
+func (i *Informers) resourceInformers() []*ResourceInformer {
+	return []*ResourceInformer{
+		i.Gateways,
+		i.HTTPRoutes,
+		i.BackendTLSPolicies,
+		i.TLSRoutes,
+	}
+}
+
+// Run starts the informer of every resource once the cluster serves it, until the context is done.
+// Resources are checked periodically, so Gateway API CRDs can be installed without restarting the operator.
+func (i *Informers) Run(ctx context.Context, discoveryClient discovery.DiscoveryInterface, interval time.Duration) {
+	apimachineryutilwait.UntilWithContext(ctx, func(ctx context.Context) {
+		for _, ri := range i.resourceInformers() {
+			if ri.started.Load() {
+				continue
+			}
+
+			available, err := isResourceAvailable(discoveryClient, ri.gvr)
+			if err != nil {
+				klog.ErrorS(err, "Can't check if Gateway API resource is served", "Resource", ri.gvr)
+				continue
+			}
+
+			if !available {
+				klog.V(4).InfoS("Gateway API resource isn't served by the cluster", "Resource", ri.gvr)
+				continue
+			}
+
+			klog.InfoS("Starting informer for Gateway API resource", "Resource", ri.gvr)
+			ri.started.Store(true)
+			go ri.informer.Run(ctx.Done())
+		}
+	}, interval)
+}
+
+// SyncedResources returns the resources whose informers have synced.
+func (i *Informers) SyncedResources() apimachineryutilsets.Set[schema.GroupVersionResource] {
+	resources := apimachineryutilsets.New[schema.GroupVersionResource]()
+	for _, ri := range i.resourceInformers() {
+		if ri.HasSynced() {
+			resources.Insert(ri.gvr)
+		}
+	}
+
+	return resources
+}
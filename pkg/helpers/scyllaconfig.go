@@ -0,0 +1,93 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package helpers
+
+import (
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ScyllaDBConfigOptions returns the scylla.yaml options, keyed by their names, that the typed config sets.
+func ScyllaDBConfigOptions(config *scyllav1alpha1.ScyllaDBConfig) map[string]any {
+	options := map[string]any{}
+
+	if config == nil {
+		return options
+	}
+
+	if config.Compaction != nil {
+		c := config.Compaction
+		setOptionIfNotNil(options, "compaction_throughput_mb_per_sec", c.ThroughputMBPerSecond)
+	}
+
+	if config.Streaming != nil {
+		s := config.Streaming
+		setOptionIfNotNil(options, "stream_io_throughput_mb_per_sec", s.IOThroughputMBPerSecond)
+	}
+
+	if config.Commitlog != nil {
+		c := config.Commitlog
+		setOptionIfNotNil(options, "commitlog_segment_size_in_mb", c.SegmentSizeMB)
+		setOptionIfNotNil(options, "commitlog_total_space_in_mb", c.TotalSpaceMB)
+		setOptionIfNotNil(options, "commitlog_sync_period_in_ms", c.SyncPeriodMilliseconds)
+	}
+
+	if config.Timeouts != nil {
+		t := config.Timeouts
+		setOptionIfNotNil(options, "read_request_timeout_in_ms", t.ReadRequestMilliseconds)
+		setOptionIfNotNil(options, "write_request_timeout_in_ms", t.WriteRequestMilliseconds)
+		setOptionIfNotNil(options, "range_request_timeout_in_ms", t.RangeRequestMilliseconds)
+		setOptionIfNotNil(options, "counter_write_request_timeout_in_ms", t.CounterWriteRequestMilliseconds)
+		setOptionIfNotNil(options, "cas_contention_timeout_in_ms", t.CASContentionMilliseconds)
+		setOptionIfNotNil(options, "truncate_request_timeout_in_ms", t.TruncateRequestMilliseconds)
+		setOptionIfNotNil(options, "request_timeout_in_ms", t.RequestMilliseconds)
+	}
+
+	if config.HintedHandoff != nil {
+		h := config.HintedHandoff
+		setOptionIfNotNil(options, "hinted_handoff_enabled", h.Enabled)
+		setOptionIfNotNil(options, "max_hint_window_in_ms", h.MaxHintWindowMilliseconds)
+	}
+
+	if config.Tablets != nil {
+		t := config.Tablets
+		setOptionIfNotNil(options, "enable_tablets", t.Enabled)
+	}
+
+	return options
+}
+
+// ScyllaDBConfigOptionArgumentName returns the name of the command line argument that sets the given scylla.yaml option.
+func ScyllaDBConfigOptionArgumentName(option string) string {
+	return strings.ReplaceAll(option, "_", "-")
+}
+
+func setOptionIfNotNil[T any](options map[string]any, name string, value *T) {
+	if value != nil {
+		options[name] = *value
+	}
+}
+
+// scyllaDBLiveUpdatableConfigOptions holds scylla.yaml options that ScyllaDB applies when it reloads its config,
+// without being restarted.
+var scyllaDBLiveUpdatableConfigOptions = apimachineryutilsets.New[string](
+	"compaction_throughput_mb_per_sec",
+	"compaction_static_shares",
+	"compaction_enforce_min_threshold",
+	"stream_io_throughput_mb_per_sec",
+	"read_request_timeout_in_ms",
+	"write_request_timeout_in_ms",
+	"range_request_timeout_in_ms",
+	"counter_write_request_timeout_in_ms",
+	"cas_contention_timeout_in_ms",
+	"truncate_request_timeout_in_ms",
+	"request_timeout_in_ms",
+	"max_hint_window_in_ms",
+)
+
+// IsScyllaDBConfigOptionLiveUpdatable returns whether ScyllaDB applies the scylla.yaml option without a restart.
+func IsScyllaDBConfigOptionLiveUpdatable(option string) bool {
+	return scyllaDBLiveUpdatableConfigOptions.Has(option)
+}
@@ -1,5 +1,9 @@
 package internalapi
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 type SidecarRuntimeConfig struct {
 	// containerID hold the ID of the scylla container this information is valid for.
 	// E.g. on restarts, the container gets a new ID.
@@ -11,3 +15,13 @@ type SidecarRuntimeConfig struct {
 	// blockingNodeConfigs is a list of NodeConfigs this pod is waiting on.
 	BlockingNodeConfigs []string `json:"blockingNodeConfigs"`
 }
+
+const (
+	// LocalDiskFailureNodeConditionType is the type of Node condition reporting failures of local disks
+	// set up by NodeConfig. The condition is True when any of the disks has failed.
+	LocalDiskFailureNodeConditionType corev1.NodeConditionType = "ScyllaDBLocalDiskFailure"
+
+	LocalDiskFailureDeviceMissingReason     = "DeviceMissing"
+	LocalDiskFailureDeviceUnhealthyReason   = "DeviceUnhealthy"
+	LocalDiskFailureWaitingForDevicesReason = "WaitingForDevices"
+)
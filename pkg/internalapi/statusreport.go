@@ -15,6 +15,21 @@ type NodeStatusReport struct {
 	// ObservedNodes holds the list of node statuses as observed by this node.
 	ObservedNodes []scyllav1alpha1.ObservedNodeStatus `json:"observedNodes,omitempty"`
 
+	// OperationMode holds the operation mode of this node.
+	OperationMode scyllav1alpha1.NodeOperationMode `json:"operationMode,omitempty"`
+
+	// LoadBytes holds the approximate size of data stored by this node, in bytes.
+	LoadBytes *int64 `json:"loadBytes,omitempty"`
+
+	// TokenCount holds the number of tokens owned by this node.
+	TokenCount *int32 `json:"tokenCount,omitempty"`
+
+	// Version holds the ScyllaDB version of this node.
+	Version string `json:"version,omitempty"`
+
+	// BootstrapStalled indicates that this node is bootstrapping and hasn't made progress for a while.
+	BootstrapStalled bool `json:"bootstrapStalled,omitempty"`
+
 	// Error holds an error message if the report could not be built.
 	Error *string `json:"error,omitempty"`
 }
@@ -19,11 +19,19 @@ const (
 )
 
 type DatacenterUpgradeContext struct {
-	State             UpgradePhase `json:"state"`
-	FromVersion       string       `json:"fromVersion"`
-	ToVersion         string       `json:"toVersion"`
-	SystemSnapshotTag string       `json:"systemSnapshotTag"`
-	DataSnapshotTag   string       `json:"dataSnapshotTag"`
+	State UpgradePhase `json:"state"`
+	// RackName is the name of the rack being upgraded.
+	// An empty RackName denotes an upgrade of all racks in the datacenter.
+	RackName          string `json:"rackName,omitempty"`
+	FromVersion       string `json:"fromVersion"`
+	ToVersion         string `json:"toVersion"`
+	SystemSnapshotTag string `json:"systemSnapshotTag"`
+	DataSnapshotTag   string `json:"dataSnapshotTag"`
+}
+
+// IsUpgradingRack returns whether the rack is subject to the upgrade.
+func (uc *DatacenterUpgradeContext) IsUpgradingRack(rackName string) bool {
+	return len(uc.RackName) == 0 || uc.RackName == rackName
 }
 
 func (uc *DatacenterUpgradeContext) Decode(reader io.Reader) error {
@@ -47,8 +47,15 @@ const (
 	// CleanupJobTokenRingHashAnnotation reflects which version of token ring cleanup Job is cleaning.
 	CleanupJobTokenRingHashAnnotation = "internal.scylla-operator.scylladb.com/cleanup-token-ring-hash"
 
+	// NodeReplacementNameAnnotation reflects the name of the last node replacement request that was started for the node.
+	NodeReplacementNameAnnotation = "internal.scylla-operator.scylladb.com/node-replacement-name"
+
 	// NodeStatusReportAnnotation reflects the current status report from the ScyllaDB node.
 	NodeStatusReportAnnotation = "internal.scylla.scylladb.com/scylladb-node-status-report"
+
+	// RestartRequiredConfigOptionsAnnotation reflects a comma-separated list of changed scylla.yaml options
+	// that the ScyllaDB node needs to be restarted for to take effect.
+	RestartRequiredConfigOptionsAnnotation = "internal.scylla-operator.scylladb.com/restart-required-config-options"
 )
 
 // Annotations used for feature backward compatibility between v1.ScyllaCluster and v1alpha1.ScyllaDBDatacenter
@@ -63,8 +70,9 @@ const (
 type ScyllaServiceType string
 
 const (
-	ScyllaServiceTypeIdentity ScyllaServiceType = "identity"
-	ScyllaServiceTypeMember   ScyllaServiceType = "member"
+	ScyllaServiceTypeIdentity   ScyllaServiceType = "identity"
+	ScyllaServiceTypeMember     ScyllaServiceType = "member"
+	ScyllaServiceTypeAlternator ScyllaServiceType = "alternator"
 )
 
 type ScyllaDBClusterLocalServiceType string
@@ -76,8 +84,9 @@ const (
 type ScyllaIngressType string
 
 const (
-	ScyllaIngressTypeNode    ScyllaIngressType = "Node"
-	ScyllaIngressTypeAnyNode ScyllaIngressType = "AnyNode"
+	ScyllaIngressTypeNode       ScyllaIngressType = "Node"
+	ScyllaIngressTypeAnyNode    ScyllaIngressType = "AnyNode"
+	ScyllaIngressTypeAlternator ScyllaIngressType = "Alternator"
 )
 
 type PodType string
@@ -89,6 +98,12 @@ const (
 	// PodTypeCleanupJob indicates that the pod is a cleanup job pod.
 	PodTypeCleanupJob PodType = "cleanup-job"
 
+	// PodTypeDatacenterOperationJob indicates that the pod is a datacenter operation job pod.
+	PodTypeDatacenterOperationJob PodType = "datacenter-operation-job"
+
+	// PodTypeConnectivityProbeJob indicates that the pod is a connectivity probe job pod.
+	PodTypeConnectivityProbeJob PodType = "connectivity-probe-job"
+
 	// PodTypeNodePerftuneJob indicates that the pod is a node perftune job pod.
 	PodTypeNodePerftuneJob PodType = "node-perftune-job"
 
@@ -114,6 +129,7 @@ const (
 	ScyllaVersionLabel           = "scylla/scylla-version"
 	ScyllaServiceTypeLabel       = "scylla-operator.scylladb.com/scylla-service-type"
 	ScyllaIngressTypeLabel       = "scylla-operator.scylladb.com/scylla-ingress-type"
+	AlternatorUserNameLabel      = "scylla-operator.scylladb.com/alternator-user-name"
 	ManagedHash                  = "scylla-operator.scylladb.com/managed-hash"
 	NodeConfigJobForNodeUIDLabel = "scylla-operator.scylladb.com/node-config-job-for-node-uid"
 	NodeConfigJobTypeLabel       = "scylla-operator.scylladb.com/node-config-job-type"
@@ -154,8 +170,14 @@ const (
 	PerftuneContainerName             = "perftune"
 	SysctlsContainerName              = "sysctls"
 	CleanupContainerName              = "cleanup"
+	DatacenterOperationContainerName  = "datacenter-operation"
+	ConnectivityProbeContainerName    = "connectivity-probe"
 	RLimitsContainerName              = "rlimits"
 
+	ScyllaDBAPIStatusProbeContainerName   = "scylladb-api-status-probe"
+	SysctlBuddyContainerName              = "sysctl-buddy"
+	ScyllaDBBootstrapBarrierContainerName = "scylladb-bootstrap-barrier"
+
 	PVCTemplateName = "data"
 
 	SharedDirName = "/mnt/shared"
@@ -172,6 +194,8 @@ const (
 	ScyllaConfigName                = "scylla.yaml"
 	ScyllaDBManagedConfigName       = "scylladb-managed-config.yaml"
 	ScyllaManagedConfigPath         = ScyllaDBManagedConfigDir + "/" + ScyllaDBManagedConfigName
+	ScyllaDBConfigName              = "scylladb-config.yaml"
+	ScyllaDBConfigPath              = ScyllaDBManagedConfigDir + "/" + ScyllaDBConfigName
 	ScyllaRackDCPropertiesName      = "cassandra-rackdc.properties"
 	ScyllaIOPropertiesName          = "io_properties.yaml"
 
@@ -183,10 +207,16 @@ const (
 
 	ReadinessProbePath         = "/readyz"
 	LivenessProbePath          = "/healthz"
+	StartupProbePath           = "/startupz"
 	ScyllaDBAPIStatusProbePort = 8080
 	ScyllaDBIgnitionProbePort  = 42081
 	ScyllaAPIPort              = 10000
 
+	CQLPortName              = "cql"
+	CQLSSLPortName           = "cql-ssl"
+	CQLShardAwarePortName    = "cql-shard-aware"
+	CQLSSLShardAwarePortName = "cql-ssl-shard-aware"
+
 	OperatorEnvVarPrefix = "SCYLLA_OPERATOR_"
 )
 
@@ -197,6 +227,10 @@ const (
 
 	ScyllaOperatorNodeTuningNamespace = "scylla-operator-node-tuning"
 
+	// RemoteKubernetesClusterCredentialsNamespace is the only namespace the operator is allowed to request
+	// ServiceAccount tokens in, on behalf of RemoteKubernetesClusters.
+	RemoteKubernetesClusterCredentialsNamespace = "scylla-operator-remote-credentials"
+
 	ScyllaClusterMemberClusterRoleName = "scyllacluster-member"
 
 	SingletonName = "cluster"
@@ -231,13 +265,22 @@ const (
 type ProtocolDNSLabel string
 
 const (
-	CQLProtocolDNSLabel = "cql"
+	CQLProtocolDNSLabel        = "cql"
+	AlternatorProtocolDNSLabel = "alternator"
 )
 
 type NodeJobType string
 
 const (
-	JobTypeCleanup NodeJobType = "Cleanup"
+	JobTypeCleanup     NodeJobType = "Cleanup"
+	JobTypeReplication NodeJobType = "Replication"
+	JobTypeRebuild     NodeJobType = "Rebuild"
+	JobTypeRepair      NodeJobType = "Repair"
+	JobTypeRemoveNodes NodeJobType = "RemoveNodes"
+
+	JobTypeImportValidation NodeJobType = "ImportValidation"
+
+	JobTypeConnectivityProbe NodeJobType = "ConnectivityProbe"
 )
 
 const (
@@ -248,6 +291,22 @@ const (
 	UpgradeContextConfigMapKey = "upgrade-context.json"
 )
 
+const (
+	AlternatorAccessKeyIDKey     = "AWS_ACCESS_KEY_ID"
+	AlternatorSecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+)
+
+const (
+	ClientConnectionBundleCAKey                  = "ca.crt"
+	ClientConnectionBundleCertKey                = "tls.crt"
+	ClientConnectionBundleKeyKey                 = "tls.key"
+	ClientConnectionBundleUsernameKey            = "username"
+	ClientConnectionBundlePasswordKey            = "password"
+	ClientConnectionBundleCQLConnectionConfigKey = "cql-connection-config.yaml"
+	ClientConnectionBundleConnectionKey          = "connection.yaml"
+	ClientConnectionBundleEnvKey                 = "connection.env"
+)
+
 const (
 	ManagedByClusterLabel = "scylla-operator.scylladb.com/managed-by-cluster"
 )
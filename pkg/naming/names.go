@@ -92,6 +92,22 @@ func IdentityServiceName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
 	return fmt.Sprintf("%s-client", sdc.Name)
 }
 
+func AlternatorServiceName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
+	return fmt.Sprintf("%s-alternator", sdc.Name)
+}
+
+func AlternatorUserCredentialsSecretName(sdc *scyllav1alpha1.ScyllaDBDatacenter, userName string) string {
+	return fmt.Sprintf("%s-alternator-user-%s", sdc.Name, userName)
+}
+
+func ClientConnectionBundleSecretName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
+	return fmt.Sprintf("%s-client-connection-bundle", sdc.Name)
+}
+
+func GatewayName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
+	return sdc.Name
+}
+
 func IdentityServiceNameForScyllaCluster(sc *scyllav1.ScyllaCluster) string {
 	return fmt.Sprintf("%s-client", sc.Name)
 }
@@ -227,6 +243,10 @@ func GetScyllaClusterLocalUserAdminCertName(scName string) string {
 	return fmt.Sprintf("%s-local-user-admin", scName)
 }
 
+func GetScyllaClusterLocalUserClientCertName(scName string) string {
+	return fmt.Sprintf("%s-local-user-client", scName)
+}
+
 func GetScyllaClusterLocalServingCAName(scName string) string {
 	return fmt.Sprintf("%s-local-serving-ca", scName)
 }
@@ -259,10 +279,34 @@ func GetCQLHostIDSubDomain(hostID, domain string) string {
 	return fmt.Sprintf("%s.%s", hostID, GetCQLProtocolSubDomain(domain))
 }
 
+func GetAlternatorProtocolSubDomain(domain string) string {
+	return GetProtocolSubDomain(AlternatorProtocolDNSLabel, domain)
+}
+
 func CleanupJobForService(svcName string) string {
 	return fmt.Sprintf("cleanup-%s", svcName)
 }
 
+func RestoreScyllaDBManagerTaskName(sdc *scyllav1alpha1.ScyllaDBDatacenter, target string) string {
+	return fmt.Sprintf("%s-restore-%s", sdc.Name, target)
+}
+
+func DatacenterOperationJobName(sdcName string, jobType NodeJobType) string {
+	return fmt.Sprintf("%s-%s", sdcName, strings.ToLower(string(jobType)))
+}
+
+func LostDatacenterJobName(sc *scyllav1alpha1.ScyllaDBCluster, lostDCName string, jobType NodeJobType) string {
+	return DatacenterOperationJobName(fmt.Sprintf("%s-%s", sc.Name, lostDCName), jobType)
+}
+
+func ImportJobName(sc *scyllav1alpha1.ScyllaDBCluster, jobType NodeJobType) string {
+	return DatacenterOperationJobName(fmt.Sprintf("%s-import", sc.Name), jobType)
+}
+
+func ConnectivityProbeJobName(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter) string {
+	return DatacenterOperationJobName(ScyllaDBDatacenterName(sc, dc), JobTypeConnectivityProbe)
+}
+
 func GetScyllaDBManagedConfigCMName(clusterName string) string {
 	return fmt.Sprintf("%s-managed-config", clusterName)
 }
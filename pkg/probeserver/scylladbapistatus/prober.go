@@ -3,36 +3,80 @@ package scylladbapistatus
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/bootstrapprogress"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scylla"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
 
+const (
+	cqlQueryTimeout = 5 * time.Second
+
+	bootstrapProgressTrackingInterval = 10 * time.Second
+)
+
 type Prober struct {
-	namespace        string
-	serviceName      string
-	localhostAddress string
-	serviceLister    corev1listers.ServiceLister
-	timeout          time.Duration
+	namespace         string
+	serviceName       string
+	localhostAddress  string
+	readinessMode     scyllav1alpha1.ScyllaDBReadinessMode
+	minUpPeersPercent int32
+	serviceLister     corev1listers.ServiceLister
+	timeout           time.Duration
+
+	// memberServiceLister and podLister list member Services and Pods of ScyllaDB nodes in the namespace.
+	// They are only set in the PeerQuorum readiness mode.
+	memberServiceLister corev1listers.ServiceLister
+	podLister           corev1listers.PodLister
+
+	bootstrapProgressTracker *bootstrapprogress.Tracker
+
+	nodeDatacentersLock sync.Mutex
+	nodeDatacenters     map[string]string
 }
 
 func NewProber(
 	namespace string,
 	serviceName string,
 	localhostAddress string,
+	readinessMode scyllav1alpha1.ScyllaDBReadinessMode,
+	minUpPeersPercent int32,
 	serviceLister corev1listers.ServiceLister,
+	memberServiceLister corev1listers.ServiceLister,
+	podLister corev1listers.PodLister,
 ) *Prober {
 	return &Prober{
-		namespace:        namespace,
-		serviceName:      serviceName,
-		localhostAddress: localhostAddress,
-		serviceLister:    serviceLister,
-		timeout:          60 * time.Second,
+		namespace:         namespace,
+		serviceName:       serviceName,
+		localhostAddress:  localhostAddress,
+		readinessMode:     readinessMode,
+		minUpPeersPercent: minUpPeersPercent,
+		serviceLister:     serviceLister,
+		timeout:           60 * time.Second,
+
+		memberServiceLister: memberServiceLister,
+		podLister:           podLister,
+
+		bootstrapProgressTracker: bootstrapprogress.NewTracker(bootstrapprogress.DefaultStallTimeout, time.Now),
+
+		nodeDatacenters: map[string]string{},
 	}
 }
 
@@ -113,6 +157,13 @@ func (p *Prober) Readyz(w http.ResponseWriter, req *http.Request) {
 
 			klog.V(4).InfoS("readyz probe: node state", "Node", s.Addr, "NativeTransportEnabled", transportEnabled)
 			if transportEnabled {
+				err = p.checkReadinessMode(ctx, scyllaClient, hostID, nodeStatuses)
+				if err != nil {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					klog.V(2).InfoS("readyz probe: node is not ready", "Service", p.serviceRef(), "ReadinessMode", p.readinessMode, "Reason", err)
+					return
+				}
+
 				w.WriteHeader(http.StatusOK)
 				return
 			}
@@ -123,6 +174,155 @@ func (p *Prober) Readyz(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusServiceUnavailable)
 }
 
+// checkReadinessMode runs the checks specific to the readiness mode, on top of the node status checks.
+func (p *Prober) checkReadinessMode(ctx context.Context, scyllaClient *scyllaclient.Client, hostID string, nodeStatuses scyllaclient.NodeStatusAndStateInfoSlice) error {
+	switch p.readinessMode {
+	case scyllav1alpha1.ScyllaDBReadinessModeCQLQuery:
+		return p.checkCQLQuery(ctx)
+
+	case scyllav1alpha1.ScyllaDBReadinessModePeerQuorum:
+		nodeDatacenters, err := p.getNodeDatacenters(ctx, scyllaClient, nodeStatuses)
+		if err != nil {
+			return fmt.Errorf("can't get datacenters of nodes: %w", err)
+		}
+
+		unscheduledHostIDs, err := p.getUnscheduledHostIDs()
+		if err != nil {
+			return fmt.Errorf("can't get nodes without a scheduled Pod: %w", err)
+		}
+
+		return checkPeerQuorum(hostID, nodeStatuses, nodeDatacenters, unscheduledHostIDs, p.minUpPeersPercent)
+
+	default:
+		return nil
+
+	}
+}
+
+// checkCQLQuery runs a lightweight CQL query over the local node.
+// The probe has no CQL credentials, so when authentication is enabled, completing the connection handshake
+// up to the authentication challenge is considered proof that the node serves CQL.
+func (p *Prober) checkCQLQuery(ctx context.Context) error {
+	cluster := gocql.NewCluster(net.JoinHostPort(p.localhostAddress, strconv.Itoa(scylla.DefaultNativeTransportPort)))
+	cluster.DisableInitialHostLookup = true
+	cluster.Consistency = gocql.One
+	cluster.ConnectTimeout = cqlQueryTimeout
+	cluster.Timeout = cqlQueryTimeout
+	cluster.NumConns = 1
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		if strings.Contains(err.Error(), "authentication required") {
+			klog.V(4).InfoS("readyz probe: CQL authentication is required, skipping the query", "Service", p.serviceRef())
+			return nil
+		}
+
+		return fmt.Errorf("can't create CQL session: %w", err)
+	}
+	defer session.Close()
+
+	err = session.Query("SELECT key FROM system.local").WithContext(ctx).Exec()
+	if err != nil {
+		return fmt.Errorf("can't query local node: %w", err)
+	}
+
+	return nil
+}
+
+// getUnscheduledHostIDs returns host IDs of the nodes whose member Service exists, but their Pod doesn't.
+func (p *Prober) getUnscheduledHostIDs() (sets.Set[string], error) {
+	services, err := p.memberServiceLister.Services(p.namespace).List(labels.SelectorFromSet(labels.Set{
+		naming.ScyllaServiceTypeLabel: string(naming.ScyllaServiceTypeMember),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("can't list member services: %w", err)
+	}
+
+	unscheduledHostIDs := sets.New[string]()
+	for _, svc := range services {
+		hostID, ok := svc.Annotations[naming.HostIDAnnotation]
+		if !ok {
+			continue
+		}
+
+		_, err = p.podLister.Pods(p.namespace).Get(svc.Name)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(p.namespace, svc.Name), err)
+		}
+
+		unscheduledHostIDs.Insert(hostID)
+	}
+
+	return unscheduledHostIDs, nil
+}
+
+// getNodeDatacenters returns datacenters of the nodes keyed by their host ID.
+// Datacenter of a node never changes, so it's only looked up for nodes that haven't been seen yet.
+func (p *Prober) getNodeDatacenters(ctx context.Context, scyllaClient *scyllaclient.Client, nodeStatuses scyllaclient.NodeStatusAndStateInfoSlice) (map[string]string, error) {
+	p.nodeDatacentersLock.Lock()
+	defer p.nodeDatacentersLock.Unlock()
+
+	nodeDatacenters := make(map[string]string, len(nodeStatuses))
+	for _, s := range nodeStatuses {
+		dc, ok := p.nodeDatacenters[s.HostID]
+		if !ok {
+			var err error
+			dc, err = scyllaClient.GetSnitchDatacenter(ctx, s.Addr)
+			if err != nil {
+				return nil, fmt.Errorf("can't get datacenter of node %q: %w", s.Addr, err)
+			}
+
+			p.nodeDatacenters[s.HostID] = dc
+		}
+
+		nodeDatacenters[s.HostID] = dc
+	}
+
+	return nodeDatacenters, nil
+}
+
+// checkPeerQuorum checks that at least minUpPeersPercent of the nodes in the node's datacenter, including the node itself, are UP,
+// as observed by the node. Nodes in other datacenters are ignored, so that an outage of a remote datacenter doesn't affect
+// the readiness of local nodes.
+// Nodes that are down because their Pod isn't scheduled are ignored too. StatefulSets create Pods in order, each one
+// only after the previous one is ready, so counting them would keep a datacenter with all nodes restarted from ever becoming ready.
+func checkPeerQuorum(hostID string, nodeStatuses scyllaclient.NodeStatusAndStateInfoSlice, nodeDatacenters map[string]string, unscheduledHostIDs sets.Set[string], minUpPeersPercent int32) error {
+	localDC, ok := nodeDatacenters[hostID]
+	if !ok {
+		return fmt.Errorf("datacenter of the local node %q is unknown", hostID)
+	}
+
+	// The local node is serving at this point, so it's counted as UP.
+	nodes, upNodes := 1, 1
+	for _, s := range nodeStatuses {
+		if s.HostID == hostID {
+			continue
+		}
+
+		if nodeDatacenters[s.HostID] != localDC {
+			continue
+		}
+
+		if s.Status != scyllaclient.NodeStatusUp && unscheduledHostIDs.Has(s.HostID) {
+			continue
+		}
+
+		nodes++
+		if s.Status == scyllaclient.NodeStatusUp {
+			upNodes++
+		}
+	}
+
+	if upNodes*100 < int(minUpPeersPercent)*nodes {
+		return fmt.Errorf("only %d out of %d nodes in datacenter %q are UP, at least %d%% of nodes have to be UP", upNodes, nodes, localDC, minUpPeersPercent)
+	}
+
+	return nil
+}
+
 func (p *Prober) Healthz(w http.ResponseWriter, req *http.Request) {
 	ctx, ctxCancel := context.WithTimeout(req.Context(), p.timeout)
 	defer ctxCancel()
@@ -167,3 +367,99 @@ func (p *Prober) Healthz(w http.ResponseWriter, req *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// Startupz succeeds once ScyllaDB API is reachable, unless the node is bootstrapping without making progress.
+// Bootstrapping and replacing nodes can stream data for hours, so a long bootstrap isn't a failure as long as it progresses.
+// The progress is tracked independently of the probe, so a ScyllaDB container restarted during a stalled bootstrap doesn't pass it.
+func (p *Prober) Startupz(w http.ResponseWriter, req *http.Request) {
+	ctx, ctxCancel := context.WithTimeout(req.Context(), p.timeout)
+	defer ctxCancel()
+
+	underMaintenance, err := p.isNodeUnderMaintenance()
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		klog.ErrorS(err, "startupz probe: can't look up service maintenance label", "Service", p.serviceRef())
+		return
+	}
+
+	if underMaintenance {
+		w.WriteHeader(http.StatusOK)
+		klog.V(2).InfoS("startupz probe: node is under maintenance", "Service", p.serviceRef())
+		return
+	}
+
+	scyllaClient, err := p.newLocalhostScyllaClient()
+	if err != nil {
+		klog.ErrorS(err, "startupz probe: can't get scylla client", "Service", p.serviceRef())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer scyllaClient.Close()
+
+	// Check if Scylla API is reachable
+	_, err = scyllaClient.Ping(ctx, p.localhostAddress)
+	if err != nil {
+		klog.ErrorS(err, "startupz probe: can't connect to Scylla API", "Service", p.serviceRef())
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	// Bootstrap progress is best effort, ScyllaDB may not be able to report it this early in the startup.
+	stalled, err := p.observeBootstrapProgress(ctx, scyllaClient)
+	if err != nil {
+		klog.V(2).InfoS("startupz probe: can't observe bootstrap progress", "Service", p.serviceRef(), "Error", err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if stalled {
+		klog.InfoS("startupz probe: node bootstrap has stalled", "Service", p.serviceRef())
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// TrackBootstrapProgress periodically observes the bootstrap progress of the node until the context is done.
+func (p *Prober) TrackBootstrapProgress(ctx context.Context) {
+	apimachineryutilwait.UntilWithContext(ctx, func(ctx context.Context) {
+		scyllaClient, err := p.newLocalhostScyllaClient()
+		if err != nil {
+			klog.ErrorS(err, "can't get scylla client", "Service", p.serviceRef())
+			return
+		}
+		defer scyllaClient.Close()
+
+		_, err = p.observeBootstrapProgress(ctx, scyllaClient)
+		if err != nil {
+			klog.V(4).InfoS("Can't observe bootstrap progress", "Service", p.serviceRef(), "Error", err)
+		}
+	}, bootstrapProgressTrackingInterval)
+}
+
+// observeBootstrapProgress records the operation mode and the progress of the node, and returns whether its bootstrap is stalled.
+func (p *Prober) observeBootstrapProgress(ctx context.Context, scyllaClient *scyllaclient.Client) (bool, error) {
+	operationMode, err := scyllaClient.OperationMode(ctx, p.localhostAddress)
+	if err != nil {
+		return false, fmt.Errorf("can't get operation mode: %w", err)
+	}
+
+	progress, err := bootstrapprogress.GetProgress(ctx, scyllaClient, p.localhostAddress)
+	if err != nil {
+		return false, fmt.Errorf("can't get bootstrap progress: %w", err)
+	}
+
+	klog.V(4).InfoS("Observed node bootstrap progress", "Service", p.serviceRef(), "OperationMode", operationMode, "Progress", progress)
+
+	return p.bootstrapProgressTracker.Observe(operationMode, progress), nil
+}
+
+func (p *Prober) newLocalhostScyllaClient() (*scyllaclient.Client, error) {
+	parsedIP, err := helpers.ParseIP(p.localhostAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid localhost address %q: %w", p.localhostAddress, err)
+	}
+
+	return controllerhelpers.NewScyllaClientForLocalhost(helpers.GetIPFamily(parsedIP))
+}
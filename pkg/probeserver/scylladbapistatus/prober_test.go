@@ -0,0 +1,153 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbapistatus
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func Test_checkPeerQuorum(t *testing.T) {
+	t.Parallel()
+
+	newNodeStatus := func(hostID string, status scyllaclient.NodeStatus) scyllaclient.NodeStatusAndStateInfo {
+		return scyllaclient.NodeStatusAndStateInfo{
+			NodeStatusInfo: scyllaclient.NodeStatusInfo{
+				HostID: hostID,
+				Status: status,
+			},
+			State: scyllaclient.NodeStateNormal,
+		}
+	}
+
+	tt := []struct {
+		name               string
+		nodeStatuses       scyllaclient.NodeStatusAndStateInfoSlice
+		nodeDatacenters    map[string]string
+		unscheduledHostIDs sets.Set[string]
+		minUpPeersPercent  int32
+		expectedErr        error
+	}{
+		{
+			name: "node without peers is ready",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+			},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  100,
+			expectedErr:        nil,
+		},
+		{
+			name: "node is ready with the default percentage when a single node of a three-node datacenter is down",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-1", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-2", scyllaclient.NodeStatusDown),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+				"host-id-1": "dc1",
+				"host-id-2": "dc1",
+			},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  50,
+			expectedErr:        nil,
+		},
+		{
+			name: "node is ready with the default percentage when the other node of a two-node datacenter is down",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-1", scyllaclient.NodeStatusDown),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+				"host-id-1": "dc1",
+			},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  50,
+			expectedErr:        nil,
+		},
+		{
+			name: "node isn't ready when not enough nodes are up",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-1", scyllaclient.NodeStatusDown),
+				newNodeStatus("host-id-2", scyllaclient.NodeStatusDown),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+				"host-id-1": "dc1",
+				"host-id-2": "dc1",
+			},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  50,
+			expectedErr:        errors.New(`only 1 out of 3 nodes in datacenter "dc1" are UP, at least 50% of nodes have to be UP`),
+		},
+		{
+			name: "down nodes without a scheduled pod are ignored",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-1", scyllaclient.NodeStatusDown),
+				newNodeStatus("host-id-2", scyllaclient.NodeStatusDown),
+				newNodeStatus("host-id-3", scyllaclient.NodeStatusDown),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+				"host-id-1": "dc1",
+				"host-id-2": "dc1",
+				"host-id-3": "dc1",
+			},
+			unscheduledHostIDs: sets.New("host-id-1", "host-id-2"),
+			minUpPeersPercent:  50,
+			expectedErr:        nil,
+		},
+		{
+			name: "nodes in other datacenters are ignored",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-1", scyllaclient.NodeStatusUp),
+				newNodeStatus("host-id-2", scyllaclient.NodeStatusDown),
+				newNodeStatus("host-id-3", scyllaclient.NodeStatusDown),
+				newNodeStatus("host-id-4", scyllaclient.NodeStatusDown),
+			},
+			nodeDatacenters: map[string]string{
+				"host-id-0": "dc1",
+				"host-id-1": "dc1",
+				"host-id-2": "dc2",
+				"host-id-3": "dc2",
+				"host-id-4": "dc2",
+			},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  100,
+			expectedErr:        nil,
+		},
+		{
+			name: "node isn't ready when its datacenter is unknown",
+			nodeStatuses: scyllaclient.NodeStatusAndStateInfoSlice{
+				newNodeStatus("host-id-0", scyllaclient.NodeStatusUp),
+			},
+			nodeDatacenters:    map[string]string{},
+			unscheduledHostIDs: nil,
+			minUpPeersPercent:  50,
+			expectedErr:        errors.New(`datacenter of the local node "host-id-0" is unknown`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPeerQuorum("host-id-0", tc.nodeStatuses, tc.nodeDatacenters, tc.unscheduledHostIDs, tc.minUpPeersPercent)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
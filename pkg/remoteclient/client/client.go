@@ -22,7 +22,7 @@ type DynamicClusterInterface interface {
 	DeleteCluster(name string)
 }
 
-func NewClusterClient[CT any](makeClient func(config []byte) (CT, error)) *ClusterClient[CT] {
+func NewClusterClient[CT any](makeClient func(cluster string, config []byte) (CT, error)) *ClusterClient[CT] {
 	rc := &ClusterClient[CT]{
 		makeClient:    makeClient,
 		lock:          sync.RWMutex{},
@@ -38,7 +38,7 @@ type clusterInfo[CT any] struct {
 }
 
 type ClusterClient[CT any] struct {
-	makeClient func(config []byte) (CT, error)
+	makeClient func(cluster string, config []byte) (CT, error)
 
 	lock          sync.RWMutex
 	clustersCache map[string]clusterInfo[CT]
@@ -62,7 +62,7 @@ func (c *ClusterClient[CT]) UpdateCluster(cluster string, config []byte) error {
 
 	klog.V(4).InfoS("Updating cluster client", "cluster", cluster)
 
-	clusterClient, err := c.makeClient(config)
+	clusterClient, err := c.makeClient(cluster, config)
 	if err != nil {
 		return fmt.Errorf("can't create cluster %q client: %w", cluster, err)
 	}
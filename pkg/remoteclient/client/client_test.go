@@ -54,7 +54,7 @@ func TestClusterClient_RefreshOnClusterConfigChange(t *testing.T) {
 	}
 
 	newClientCh := make(chan struct{}, 1)
-	remoteClient := NewClusterClient(func(config []byte) (dynamic.Interface, error) {
+	remoteClient := NewClusterClient(func(_ string, config []byte) (dynamic.Interface, error) {
 		newClientCh <- struct{}{}
 		return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil), nil
 	})
@@ -77,7 +77,7 @@ func TestClusterClient_RefreshOnClusterConfigChange(t *testing.T) {
 
 func TestClusterClient_AddDeleteCluster(t *testing.T) {
 	newClientCh := make(chan struct{}, 1)
-	clusterClient := NewClusterClient(func(config []byte) (dynamic.Interface, error) {
+	clusterClient := NewClusterClient(func(_ string, config []byte) (dynamic.Interface, error) {
 		newClientCh <- struct{}{}
 		return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil), nil
 	})
@@ -0,0 +1,87 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package client
+
+import (
+	"net/http"
+	"sync"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/transport"
+)
+
+// ClusterTokens holds bearer tokens of remote clusters.
+// Tokens are injected by the client transport, so they can be refreshed without changing the cluster config
+// and recreating the clients and informers bound to it.
+type ClusterTokens struct {
+	lock   sync.RWMutex
+	tokens map[string]string
+}
+
+func NewClusterTokens() *ClusterTokens {
+	return &ClusterTokens{
+		tokens: map[string]string{},
+	}
+}
+
+func (ct *ClusterTokens) SetToken(cluster string, token string) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	ct.tokens[cluster] = token
+}
+
+func (ct *ClusterTokens) DeleteToken(cluster string) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	delete(ct.tokens, cluster)
+}
+
+func (ct *ClusterTokens) Token(cluster string) (string, bool) {
+	ct.lock.RLock()
+	defer ct.lock.RUnlock()
+
+	token, ok := ct.tokens[cluster]
+	return token, ok
+}
+
+// WrapTransport returns a transport wrapper authenticating requests with the current token of the given cluster.
+// Requests which already carry credentials, or are made to clusters without a token, are passed through unchanged.
+func (ct *ClusterTokens) WrapTransport(cluster string) transport.WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &tokenRoundTripper{
+			tokens:  ct,
+			cluster: cluster,
+			base:    rt,
+		}
+	}
+}
+
+type tokenRoundTripper struct {
+	tokens  *ClusterTokens
+	cluster string
+	base    http.RoundTripper
+}
+
+var _ utilnet.RoundTripperWrapper = &tokenRoundTripper{}
+
+func (rt *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get("Authorization")) != 0 {
+		return rt.base.RoundTrip(req)
+	}
+
+	token, ok := rt.tokens.Token(rt.cluster)
+	if !ok {
+		return rt.base.RoundTrip(req)
+	}
+
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return rt.base.RoundTrip(req)
+}
+
+func (rt *tokenRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.base
+}
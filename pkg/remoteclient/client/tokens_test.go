@@ -0,0 +1,112 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClusterTokens_WrapTransport(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                  string
+		tokens                map[string]string
+		cluster               string
+		requestAuthorization  string
+		expectedAuthorization string
+	}{
+		{
+			name:                  "request to a cluster without a token is passed through",
+			tokens:                map[string]string{"other": "other-token"},
+			cluster:               "cluster",
+			expectedAuthorization: "",
+		},
+		{
+			name:                  "request to a cluster with a token is authenticated with it",
+			tokens:                map[string]string{"cluster": "token", "other": "other-token"},
+			cluster:               "cluster",
+			expectedAuthorization: "Bearer token",
+		},
+		{
+			name:                  "request carrying credentials is passed through",
+			tokens:                map[string]string{"cluster": "token"},
+			cluster:               "cluster",
+			requestAuthorization:  "Bearer kubeconfig-token",
+			expectedAuthorization: "Bearer kubeconfig-token",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ct := NewClusterTokens()
+			for cluster, token := range tc.tokens {
+				ct.SetToken(cluster, token)
+			}
+
+			var gotAuthorization string
+			rt := ct.WrapTransport(tc.cluster)(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				gotAuthorization = req.Header.Get("Authorization")
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			}))
+
+			req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tc.requestAuthorization) != 0 {
+				req.Header.Set("Authorization", tc.requestAuthorization)
+			}
+
+			_, err = rt.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotAuthorization != tc.expectedAuthorization {
+				t.Errorf("expected authorization %q, got %q", tc.expectedAuthorization, gotAuthorization)
+			}
+		})
+	}
+}
+
+func TestClusterTokens_WrapTransportUsesCurrentToken(t *testing.T) {
+	t.Parallel()
+
+	ct := NewClusterTokens()
+	ct.SetToken("cluster", "token-1")
+
+	var gotAuthorization string
+	rt := ct.WrapTransport("cluster")(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		gotAuthorization = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	for _, token := range []string{"token-1", "token-2"} {
+		ct.SetToken("cluster", token)
+
+		req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = rt.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedAuthorization := "Bearer " + token
+		if gotAuthorization != expectedAuthorization {
+			t.Errorf("expected authorization %q, got %q", expectedAuthorization, gotAuthorization)
+		}
+	}
+}
@@ -114,7 +114,7 @@ func TestDynamicSharedInformerFactory_EventHandlers(t *testing.T) {
 				objs = append(objs, tc.existingObj)
 			}
 
-			clusterClient := client.NewClusterClient(func(_ []byte) (kubernetes.Interface, error) {
+			clusterClient := client.NewClusterClient(func(_ string, _ []byte) (kubernetes.Interface, error) {
 				return fake.NewClientset(objs...), nil
 			})
 
@@ -193,7 +193,7 @@ func TestDynamicSharedInformerFactory_EventHandles_MultipleClusters(t *testing.T
 
 	testObject := newPod("ns", "name", "foo")
 
-	remoteClient := client.NewClusterClient(func(_ []byte) (kubernetes.Interface, error) {
+	remoteClient := client.NewClusterClient(func(_ string, _ []byte) (kubernetes.Interface, error) {
 		return fake.NewClientset(testObject), nil
 	})
 
@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -272,6 +273,32 @@ func (c *Client) GetSnitchDatacenter(ctx context.Context, host string) (string,
 	return resp.GetPayload(), nil
 }
 
+func (c *Client) GetSnitchName(ctx context.Context, host string) (string, error) {
+	ctx = forceHost(ctx, host)
+
+	resp, err := c.scyllaClient.Operations.SnitchNameGet(&scyllaoperations.SnitchNameGetParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetPayload(), nil
+}
+
+func (c *Client) GetClusterName(ctx context.Context, host string) (string, error) {
+	ctx = forceHost(ctx, host)
+
+	resp, err := c.scyllaClient.Operations.StorageServiceClusterNameGet(&scyllaoperations.StorageServiceClusterNameGetParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetPayload(), nil
+}
+
 const (
 	snapshotTimeout = 5 * time.Minute
 	drainTimeout    = 5 * time.Minute
@@ -376,6 +403,79 @@ func (c *Client) Decommission(ctx context.Context, host string) error {
 	return nil
 }
 
+// Rebuild streams data owned by the node from nodes in the source datacenter.
+func (c *Client) Rebuild(ctx context.Context, host string, sourceDatacenter string) error {
+	const (
+		// Rebuild is synchronous call and may take a long time to finish.
+		rebuildTimeout = 24 * time.Hour
+	)
+
+	queryCtx := forceHost(ctx, host)
+	queryCtx = customTimeout(queryCtx, rebuildTimeout)
+	// Retrying a rebuild that timed out on the client side would fail because it's still running on the node.
+	queryCtx = noRetry(queryCtx)
+
+	_, err := c.scyllaClient.Operations.StorageServiceRebuildPost(&scyllaoperations.StorageServiceRebuildPostParams{
+		Context:  queryCtx,
+		SourceDc: &sourceDatacenter,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveNode removes the dead node with the given host ID from the cluster, using the host as a coordinator.
+func (c *Client) RemoveNode(ctx context.Context, host string, hostID string) error {
+	const (
+		// Removenode is synchronous call and may take a long time to finish.
+		removeNodeTimeout = 24 * time.Hour
+	)
+
+	queryCtx := forceHost(ctx, host)
+	queryCtx = customTimeout(queryCtx, removeNodeTimeout)
+	// Retrying a removenode that timed out on the client side would fail because it's still running on the node.
+	queryCtx = noRetry(queryCtx)
+
+	_, err := c.scyllaClient.Operations.StorageServiceRemoveNodePost(&scyllaoperations.StorageServiceRemoveNodePostParams{
+		Context: queryCtx,
+		HostID:  hostID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Repair starts a repair of the keyspace on the node and returns the ID of the repair.
+func (c *Client) Repair(ctx context.Context, host string, keyspace string) (int32, error) {
+	resp, err := c.scyllaClient.Operations.StorageServiceRepairAsyncByKeyspacePost(&scyllaoperations.StorageServiceRepairAsyncByKeyspacePostParams{
+		Context:  forceHost(ctx, host),
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.GetPayload(), nil
+}
+
+// RepairStatus returns the status of the repair with the given ID.
+func (c *Client) RepairStatus(ctx context.Context, host string, keyspace string, id int32) (RepairStatus, error) {
+	resp, err := c.scyllaClient.Operations.StorageServiceRepairAsyncByKeyspaceGet(&scyllaoperations.StorageServiceRepairAsyncByKeyspaceGetParams{
+		Context:  forceHost(ctx, host),
+		Keyspace: keyspace,
+		ID:       id,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return RepairStatus(resp.GetPayload()), nil
+}
+
 func (c *Client) ScyllaVersion(ctx context.Context) (string, error) {
 	resp, err := c.scyllaClient.Operations.StorageServiceScyllaReleaseVersionGet(&scyllaoperations.StorageServiceScyllaReleaseVersionGetParams{Context: ctx})
 	if err != nil {
@@ -392,6 +492,69 @@ func (c *Client) OperationMode(ctx context.Context, host string) (OperationalMod
 	return operationalModeFromString(resp.Payload), nil
 }
 
+// Load returns the size of data stored by the node, in bytes.
+func (c *Client) Load(ctx context.Context, host string) (int64, error) {
+	resp, err := c.scyllaClient.Operations.StorageServiceLoadGet(&scyllaoperations.StorageServiceLoadGetParams{Context: forceHost(ctx, host)})
+	if err != nil {
+		return 0, err
+	}
+
+	load, err := numberToInt64(resp.GetPayload())
+	if err != nil {
+		return 0, fmt.Errorf("can't parse load: %w", err)
+	}
+
+	return load, nil
+}
+
+// ReceivedStreamingBytes returns the number of bytes the node has received in its active streaming sessions.
+func (c *Client) ReceivedStreamingBytes(ctx context.Context, host string) (int64, error) {
+	resp, err := c.scyllaClient.Operations.StreamManagerGet(&scyllaoperations.StreamManagerGetParams{Context: forceHost(ctx, host)})
+	if err != nil {
+		return 0, err
+	}
+
+	var receivedBytes int64
+	for _, state := range resp.GetPayload() {
+		for _, session := range state.Sessions {
+			for _, file := range session.ReceivingFiles {
+				if file.Value == nil {
+					continue
+				}
+
+				currentBytes, err := numberToInt64(file.Value.CurrentBytes)
+				if err != nil {
+					return 0, fmt.Errorf("can't parse current bytes of file %q: %w", file.Value.FileName, err)
+				}
+				receivedBytes += currentBytes
+			}
+		}
+	}
+
+	return receivedBytes, nil
+}
+
+// numberToInt64 converts a number decoded from an untyped JSON payload.
+func numberToInt64(v any) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("can't parse number %q: %w", n, err)
+		}
+		return int64(f), nil
+
+	case float64:
+		return int64(n), nil
+
+	case nil:
+		return 0, nil
+
+	default:
+		return 0, fmt.Errorf("unexpected number type %T", n)
+	}
+}
+
 func (c *Client) IsNativeTransportEnabled(ctx context.Context, host string) (bool, error) {
 	resp, err := c.scyllaClient.Operations.StorageServiceNativeTransportGet(&scyllaoperations.StorageServiceNativeTransportGetParams{Context: forceHost(ctx, host)})
 	if err != nil {
@@ -123,6 +123,14 @@ const (
 	CleanupCompactionType CompactionType = "CLEANUP"
 )
 
+type RepairStatus string
+
+const (
+	RepairStatusRunning    RepairStatus = "RUNNING"
+	RepairStatusSuccessful RepairStatus = "SUCCESSFUL"
+	RepairStatusFailed     RepairStatus = "FAILED"
+)
+
 // NodeStatusAndStateInfo represents a node's status and state (like in nodetool status).
 type NodeStatusAndStateInfo struct {
 	NodeStatusInfo
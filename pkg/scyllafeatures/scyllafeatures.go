@@ -18,6 +18,8 @@ type ScyllaFeature string
 
 const (
 	ReplacingNodeUsingHostID ScyllaFeature = "ReplacingNodeUsingHostID"
+	StreamIOThroughput       ScyllaFeature = "StreamIOThroughput"
+	Tablets                  ScyllaFeature = "Tablets"
 )
 
 type scyllaDBVersionMinimalConstraint struct {
@@ -30,6 +32,14 @@ var featureMinimalVersionConstraints = map[ScyllaFeature]scyllaDBVersionMinimalC
 		openSource: semver.MustParse("5.2.0"),
 		enterprise: semver.MustParse("2023.1.0"),
 	},
+	StreamIOThroughput: {
+		openSource: semver.MustParse("5.1.0"),
+		enterprise: semver.MustParse("2022.2.0"),
+	},
+	Tablets: {
+		openSource: semver.MustParse("6.0.0"),
+		enterprise: semver.MustParse("2024.2.0"),
+	},
 }
 
 func VersionSupports(image string, feature ScyllaFeature) (bool, error) {
@@ -36,18 +36,20 @@ const (
 )
 
 type ScyllaConfig struct {
-	member        *identity.Member
-	kubeClient    kubernetes.Interface
-	cpuCount      int
-	externalSeeds []string
+	member             *identity.Member
+	kubeClient         kubernetes.Interface
+	cpuCount           int
+	externalSeeds      []string
+	scyllaYAMLReloader *ScyllaYAMLReloader
 }
 
-func NewScyllaConfig(m *identity.Member, kubeClient kubernetes.Interface, cpuCount int, externalSeeds []string) *ScyllaConfig {
+func NewScyllaConfig(m *identity.Member, kubeClient kubernetes.Interface, cpuCount int, externalSeeds []string, scyllaYAMLReloader *ScyllaYAMLReloader) *ScyllaConfig {
 	return &ScyllaConfig{
-		member:        m,
-		kubeClient:    kubeClient,
-		cpuCount:      cpuCount,
-		externalSeeds: externalSeeds,
+		member:             m,
+		kubeClient:         kubeClient,
+		cpuCount:           cpuCount,
+		externalSeeds:      externalSeeds,
+		scyllaYAMLReloader: scyllaYAMLReloader,
 	}
 }
 
@@ -58,7 +60,16 @@ func (s *ScyllaConfig) Setup(ctx context.Context) (*exec.Cmd, error) {
 	}
 
 	klog.Info("Setting up scylla.yaml")
-	if err := s.setupScyllaYAML(scyllaYAMLPath, naming.ScyllaManagedConfigPath, scyllaYAMLConfigMapPath); err != nil {
+	memberConfigOverrides, err := makeMemberConfigOverrides(s.member)
+	if err != nil {
+		return nil, fmt.Errorf("can't make member config overrides: %w", err)
+	}
+	if err := s.setupScyllaYAML(scyllaYAMLPath, scyllaYAMLSources{
+		managedConfigMapPath:  naming.ScyllaManagedConfigPath,
+		configMapPath:         scyllaYAMLConfigMapPath,
+		typedConfigPath:       naming.ScyllaDBConfigPath,
+		memberConfigOverrides: memberConfigOverrides,
+	}); err != nil {
 		return nil, fmt.Errorf("can't setup scylla.yaml: %w", err)
 	}
 
@@ -82,36 +93,87 @@ func (s *ScyllaConfig) Setup(ctx context.Context) (*exec.Cmd, error) {
 // - cluster_name
 // - rpc_address
 // - endpoint_snitch
-func (s *ScyllaConfig) setupScyllaYAML(configFilePath, managedConfigMapPath, configMapPath string) error {
+func (s *ScyllaConfig) setupScyllaYAML(configFilePath string, sources scyllaYAMLSources) error {
 	// Read default scylla.yaml
 	configFileBytes, err := os.ReadFile(configFilePath)
 	if err != nil {
 		return fmt.Errorf("can't read file %q: %w", configFilePath, err)
 	}
 
-	operatorConfigOverrides, err := os.ReadFile(managedConfigMapPath)
+	desiredConfigBytes, err := makeScyllaYAML(configFileBytes, sources)
+	if err != nil {
+		return err
+	}
+
+	// Write result to file
+	err = os.WriteFile(configFilePath, desiredConfigBytes, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("can't write file %q: %w", configFilePath, err)
+	}
+
+	if s.scyllaYAMLReloader != nil {
+		s.scyllaYAMLReloader.initialize(configFilePath, sources, configFileBytes, desiredConfigBytes)
+	}
+
+	return nil
+}
+
+// scyllaYAMLSources holds paths of the configs that are merged on top of the default scylla.yaml.
+type scyllaYAMLSources struct {
+	managedConfigMapPath string
+	configMapPath        string
+	typedConfigPath      string
+	// memberConfigOverrides holds options specific to the member, which take precedence over all configs.
+	memberConfigOverrides []byte
+}
+
+// makeMemberConfigOverrides returns scylla.yaml options that are specific to the member.
+func makeMemberConfigOverrides(m *identity.Member) ([]byte, error) {
+	overrides := map[string]any{}
+
+	if m.ShardAwareNativeTransportPort != nil {
+		overrides["native_shard_aware_transport_port"] = *m.ShardAwareNativeTransportPort
+	}
+
+	if m.ShardAwareNativeTransportPortSSL != nil {
+		overrides["native_shard_aware_transport_port_ssl"] = *m.ShardAwareNativeTransportPortSSL
+	}
+
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	return yaml.Marshal(overrides)
+}
+
+// makeScyllaYAML merges the configs on top of the default scylla.yaml.
+func makeScyllaYAML(defaultConfigBytes []byte, sources scyllaYAMLSources) ([]byte, error) {
+	operatorConfigOverrides, err := os.ReadFile(sources.managedConfigMapPath)
 	if err != nil {
-		return fmt.Errorf("can't make scylladb config overrides: %w", err)
+		return nil, fmt.Errorf("can't make scylladb config overrides: %w", err)
 	}
 
 	// Read config map scylla.yaml
-	configMapBytes, err := os.ReadFile(configMapPath)
+	configMapBytes, err := os.ReadFile(sources.configMapPath)
 	if err != nil {
-		klog.InfoS("no scylla.yaml config map available")
+		klog.V(4).InfoS("no scylla.yaml config map available")
 	}
 
-	desiredConfigBytes, err := mergeYAMLs(configFileBytes, operatorConfigOverrides, configMapBytes)
+	// Read typed config, which is validated and so takes precedence over the config map.
+	typedConfigBytes, err := os.ReadFile(sources.typedConfigPath)
 	if err != nil {
-		return fmt.Errorf("can't merge scylladb configs: %w", err)
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("can't read file %q: %w", sources.typedConfigPath, err)
+		}
+		klog.V(4).InfoS("no typed scylladb config available")
 	}
 
-	// Write result to file
-	err = os.WriteFile(configFilePath, desiredConfigBytes, os.ModePerm)
+	desiredConfigBytes, err := mergeYAMLs(defaultConfigBytes, operatorConfigOverrides, configMapBytes, typedConfigBytes, sources.memberConfigOverrides)
 	if err != nil {
-		return fmt.Errorf("can't write file %q: %w", configFilePath, err)
+		return nil, fmt.Errorf("can't merge scylladb configs: %w", err)
 	}
 
-	return nil
+	return desiredConfigBytes, nil
 }
 
 // Operator reconciles only three out of four possible settings in snitch config taking values from an API object.
@@ -194,11 +256,6 @@ func (s *ScyllaConfig) setupEntrypoint(ctx context.Context) (*exec.Cmd, error) {
 		return nil, fmt.Errorf("can't get seeds: %w", err)
 	}
 
-	overprovisioned := "0"
-	if m.Overprovisioned {
-		overprovisioned = "1"
-	}
-
 	cpusAllowed, err := getCPUsAllowedList("/proc/1/status")
 	if err != nil {
 		return nil, fmt.Errorf("can't get cpus allowed list: %w", err)
@@ -207,7 +264,43 @@ func (s *ScyllaConfig) setupEntrypoint(ctx context.Context) (*exec.Cmd, error) {
 		return nil, fmt.Errorf("can't validate cpu set: %w", err)
 	}
 
-	isBroadcastIPv6 := m.IPFamily == corev1.IPv6Protocol
+	args := makeScyllaDBArguments(m, seeds, s.cpuCount, cpusAllowed)
+
+	if _, err := os.Stat(scyllaIOPropertiesPath); err == nil {
+		klog.InfoS("Scylla IO properties are already set, skipping io tuning")
+		args["io-setup"] = pointer.Ptr("0")
+		args["io-properties-file"] = pointer.Ptr(scyllaIOPropertiesPath)
+	}
+
+	var argsList []string
+	for key, value := range args {
+		if value == nil {
+			argsList = append(argsList, fmt.Sprintf("--%s", key))
+		} else {
+			argsList = append(argsList, fmt.Sprintf("--%s=%s", key, *value))
+		}
+	}
+
+	scyllaCmd := exec.Command(entrypointPath, argsList...)
+	scyllaCmd.Stderr = os.Stderr
+	scyllaCmd.Stdout = os.Stdout
+	klog.InfoS("Scylla entrypoint", "Command", scyllaCmd)
+
+	return scyllaCmd, nil
+}
+
+// makeScyllaDBArguments returns ScyllaDB entrypoint arguments of the member, merged with the user-provided ones.
+func makeScyllaDBArguments(m *identity.Member, seeds []string, cpuCount int, cpusAllowed string) map[string]*string {
+	overprovisioned := "0"
+	if m.Overprovisioned {
+		overprovisioned = "1"
+	}
+
+	// Nodes and clients may broadcast addresses of different IP families in dual-stack clusters,
+	// so listen and rpc addresses are chosen separately.
+	isNodesBroadcastIPv6 := m.NodesIPFamily == corev1.IPv6Protocol
+	isClientsBroadcastIPv6 := m.ClientsIPFamily == corev1.IPv6Protocol
+	isBroadcastIPv6 := isNodesBroadcastIPv6 || isClientsBroadcastIPv6
 
 	prometheusAddress := "0.0.0.0"
 	if isBroadcastIPv6 {
@@ -217,14 +310,14 @@ func (s *ScyllaConfig) setupEntrypoint(ctx context.Context) (*exec.Cmd, error) {
 	args := map[string]*string{
 		"seeds":                 pointer.Ptr(strings.Join(seeds, ",")),
 		"overprovisioned":       &overprovisioned,
-		"smp":                   pointer.Ptr(strconv.Itoa(s.cpuCount)),
+		"smp":                   pointer.Ptr(strconv.Itoa(cpuCount)),
 		"prometheus-address":    &prometheusAddress,
 		"broadcast-address":     &m.BroadcastAddress,
 		"broadcast-rpc-address": &m.BroadcastRPCAddress,
 		"cpuset":                &cpusAllowed,
 	}
 
-	if !isBroadcastIPv6 {
+	if !isNodesBroadcastIPv6 {
 		listenAddress := "0.0.0.0"
 		args["listen-address"] = &listenAddress
 	}
@@ -242,42 +335,30 @@ func (s *ScyllaConfig) setupEntrypoint(ctx context.Context) (*exec.Cmd, error) {
 		args = mergeArguments(args, userArgs)
 	}
 
-	if isBroadcastIPv6 {
-		if _, hasRpcAddress := args["rpc-address"]; !hasRpcAddress {
+	if _, hasRpcAddress := args["rpc-address"]; !hasRpcAddress {
+		if isClientsBroadcastIPv6 {
 			args["rpc-address"] = pointer.Ptr("::")
 			klog.Infof("Using default IPv6 rpc-address: ::")
+		} else if isNodesBroadcastIPv6 {
+			// The entrypoint would otherwise default rpc-address to the IPv6 listen-address.
+			args["rpc-address"] = pointer.Ptr("0.0.0.0")
+			klog.Infof("Using default IPv4 rpc-address: 0.0.0.0")
 		}
+	}
 
+	if isNodesBroadcastIPv6 {
 		if _, hasListenAddress := args["listen-address"]; !hasListenAddress {
 			args["listen-address"] = pointer.Ptr("::")
 			klog.Infof("Using default IPv6 listen-address: ::")
 		}
+	}
 
+	if isBroadcastIPv6 {
 		args["enable-ipv6-dns-lookup"] = pointer.Ptr("1")
 		klog.Info("Enabling IPv6 DNS lookup due to cluster IPv6 IPFamily")
 	}
 
-	if _, err := os.Stat(scyllaIOPropertiesPath); err == nil {
-		klog.InfoS("Scylla IO properties are already set, skipping io tuning")
-		args["io-setup"] = pointer.Ptr("0")
-		args["io-properties-file"] = pointer.Ptr(scyllaIOPropertiesPath)
-	}
-
-	var argsList []string
-	for key, value := range args {
-		if value == nil {
-			argsList = append(argsList, fmt.Sprintf("--%s", key))
-		} else {
-			argsList = append(argsList, fmt.Sprintf("--%s=%s", key, *value))
-		}
-	}
-
-	scyllaCmd := exec.Command(entrypointPath, argsList...)
-	scyllaCmd.Stderr = os.Stderr
-	scyllaCmd.Stdout = os.Stdout
-	klog.InfoS("Scylla entrypoint", "Command", scyllaCmd)
-
-	return scyllaCmd, nil
+	return args
 }
 
 func (s *ScyllaConfig) validateCpuSet(ctx context.Context, cpusAllowed string, shards int) error {
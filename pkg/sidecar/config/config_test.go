@@ -11,6 +11,8 @@ import (
 	"github.com/magiconair/properties"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/sidecar/identity"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
@@ -267,6 +269,166 @@ key:
 	}
 }
 
+func TestMakeMemberConfigOverrides(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		member   *identity.Member
+		expected []byte
+	}{
+		{
+			name:     "no overrides when member uses default ports",
+			member:   &identity.Member{},
+			expected: nil,
+		},
+		{
+			name: "shard-aware ports allocated to member",
+			member: &identity.Member{
+				ShardAwareNativeTransportPort:    pointer.Ptr[int32](30002),
+				ShardAwareNativeTransportPortSSL: pointer.Ptr[int32](30003),
+			},
+			expected: []byte(`
+native_shard_aware_transport_port: 30002
+native_shard_aware_transport_port_ssl: 30003
+`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeMemberConfigOverrides(tc.member)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.expected != nil {
+				tc.expected = []byte(strings.TrimPrefix(string(tc.expected), "\n"))
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and actual data differ: %s", cmp.Diff(string(tc.expected), string(got)))
+			}
+		})
+	}
+}
+
+func TestMakeScyllaDBArguments(t *testing.T) {
+	t.Parallel()
+
+	newMember := func(nodesIPFamily, clientsIPFamily corev1.IPFamily, broadcastAddress, broadcastRPCAddress string) *identity.Member {
+		return &identity.Member{
+			Overprovisioned:     true,
+			BroadcastAddress:    broadcastAddress,
+			BroadcastRPCAddress: broadcastRPCAddress,
+			NodesIPFamily:       nodesIPFamily,
+			ClientsIPFamily:     clientsIPFamily,
+		}
+	}
+
+	tt := []struct {
+		name         string
+		member       *identity.Member
+		expectedArgs map[string]*string
+	}{
+		{
+			name:   "IPv4 nodes and clients",
+			member: newMember(corev1.IPv4Protocol, corev1.IPv4Protocol, "10.0.0.1", "10.0.0.2"),
+			expectedArgs: map[string]*string{
+				"seeds":                 pointer.Ptr("10.0.0.3"),
+				"overprovisioned":       pointer.Ptr("1"),
+				"smp":                   pointer.Ptr("2"),
+				"prometheus-address":    pointer.Ptr("0.0.0.0"),
+				"broadcast-address":     pointer.Ptr("10.0.0.1"),
+				"broadcast-rpc-address": pointer.Ptr("10.0.0.2"),
+				"cpuset":                pointer.Ptr("0-1"),
+				"listen-address":        pointer.Ptr("0.0.0.0"),
+			},
+		},
+		{
+			name:   "IPv6 nodes and clients",
+			member: newMember(corev1.IPv6Protocol, corev1.IPv6Protocol, "fd00::1", "fd00::2"),
+			expectedArgs: map[string]*string{
+				"seeds":                  pointer.Ptr("10.0.0.3"),
+				"overprovisioned":        pointer.Ptr("1"),
+				"smp":                    pointer.Ptr("2"),
+				"prometheus-address":     pointer.Ptr("::"),
+				"broadcast-address":      pointer.Ptr("fd00::1"),
+				"broadcast-rpc-address":  pointer.Ptr("fd00::2"),
+				"cpuset":                 pointer.Ptr("0-1"),
+				"listen-address":         pointer.Ptr("::"),
+				"rpc-address":            pointer.Ptr("::"),
+				"enable-ipv6-dns-lookup": pointer.Ptr("1"),
+			},
+		},
+		{
+			name:   "IPv6 nodes and IPv4 clients",
+			member: newMember(corev1.IPv6Protocol, corev1.IPv4Protocol, "fd00::1", "10.0.0.2"),
+			expectedArgs: map[string]*string{
+				"seeds":                  pointer.Ptr("10.0.0.3"),
+				"overprovisioned":        pointer.Ptr("1"),
+				"smp":                    pointer.Ptr("2"),
+				"prometheus-address":     pointer.Ptr("::"),
+				"broadcast-address":      pointer.Ptr("fd00::1"),
+				"broadcast-rpc-address":  pointer.Ptr("10.0.0.2"),
+				"cpuset":                 pointer.Ptr("0-1"),
+				"listen-address":         pointer.Ptr("::"),
+				"rpc-address":            pointer.Ptr("0.0.0.0"),
+				"enable-ipv6-dns-lookup": pointer.Ptr("1"),
+			},
+		},
+		{
+			name:   "IPv4 nodes and IPv6 clients",
+			member: newMember(corev1.IPv4Protocol, corev1.IPv6Protocol, "10.0.0.1", "fd00::2"),
+			expectedArgs: map[string]*string{
+				"seeds":                  pointer.Ptr("10.0.0.3"),
+				"overprovisioned":        pointer.Ptr("1"),
+				"smp":                    pointer.Ptr("2"),
+				"prometheus-address":     pointer.Ptr("::"),
+				"broadcast-address":      pointer.Ptr("10.0.0.1"),
+				"broadcast-rpc-address":  pointer.Ptr("fd00::2"),
+				"cpuset":                 pointer.Ptr("0-1"),
+				"listen-address":         pointer.Ptr("0.0.0.0"),
+				"rpc-address":            pointer.Ptr("::"),
+				"enable-ipv6-dns-lookup": pointer.Ptr("1"),
+			},
+		},
+		{
+			name: "user-provided rpc-address is preferred over the default for IPv6 nodes and IPv4 clients",
+			member: func() *identity.Member {
+				m := newMember(corev1.IPv6Protocol, corev1.IPv4Protocol, "fd00::1", "10.0.0.2")
+				m.AdditionalScyllaDBArguments = []string{"--rpc-address=10.0.0.2"}
+				return m
+			}(),
+			expectedArgs: map[string]*string{
+				"seeds":                  pointer.Ptr("10.0.0.3"),
+				"overprovisioned":        pointer.Ptr("1"),
+				"smp":                    pointer.Ptr("2"),
+				"prometheus-address":     pointer.Ptr("::"),
+				"broadcast-address":      pointer.Ptr("fd00::1"),
+				"broadcast-rpc-address":  pointer.Ptr("10.0.0.2"),
+				"cpuset":                 pointer.Ptr("0-1"),
+				"listen-address":         pointer.Ptr("::"),
+				"rpc-address":            pointer.Ptr("10.0.0.2"),
+				"enable-ipv6-dns-lookup": pointer.Ptr("1"),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeScyllaDBArguments(tc.member, []string{"10.0.0.3"}, 2, "0-1")
+			if !reflect.DeepEqual(got, tc.expectedArgs) {
+				t.Errorf("expected and actual arguments differ: %s", cmp.Diff(tc.expectedArgs, got))
+			}
+		})
+	}
+}
+
 func TestAllowedCPUs(t *testing.T) {
 	cpusAllowed, err := getCPUsAllowedList("./procstatus")
 	if err != nil {
@@ -0,0 +1,153 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// ScyllaYAMLReloader keeps scylla.yaml in sync with the mounted configs while ScyllaDB is running.
+// Live-updatable options are applied in place by making ScyllaDB reload its config,
+// the remaining ones are reported as requiring a restart.
+type ScyllaYAMLReloader struct {
+	lock sync.Mutex
+
+	configFilePath string
+	sources        scyllaYAMLSources
+
+	// defaultConfig holds the default scylla.yaml shipped with the image.
+	defaultConfig []byte
+	// startupConfig holds scylla.yaml that ScyllaDB was started with.
+	startupConfig []byte
+	// appliedConfig holds scylla.yaml that ScyllaDB has last loaded.
+	appliedConfig []byte
+
+	reloadScyllaDBConfigFunc func(ctx context.Context) error
+}
+
+func NewScyllaYAMLReloader() *ScyllaYAMLReloader {
+	return &ScyllaYAMLReloader{
+		reloadScyllaDBConfigFunc: reloadScyllaDBConfig,
+	}
+}
+
+func (r *ScyllaYAMLReloader) initialize(configFilePath string, sources scyllaYAMLSources, defaultConfig, startupConfig []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.configFilePath = configFilePath
+	r.sources = sources
+	r.defaultConfig = defaultConfig
+	r.startupConfig = startupConfig
+	r.appliedConfig = startupConfig
+}
+
+// Reload makes ScyllaDB load the live-updatable options that changed in the mounted configs.
+// It returns the sorted names of options that differ from the ones ScyllaDB was started with and require a restart to take effect.
+func (r *ScyllaYAMLReloader) Reload(ctx context.Context) ([]string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.startupConfig == nil {
+		return nil, fmt.Errorf("scylla.yaml hasn't been set up yet")
+	}
+
+	desiredConfig, err := makeScyllaYAML(r.defaultConfig, r.sources)
+	if err != nil {
+		return nil, fmt.Errorf("can't make scylla.yaml: %w", err)
+	}
+
+	if !bytes.Equal(desiredConfig, r.appliedConfig) {
+		changedOptions, err := diffScyllaYAMLOptions(r.appliedConfig, desiredConfig)
+		if err != nil {
+			return nil, fmt.Errorf("can't diff scylla.yaml options: %w", err)
+		}
+
+		err = os.WriteFile(r.configFilePath, desiredConfig, os.ModePerm)
+		if err != nil {
+			return nil, fmt.Errorf("can't write file %q: %w", r.configFilePath, err)
+		}
+
+		liveUpdatableOptions := slices.DeleteFunc(slices.Clone(changedOptions), func(option string) bool {
+			return !helpers.IsScyllaDBConfigOptionLiveUpdatable(option)
+		})
+		if len(liveUpdatableOptions) != 0 {
+			klog.InfoS("Reloading ScyllaDB config", "LiveUpdatableOptions", liveUpdatableOptions)
+			err = r.reloadScyllaDBConfigFunc(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("can't reload ScyllaDB config: %w", err)
+			}
+		}
+
+		r.appliedConfig = desiredConfig
+	}
+
+	pendingOptions, err := diffScyllaYAMLOptions(r.startupConfig, desiredConfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't diff scylla.yaml options: %w", err)
+	}
+
+	var restartRequiredOptions []string
+	for _, option := range pendingOptions {
+		if !helpers.IsScyllaDBConfigOptionLiveUpdatable(option) {
+			restartRequiredOptions = append(restartRequiredOptions, option)
+		}
+	}
+
+	return restartRequiredOptions, nil
+}
+
+// diffScyllaYAMLOptions returns the sorted names of top level options which values differ between the configs.
+func diffScyllaYAMLOptions(oldConfig, newConfig []byte) ([]string, error) {
+	var oldOptions, newOptions map[string]any
+
+	err := yaml.Unmarshal(oldConfig, &oldOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal old config: %w", err)
+	}
+
+	err = yaml.Unmarshal(newConfig, &newOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal new config: %w", err)
+	}
+
+	var changedOptions []string
+	for option, oldValue := range oldOptions {
+		newValue, ok := newOptions[option]
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			changedOptions = append(changedOptions, option)
+		}
+	}
+
+	for option := range newOptions {
+		_, ok := oldOptions[option]
+		if !ok {
+			changedOptions = append(changedOptions, option)
+		}
+	}
+
+	slices.Sort(changedOptions)
+
+	return changedOptions, nil
+}
+
+// reloadScyllaDBConfig makes ScyllaDB re-read scylla.yaml and apply the live-updatable options.
+func reloadScyllaDBConfig(ctx context.Context) error {
+	out, err := exec.CommandContext(ctx, "supervisorctl", "signal", "HUP", "scylla").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("can't send SIGHUP to scylla: %w, output: %q", err, out)
+	}
+
+	return nil
+}
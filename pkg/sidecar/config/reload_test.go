@@ -0,0 +1,181 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffScyllaYAMLOptions(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		oldConfig       string
+		newConfig       string
+		expectedOptions []string
+	}{
+		{
+			name:            "no changes",
+			oldConfig:       "cluster_name: foo\nread_request_timeout_in_ms: 5000\n",
+			newConfig:       "read_request_timeout_in_ms: 5000\ncluster_name: foo\n",
+			expectedOptions: nil,
+		},
+		{
+			name:            "changed, added and removed options",
+			oldConfig:       "cluster_name: foo\nread_request_timeout_in_ms: 5000\nenable_tablets: true\n",
+			newConfig:       "cluster_name: foo\nread_request_timeout_in_ms: 1000\ncommitlog_segment_size_in_mb: 64\n",
+			expectedOptions: []string{"commitlog_segment_size_in_mb", "enable_tablets", "read_request_timeout_in_ms"},
+		},
+		{
+			name:            "nested values are compared deeply",
+			oldConfig:       "client_encryption_options:\n  enabled: true\n",
+			newConfig:       "client_encryption_options:\n  enabled: false\n",
+			expectedOptions: []string{"client_encryption_options"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := diffScyllaYAMLOptions([]byte(tc.oldConfig), []byte(tc.newConfig))
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expectedOptions) {
+				t.Errorf("expected and got options differ:\n%s", cmp.Diff(tc.expectedOptions, got))
+			}
+		})
+	}
+}
+
+func TestScyllaYAMLReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	const (
+		defaultConfig = "cluster_name: default\ncompaction_throughput_mb_per_sec: 0\n"
+		managedConfig = "cluster_name: foo\n"
+	)
+
+	tt := []struct {
+		name                           string
+		initialTypedConfig             string
+		typedConfig                    string
+		expectedRestartRequiredOptions []string
+		expectedReloads                int
+		expectedConfig                 string
+	}{
+		{
+			name:                           "unchanged config isn't reloaded",
+			initialTypedConfig:             "compaction_throughput_mb_per_sec: 64\n",
+			typedConfig:                    "compaction_throughput_mb_per_sec: 64\n",
+			expectedRestartRequiredOptions: nil,
+			expectedReloads:                0,
+			expectedConfig: strings.TrimPrefix(`
+cluster_name: foo
+compaction_throughput_mb_per_sec: 64
+`, "\n"),
+		},
+		{
+			name:                           "live-updatable options are reloaded in place",
+			initialTypedConfig:             "compaction_throughput_mb_per_sec: 64\n",
+			typedConfig:                    "compaction_throughput_mb_per_sec: 128\nread_request_timeout_in_ms: 1000\n",
+			expectedRestartRequiredOptions: nil,
+			expectedReloads:                1,
+			expectedConfig: strings.TrimPrefix(`
+cluster_name: foo
+compaction_throughput_mb_per_sec: 128
+read_request_timeout_in_ms: 1000
+`, "\n"),
+		},
+		{
+			name:                           "options which aren't live-updatable are reported as requiring a restart",
+			initialTypedConfig:             "compaction_throughput_mb_per_sec: 64\n",
+			typedConfig:                    "compaction_throughput_mb_per_sec: 64\ncommitlog_segment_size_in_mb: 64\nenable_tablets: true\n",
+			expectedRestartRequiredOptions: []string{"commitlog_segment_size_in_mb", "enable_tablets"},
+			expectedReloads:                0,
+			expectedConfig: strings.TrimPrefix(`
+cluster_name: foo
+commitlog_segment_size_in_mb: 64
+compaction_throughput_mb_per_sec: 64
+enable_tablets: true
+`, "\n"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpDir := t.TempDir()
+
+			configFilePath := filepath.Join(tmpDir, "scylla.yaml")
+			sources := scyllaYAMLSources{
+				managedConfigMapPath: filepath.Join(tmpDir, "managed.yaml"),
+				configMapPath:        filepath.Join(tmpDir, "custom.yaml"),
+				typedConfigPath:      filepath.Join(tmpDir, "typed.yaml"),
+			}
+
+			writeFile := func(path, content string) {
+				t.Helper()
+
+				err := os.WriteFile(path, []byte(content), 0666)
+				if err != nil {
+					t.Fatalf("can't write file %q: %v", path, err)
+				}
+			}
+
+			writeFile(configFilePath, defaultConfig)
+			writeFile(sources.managedConfigMapPath, managedConfig)
+			writeFile(sources.typedConfigPath, tc.initialTypedConfig)
+
+			reloads := 0
+			reloader := NewScyllaYAMLReloader()
+			reloader.reloadScyllaDBConfigFunc = func(ctx context.Context) error {
+				reloads++
+				return nil
+			}
+
+			sc := &ScyllaConfig{
+				scyllaYAMLReloader: reloader,
+			}
+			err := sc.setupScyllaYAML(configFilePath, sources)
+			if err != nil {
+				t.Fatalf("can't set up scylla.yaml: %v", err)
+			}
+
+			writeFile(sources.typedConfigPath, tc.typedConfig)
+
+			restartRequiredOptions, err := reloader.Reload(context.Background())
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(restartRequiredOptions, tc.expectedRestartRequiredOptions) {
+				t.Errorf("expected and got restart required options differ:\n%s", cmp.Diff(tc.expectedRestartRequiredOptions, restartRequiredOptions))
+			}
+
+			if reloads != tc.expectedReloads {
+				t.Errorf("expected %d reloads, got %d", tc.expectedReloads, reloads)
+			}
+
+			gotConfig, err := os.ReadFile(configFilePath)
+			if err != nil {
+				t.Fatalf("can't read file %q: %v", configFilePath, err)
+			}
+
+			if string(gotConfig) != tc.expectedConfig {
+				t.Errorf("expected and got configs differ:\n%s", cmp.Diff(tc.expectedConfig, string(gotConfig)))
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -35,10 +36,18 @@ type Member struct {
 	AdditionalScyllaDBArguments []string
 
 	NodesBroadcastAddressType scyllav1alpha1.BroadcastAddressType
-	IPFamily                  corev1.IPFamily
+	// NodesIPFamily and ClientsIPFamily hold IP families of addresses broadcasted for communication
+	// with other nodes and clients respectively.
+	NodesIPFamily   corev1.IPFamily
+	ClientsIPFamily corev1.IPFamily
+
+	// ShardAwareNativeTransportPort and ShardAwareNativeTransportPortSSL hold shard-aware CQL ports
+	// allocated to the member by its Service. They are nil when default ports are used.
+	ShardAwareNativeTransportPort    *int32
+	ShardAwareNativeTransportPortSSL *int32
 }
 
-func NewMember(service *corev1.Service, pod *corev1.Pod, nodesAddressType, clientAddressType scyllav1alpha1.BroadcastAddressType, ipFamily corev1.IPFamily, additionalScyllaDBArguments []string) (*Member, error) {
+func NewMember(service *corev1.Service, pod *corev1.Pod, nodesAddressType, clientAddressType scyllav1alpha1.BroadcastAddressType, nodesIPFamily, clientsIPFamily corev1.IPFamily, additionalScyllaDBArguments []string) (*Member, error) {
 	rackOrdinalString, ok := pod.Labels[naming.RackOrdinalLabel]
 	if !ok {
 		return nil, fmt.Errorf("pod %q is missing %q label", naming.ObjRef(pod), naming.RackOrdinalLabel)
@@ -59,20 +68,38 @@ func NewMember(service *corev1.Service, pod *corev1.Pod, nodesAddressType, clien
 		PodID:                       string(pod.UID),
 		Overprovisioned:             pod.Status.QOSClass != corev1.PodQOSGuaranteed,
 		NodesBroadcastAddressType:   nodesAddressType,
-		IPFamily:                    ipFamily,
+		NodesIPFamily:               nodesIPFamily,
+		ClientsIPFamily:             clientsIPFamily,
 		AdditionalScyllaDBArguments: additionalScyllaDBArguments,
 	}
 
-	m.BroadcastAddress, err = controllerhelpers.GetScyllaBroadcastAddress(nodesAddressType, service, pod, &ipFamily)
+	m.BroadcastAddress, err = controllerhelpers.GetScyllaBroadcastAddress(nodesAddressType, service, pod, &nodesIPFamily)
 	if err != nil {
 		return nil, fmt.Errorf("can't get node broadcast address: %w", err)
 	}
 
-	m.BroadcastRPCAddress, err = controllerhelpers.GetScyllaBroadcastAddress(clientAddressType, service, pod, &ipFamily)
+	m.BroadcastRPCAddress, err = controllerhelpers.GetScyllaBroadcastAddress(clientAddressType, service, pod, &clientsIPFamily)
 	if err != nil {
 		return nil, fmt.Errorf("can't get client broadcast address: %w", err)
 	}
 
+	// NodePort Services route shard-aware ports to the allocated node ports, so ScyllaDB has to listen on them.
+	if service.Spec.Type == corev1.ServiceTypeNodePort {
+		for _, port := range service.Spec.Ports {
+			targetPort := port.Port
+			if port.TargetPort.IntValue() != 0 {
+				targetPort = int32(port.TargetPort.IntValue())
+			}
+
+			switch port.Name {
+			case naming.CQLShardAwarePortName:
+				m.ShardAwareNativeTransportPort = pointer.Ptr(targetPort)
+			case naming.CQLSSLShardAwarePortName:
+				m.ShardAwareNativeTransportPortSSL = pointer.Ptr(targetPort)
+			}
+		}
+	}
+
 	return m, nil
 }
 
@@ -142,7 +169,7 @@ func (m *Member) GetSeeds(ctx context.Context, coreClient v1.CoreV1Interface, ex
 	res = append(res, externalSeeds...)
 
 	// Assume nodes share broadcast address type and IP family and they are immutable.
-	localSeed, err := controllerhelpers.GetScyllaBroadcastAddress(m.NodesBroadcastAddressType, svc, pod, &m.IPFamily)
+	localSeed, err := controllerhelpers.GetScyllaBroadcastAddress(m.NodesBroadcastAddressType, svc, pod, &m.NodesIPFamily)
 	if err != nil {
 		return nil, fmt.Errorf("can't get node broadcast address for service %q: %w", naming.ObjRef(svc), err)
 	}
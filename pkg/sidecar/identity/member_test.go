@@ -60,9 +60,11 @@ func TestMember_GetSeeds(t *testing.T) {
 		memberClientsBroadcastType scyllav1alpha1.BroadcastAddressType
 		memberNodesBroadcastType   scyllav1alpha1.BroadcastAddressType
 		ipFamily                   corev1.IPFamily
+		clientsIPFamily            corev1.IPFamily
 		externalSeeds              []string
 		objects                    []runtime.Object
 		expectSeeds                []string
+		expectBroadcastRPCAddress  string
 		expectError                error
 	}{
 		{
@@ -533,6 +535,49 @@ func TestMember_GetSeeds(t *testing.T) {
 			},
 			expectSeeds: []string{"2001:db8::1"}, // Should use IPv6 from PodIPs[0]
 		},
+		{
+			name: "dual-stack with IPv6 nodes and IPv4 clients broadcast uses nodes IP family for seeds",
+			memberPod: func() *corev1.Pod {
+				pod := firstPod.DeepCopy()
+				pod.Status.PodIP = "192.168.1.1"
+				pod.Status.PodIPs = []corev1.PodIP{
+					{IP: "192.168.1.1"},
+					{IP: "2001:db8::1"},
+				}
+				return pod
+			}(),
+			memberService: func() *corev1.Service {
+				svc := firstService.DeepCopy()
+				svc.Spec.ClusterIP = "10.96.0.1"
+				svc.Spec.ClusterIPs = []string{"10.96.0.1", "fd00::1"}
+				svc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+				return svc
+			}(),
+			memberClientsBroadcastType: scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
+			memberNodesBroadcastType:   scyllav1alpha1.BroadcastAddressTypePodIP,
+			ipFamily:                   corev1.IPv6Protocol,
+			clientsIPFamily:            corev1.IPv4Protocol,
+			objects: []runtime.Object{
+				func() *corev1.Pod {
+					pod := firstPod.DeepCopy()
+					pod.Status.PodIP = "192.168.1.1"
+					pod.Status.PodIPs = []corev1.PodIP{
+						{IP: "192.168.1.1"},
+						{IP: "2001:db8::1"},
+					}
+					return pod
+				}(),
+				func() *corev1.Service {
+					svc := firstService.DeepCopy()
+					svc.Spec.ClusterIP = "10.96.0.1"
+					svc.Spec.ClusterIPs = []string{"10.96.0.1", "fd00::1"}
+					svc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+					return svc
+				}(),
+			},
+			expectSeeds:               []string{"2001:db8::1"},
+			expectBroadcastRPCAddress: "10.96.0.1",
+		},
 	}
 
 	for _, test := range ts {
@@ -542,11 +587,20 @@ func TestMember_GetSeeds(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			member, err := NewMember(test.memberService, test.memberPod, test.memberNodesBroadcastType, test.memberClientsBroadcastType, test.ipFamily, nil)
+			clientsIPFamily := test.ipFamily
+			if len(test.clientsIPFamily) != 0 {
+				clientsIPFamily = test.clientsIPFamily
+			}
+
+			member, err := NewMember(test.memberService, test.memberPod, test.memberNodesBroadcastType, test.memberClientsBroadcastType, test.ipFamily, clientsIPFamily, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
 
+			if len(test.expectBroadcastRPCAddress) != 0 && member.BroadcastRPCAddress != test.expectBroadcastRPCAddress {
+				t.Errorf("expected broadcast rpc address %q, got %q", test.expectBroadcastRPCAddress, member.BroadcastRPCAddress)
+			}
+
 			fakeClient := fake.NewSimpleClientset(test.objects...)
 			seeds, err := member.GetSeeds(ctx, fakeClient.CoreV1(), test.externalSeeds)
 			if !reflect.DeepEqual(err, test.expectError) {